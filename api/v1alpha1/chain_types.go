@@ -17,7 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // ChainSpec defines the desired state of a Chain — a declarative multi-knight task pipeline.
@@ -28,9 +30,30 @@ type ChainSpec struct {
 
 	// steps defines the ordered list of pipeline steps.
 	// Steps execute sequentially unless parallel grouping is used via `parallel`.
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:MinItems=1
-	Steps []ChainStep `json:"steps"`
+	// Required unless templateRef is set, in which case it must be empty.
+	// +optional
+	Steps []ChainStep `json:"steps,omitempty"`
+
+	// templateRef instantiates a ChainTemplate in the chain's namespace: its
+	// steps are used, and so are its onFailure and finally handlers when the
+	// chain sets none. Template edits apply from the next reconcile.
+	// +optional
+	TemplateRef *ChainTemplateRef `json:"templateRef,omitempty"`
+
+	// onFailure lists handler steps that run once the main steps have
+	// finished and the chain has failed (e.g. "notify the channel").
+	// They run alongside finally and can read main step results through
+	// templates, but may only depend on steps in the same list.
+	// +optional
+	OnFailure []ChainStep `json:"onFailure,omitempty"`
+
+	// finally lists handler steps that run once the main steps have
+	// finished, whatever the outcome (e.g. "clean up the scratch namespace").
+	// A handler step that fails without continueOnFailure fails the chain.
+	// When the chain-level timeout fires the running steps are aborted and
+	// the handlers run, within handlerTimeout.
+	// +optional
+	Finally []ChainStep `json:"finally,omitempty"`
 
 	// timeout is the overall chain timeout in seconds. The entire chain is failed if exceeded.
 	// +kubebuilder:default=600
@@ -39,11 +62,25 @@ type ChainSpec struct {
 	// +optional
 	Timeout int32 `json:"timeout,omitempty"`
 
+	// handlerTimeout is how long in seconds the onFailure and finally
+	// handlers may run once the chain has timed out. Handlers still
+	// running then are aborted.
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=86400
+	// +optional
+	HandlerTimeout int32 `json:"handlerTimeout,omitempty"`
+
 	// schedule is an optional cron expression to trigger this chain on a recurring basis.
 	// Uses standard cron syntax (e.g., "0 */6 * * *").
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
 
+	// timeZone is the IANA time zone name the schedule is interpreted in
+	// (e.g. "Europe/London"). Defaults to the operator's local time zone.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
 	// startingDeadlineSeconds bounds catch-up of missed scheduled runs.
 	// If the controller was down when a scheduled run should have fired, the
 	// run is triggered late only if fewer than this many seconds have passed
@@ -53,10 +90,40 @@ type ChainSpec struct {
 	// +optional
 	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
 
+	// triggers starts runs in response to events, in addition to schedule
+	// and manual triggers. Events arriving while a run is in progress are
+	// handled once it finishes.
+	// +optional
+	Triggers *ChainTriggers `json:"triggers,omitempty"`
+
+	// parameters declares typed values for each run, read by step tasks and
+	// notification messages as {{ .Params.name }}. Values come from the
+	// parameters annotation when a run is triggered; a run missing a
+	// required parameter, or given a value of the wrong type, is rejected.
+	// Parameters of the template named by templateRef are added to these.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Parameters []ChainParameter `json:"parameters,omitempty"`
+
 	// input provides initial data passed to the first step(s) as JSON.
 	// +optional
 	Input string `json:"input,omitempty"`
 
+	// inputFrom reads the input from a ConfigMap or Secret key in the
+	// chain's namespace instead, for inputs too large or too sensitive to
+	// live in the Chain spec (target lists, API tokens for context). The key
+	// is read whenever a template is rendered and never copied into status.
+	// Mutually exclusive with input.
+	// +optional
+	InputFrom *ChainInputSource `json:"inputFrom,omitempty"`
+
+	// outputPolicy controls how much of each step's output is kept in
+	// status and where the rest goes. Defaults to keeping 4000 bytes and
+	// offloading the full output to the artifact store.
+	// +optional
+	OutputPolicy *OutputPolicy `json:"outputPolicy,omitempty"`
+
 	// outputKnight is the knight responsible for writing chain artifacts when steps have outputPath set.
 	// Defaults to "gawain" if not specified.
 	// +kubebuilder:default="gawain"
@@ -73,6 +140,48 @@ type ChainSpec struct {
 	// +optional
 	Suspended bool `json:"suspended,omitempty"`
 
+	// dryRun, if true, never publishes tasks. The controller validates the
+	// chain, resolves each step's knight, renders every task with placeholder
+	// step outputs, and records the would-be tasks in status.dryRun. Triggers
+	// are ignored while set; a run already dispatching is allowed to finish.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// priority is sent with every task the chain dispatches so knights can
+	// order their local queue; higher runs first. Steps may override it.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// costBudgetUSD is the maximum cost of a single run, summed from the
+	// cost knights report with each result (retries included). When a run
+	// exceeds it, the remaining steps are skipped and the run fails.
+	// "0" or unset means unlimited.
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	// +optional
+	CostBudgetUSD string `json:"costBudgetUSD,omitempty"`
+
+	// successfulRunsHistoryLimit is the number of succeeded (or partially
+	// succeeded) runs kept in status.runHistory.
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuccessfulRunsHistoryLimit *int32 `json:"successfulRunsHistoryLimit,omitempty"`
+
+	// failedRunsHistoryLimit is the number of failed runs kept in
+	// status.runHistory.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedRunsHistoryLimit *int32 `json:"failedRunsHistoryLimit,omitempty"`
+
+	// runHistoryTTLSeconds drops run records that finished longer ago than
+	// this, regardless of the limits. Records are pruned when a run finishes.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RunHistoryTTLSeconds *int32 `json:"runHistoryTTLSeconds,omitempty"`
+
 	// retryPolicy configures retry behavior for failed steps.
 	// +optional
 	RetryPolicy *ChainRetryPolicy `json:"retryPolicy,omitempty"`
@@ -87,6 +196,98 @@ type ChainSpec struct {
 	// PartiallySucceeded).
 	// +optional
 	Notify *NotifySpec `json:"notify,omitempty"`
+
+	// notifications post a summary of each finished run to Slack, Discord,
+	// or a generic webhook, so failures are visible without inspecting the
+	// Chain.
+	// +optional
+	Notifications []ChainNotification `json:"notifications,omitempty"`
+}
+
+// ChainInputSource selects the key a chain's input is read from. Exactly one
+// of configMapKeyRef and secretKeyRef must be set.
+type ChainInputSource struct {
+	// configMapKeyRef selects a key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// secretKeyRef selects a key of a Secret.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// ChainTriggers configures event triggers for a Chain.
+type ChainTriggers struct {
+	// nats starts a run for each message published on a subject.
+	// +optional
+	NATS *NATSTrigger `json:"nats,omitempty"`
+
+	// chainRef starts a run when another chain completes a run successfully.
+	// +optional
+	ChainRef *ChainRefTrigger `json:"chainRef,omitempty"`
+}
+
+// ChainRefTrigger starts a run when an upstream Chain in the same namespace
+// finishes a run with phase Succeeded. The run's input ({{ .Input }}) is a
+// JSON object holding the upstream run's step outputs:
+// {"chain": "recon", "runId": "...", "outputs": {"scan": "..."}}.
+// Only upstream runs finishing after this chain was created trigger it.
+type ChainRefTrigger struct {
+	// name is the upstream Chain. Exactly one of name and selector is set.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// selector matches upstream Chains by label; a run of any of them
+	// triggers this chain.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// NATSTrigger starts a chain run for each matching message on a JetStream
+// subject, with the message payload as the run's input ({{ .Input }}).
+type NATSTrigger struct {
+	// subject is the subject (wildcards allowed) to consume, e.g.
+	// "alerts.security.>". It must be captured by a JetStream stream and
+	// start with one of the operator's NATS_TRIGGER_ALLOWED_SUBJECT_PREFIXES,
+	// or the trigger never fires.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Subject string `json:"subject"`
+
+	// stream is the JetStream stream capturing subject. Looked up from the
+	// subject if not set.
+	// +optional
+	Stream string `json:"stream,omitempty"`
+
+	// filter is a Go template evaluated per message; only messages for which
+	// it renders "true" start a run. It can read .Subject, .Data (the
+	// payload as a string), and .JSON (the payload parsed as JSON, if it
+	// is), e.g. {{ eq .JSON.severity "critical" }}. Other messages are
+	// dropped.
+	// +optional
+	Filter string `json:"filter,omitempty"`
+}
+
+// NATSTriggerConsumer records the durable consumer a NATS trigger reads
+// from.
+type NATSTriggerConsumer struct {
+	// stream is the JetStream stream holding the consumer.
+	Stream string `json:"stream"`
+
+	// subject is the consumer's filter subject.
+	Subject string `json:"subject"`
+}
+
+// ChainTemplateRef names a ChainTemplate and supplies its parameters.
+type ChainTemplateRef struct {
+	// name is the ChainTemplate name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// parameters are the values for the template's declared parameters.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
 }
 
 // ChainStep defines a single step in the pipeline.
@@ -96,12 +297,69 @@ type ChainStep struct {
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
 
+	// type selects how the step executes. "task" (the default) dispatches
+	// the task to knightRef over NATS. "approval" pauses the chain until a
+	// human approves or rejects the step by annotating the Chain with
+	// approval.ai.roundtable.io/<step>=approve|reject. "input" pauses the
+	// chain until a human writes the value into this step's status output
+	// (status subresource patch); downstream steps read it as
+	// {{ .Steps.<name>.Output }}.
+	// +kubebuilder:validation:Enum=task;approval;input
+	// +kubebuilder:default="task"
+	// +optional
+	Type ChainStepType `json:"type,omitempty"`
+
+	// executor selects what runs a task step. "knight" (the default)
+	// dispatches the task to a knight over NATS. "job" runs the container
+	// described by job as a Kubernetes Job, for deterministic work (e.g.
+	// nmap, terraform plan) that needs no LLM; its output becomes the step
+	// output. "http" sends the request described by http (e.g. to a
+	// ticketing system or scanner API); the response body becomes the step
+	// output. Ignored for approval and input steps.
+	// +kubebuilder:validation:Enum=knight;job;http
+	// +optional
+	Executor StepExecutor `json:"executor,omitempty"`
+
+	// job describes the container run by job executor steps.
+	// +optional
+	Job *JobExecutor `json:"job,omitempty"`
+
+	// http describes the request sent by http executor steps.
+	// +optional
+	HTTP *HTTPExecutor `json:"http,omitempty"`
+
 	// knightRef is the name of the Knight to execute this step.
-	// +kubebuilder:validation:Required
-	KnightRef string `json:"knightRef"`
+	// Task steps need knightRef, or knightSelector and/or domain instead;
+	// approval and input steps ignore all three.
+	// +optional
+	KnightRef string `json:"knightRef,omitempty"`
+
+	// knightSelector picks the knight at dispatch time from the Ready,
+	// unsuspended Knights in the chain's namespace matching these labels.
+	// Mutually exclusive with knightRef.
+	// +optional
+	KnightSelector *metav1.LabelSelector `json:"knightSelector,omitempty"`
+
+	// domain picks the knight at dispatch time from the Ready, unsuspended
+	// Knights with this spec.domain (combined with knightSelector if both
+	// are set). Mutually exclusive with knightRef.
+	// +optional
+	Domain string `json:"domain,omitempty"`
+
+	// routing chooses among the knights matched by knightSelector/domain.
+	// LeastLoaded (the default) picks the knight whose JetStream consumer has
+	// the fewest pending and unacknowledged tasks; RoundRobin rotates through
+	// them. Ignored when knightRef is set.
+	// +optional
+	Routing KnightRoutingStrategy `json:"routing,omitempty"`
 
 	// task is the task prompt or instruction to send to the knight.
-	// Supports Go template syntax with access to prior step outputs: {{ .Steps.step_name.Output }}
+	// For approval and input steps it is the message shown to the human;
+	// job steps receive it in the TASK environment variable.
+	// Supports Go template syntax with access to prior step outputs: {{ .Steps.step_name.Output }},
+	// run metadata ({{ .Run.ID }}, .Run.Chain, .Run.Namespace, .Run.TriggeredBy, .Run.StartedAt,
+	// {{ .Step.Name }}, {{ .Step.Attempt }}), and the sprig function library (except
+	// env/expandenv/getHostByName).
 	// +kubebuilder:validation:Required
 	Task string `json:"task"`
 
@@ -110,6 +368,18 @@ type ChainStep struct {
 	// +optional
 	DependsOn []string `json:"dependsOn,omitempty"`
 
+	// fanIn configures how the step merges the outputs of its dependencies.
+	// Every step's templates can read them as {{ .Deps.Outputs }}; fanIn
+	// additionally lets the step summarize them.
+	// +optional
+	FanIn *FanIn `json:"fanIn,omitempty"`
+
+	// context packs prior steps' outputs into the step's task, trimmed to a
+	// total byte budget, so authors need not interpolate each output by
+	// hand. The packed outputs are appended to the rendered task.
+	// +optional
+	Context *StepContext `json:"context,omitempty"`
+
 	// timeout is the per-step timeout in seconds. Overrides the knight's default taskTimeout.
 	// +kubebuilder:default=120
 	// +kubebuilder:validation:Minimum=10
@@ -117,25 +387,337 @@ type ChainStep struct {
 	// +optional
 	Timeout int32 `json:"timeout,omitempty"`
 
+	// model overrides the knight's spec.model for this step's task (e.g. a
+	// cheap model for extraction, an expensive one for synthesis). It is sent
+	// as a hint in the task payload; knight runtimes that can't switch
+	// models ignore it.
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// priority overrides the chain's priority for this step's task.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+
 	// outputKey is the key name under which this step's output is stored for downstream steps.
 	// Defaults to the step name if not specified.
 	// +optional
 	OutputKey string `json:"outputKey,omitempty"`
 
+	// parseOutput parses the step result so downstream templates can address
+	// fields directly. "json" exposes the decoded value as
+	// {{ .Steps.<name>.JSON.<field> }}; a result that is not valid JSON (a
+	// surrounding ```json fence is tolerated) fails the step.
+	// +kubebuilder:validation:Enum=json
+	// +optional
+	ParseOutput string `json:"parseOutput,omitempty"`
+
+	// outputSchema is a JSON Schema the step result must satisfy. The result
+	// is parsed as JSON (a surrounding ```json fence is tolerated) and
+	// validated; a result that doesn't match fails the step, and is retried
+	// under the retry policy like any other failure. Remote $refs are not
+	// resolved.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	OutputSchema *runtime.RawExtension `json:"outputSchema,omitempty"`
+
+	// outputTransform is a CEL expression applied to a successful result
+	// before it is stored and exposed to templates, to keep downstream
+	// prompts small, e.g. `json.findings` or `output.split("\n")[0]`. It
+	// sees the raw result as the string `output` and its JSON decoding as
+	// `json` (null when the result is not JSON; a surrounding ```json fence
+	// is tolerated). A string result is stored as is, anything else as JSON.
+	// parseOutput and outputSchema check the raw result; an expression that
+	// fails to evaluate fails the step.
+	// +optional
+	OutputTransform string `json:"outputTransform,omitempty"`
+
+	// verify sends the step's successful output to a judge knight, which
+	// decides whether it meets the criteria. A fail verdict fails the step,
+	// and is retried under the retry policy like any other failure. The
+	// judge's time counts against the step's timeout and its cost against
+	// the step's.
+	// +optional
+	Verify *StepVerification `json:"verify,omitempty"`
+
 	// outputPath is an optional file path where this step's output should be written.
 	// Supports Go template variables: {{ .Date }} (YYYY-MM-DD), {{ .Chain }} (chain name), {{ .Step }} (step name).
 	// When set, the controller dispatches a write task to the outputKnight after the step succeeds.
 	// +optional
 	OutputPath string `json:"outputPath,omitempty"`
 
+	// vaultPath writes the step's output as a Markdown note into the shared
+	// Obsidian vault when the step succeeds, for a browsable record of chain
+	// results. It is a path relative to the vault root (".md" is appended if
+	// missing) and supports the outputPath template variables plus
+	// {{ .RunID }}. The note is written by the outputKnight, which must mount
+	// the vault with the path under one of its writablePaths.
+	// +optional
+	VaultPath string `json:"vaultPath,omitempty"`
+
 	// continueOnFailure allows downstream steps to proceed even if this step fails.
 	// +kubebuilder:default=false
 	// +optional
 	ContinueOnFailure bool `json:"continueOnFailure,omitempty"`
 
-	// retry configures per-step retry behavior, overriding the chain-level retryPolicy.
+	// retry configures per-step retry behavior, overriding the chain-level
+	// retryPolicy's attempt count and base delay (its backoff strategy,
+	// cap, and jitter still apply).
 	// +optional
 	Retry *StepRetry `json:"retry,omitempty"`
+
+	// notifications publish this step's outcome (e.g. key milestones) to a
+	// webhook or NATS subject as soon as the step finishes.
+	// +optional
+	Notifications []StepNotification `json:"notifications,omitempty"`
+
+	// approval configures the gate for approval steps.
+	// +optional
+	Approval *StepApproval `json:"approval,omitempty"`
+
+	// inputRequest configures the wait for input steps.
+	// +optional
+	InputRequest *StepInputRequest `json:"inputRequest,omitempty"`
+}
+
+// ChainStepType selects how a chain step is executed.
+type ChainStepType string
+
+const (
+	ChainStepTypeTask     ChainStepType = "task"
+	ChainStepTypeApproval ChainStepType = "approval"
+	ChainStepTypeInput    ChainStepType = "input"
+)
+
+// OutputPolicy controls how step outputs are stored.
+type OutputPolicy struct {
+	// maxBytes is the most bytes of a step's output kept in its status
+	// output. Longer outputs are cut at a character boundary and the step
+	// status is marked truncated.
+	// +kubebuilder:default=4000
+	// +kubebuilder:validation:Minimum=256
+	// +kubebuilder:validation:Maximum=262144
+	// +optional
+	MaxBytes int32 `json:"maxBytes,omitempty"`
+
+	// overflow is where the full output of a truncated step goes.
+	// ObjectStore (the default) writes it to the artifact store, falling
+	// back to NATSKV when no store is configured or the write fails.
+	// NATSKV points at the step's entry in the chain-outputs KV bucket,
+	// which holds only the latest run. Drop keeps nothing beyond maxBytes.
+	// Downstream templates read the full output as {{ .Steps.<name>.Output }}
+	// unless it was dropped, in which case {{ .Steps.<name>.Truncated }} is
+	// true.
+	// +kubebuilder:validation:Enum=ObjectStore;NATSKV;Drop
+	// +kubebuilder:default="ObjectStore"
+	// +optional
+	Overflow OutputOverflow `json:"overflow,omitempty"`
+}
+
+// OutputOverflow selects where the full output of a truncated step goes.
+type OutputOverflow string
+
+const (
+	OutputOverflowObjectStore OutputOverflow = "ObjectStore"
+	OutputOverflowNATSKV      OutputOverflow = "NATSKV"
+	OutputOverflowDrop        OutputOverflow = "Drop"
+)
+
+// StepExecutor selects what runs a task step.
+type StepExecutor string
+
+const (
+	StepExecutorKnight StepExecutor = "knight"
+	StepExecutorJob    StepExecutor = "job"
+	StepExecutorHTTP   StepExecutor = "http"
+)
+
+// StepVerification configures the judge that checks a step's output.
+type StepVerification struct {
+	// judgeKnightRef names the knight (in the chain's namespace) that judges
+	// the output.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	JudgeKnightRef string `json:"judgeKnightRef"`
+
+	// criteria describes what a passing output looks like. The judge is
+	// asked to answer with {"pass": true|false, "reason": "..."}.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Criteria string `json:"criteria"`
+}
+
+// FanIn configures how a step with several dependencies merges their
+// outputs.
+type FanIn struct {
+	// summarize dispatches a synthesized summary task to the step's knight:
+	// the rendered task is sent as the summary instructions, followed by
+	// every dependency's output. The step's output is the summary, so later
+	// steps read one consolidated result instead of N branches. Only knight
+	// steps can summarize.
+	// +optional
+	Summarize bool `json:"summarize,omitempty"`
+}
+
+// StepContextOrder is the order a step's context outputs are packed in.
+// +kubebuilder:validation:Enum=NewestFirst;Priority
+type StepContextOrder string
+
+const (
+	// StepContextOrderNewestFirst packs the most recently completed
+	// outputs first.
+	StepContextOrderNewestFirst StepContextOrder = "NewestFirst"
+
+	// StepContextOrderPriority packs outputs in the order steps lists them.
+	StepContextOrderPriority StepContextOrder = "Priority"
+)
+
+// StepContext selects the prior outputs packed into a step's task.
+type StepContext struct {
+	// steps names the steps whose outputs are included. Steps that have not
+	// succeeded by the time this step runs are left out.
+	// +kubebuilder:validation:MinItems=1
+	Steps []string `json:"steps"`
+
+	// maxBytes is the budget for the packed outputs, headings included.
+	// Outputs are packed whole in order until one does not fit; that one is
+	// cut to the remaining budget and marked truncated, and the rest are
+	// left out.
+	// +kubebuilder:validation:Minimum=1
+	MaxBytes int32 `json:"maxBytes"`
+
+	// order decides which outputs come first, and so which are kept when
+	// the budget runs out.
+	// +kubebuilder:default=NewestFirst
+	// +optional
+	Order StepContextOrder `json:"order,omitempty"`
+}
+
+// JobExecutor describes the container a job executor step runs. The Job is
+// owned by the Chain, runs once (no pod retries; the step's retry policy
+// applies instead), and is bounded by the step timeout.
+type JobExecutor struct {
+	// image is the container image to run.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// command overrides the image entrypoint.
+	// +optional
+	Command []string `json:"command,omitempty"`
+
+	// args are the container arguments. Each is rendered as a Go template
+	// with the same data as task (e.g. {{ .Params.target }}).
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// env sets additional environment variables. CHAIN_NAME, STEP_NAME,
+	// RUN_ID, and TASK (the rendered task) are always set.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// outputFile is a path inside the container whose contents become the
+	// step output when the container exits. It is read as the container's
+	// termination message, so only the first 4096 bytes are kept. When
+	// unset, the container's stdout is the step output.
+	// +optional
+	OutputFile string `json:"outputFile,omitempty"`
+
+	// serviceAccountName runs the Job's pod as this service account, with
+	// its token mounted. It must be one the operator allows
+	// (JOB_STEP_SERVICE_ACCOUNTS), or the step fails. When unset the pod
+	// mounts no token.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// resources sets the container's compute resources.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// HTTPExecutor describes the request an http executor step sends. The URL
+// must match one of the operator's allowed URL prefixes. Any 2xx response
+// succeeds the step with the response body as its output; other statuses
+// and transport errors fail it (and are retried under the retry policy).
+type HTTPExecutor struct {
+	// url is the request URL. Rendered as a Go template with the same data
+	// as task.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url"`
+
+	// method is the HTTP method.
+	// +kubebuilder:validation:Enum=GET;POST;PUT;PATCH;DELETE
+	// +kubebuilder:default="GET"
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// headers are sent with the request.
+	// +optional
+	Headers []HTTPHeader `json:"headers,omitempty"`
+
+	// body is the request body. Rendered as a Go template with the same
+	// data as task.
+	// +optional
+	Body string `json:"body,omitempty"`
+}
+
+// HTTPHeader is a request header of an http executor step. Exactly one of
+// value and secretKeyRef is set.
+type HTTPHeader struct {
+	// name is the header name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// value is the header value, rendered as a Go template with the same
+	// data as task.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// secretKeyRef reads the header value from a Secret key in the chain's
+	// namespace (e.g. an API token). Never inline credentials in the spec.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// AnnotationApprovalPrefix prefixes the per-step approval annotation on a
+// Chain (approval.ai.roundtable.io/<step>). Its value is ApprovalApprove or
+// ApprovalReject; the controller removes it once the decision is recorded.
+const AnnotationApprovalPrefix = "approval.ai.roundtable.io/"
+
+// Approval decisions accepted in the approval annotation.
+const (
+	ApprovalApprove = "approve"
+	ApprovalReject  = "reject"
+)
+
+// AnnotationCancel cancels a running Chain. Its value is "true" or the run ID
+// to cancel (so a stale request can't cancel a later run). The controller
+// skips the remaining steps, asks knights to abort in-flight tasks, and
+// removes the annotation.
+const AnnotationCancel = "ai.roundtable.io/cancel"
+
+// AnnotationParameters supplies parameter values for the runs triggered
+// while it is set, as a JSON object (e.g. {"target": "example.com",
+// "ports": [22, 443]}). String values are used as-is; other JSON values are
+// used as their JSON text.
+const AnnotationParameters = "ai.roundtable.io/parameters"
+
+// StepApproval configures a manual approval gate.
+type StepApproval struct {
+	// timeoutSeconds bounds how long the step waits for a decision.
+	// Zero waits until the chain-level timeout.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// onTimeout is the decision applied when timeoutSeconds elapses.
+	// +kubebuilder:validation:Enum=Reject;Approve
+	// +kubebuilder:default="Reject"
+	// +optional
+	OnTimeout string `json:"onTimeout,omitempty"`
 }
 
 // StepRetry configures retry behavior for an individual step.
@@ -152,6 +734,10 @@ type StepRetry struct {
 	// +kubebuilder:validation:Minimum=1
 	// +optional
 	BackoffSeconds int32 `json:"backoffSeconds,omitempty"`
+
+	// retryOn overrides the chain retryPolicy.retryOn for this step.
+	// +optional
+	RetryOn []string `json:"retryOn,omitempty"`
 }
 
 // ChainRetryPolicy configures retry behavior for failed steps.
@@ -163,10 +749,85 @@ type ChainRetryPolicy struct {
 	// +optional
 	MaxRetries int32 `json:"maxRetries,omitempty"`
 
-	// backoffSeconds is the delay between retries in seconds.
+	// backoffSeconds is the delay between retries in seconds. With the
+	// Exponential strategy it is the delay before the first retry.
 	// +kubebuilder:default=30
 	// +optional
 	BackoffSeconds int32 `json:"backoffSeconds,omitempty"`
+
+	// backoffStrategy selects how the delay grows between retries.
+	// Fixed waits backoffSeconds every time; Exponential multiplies the
+	// delay by backoffMultiplier after each attempt.
+	// +kubebuilder:default=Fixed
+	// +optional
+	BackoffStrategy BackoffStrategy `json:"backoffStrategy,omitempty"`
+
+	// backoffMultiplier is the growth factor for the Exponential strategy.
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	BackoffMultiplier int32 `json:"backoffMultiplier,omitempty"`
+
+	// maxBackoffSeconds caps the delay between retries. Zero caps it at a
+	// day, the longest a chain can run.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxBackoffSeconds int32 `json:"maxBackoffSeconds,omitempty"`
+
+	// jitterPercent randomizes each delay by up to ± this percentage so
+	// retries against an overloaded knight do not fire in lockstep.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	JitterPercent int32 `json:"jitterPercent,omitempty"`
+
+	// retryOn limits retries to failures whose error matches one of these
+	// entries; any other failure fails the step at once. An entry is an
+	// error class (Timeout, RateLimit, Network) or a regular expression
+	// matched case-insensitively against the step error. Empty retries
+	// every failure.
+	// +optional
+	RetryOn []string `json:"retryOn,omitempty"`
+}
+
+// Retry error classes usable in retryOn.
+const (
+	RetryOnTimeout   = "Timeout"
+	RetryOnRateLimit = "RateLimit"
+	RetryOnNetwork   = "Network"
+)
+
+// BackoffStrategy selects how the delay between step retries grows.
+// +kubebuilder:validation:Enum=Fixed;Exponential
+type BackoffStrategy string
+
+const (
+	BackoffStrategyFixed       BackoffStrategy = "Fixed"
+	BackoffStrategyExponential BackoffStrategy = "Exponential"
+)
+
+// KnightRoutingStrategy selects how a step picks among eligible knights.
+// +kubebuilder:validation:Enum=LeastLoaded;RoundRobin
+type KnightRoutingStrategy string
+
+const (
+	KnightRoutingLeastLoaded KnightRoutingStrategy = "LeastLoaded"
+	KnightRoutingRoundRobin  KnightRoutingStrategy = "RoundRobin"
+)
+
+// StepInputRequest configures how an input step waits for a human value.
+type StepInputRequest struct {
+	// timeoutSeconds bounds how long the step waits for input.
+	// Zero waits until the chain-level timeout.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// default is used as the step output when the timeout elapses.
+	// If unset, the step fails on timeout.
+	// +optional
+	Default *string `json:"default,omitempty"`
 }
 
 // ChainPhase represents the current lifecycle phase of the Chain.
@@ -183,15 +844,17 @@ const (
 )
 
 // ChainStepPhase represents the status of an individual step.
-// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed;Skipped
+// +kubebuilder:validation:Enum=Pending;Running;AwaitingApproval;AwaitingInput;Succeeded;Failed;Skipped
 type ChainStepPhase string
 
 const (
-	ChainStepPhasePending   ChainStepPhase = "Pending"
-	ChainStepPhaseRunning   ChainStepPhase = "Running"
-	ChainStepPhaseSucceeded ChainStepPhase = "Succeeded"
-	ChainStepPhaseFailed    ChainStepPhase = "Failed"
-	ChainStepPhaseSkipped   ChainStepPhase = "Skipped"
+	ChainStepPhasePending          ChainStepPhase = "Pending"
+	ChainStepPhaseRunning          ChainStepPhase = "Running"
+	ChainStepPhaseAwaitingApproval ChainStepPhase = "AwaitingApproval"
+	ChainStepPhaseAwaitingInput    ChainStepPhase = "AwaitingInput"
+	ChainStepPhaseSucceeded        ChainStepPhase = "Succeeded"
+	ChainStepPhaseFailed           ChainStepPhase = "Failed"
+	ChainStepPhaseSkipped          ChainStepPhase = "Skipped"
 )
 
 // ChainStepStatus tracks the execution status of an individual step.
@@ -203,8 +866,13 @@ type ChainStepStatus struct {
 	// +optional
 	Phase ChainStepPhase `json:"phase,omitempty"`
 
+	// knight is the Knight the step's current execution was dispatched to.
+	// +optional
+	Knight string `json:"knight,omitempty"`
+
 	// taskID is the unique NATS task identifier for this step's current execution.
 	// Used to poll for the exact result message, preventing stale result replay.
+	// For job executor steps it is the name of the Job.
 	// +optional
 	TaskID string `json:"taskId,omitempty"`
 
@@ -217,16 +885,57 @@ type ChainStepStatus struct {
 	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
 
 	// output is the result data from this step (truncated if large).
+	// For input steps awaiting a value, a human writes the value here.
 	// +optional
 	Output string `json:"output,omitempty"`
 
+	// truncated is set when output holds only the first
+	// outputPolicy.maxBytes bytes of the step's output.
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+
+	// artifactRef locates the full, untruncated output when output is
+	// truncated: a nats-object:// artifact store reference, or a nats-kv://
+	// reference to the chain-outputs KV entry. Templates read it with
+	// {{ artifact "<step>" }}.
+	// +optional
+	ArtifactRef string `json:"artifactRef,omitempty"`
+
 	// error contains the error message if the step failed.
 	// +optional
 	Error string `json:"error,omitempty"`
 
+	// handler is set for onFailure/finally handler steps and names the
+	// list the step came from; it is empty for main steps.
+	// +kubebuilder:validation:Enum=onFailure;finally
+	// +optional
+	Handler string `json:"handler,omitempty"`
+
+	// message is a human-readable note about the step's current state,
+	// e.g. the rendered prompt an approval or input step is waiting on.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// verifying is set while a Running step's output is with its judge
+	// knight; taskId is then the judge's task.
+	// +optional
+	Verifying bool `json:"verifying,omitempty"`
+
 	// retries is the number of retry attempts made.
 	// +optional
 	Retries int32 `json:"retries,omitempty"`
+
+	// costUSD is the cost in USD reported for this step, across all attempts.
+	// +optional
+	CostUSD string `json:"costUSD,omitempty"`
+
+	// inputTokens is the number of input tokens reported for this step.
+	// +optional
+	InputTokens int64 `json:"inputTokens,omitempty"`
+
+	// outputTokens is the number of output tokens reported for this step.
+	// +optional
+	OutputTokens int64 `json:"outputTokens,omitempty"`
 }
 
 // ChainStatus defines the observed state of Chain.
@@ -247,6 +956,11 @@ type ChainStatus struct {
 	// +optional
 	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
 
+	// timedOutAt is when the current run exceeded its timeout: its steps
+	// were aborted and its handlers started, to run within handlerTimeout.
+	// +optional
+	TimedOutAt *metav1.Time `json:"timedOutAt,omitempty"`
+
 	// runsCompleted is the total number of successful chain runs.
 	// +optional
 	RunsCompleted int64 `json:"runsCompleted,omitempty"`
@@ -265,6 +979,74 @@ type ChainStatus struct {
 	// +optional
 	RunID string `json:"runId,omitempty"`
 
+	// triggeredBy records what started the current (or most recent) run:
+	// "schedule", "manual", "nats:<subject>", or "chain:<name>".
+	// +optional
+	TriggeredBy string `json:"triggeredBy,omitempty"`
+
+	// upstreamRuns records, per upstream chain of a chainRef trigger, the
+	// last upstream run this chain has handled.
+	// +optional
+	UpstreamRuns map[string]string `json:"upstreamRuns,omitempty"`
+
+	// triggerConsumer is the durable consumer created for the NATS trigger,
+	// deleted when the trigger is edited or removed.
+	// +optional
+	TriggerConsumer *NATSTriggerConsumer `json:"triggerConsumer,omitempty"`
+
+	// input is the input of the current run supplied by its trigger (e.g. a
+	// NATS message payload). When set it replaces spec.input.
+	// +optional
+	Input string `json:"input,omitempty"`
+
+	// parameters are the resolved parameter values of the current (or most
+	// recent) run.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// progress summarizes the current (or most recent) run as finished
+	// steps over steps in the run, e.g. "4/9 steps".
+	// +optional
+	Progress string `json:"progress,omitempty"`
+
+	// currentSteps lists the steps of the current run that are running or
+	// waiting on a human.
+	// +optional
+	CurrentSteps []string `json:"currentSteps,omitempty"`
+
+	// percentComplete estimates how far the current run is, from the share
+	// of its steps that have finished.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	PercentComplete int32 `json:"percentComplete,omitempty"`
+
+	// costUSD is the total cost in USD of the current (or most recent) run.
+	// +optional
+	CostUSD string `json:"costUSD,omitempty"`
+
+	// inputTokens is the total input tokens of the current (or most recent) run.
+	// +optional
+	InputTokens int64 `json:"inputTokens,omitempty"`
+
+	// outputTokens is the total output tokens of the current (or most recent) run.
+	// +optional
+	OutputTokens int64 `json:"outputTokens,omitempty"`
+
+	// runHistory records recently finished runs, oldest first, bounded by
+	// spec.successfulRunsHistoryLimit, spec.failedRunsHistoryLimit and
+	// spec.runHistoryTTLSeconds.
+	// +optional
+	RunHistory []ChainRunRecord `json:"runHistory,omitempty"`
+
+	// notifiedRunId is the run whose spec.notifications have been sent.
+	// +optional
+	NotifiedRunID string `json:"notifiedRunId,omitempty"`
+
+	// dryRun holds the would-be tasks computed while spec.dryRun is set.
+	// +optional
+	DryRun []ChainDryRunStep `json:"dryRun,omitempty"`
+
 	// observedGeneration is the most recent generation observed by the controller.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
@@ -276,13 +1058,71 @@ type ChainStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// ChainRunRecord summarizes a finished chain run.
+type ChainRunRecord struct {
+	// runId identifies the run.
+	RunID string `json:"runId"`
+
+	// phase is the run's terminal phase.
+	Phase ChainPhase `json:"phase"`
+
+	// reason is the ChainComplete condition reason, e.g. Timeout or Cancelled.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// startedAt is when the run started.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// completedAt is when the run finished.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// costUSD is the run's total reported cost.
+	// +optional
+	CostUSD string `json:"costUSD,omitempty"`
+}
+
+// ChainDryRunStep is the task a step would publish, as computed by a dry run.
+type ChainDryRunStep struct {
+	// name is the step name.
+	Name string `json:"name"`
+
+	// knights are the knights the step would be dispatched to: its knightRef,
+	// or every knight currently eligible for its knightSelector/domain.
+	// +optional
+	Knights []string `json:"knights,omitempty"`
+
+	// subject is the NATS subject the task would be published to, when the
+	// step resolves to a single knight.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+
+	// task is the rendered task, with placeholders for upstream outputs.
+	// +optional
+	Task string `json:"task,omitempty"`
+
+	// model is the step's model hint, if any.
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// message explains a problem found for this step (e.g. no eligible
+	// knight, a template that fails to render) or what a non-task step does.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:shortName=ch,categories=roundtable
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Steps",type=integer,JSONPath=`.spec.steps`,priority=1
+// +kubebuilder:printcolumn:name="Progress",type=string,JSONPath=`.status.progress`
+// +kubebuilder:printcolumn:name="%",type=integer,JSONPath=`.status.percentComplete`
+// +kubebuilder:printcolumn:name="Current",type=string,JSONPath=`.status.currentSteps`,priority=1
 // +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
 // +kubebuilder:printcolumn:name="Runs",type=integer,JSONPath=`.status.runsCompleted`
+// +kubebuilder:printcolumn:name="Cost",type=string,JSONPath=`.status.costUSD`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Chain is the Schema for the chains API.
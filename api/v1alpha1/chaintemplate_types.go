@@ -0,0 +1,122 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChainTemplateSpec defines a reusable pipeline that Chains instantiate via
+// spec.templateRef.
+type ChainTemplateSpec struct {
+	// description is a human-readable summary of what the template does.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// parameters declares the values a Chain supplies in
+	// spec.templateRef.parameters. Step tasks and notification messages read
+	// them as {{ .Params.name }}.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Parameters []ChainParameter `json:"parameters,omitempty"`
+
+	// steps are the pipeline steps, as in a Chain's spec.steps.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	Steps []ChainStep `json:"steps"`
+
+	// onFailure lists handler steps used when the Chain sets none.
+	// +optional
+	OnFailure []ChainStep `json:"onFailure,omitempty"`
+
+	// finally lists handler steps used when the Chain sets none.
+	// +optional
+	Finally []ChainStep `json:"finally,omitempty"`
+}
+
+// ChainParameter declares a named value a pipeline can be instantiated with.
+type ChainParameter struct {
+	// name is the parameter name, used as {{ .Params.name }}.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z_][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+
+	// description documents the parameter.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// type is the parameter's value type. Values are validated against it
+	// and templates receive them as a string, float64, bool, or list.
+	// Array values are written as a JSON array.
+	// +kubebuilder:default=string
+	// +optional
+	Type ChainParameterType `json:"type,omitempty"`
+
+	// default is used when no value is supplied.
+	// +optional
+	Default *string `json:"default,omitempty"`
+
+	// required parameters must be supplied unless they have a default.
+	// +optional
+	Required bool `json:"required,omitempty"`
+}
+
+// ChainParameterType is the value type of a ChainParameter.
+// +kubebuilder:validation:Enum=string;number;boolean;array
+type ChainParameterType string
+
+const (
+	ChainParameterTypeString  ChainParameterType = "string"
+	ChainParameterTypeNumber  ChainParameterType = "number"
+	ChainParameterTypeBoolean ChainParameterType = "boolean"
+	ChainParameterTypeArray   ChainParameterType = "array"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=cht,categories=roundtable
+// +kubebuilder:printcolumn:name="Steps",type=integer,JSONPath=`.spec.steps`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ChainTemplate is the Schema for the chaintemplates API.
+// A ChainTemplate holds a parameterized pipeline shared by any number of
+// Chains, so a recurring pipeline is written once and instantiated with
+// different parameters.
+type ChainTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the pipeline and its parameters
+	// +required
+	Spec ChainTemplateSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChainTemplateList contains a list of ChainTemplate
+type ChainTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ChainTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ChainTemplate{}, &ChainTemplateList{})
+}
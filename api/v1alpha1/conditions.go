@@ -51,6 +51,30 @@ const (
 	// Status=False means stream creation failed or streams are unhealthy.
 	ConditionNATSReady = "NATSReady"
 
+	// ConditionNATSHealthy indicates whether the table's NATS server
+	// answers promptly and its knights' consumers keep up. Only set when
+	// the operator has a NATS provider.
+	// Status=False means the server is unreachable or slow, or a knight
+	// consumer is lagging or failing; the table is then Degraded.
+	ConditionNATSHealthy = "NATSHealthy"
+
+	// ConditionRoundTableAtCapacity indicates whether the table has reached
+	// policies.maxKnights or a policies.maxKnightsPerDomain quota. Only set
+	// when one of them is configured.
+	// Status=True means new knights in the table (or the full domain) are rejected.
+	ConditionRoundTableAtCapacity = "AtCapacity"
+
+	// ConditionRoundTableMembersPresent indicates whether every knight in
+	// spec.knights exists in the table. Only set when spec.knights is.
+	// Status=False means listed knights are missing (status.missingKnights).
+	ConditionRoundTableMembersPresent = "MembersPresent"
+
+	// ConditionRoundTableBudgetAtRisk indicates whether the table's burn
+	// rate projects policies.costBudgetUSD to be exceeded before the next
+	// policies.costResetSchedule reset. Only set once there is a forecast.
+	// Status=True means the budget is projected to run out before the reset.
+	ConditionRoundTableBudgetAtRisk = "BudgetAtRisk"
+
 	// ===== Chain Condition Types =====
 
 	// ConditionChainValid indicates whether the chain spec passed validation.
@@ -63,6 +87,12 @@ const (
 	// Status=False means chain is still running or pending.
 	ConditionChainComplete = "Complete"
 
+	// ConditionChainDeferred indicates whether a scheduled run is held
+	// because the chain's RoundTable holds scheduled runs (policies.scheduleHold).
+	// Status=True means a run is deferred until the fleet recovers.
+	// Status=False means the deferred run has started.
+	ConditionChainDeferred = "Deferred"
+
 	// ===== Mission Condition Types =====
 
 	// ConditionMissionComplete indicates whether the mission finished execution.
@@ -75,11 +105,74 @@ const (
 	// Status=False means briefing publish failed or no briefing was configured.
 	ConditionBriefingPublished = "BriefingPublished"
 
+	// ConditionBriefingAcknowledged indicates whether every briefed knight
+	// acknowledged the briefing. Only set with spec.briefingAckTimeout.
+	// Status=True means all knights acknowledged and the mission may start.
+	// Status=False means acknowledgments are outstanding or timed out.
+	ConditionBriefingAcknowledged = "BriefingAcknowledged"
+
+	// ConditionMissionWithinBudget indicates whether the mission's cost is
+	// within spec.costBudgetUSD. Only set when the mission has a budget.
+	// Status=True means the cost is at or under the budget.
+	// Status=False means the budget was exceeded and the mission aborted.
+	ConditionMissionWithinBudget = "WithinBudget"
+
+	// ConditionMissionAdmitted indicates whether the mission got a slot under
+	// its RoundTable's maxMissions. Only set once a mission has been queued.
+	// Status=True means the mission was admitted and left Pending.
+	// Status=False means the mission waits in Pending for a free slot.
+	ConditionMissionAdmitted = "Admitted"
+
+	// ConditionMissionPreempted indicates whether a higher-priority mission
+	// paused this one. Only set once a mission has been preempted.
+	// Status=True means the mission's chains are suspended until a slot frees.
+	// Status=False means the mission resumed.
+	ConditionMissionPreempted = "Preempted"
+
+	// ConditionMissionPlanned indicates whether a dry run recorded the
+	// mission's plan. Only set for dry runs.
+	// Status=True means status.plan is current and has no warnings.
+	// Status=False means the plan has warnings to review.
+	ConditionMissionPlanned = "Planned"
+
+	// ConditionPlanApproved indicates the human review of a meta-mission's
+	// generated plan. Only set when spec.planner.requireApproval is true.
+	// Status=Unknown means the plan is waiting for a decision.
+	// Status=True means the plan was approved.
+	// Status=False means the plan was rejected and the mission failed.
+	ConditionPlanApproved = "PlanApproved"
+
+	// ConditionMissionKnightDegraded indicates whether a participating
+	// knight stopped being Ready during the Active phase.
+	// Status=True means at least one knight is not Ready (see the message).
+	// Status=False means every knight recovered or was replaced.
+	ConditionMissionKnightDegraded = "KnightDegraded"
+
+	// ConditionMissionEscalated indicates whether spec.onFailure ran for a
+	// failed mission. Only set once, when the failure is recorded.
+	// Status=True means every escalation action succeeded.
+	// Status=False means at least one action failed (see the message).
+	ConditionMissionEscalated = "Escalated"
+
+	// ConditionMissionExtended indicates whether the mission's expiry moved
+	// after it started. Only set once spec.ttl changes or the extend
+	// annotation is used.
+	// Status=True means status.expiresAt was recomputed (see the message).
+	ConditionMissionExtended = "Extended"
+
 	// ConditionCleanupComplete indicates whether mission cleanup finished.
 	// Status=True means all ephemeral resources were deleted.
 	// Status=False means cleanup is in progress.
 	ConditionCleanupComplete = "CleanupComplete"
 
+	// ===== MissionRequest Condition Types =====
+
+	// ConditionMissionRequestAccepted indicates whether the request was
+	// expanded into a Mission.
+	// Status=True means the Mission named in status.missionName exists.
+	// Status=False means the template or parameters were rejected.
+	ConditionMissionRequestAccepted = "Accepted"
+
 	// ===== Shared Condition Types (Chain + Mission) =====
 
 	// ConditionNotificationSent indicates the state of the spec.notify
@@ -129,6 +222,58 @@ const (
 	// ReasonStreamError indicates NATS stream creation or update failed.
 	ReasonStreamError = "StreamError"
 
+	// ReasonStreamDrift indicates a stream differs from the spec in a
+	// setting that can't be updated in place.
+	ReasonStreamDrift = "StreamDrift"
+
+	// ReasonNATSResponsive indicates the NATS server and the knights'
+	// consumers are healthy.
+	ReasonNATSResponsive = "NATSResponsive"
+
+	// ReasonNATSUnreachable indicates the NATS server or its JetStream
+	// tasks stream can't be reached.
+	ReasonNATSUnreachable = "NATSUnreachable"
+
+	// ReasonNATSSlow indicates the round trip to the NATS server exceeds
+	// nats.health.maxRTT.
+	ReasonNATSSlow = "NATSSlow"
+
+	// ReasonConsumerErrors indicates a knight's consumer couldn't be
+	// inspected.
+	ReasonConsumerErrors = "ConsumerErrors"
+
+	// ReasonConsumersLagging indicates a knight's consumer has more than
+	// nats.health.maxConsumerLag tasks pending.
+	ReasonConsumersLagging = "ConsumersLagging"
+
+	// ReasonNATSUnhealthy indicates the table's knights are ready but its
+	// NATS is not.
+	ReasonNATSUnhealthy = "NATSUnhealthy"
+
+	// ReasonMaxKnightsReached indicates the table has policies.maxKnights knights.
+	ReasonMaxKnightsReached = "MaxKnightsReached"
+
+	// ReasonDomainQuotaReached indicates a domain has its
+	// policies.maxKnightsPerDomain knights.
+	ReasonDomainQuotaReached = "DomainQuotaReached"
+
+	// ReasonWithinCapacity indicates the table is below its knight limits.
+	ReasonWithinCapacity = "WithinCapacity"
+
+	// ReasonAllMembersPresent indicates every listed knight exists.
+	ReasonAllMembersPresent = "AllMembersPresent"
+
+	// ReasonMembersMissing indicates listed knights don't exist.
+	ReasonMembersMissing = "MembersMissing"
+
+	// ReasonBurnRateExceedsBudget indicates the forecast cost at the next
+	// reset exceeds the cost budget.
+	ReasonBurnRateExceedsBudget = "BurnRateExceedsBudget"
+
+	// ReasonForecastWithinBudget indicates the forecast cost at the next
+	// reset is within the cost budget.
+	ReasonForecastWithinBudget = "ForecastWithinBudget"
+
 	// ===== Chain Condition Reasons =====
 
 	// ReasonChainValid indicates the chain spec passed all validation checks.
@@ -140,12 +285,40 @@ const (
 	// ReasonInvalidKnightRef indicates a step references a non-existent knight.
 	ReasonInvalidKnightRef = "InvalidKnightRef"
 
+	// ReasonInvalidTemplateRef indicates spec.templateRef names a missing
+	// ChainTemplate or supplies parameters that don't match it.
+	ReasonInvalidTemplateRef = "InvalidTemplateRef"
+
+	// ReasonInvalidParameters indicates a parameter declaration is invalid,
+	// or a run was triggered with missing or mistyped parameter values.
+	ReasonInvalidParameters = "InvalidParameters"
+
+	// ReasonInvalidInput indicates spec.input and spec.inputFrom conflict, or
+	// inputFrom does not select exactly one key.
+	ReasonInvalidInput = "InvalidInput"
+
+	// ReasonInvalidTrigger indicates spec.triggers is misconfigured.
+	ReasonInvalidTrigger = "InvalidTrigger"
+
+	// ReasonInvalidExecutor indicates a step's executor settings are inconsistent.
+	ReasonInvalidExecutor = "InvalidExecutor"
+
+	// ReasonInvalidRetryPolicy indicates a retryOn entry is not a known
+	// error class or valid regular expression.
+	ReasonInvalidRetryPolicy = "InvalidRetryPolicy"
+
 	// ReasonCyclicDependency indicates the chain has cyclic step dependencies.
 	ReasonCyclicDependency = "CyclicDependency"
 
 	// ReasonInvalidTemplate indicates a step's Go template failed to parse.
 	ReasonInvalidTemplate = "InvalidTemplate"
 
+	// ReasonInvalidOutputSchema indicates a step's outputSchema failed to compile.
+	ReasonInvalidOutputSchema = "InvalidOutputSchema"
+
+	// ReasonInvalidOutputTransform indicates a step's outputTransform failed to compile.
+	ReasonInvalidOutputTransform = "InvalidOutputTransform"
+
 	// ReasonChainSucceeded indicates all chain steps completed successfully.
 	ReasonChainSucceeded = "Succeeded"
 
@@ -158,6 +331,28 @@ const (
 	// ReasonChainTimeout indicates the chain exceeded its timeout duration.
 	ReasonChainTimeout = "Timeout"
 
+	// ReasonChainCancelled indicates the run was cancelled via the cancel annotation.
+	ReasonChainCancelled = "Cancelled"
+
+	// ReasonChainCostBudgetExceeded indicates the run's cost exceeded spec.costBudgetUSD.
+	ReasonChainCostBudgetExceeded = "CostBudgetExceeded"
+
+	// ReasonFleetOverBudget indicates a scheduled run is deferred because
+	// the chain's RoundTable is OverBudget.
+	ReasonFleetOverBudget = "FleetOverBudget"
+
+	// ReasonFleetDegraded indicates a scheduled run is deferred because too
+	// few of the chain's RoundTable's knights are ready.
+	ReasonFleetDegraded = "FleetDegraded"
+
+	// ReasonFleetRecovered indicates a deferred run started once the
+	// chain's RoundTable recovered.
+	ReasonFleetRecovered = "FleetRecovered"
+
+	// ReasonDeferredRunDropped indicates a deferred run was dropped: the
+	// chain could not start it, or its schedule was removed.
+	ReasonDeferredRunDropped = "DeferredRunDropped"
+
 	// ===== Mission Condition Reasons =====
 
 	// ReasonMissionSucceeded indicates all mission chains completed successfully.
@@ -166,16 +361,77 @@ const (
 	// ReasonMissionChainFailed indicates one or more mission chains failed.
 	ReasonMissionChainFailed = "ChainFailed"
 
+	// ReasonMissionSetupFailed indicates a Setup chain failed, so the
+	// mission's Active chains were never started.
+	ReasonMissionSetupFailed = "SetupFailed"
+
 	// ReasonMissionFailed indicates the mission failed before its chains could
 	// complete (e.g. assembly timeout or planning failure).
 	ReasonMissionFailed = "Failed"
 
+	// ReasonMissionNoConsensus indicates no vote reached spec.consensus.quorum.
+	ReasonMissionNoConsensus = "NoConsensus"
+
+	// ReasonMissionInconclusive indicates the debate moderator gave no
+	// conclusion.
+	ReasonMissionInconclusive = "Inconclusive"
+
 	// ReasonMissionTimeout indicates the mission exceeded its timeout.
 	ReasonMissionTimeout = "Timeout"
 
+	// ReasonMissionWithinBudget indicates the mission's cost is within its budget.
+	ReasonMissionWithinBudget = "WithinBudget"
+
 	// ReasonMissionExpired indicates the mission exceeded its TTL.
 	ReasonMissionExpired = "Expired"
 
+	// ReasonMissionAdmitted indicates a queued mission got a slot.
+	ReasonMissionAdmitted = "Admitted"
+
+	// ReasonRoundTableAtCapacity indicates the RoundTable already runs
+	// maxMissions missions.
+	ReasonRoundTableAtCapacity = "RoundTableAtCapacity"
+
+	// ReasonMissionPreempted indicates a higher-priority mission took the
+	// mission's slot.
+	ReasonMissionPreempted = "Preempted"
+
+	// ReasonMissionResumed indicates a preempted mission got a slot back.
+	ReasonMissionResumed = "Resumed"
+
+	// ReasonPlanReady indicates a dry run found nothing to review.
+	ReasonPlanReady = "PlanReady"
+
+	// ReasonPlanHasWarnings indicates a dry run found problems to review.
+	ReasonPlanHasWarnings = "PlanHasWarnings"
+
+	// ReasonPlanAwaitingApproval indicates a generated plan waits for review.
+	ReasonPlanAwaitingApproval = "AwaitingApproval"
+
+	// ReasonPlanApproved indicates a human approved the generated plan.
+	ReasonPlanApproved = "Approved"
+
+	// ReasonPlanRejected indicates a human rejected the generated plan.
+	ReasonPlanRejected = "Rejected"
+
+	// ReasonKnightNotReady indicates a mission knight is not Ready.
+	ReasonKnightNotReady = "KnightNotReady"
+
+	// ReasonKnightsHealthy indicates every mission knight is Ready or replaced.
+	ReasonKnightsHealthy = "KnightsHealthy"
+
+	// ReasonEscalated indicates every onFailure action succeeded.
+	ReasonEscalated = "Escalated"
+
+	// ReasonEscalationFailed indicates an onFailure action failed.
+	ReasonEscalationFailed = "EscalationFailed"
+
+	// ReasonExtendRequested indicates the extend annotation extended the mission.
+	ReasonExtendRequested = "ExtendRequested"
+
+	// ReasonTTLChanged indicates an edit to spec.ttl moved the expiry.
+	ReasonTTLChanged = "TTLChanged"
+
 	// ReasonBriefingPublished indicates briefing was published successfully.
 	ReasonBriefingPublished = "Published"
 
@@ -185,9 +441,32 @@ const (
 	// ReasonNoBriefing indicates no briefing text was configured.
 	ReasonNoBriefing = "NoBriefing"
 
+	// ReasonBriefingAcknowledged indicates every briefed knight acknowledged.
+	ReasonBriefingAcknowledged = "Acknowledged"
+
+	// ReasonBriefingAckPending indicates knights have yet to acknowledge.
+	ReasonBriefingAckPending = "AwaitingAcknowledgment"
+
+	// ReasonBriefingAckTimeout indicates knights did not acknowledge the
+	// briefing within spec.briefingAckTimeout.
+	ReasonBriefingAckTimeout = "AcknowledgmentTimeout"
+
 	// ReasonCleanupComplete indicates mission cleanup finished successfully.
 	ReasonCleanupComplete = "CleanedUp"
 
+	// ===== MissionRequest Condition Reasons =====
+
+	// ReasonMissionCreated indicates the request's Mission was created.
+	ReasonMissionCreated = "MissionCreated"
+
+	// ReasonTemplateNotAllowed indicates spec.templateRef names a Mission
+	// without the request-template label.
+	ReasonTemplateNotAllowed = "TemplateNotAllowed"
+
+	// ReasonMissionExists indicates a Mission with the request's name
+	// exists and was not created by the request.
+	ReasonMissionExists = "MissionExists"
+
 	// ===== Notification Condition Reasons =====
 
 	// ReasonNotifyDelivered indicates the completion webhook was delivered.
@@ -30,6 +30,22 @@ const (
 	// LabelMission links resources to their owning Mission
 	LabelMission = "ai.roundtable.io/mission"
 
+	// LabelMissionNamespace records the owning Mission's namespace on
+	// resources in its isolation namespace
+	LabelMissionNamespace = "ai.roundtable.io/mission-namespace"
+
+	// LabelFollowUpOf links a follow-up diagnostic Mission to the failed
+	// Mission that created it
+	LabelFollowUpOf = "ai.roundtable.io/follow-up-of"
+
+	// LabelMissionRequest links a Mission to the MissionRequest that
+	// created it
+	LabelMissionRequest = "ai.roundtable.io/mission-request"
+
+	// LabelRequestTemplate marks a Mission as a template MissionRequests
+	// may instantiate; only the value "true" counts
+	LabelRequestTemplate = "ai.roundtable.io/request-template"
+
 	// LabelRoundTable links resources to their RoundTable
 	LabelRoundTable = "ai.roundtable.io/round-table"
 
@@ -42,6 +58,10 @@ const (
 	// LabelWarmPoolClaimed marks a warm pool knight as claimed by a mission
 	LabelWarmPoolClaimed = "ai.roundtable.io/warm-pool-claimed"
 
+	// LabelAutoProvisioned marks a knight created by its RoundTable's
+	// autoProvision for a domain's backlog
+	LabelAutoProvisioned = "ai.roundtable.io/auto-provisioned"
+
 	// AnnotationWarmPoolCreatedAt tracks when a warm pool knight was created (for idle recycling)
 	AnnotationWarmPoolCreatedAt = "ai.roundtable.io/warm-pool-created-at"
 )
@@ -113,6 +133,15 @@ type KnightSpec struct {
 	// +optional
 	Concurrency int32 `json:"concurrency,omitempty"`
 
+	// maxTasksPerMinute caps how many chain step tasks the Chain controller
+	// dispatches to this knight in any one-minute window, across all chains,
+	// so a wide fan-out stays under the rate limits of the API provider
+	// behind the knight. Steps over the limit wait in Pending. 0 or unset
+	// means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxTasksPerMinute int32 `json:"maxTasksPerMinute,omitempty"`
+
 	// taskTimeout is the default task timeout in seconds.
 	// +kubebuilder:default=120
 	// +kubebuilder:validation:Minimum=30
@@ -238,6 +267,17 @@ type KnightNATS struct {
 	// +optional
 	URL string `json:"url,omitempty"`
 
+	// auth selects the credentials the knight connects with. Unset
+	// inherits the auth of the knight's RoundTable
+	// (ai.roundtable.io/table label).
+	// +optional
+	Auth *NATSAuth `json:"auth,omitempty"`
+
+	// tls configures TLS for the knight's connection. Unset inherits the
+	// TLS settings of the knight's RoundTable.
+	// +optional
+	TLS *NATSTLS `json:"tls,omitempty"`
+
 	// subjects defines the JetStream filter subjects for task consumption.
 	// e.g., ["fleet-a.tasks.security.>"]
 	// +kubebuilder:validation:MinItems=1
@@ -354,6 +394,12 @@ type KnightStatus struct {
 	// +optional
 	NATSConsumer string `json:"natsConsumer,omitempty"`
 
+	// natsStream is the stream the knight consumes tasks from: its
+	// domain's stream when its RoundTable uses the PerDomain topology,
+	// otherwise spec.nats.stream.
+	// +optional
+	NATSStream string `json:"natsStream,omitempty"`
+
 	// nixToolsHash is the tools hash whose flake has been successfully built
 	// and published to the shared Nix store. Empty until the first build
 	// completes; used to avoid rebuilding unchanged tool sets.
@@ -46,6 +46,7 @@ type MissionSpec struct {
 
 	// ttl is the mission's time-to-live in seconds. The mission is automatically
 	// cleaned up after this duration, regardless of completion status.
+	// Changing it moves status.expiresAt; it still counts from the start.
 	// +kubebuilder:default=3600
 	// +kubebuilder:validation:Minimum=60
 	// +kubebuilder:validation:Maximum=604800
@@ -54,6 +55,7 @@ type MissionSpec struct {
 
 	// timeout is the maximum time in seconds to wait for the mission objective
 	// to be achieved before marking it as failed.
+	// Edits apply to a running mission.
 	// +kubebuilder:default=1800
 	// +kubebuilder:validation:Minimum=60
 	// +kubebuilder:validation:Maximum=86400
@@ -69,6 +71,32 @@ type MissionSpec struct {
 	// +optional
 	RoundTableRef string `json:"roundTableRef,omitempty"`
 
+	// priority is sent with every task the mission dispatches, and is the
+	// minimum priority of its chains' tasks, so knights run them ahead of
+	// lower-priority work; higher runs first. It also orders missions
+	// waiting for the RoundTable's maxMissions, and with the RoundTable's
+	// preemptMissions policy a waiting mission pauses a lower-priority one.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// dryRun, if true, stops the mission after validation: it resolves the
+	// knights, checks the chains and estimates the cost, records the result
+	// in status.plan and stays Pending without creating or dispatching
+	// anything. Clearing it runs the mission.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// parameters declares the values a MissionRequest supplies when this
+	// Mission is its template. The objective and briefing read them as
+	// {{ .Params.name }} and are rendered when the request is expanded;
+	// the mission itself never renders them.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Parameters []ChainParameter `json:"parameters,omitempty"`
+
 	// metaMission enables the built-in planner knight to generate the execution plan.
 	// When true, the operator dispatches the objective to the planner knight,
 	// which reasons about what chains, knights, nix packages, and skills are needed.
@@ -86,15 +114,59 @@ type MissionSpec struct {
 	// +optional
 	Briefing string `json:"briefing,omitempty"`
 
+	// briefingAckTimeout, when set, holds the mission in Briefing until
+	// every briefed knight acknowledges the briefing by publishing to the
+	// ackSubject named in its briefing task,
+	// "<natsPrefix>.briefing.ack.<knight>". Knights that have not
+	// acknowledged within this many seconds fail the mission. 0 moves to
+	// Active as soon as the briefing is published.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BriefingAckTimeout int32 `json:"briefingAckTimeout,omitempty"`
+
+	// debrief adds a Debriefing phase after the mission's chains finish:
+	// every knight is asked for a final summary of its work, and the lead
+	// knight composes them into a mission report, which replaces the
+	// one-line status.result.
+	// +optional
+	Debrief *MissionDebrief `json:"debrief,omitempty"`
+
+	// consensus adds a Voting phase after the mission's chains succeed (or
+	// straight after Briefing if it has none): several knights answer the
+	// same question independently, and the mission succeeds only if enough
+	// of them agree. The agreed answer becomes status.result.
+	// +optional
+	Consensus *MissionConsensus `json:"consensus,omitempty"`
+
+	// debate adds a Debating phase after the mission's chains succeed (or
+	// straight after Briefing if it has none): knights argue assigned
+	// positions over several rounds, and a moderator knight's conclusion
+	// becomes status.result. It cannot be combined with consensus.
+	// +optional
+	Debate *MissionDebate `json:"debate,omitempty"`
+
+	// chainRetryPolicy re-runs a failed mission chain before failing the
+	// mission. Without it, the first chain failure fails the mission.
+	// +optional
+	ChainRetryPolicy *MissionChainRetryPolicy `json:"chainRetryPolicy,omitempty"`
+
+	// audit archives every task dispatched and every result received for
+	// the mission to a JetStream stream of its own, for compliance review
+	// of what its knights were asked and answered. The archive outlives
+	// the mission.
+	// +optional
+	Audit *MissionAudit `json:"audit,omitempty"`
+
 	// knightTemplates defines reusable knight configurations that can be referenced
 	// by MissionKnight entries. Allows defining a template once and instantiating
 	// multiple ephemeral knights from it.
 	// +optional
 	KnightTemplates []MissionKnightTemplate `json:"knightTemplates,omitempty"`
 
-	// costBudgetUSD is the maximum cost for this mission. When exceeded, the mission
-	// is failed and cleanup begins. "0" means inherit from parent RoundTable.
-	// +kubebuilder:default="0"
+	// costBudgetUSD is the maximum cost for this mission's tasks, as reported
+	// by their results. When exceeded, the mission's chains are suspended, the
+	// mission is failed and cleanup begins. "0" means no mission budget;
+	// unset inherits the RoundTable's defaults.missionCostBudgetUSD.
 	// +optional
 	CostBudgetUSD string `json:"costBudgetUSD,omitempty"`
 
@@ -103,6 +175,16 @@ type MissionSpec struct {
 	// +optional
 	Secrets []corev1.LocalObjectReference `json:"secrets,omitempty"`
 
+	// isolation selects where the mission's workloads run. "None" places
+	// ephemeral knights, chain copies and the ephemeral RoundTable in the
+	// mission's namespace. "Namespace" creates a mission-scoped namespace
+	// for them, copies the mission's and parent RoundTable's secrets into
+	// it, and deletes it at cleanup. An isolated mission runs on its own
+	// ephemeral RoundTable, and every knight must be ephemeral.
+	// +kubebuilder:default=None
+	// +optional
+	Isolation MissionIsolation `json:"isolation,omitempty"`
+
 	// recruitExisting, if true, allows the mission to use non-ephemeral knights
 	// from the parent RoundTable alongside ephemeral ones.
 	// When false (default), only ephemeral knights participate.
@@ -140,6 +222,152 @@ type MissionSpec struct {
 	// mission reaches a terminal outcome (Succeeded, Failed, Expired).
 	// +optional
 	Notify *NotifySpec `json:"notify,omitempty"`
+
+	// onFailure escalates the mission once its Failed outcome is recorded,
+	// instead of leaving it to sit in Failed until the TTL reaps it.
+	// +optional
+	OnFailure *MissionFailurePolicy `json:"onFailure,omitempty"`
+
+	// knightHealth configures what happens when a participating knight stops
+	// being Ready while the mission is Active. The KnightDegraded condition
+	// reports such knights either way.
+	// +optional
+	KnightHealth *MissionKnightHealth `json:"knightHealth,omitempty"`
+
+	// timeline records the mission's phase changes and exports a structured
+	// timeline of them, its chain steps and their costs when the mission
+	// finishes, for post-engagement reports and dashboards.
+	// +optional
+	Timeline *MissionTimeline `json:"timeline,omitempty"`
+}
+
+// KnightHealthAction selects how a mission handles a knight that stopped
+// being Ready.
+// +kubebuilder:validation:Enum=Wait;Reroute;Replace
+type KnightHealthAction string
+
+const (
+	// KnightHealthWait only reports the knight; its steps wait for it.
+	KnightHealthWait KnightHealthAction = "Wait"
+	// KnightHealthReroute moves the knight's pending chain steps to a Ready
+	// knight in the same domain.
+	KnightHealthReroute KnightHealthAction = "Reroute"
+	// KnightHealthReplace creates an ephemeral replacement from the knight's
+	// spec and moves its pending chain steps to it.
+	KnightHealthReplace KnightHealthAction = "Replace"
+)
+
+// MissionKnightHealth configures knight health handling for a mission.
+type MissionKnightHealth struct {
+	// action is taken once a knight has been not Ready for the grace period.
+	// Steps already running on the knight are left to their timeouts.
+	// +kubebuilder:default=Wait
+	// +optional
+	Action KnightHealthAction `json:"action,omitempty"`
+
+	// gracePeriodSeconds is how long a knight may be not Ready before the
+	// action is taken.
+	// +kubebuilder:default=120
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	GracePeriodSeconds int32 `json:"gracePeriodSeconds,omitempty"`
+}
+
+// MissionTimelineTarget selects where a mission's timeline is exported.
+// +kubebuilder:validation:Enum=ConfigMap;ObjectStore
+type MissionTimelineTarget string
+
+const (
+	// MissionTimelineConfigMap writes the timeline to the ConfigMap
+	// "<mission>-timeline" in the mission's namespace.
+	MissionTimelineConfigMap MissionTimelineTarget = "ConfigMap"
+	// MissionTimelineObjectStore writes the timeline to the operator's
+	// artifact object store.
+	MissionTimelineObjectStore MissionTimelineTarget = "ObjectStore"
+)
+
+// MissionTimeline configures the mission's timeline export.
+type MissionTimeline struct {
+	// target is where the timeline is exported. Either way the export is
+	// not owned by the mission and outlives it.
+	// +kubebuilder:default=ConfigMap
+	// +optional
+	Target MissionTimelineTarget `json:"target,omitempty"`
+}
+
+// MissionPhaseTransition records when a mission entered a phase.
+type MissionPhaseTransition struct {
+	// phase is the phase the mission entered.
+	Phase MissionPhase `json:"phase"`
+
+	// time is when the controller observed the mission in the phase.
+	Time metav1.Time `json:"time"`
+}
+
+// MissionFailurePolicy defines how a failed mission is escalated. Each action
+// is a single best-effort attempt; failures are reported as warning Events
+// and in the Escalated condition and never retried.
+type MissionFailurePolicy struct {
+	// notifications posts a failure summary (objective, reason, cost) to chat
+	// channels or webhooks.
+	// +optional
+	Notifications []MissionFailureNotification `json:"notifications,omitempty"`
+
+	// followUp creates a diagnostic Mission from a template.
+	// +optional
+	FollowUp *MissionFollowUp `json:"followUp,omitempty"`
+
+	// page triggers a PagerDuty incident.
+	// +optional
+	Page *MissionPage `json:"page,omitempty"`
+}
+
+// MissionFailureNotification posts a failed mission's summary to a chat
+// channel or webhook.
+type MissionFailureNotification struct {
+	// type selects the message format.
+	// +kubebuilder:validation:Required
+	Type NotificationChannelType `json:"type"`
+
+	// urlSecretRef references the Secret key (in the mission's namespace)
+	// holding the webhook URL. The URL must match one of the operator's
+	// allowed URL prefixes (notify.allowedURLPrefixes Helm value).
+	// +kubebuilder:validation:Required
+	URLSecretRef corev1.SecretKeySelector `json:"urlSecretRef"`
+}
+
+// MissionFollowUp creates a diagnostic Mission named "<mission>-followup"
+// when the mission fails. The follow-up copies the template's spec with
+// dryRun and onFailure.followUp cleared, and its briefing starts with the
+// failed mission's name and failure reason.
+type MissionFollowUp struct {
+	// templateRef names the Mission (in the same namespace) whose spec the
+	// follow-up copies. Keep the template at dryRun: true so it never runs
+	// itself.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TemplateRef string `json:"templateRef"`
+}
+
+// MissionPage triggers a PagerDuty Events API v2 incident, deduplicated on
+// the mission's UID.
+type MissionPage struct {
+	// routingKeySecretRef references the Secret key (in the mission's
+	// namespace) holding the integration's routing key.
+	// +kubebuilder:validation:Required
+	RoutingKeySecretRef corev1.SecretKeySelector `json:"routingKeySecretRef"`
+
+	// url is the Events API endpoint. It must match one of the operator's
+	// allowed URL prefixes.
+	// +kubebuilder:default="https://events.pagerduty.com/v2/enqueue"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// severity is the incident severity.
+	// +kubebuilder:validation:Enum=critical;error;warning;info
+	// +kubebuilder:default=error
+	// +optional
+	Severity string `json:"severity,omitempty"`
 }
 
 // MissionKnight references a knight participating in a mission.
@@ -190,8 +418,260 @@ type MissionChainRef struct {
 	Phase string `json:"phase,omitempty"`
 }
 
+// MissionDebrief configures the mission's Debriefing phase.
+type MissionDebrief struct {
+	// leadKnight composes the mission report. It must be one of spec.knights.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	LeadKnight string `json:"leadKnight"`
+
+	// instructions are added to the report task, e.g. the sections the
+	// report must have.
+	// +optional
+	Instructions string `json:"instructions,omitempty"`
+
+	// vaultPath asks the lead knight to also save the report as a note at
+	// this path in the shared vault, e.g. "Briefings/missions/recon.md".
+	// The knight's vault mount must be able to write it.
+	// +optional
+	VaultPath string `json:"vaultPath,omitempty"`
+
+	// timeout bounds the whole debrief in seconds. Knights get the first
+	// half to answer; those that have not are listed as missing in the
+	// report. If the lead knight has not answered by the end, the summaries
+	// are joined as the report.
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=30
+	// +optional
+	Timeout int32 `json:"timeout,omitempty"`
+}
+
+// MissionChainRetryPolicy configures how failed mission chains are re-run.
+type MissionChainRetryPolicy struct {
+	// maxRetries is how many times a failed chain is re-run before the
+	// mission is failed.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries int32 `json:"maxRetries,omitempty"`
+
+	// backoffSeconds is the delay after the first failure before the chain
+	// is re-run. It doubles with each retry.
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffSeconds int32 `json:"backoffSeconds,omitempty"`
+
+	// maxBackoffSeconds caps the delay before a re-run. Zero caps it at a
+	// day.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxBackoffSeconds int32 `json:"maxBackoffSeconds,omitempty"`
+}
+
+// MissionAudit configures the mission's audit archive.
+type MissionAudit struct {
+	// retentionDays is how long archived messages are kept.
+	// +kubebuilder:default=90
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+}
+
+// MissionKnightSummary is a knight's answer to the debrief task.
+type MissionKnightSummary struct {
+	// knight is the mission knight's name.
+	Knight string `json:"knight"`
+
+	// summary is the knight's final summary, truncated if long.
+	// +optional
+	Summary string `json:"summary,omitempty"`
+
+	// error is set when the knight failed the debrief task.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// MissionConsensus configures a mission's vote.
+type MissionConsensus struct {
+	// prompt is the question every voter answers.
+	// +kubebuilder:validation:MinLength=1
+	Prompt string `json:"prompt"`
+
+	// choices, if set, are the only valid votes, compared case-insensitively.
+	// Otherwise any answer is a vote, and identical answers agree.
+	// +optional
+	Choices []string `json:"choices,omitempty"`
+
+	// voters are the mission knights asked to vote. Defaults to every
+	// knight in spec.knights.
+	// +optional
+	Voters []string `json:"voters,omitempty"`
+
+	// quorum is how many voters must cast the same vote for it to become
+	// the mission's result.
+	// +kubebuilder:validation:Minimum=1
+	Quorum int32 `json:"quorum"`
+
+	// timeout bounds the vote in seconds. Voters that have not answered by
+	// then abstain.
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=30
+	// +optional
+	Timeout int32 `json:"timeout,omitempty"`
+}
+
+// MissionDebate configures a mission's debate.
+type MissionDebate struct {
+	// motion is the question or proposition under debate.
+	// +kubebuilder:validation:MinLength=1
+	Motion string `json:"motion"`
+
+	// debaters are the mission knights arguing, each with its position.
+	// +kubebuilder:validation:MinItems=2
+	Debaters []MissionDebater `json:"debaters"`
+
+	// moderator is the mission knight that weighs the arguments and writes
+	// the conclusion. It must not be a debater.
+	// +kubebuilder:validation:MinLength=1
+	Moderator string `json:"moderator"`
+
+	// rounds is how many rounds of arguments are exchanged. From the second
+	// round on, each debater sees every earlier argument and can rebut it.
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	Rounds int32 `json:"rounds,omitempty"`
+
+	// roundTimeout bounds each round, and the moderator's conclusion, in
+	// seconds. A debater that has not answered by then forfeits the round.
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=30
+	// +optional
+	RoundTimeout int32 `json:"roundTimeout,omitempty"`
+}
+
+// MissionDebater assigns a debate position to a mission knight.
+type MissionDebater struct {
+	// knight is the mission knight's name.
+	// +kubebuilder:validation:MinLength=1
+	Knight string `json:"knight"`
+
+	// position is the side the knight argues, e.g. "for the motion".
+	// +kubebuilder:validation:MinLength=1
+	Position string `json:"position"`
+}
+
+// MissionArgument is a debater's argument in one round.
+type MissionArgument struct {
+	// round is the debate round, starting at 1.
+	Round int32 `json:"round"`
+
+	// knight is the arguing mission knight.
+	Knight string `json:"knight"`
+
+	// argument is the knight's argument, truncated if long.
+	// +optional
+	Argument string `json:"argument,omitempty"`
+
+	// error is set when the knight failed the round or did not answer in time.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// MissionDebateStatus tracks the Debating phase.
+type MissionDebateStatus struct {
+	// round is the round in progress; 0 before the first is dispatched.
+	// +optional
+	Round int32 `json:"round,omitempty"`
+
+	// roundStartedAt is when the current round, or the moderator's
+	// conclusion task, was dispatched.
+	// +optional
+	RoundStartedAt *metav1.Time `json:"roundStartedAt,omitempty"`
+
+	// arguments are the arguments received so far, oldest first.
+	// +optional
+	Arguments []MissionArgument `json:"arguments,omitempty"`
+
+	// conclusionTaskID is the task asking the moderator for the conclusion,
+	// set once every round is over.
+	// +optional
+	ConclusionTaskID string `json:"conclusionTaskID,omitempty"`
+
+	// subject is where each argument is published for knights and
+	// observers to follow the debate.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+}
+
+// MissionVote is a knight's answer to the consensus prompt.
+type MissionVote struct {
+	// knight is the voting mission knight.
+	Knight string `json:"knight"`
+
+	// vote is the knight's answer, normalized to the matching choice.
+	// +optional
+	Vote string `json:"vote,omitempty"`
+
+	// rationale is the knight's reasoning, truncated if long.
+	// +optional
+	Rationale string `json:"rationale,omitempty"`
+
+	// error is set when the knight failed the task or cast no valid vote.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// MissionConsensusStatus tracks the Voting phase.
+type MissionConsensusStatus struct {
+	// startedAt is when the vote tasks were dispatched.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// votes are the answers received so far.
+	// +optional
+	Votes []MissionVote `json:"votes,omitempty"`
+
+	// decision is the vote that reached quorum, set when the vote closes.
+	// +optional
+	Decision string `json:"decision,omitempty"`
+
+	// tally summarizes the closed vote, e.g. "approve 3, reject 1, 1 abstained".
+	// +optional
+	Tally string `json:"tally,omitempty"`
+}
+
+// MissionDebriefStatus tracks the Debriefing phase.
+type MissionDebriefStatus struct {
+	// startedAt is when the debrief tasks were dispatched.
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// summaries are the knights' answers received so far.
+	// +optional
+	Summaries []MissionKnightSummary `json:"summaries,omitempty"`
+
+	// reportTaskID is the task asking the lead knight for the report, set
+	// once it is dispatched.
+	// +optional
+	ReportTaskID string `json:"reportTaskID,omitempty"`
+}
+
+// MissionIsolation selects where a mission's workloads run.
+// +kubebuilder:validation:Enum=None;Namespace
+type MissionIsolation string
+
+const (
+	// MissionIsolationNone runs the mission's workloads in its own namespace.
+	MissionIsolationNone MissionIsolation = "None"
+	// MissionIsolationNamespace runs them in a mission-scoped namespace.
+	MissionIsolationNamespace MissionIsolation = "Namespace"
+)
+
 // MissionPhase represents the current lifecycle phase of the Mission.
-// +kubebuilder:validation:Enum=Pending;Provisioning;Planning;Assembling;Briefing;Active;Succeeded;Failed;Expired;CleaningUp
+// +kubebuilder:validation:Enum=Pending;Provisioning;Planning;Assembling;Briefing;Active;Voting;Debating;Debriefing;Succeeded;Failed;Expired;CleaningUp
 type MissionPhase string
 
 const (
@@ -201,6 +681,9 @@ const (
 	MissionPhaseAssembling   MissionPhase = "Assembling"
 	MissionPhaseBriefing     MissionPhase = "Briefing"
 	MissionPhaseActive       MissionPhase = "Active"
+	MissionPhaseVoting       MissionPhase = "Voting"
+	MissionPhaseDebating     MissionPhase = "Debating"
+	MissionPhaseDebriefing   MissionPhase = "Debriefing"
 	MissionPhaseSucceeded    MissionPhase = "Succeeded"
 	MissionPhaseFailed       MissionPhase = "Failed"
 	MissionPhaseExpired      MissionPhase = "Expired"
@@ -216,13 +699,29 @@ type MissionKnightStatus struct {
 	// +optional
 	Ready bool `json:"ready,omitempty"`
 
-	// tasksCompleted is the number of tasks this knight completed during the mission.
+	// briefingAcknowledged indicates the knight acknowledged the mission
+	// briefing. Only tracked with spec.briefingAckTimeout.
+	// +optional
+	BriefingAcknowledged bool `json:"briefingAcknowledged,omitempty"`
+
+	// tasksCompleted is the number of mission chain steps this knight
+	// completed successfully, counted from their task results.
 	// +optional
 	TasksCompleted int64 `json:"tasksCompleted,omitempty"`
 
 	// ephemeral indicates whether this knight was created ephemerally for this mission.
 	// +optional
 	Ephemeral bool `json:"ephemeral,omitempty"`
+
+	// notReadySince is when the knight stopped being Ready during the
+	// Active phase.
+	// +optional
+	NotReadySince *metav1.Time `json:"notReadySince,omitempty"`
+
+	// replacedBy names the knight the mission moved this knight's pending
+	// chain steps to under spec.knightHealth.
+	// +optional
+	ReplacedBy string `json:"replacedBy,omitempty"`
 }
 
 // MissionStatus defines the observed state of Mission.
@@ -235,6 +734,11 @@ type MissionStatus struct {
 	// +optional
 	KnightStatuses []MissionKnightStatus `json:"knightStatuses,omitempty"`
 
+	// progress summarizes the mission as finished chains over chains and
+	// ready knights over knights, e.g. "1/3 chains, 4/4 knights ready".
+	// +optional
+	Progress string `json:"progress,omitempty"`
+
 	// startedAt is when the mission began.
 	// +optional
 	StartedAt *metav1.Time `json:"startedAt,omitempty"`
@@ -273,6 +777,12 @@ type MissionStatus struct {
 	// +optional
 	RoundTableName string `json:"roundTableName,omitempty"`
 
+	// isolationNamespace is the mission-scoped namespace created for
+	// isolation: Namespace. It holds the mission's ephemeral knights, chain
+	// copies, ephemeral RoundTable and copied secrets.
+	// +optional
+	IsolationNamespace string `json:"isolationNamespace,omitempty"`
+
 	// natsTasksStream is the JetStream stream name for mission tasks.
 	// +optional
 	NATSTasksStream string `json:"natsTasksStream,omitempty"`
@@ -281,6 +791,24 @@ type MissionStatus struct {
 	// +optional
 	NATSResultsStream string `json:"natsResultsStream,omitempty"`
 
+	// natsMissionStream is the JetStream stream capturing the mission's
+	// own subjects (<natsPrefix>.>). It is deleted with the mission.
+	// +optional
+	NATSMissionStream string `json:"natsMissionStream,omitempty"`
+
+	// chatSubject is the mission's collaboration channel
+	// (<natsPrefix>.chat), announced to knights in the briefing. Messages
+	// are retained by the mission stream and archived into the mission's
+	// results record.
+	// +optional
+	ChatSubject string `json:"chatSubject,omitempty"`
+
+	// auditStream is the JetStream stream archiving the mission's tasks and
+	// results under audit.<namespace>.<mission>.>. It is kept after the
+	// mission is deleted, until its retention expires.
+	// +optional
+	AuditStream string `json:"auditStream,omitempty"`
+
 	// chainStatuses tracks the status of each mission chain.
 	// +optional
 	ChainStatuses []MissionChainStatus `json:"chainStatuses,omitempty"`
@@ -298,6 +826,97 @@ type MissionStatus struct {
 	// planningResult contains the output from the planner knight.
 	// +optional
 	PlanningResult *PlanningResult `json:"planningResult,omitempty"`
+
+	// debrief tracks the Debriefing phase when spec.debrief is set.
+	// +optional
+	Debrief *MissionDebriefStatus `json:"debrief,omitempty"`
+
+	// consensus tracks the Voting phase when spec.consensus is set.
+	// +optional
+	Consensus *MissionConsensusStatus `json:"consensus,omitempty"`
+
+	// debate tracks the Debating phase when spec.debate is set.
+	// +optional
+	Debate *MissionDebateStatus `json:"debate,omitempty"`
+
+	// plan is what a dry run found the mission would do.
+	// +optional
+	Plan *MissionPlan `json:"plan,omitempty"`
+
+	// followUpMission is the diagnostic Mission created by onFailure.followUp.
+	// +optional
+	FollowUpMission string `json:"followUpMission,omitempty"`
+
+	// phaseHistory lists the mission's phase changes, oldest first. Only
+	// recorded when spec.timeline is set.
+	// +optional
+	PhaseHistory []MissionPhaseTransition `json:"phaseHistory,omitempty"`
+
+	// extensionSeconds is the time granted through the extend annotation,
+	// added to both spec.ttl and spec.timeout.
+	// +optional
+	ExtensionSeconds int32 `json:"extensionSeconds,omitempty"`
+
+	// timelineRef locates the exported timeline: the ConfigMap's name, or
+	// the object store reference.
+	// +optional
+	TimelineRef string `json:"timelineRef,omitempty"`
+}
+
+// MissionPlan is the output of a mission dry run.
+type MissionPlan struct {
+	// observedGeneration is the mission generation the plan was made for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// knights are the knights the mission would run with.
+	// +optional
+	Knights []MissionPlanKnight `json:"knights,omitempty"`
+
+	// chains are the chains the mission would run, in spec order.
+	// +optional
+	Chains []MissionPlanChain `json:"chains,omitempty"`
+
+	// estimatedCostUSD is the estimated cost of every chain's tasks.
+	// +optional
+	EstimatedCostUSD string `json:"estimatedCostUSD,omitempty"`
+
+	// warnings are problems that would stop or slow the mission, such as
+	// unknown knights or template errors.
+	// +optional
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// MissionPlanKnight is a knight in a mission plan.
+type MissionPlanKnight struct {
+	// name is the mission knight's name.
+	Name string `json:"name"`
+
+	// ephemeral is true when the mission would create the knight.
+	// +optional
+	Ephemeral bool `json:"ephemeral,omitempty"`
+
+	// model is the model the knight would run.
+	// +optional
+	Model string `json:"model,omitempty"`
+}
+
+// MissionPlanChain is a chain in a mission plan.
+type MissionPlanChain struct {
+	// name is the chain's name in spec.chains.
+	Name string `json:"name"`
+
+	// phase is when the chain would run.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// tasks is the number of steps the chain would dispatch to knights.
+	// +optional
+	Tasks int32 `json:"tasks,omitempty"`
+
+	// estimatedCostUSD is the estimated cost of the chain's tasks.
+	// +optional
+	EstimatedCostUSD string `json:"estimatedCostUSD,omitempty"`
 }
 
 // MissionKnightTemplate is a named, reusable knight spec template.
@@ -383,6 +1002,17 @@ type MissionChainStatus struct {
 	// phase is the chain's current phase.
 	// +optional
 	Phase ChainPhase `json:"phase,omitempty"`
+
+	// retries counts the times the chain was re-run under the mission's
+	// chainRetryPolicy.
+	// +optional
+	Retries int32 `json:"retries,omitempty"`
+
+	// retriedCostUSD is the cost of the chain's failed runs, which a re-run
+	// clears from the Chain's step statuses. It still counts toward the
+	// mission's cost.
+	// +optional
+	RetriedCostUSD string `json:"retriedCostUSD,omitempty"`
 }
 
 // MissionPlanner configures the planning phase.
@@ -433,8 +1063,25 @@ type MissionPlanner struct {
 	// +kubebuilder:validation:Maximum=50
 	// +optional
 	MaxKnights int32 `json:"maxKnights,omitempty"`
+
+	// requireApproval holds the generated plan for human review: the mission
+	// stays in Planning, with the PlanApproved condition Unknown, until the
+	// mission is annotated approval.ai.roundtable.io/plan=approve (continue
+	// to Assembling) or =reject (fail the mission).
+	// +optional
+	RequireApproval bool `json:"requireApproval,omitempty"`
 }
 
+// AnnotationPlanApproval records a human decision on a mission's generated
+// plan when spec.planner.requireApproval is set. Its value is ApprovalApprove
+// or ApprovalReject; the controller removes it once the decision is recorded.
+const AnnotationPlanApproval = AnnotationApprovalPrefix + "plan"
+
+// AnnotationExtend extends a mission's TTL and timeout. Its value is the
+// extra time, in seconds ("600") or as a duration ("30m"); the controller
+// adds it to status.extensionSeconds and removes the annotation.
+const AnnotationExtend = "ai.roundtable.io/extend"
+
 // GeneratedChain represents a chain definition created by the planner.
 type GeneratedChain struct {
 	// name is the chain name (must be unique within the mission).
@@ -500,8 +1147,11 @@ type PlanningResult struct {
 // +kubebuilder:resource:shortName=msn,categories=roundtable
 // +kubebuilder:printcolumn:name="Objective",type=string,JSONPath=`.spec.objective`,priority=1
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Progress",type=string,JSONPath=`.status.progress`
+// +kubebuilder:printcolumn:name="Cost",type=string,JSONPath=`.status.totalCost`
 // +kubebuilder:printcolumn:name="Knights",type=integer,JSONPath=`.spec.knights`,priority=1
-// +kubebuilder:printcolumn:name="TTL",type=integer,JSONPath=`.spec.ttl`
+// +kubebuilder:printcolumn:name="Expires",type=string,JSONPath=`.status.expiresAt`
+// +kubebuilder:printcolumn:name="TTL",type=integer,JSONPath=`.spec.ttl`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Mission is the Schema for the missions API.
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MissionRequestSpec names a template Mission and the parameters to start
+// it with. It carries nothing else, so an account that may only create
+// MissionRequests can launch the missions an operator prepared, never an
+// arbitrary spec.
+type MissionRequestSpec struct {
+	// templateRef names the Mission (in the same namespace) whose spec the
+	// new mission copies. The template must carry the label
+	// ai.roundtable.io/request-template: "true"; keep it at dryRun: true
+	// so it never runs itself.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TemplateRef string `json:"templateRef"`
+
+	// parameters are the values for the template's declared
+	// spec.parameters.
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// MissionRequestStatus defines the observed state of MissionRequest.
+type MissionRequestStatus struct {
+	// missionName is the Mission created for the request. It has the
+	// request's name and is owned by it.
+	// +optional
+	MissionName string `json:"missionName,omitempty"`
+
+	// missionPhase mirrors the created Mission's status.phase.
+	// +optional
+	MissionPhase MissionPhase `json:"missionPhase,omitempty"`
+
+	// conditions represent the current state of the MissionRequest.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=msnreq,categories=roundtable
+// +kubebuilder:printcolumn:name="Template",type=string,JSONPath=`.spec.templateRef`
+// +kubebuilder:printcolumn:name="Mission",type=string,JSONPath=`.status.missionName`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.missionPhase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// MissionRequest is the Schema for the missionrequests API.
+// A MissionRequest starts a Mission from an opted-in template Mission. The
+// operator expands it server-side, so dashboards and service accounts can
+// be granted create on missionrequests without create on missions.
+// Changes to the spec after the mission is created are ignored.
+type MissionRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec names the template and its parameters
+	// +required
+	Spec MissionRequestSpec `json:"spec"`
+
+	// status defines the observed state of MissionRequest
+	// +optional
+	Status MissionRequestStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// MissionRequestList contains a list of MissionRequest
+type MissionRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []MissionRequest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MissionRequest{}, &MissionRequestList{})
+}
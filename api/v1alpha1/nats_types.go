@@ -0,0 +1,72 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// NATSAuth selects the credentials a NATS connection authenticates with.
+// Set at most one method; the operator uses the first of creds, nkey,
+// token, user.
+type NATSAuth struct {
+	// user authenticates with a username and password.
+	// +optional
+	User *NATSUserAuth `json:"user,omitempty"`
+
+	// tokenSecretRef selects the Secret key holding a server auth token.
+	// +optional
+	TokenSecretRef *corev1.SecretKeySelector `json:"tokenSecretRef,omitempty"`
+
+	// nkeySeedSecretRef selects the Secret key holding a user NKey seed
+	// ("SU...").
+	// +optional
+	NKeySeedSecretRef *corev1.SecretKeySelector `json:"nkeySeedSecretRef,omitempty"`
+
+	// credsSecretRef selects the Secret key holding a .creds file (a user
+	// JWT and its NKey seed), as issued by nsc for decentralized auth.
+	// +optional
+	CredsSecretRef *corev1.SecretKeySelector `json:"credsSecretRef,omitempty"`
+}
+
+// NATSUserAuth is username and password authentication.
+type NATSUserAuth struct {
+	// username is the NATS user.
+	// +kubebuilder:validation:MinLength=1
+	Username string `json:"username"`
+
+	// passwordSecretRef selects the Secret key holding the password.
+	PasswordSecretRef corev1.SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// NATSTLS configures TLS for a NATS connection.
+type NATSTLS struct {
+	// caSecretRef selects the Secret key holding the PEM CA bundle that
+	// verifies the server. Unset uses the system roots.
+	// +optional
+	CASecretRef *corev1.SecretKeySelector `json:"caSecretRef,omitempty"`
+
+	// clientCertSecretName names a kubernetes.io/tls Secret (tls.crt,
+	// tls.key) with the client certificate for mutual TLS.
+	// +optional
+	ClientCertSecretName string `json:"clientCertSecretName,omitempty"`
+
+	// insecureSkipVerify skips verifying the server's certificate. Only
+	// for test servers with self-signed certificates.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
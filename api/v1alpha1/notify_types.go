@@ -51,3 +51,67 @@ type WebhookSink struct {
 	// +optional
 	Context map[string]string `json:"context,omitempty"`
 }
+
+// StepNotification publishes a chain step's outcome when the step finishes.
+// Delivery is a single best-effort attempt; failures are reported as
+// warning Events and never affect the step or chain.
+type StepNotification struct {
+	// on selects which step outcomes trigger the notification.
+	// Defaults to both Succeeded and Failed.
+	// +optional
+	On []ChainStepPhase `json:"on,omitempty"`
+
+	// message is a Go template rendered like a step task (with access to
+	// .Steps and .Input) and sent as the payload output. Defaults to the
+	// step's own output, or its error if it failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// webhook posts a roundtable.notify/v1 JSON payload to an HTTP endpoint,
+	// subject to the operator's allowed URL prefixes.
+	// +optional
+	Webhook *WebhookSink `json:"webhook,omitempty"`
+
+	// natsSubject publishes the same payload to this NATS subject on the
+	// RoundTable's connection. It must fall under the RoundTable's
+	// "{subjectPrefix}.notifications." prefix.
+	// +optional
+	NATSSubject string `json:"natsSubject,omitempty"`
+}
+
+// NotificationChannelType selects the message format of a chain
+// notification channel.
+// +kubebuilder:validation:Enum=slack;discord;webhook
+type NotificationChannelType string
+
+const (
+	// NotificationChannelSlack posts a Slack incoming-webhook message.
+	NotificationChannelSlack NotificationChannelType = "slack"
+	// NotificationChannelDiscord posts a Discord webhook message.
+	NotificationChannelDiscord NotificationChannelType = "discord"
+	// NotificationChannelWebhook posts the roundtable.notify/v1 JSON
+	// payload, with the run summary as its output.
+	NotificationChannelWebhook NotificationChannelType = "webhook"
+)
+
+// ChainNotification posts a summary of a finished chain run (phase, failed
+// steps and their errors, duration, and cost) to a chat channel or webhook.
+// Delivery is a single best-effort attempt per run; failures are reported
+// as warning Events and never affect the chain.
+type ChainNotification struct {
+	// type selects the message format.
+	// +kubebuilder:validation:Required
+	Type NotificationChannelType `json:"type"`
+
+	// urlSecretRef references the Secret key (in the chain's namespace)
+	// holding the webhook URL; Slack and Discord webhook URLs are
+	// credentials. The URL must match one of the operator's allowed URL
+	// prefixes (notify.allowedURLPrefixes Helm value).
+	// +kubebuilder:validation:Required
+	URLSecretRef corev1.SecretKeySelector `json:"urlSecretRef"`
+
+	// on selects which run outcomes are posted. Defaults to every terminal
+	// phase.
+	// +optional
+	On []ChainPhase `json:"on,omitempty"`
+}
@@ -46,11 +46,51 @@ type RoundTableSpec struct {
 	// +optional
 	KnightSelector *metav1.LabelSelector `json:"knightSelector,omitempty"`
 
-	// secrets references shared secrets available to all knights in this table.
+	// knights lists the table's members explicitly, making the RoundTable
+	// rather than labels on Knights the source of truth. When set, the
+	// table's knights are the listed ones, plus those matching
+	// knightSelector if one is set. A listed knight must be in one of the
+	// table's namespaces; listed knights that don't exist are reported in
+	// status.missingKnights and the MembersPresent condition. Each entry's
+	// overrides are set on the knight's spec.
+	// +listType=map
+	// +listMapKey=namespace
+	// +listMapKey=name
+	// +optional
+	Knights []RoundTableMember `json:"knights,omitempty"`
+
+	// namespaces lists other namespaces to discover knights in, besides the
+	// table's own, so a central table can manage knights deployed across
+	// team namespaces. A namespace joins only if it names the table in its
+	// ai.roundtable.io/round-tables annotation; others are skipped. Ignored
+	// by ephemeral tables.
+	// +listType=set
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// namespaceSelector adds the namespaces matching it to namespaces. They
+	// must opt in the same way. Ignored by ephemeral tables.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// secrets references shared secrets available to all knights in this
+	// table, distributed as secretsMode selects. They are copied into the
+	// namespaces of knights outside the table's own (see namespaces), and
+	// the copies kept in sync when a secret rotates.
 	// +optional
 	Secrets []corev1.LocalObjectReference `json:"secrets,omitempty"`
 
+	// secretsMode selects how secrets reach the knights. EnvFrom injects
+	// each secret into every knight's pod as envFrom, restarting the
+	// knights when one changes; Copy only copies them, for knights to
+	// reference themselves.
+	// +kubebuilder:default="EnvFrom"
+	// +kubebuilder:validation:Enum=EnvFrom;Copy
+	// +optional
+	SecretsMode SecretsMode `json:"secretsMode,omitempty"`
+
 	// vault configures the shared Obsidian vault for all knights in this table.
+	// Knights that don't set their own vault inherit it.
 	// +optional
 	Vault *KnightVault `json:"vault,omitempty"`
 
@@ -70,7 +110,30 @@ type RoundTableSpec struct {
 	// +optional
 	WarmPool *WarmPoolConfig `json:"warmPool,omitempty"`
 
-	// suspended, if true, suspends all knights in this table.
+	// autoProvision creates knights from knightTemplates for domains whose
+	// tasks back up in the tasks stream, and removes them once idle.
+	// +optional
+	AutoProvision *RoundTableAutoProvision `json:"autoProvision,omitempty"`
+
+	// herald routes tasks published to {subjectPrefix}.tasks.any to the
+	// table's knights, so producers needn't know knight names or domains.
+	// +optional
+	Herald *RoundTableHerald `json:"herald,omitempty"`
+
+	// federation admits knights running in other clusters, which join over
+	// NATS (such as a leaf node) instead of as Knight resources. They put
+	// heartbeats in the {subjectPrefix}-federation KV bucket and appear in
+	// status.knights as external members.
+	// +optional
+	Federation *RoundTableFederation `json:"federation,omitempty"`
+
+	// modelRollout moves the table's knights to a new model in waves,
+	// pausing when the upgraded knights' task failure rate rises.
+	// +optional
+	ModelRollout *RoundTableModelRollout `json:"modelRollout,omitempty"`
+
+	// suspended, if true, suspends all knights in this table. Knights
+	// already suspended are left suspended when the table resumes.
 	// +kubebuilder:default=false
 	// +optional
 	Suspended bool `json:"suspended,omitempty"`
@@ -86,6 +149,72 @@ type RoundTableSpec struct {
 	MissionRef string `json:"missionRef,omitempty"`
 }
 
+// RoundTableHerald configures a RoundTable's task router. Each task on
+// {subjectPrefix}.tasks.any is republished to the least-loaded ready knight
+// matching its Roundtable-Domain header and holding every skill in its
+// Roundtable-Skills header (comma-separated); both are optional.
+type RoundTableHerald struct {
+	// retryAfter is how long a task no knight can take waits before it is
+	// routed again, as a Go duration.
+	// +kubebuilder:default="30s"
+	// +optional
+	RetryAfter string `json:"retryAfter,omitempty"`
+}
+
+// RoundTableFederation configures how a RoundTable tracks knights in other
+// clusters.
+type RoundTableFederation struct {
+	// heartbeatTimeout is how old a knight's last heartbeat may be before
+	// it is no longer counted ready, as a Go duration.
+	// +kubebuilder:default="90s"
+	// +optional
+	HeartbeatTimeout string `json:"heartbeatTimeout,omitempty"`
+
+	// forgetAfter is how long a knight that stopped sending heartbeats is
+	// listed before it is dropped from the table, as a Go duration.
+	// +kubebuilder:default="24h"
+	// +optional
+	ForgetAfter string `json:"forgetAfter,omitempty"`
+}
+
+// RoundTableModelRollout configures a fleet-wide model upgrade. Each wave
+// sets spec.model on the next knights, and the next wave starts once the
+// last one has run for waveInterval.
+type RoundTableModelRollout struct {
+	// model is the model to move the knights to.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Model string `json:"model"`
+
+	// wavePercent is the share of the table's knights upgraded per wave.
+	// Ignored when domainOrder is set.
+	// +kubebuilder:default=25
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	WavePercent int32 `json:"wavePercent,omitempty"`
+
+	// domainOrder upgrades one domain per wave, in this order. Domains not
+	// listed follow together in a final wave.
+	// +optional
+	DomainOrder []string `json:"domainOrder,omitempty"`
+
+	// waveInterval is how long a wave runs before the next starts, as a Go
+	// duration.
+	// +kubebuilder:default="10m"
+	// +optional
+	WaveInterval string `json:"waveInterval,omitempty"`
+
+	// maxFailurePercent pauses the rollout when the upgraded knights fail
+	// more than this share of the tasks they finished since upgrading. It
+	// is checked once they have finished at least five tasks.
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxFailurePercent int32 `json:"maxFailurePercent,omitempty"`
+}
+
 // SharedWorkspaceConfig configures a shared RWX volume for collaborative knight work.
 type SharedWorkspaceConfig struct {
 	// claimName is the PVC name for the shared workspace.
@@ -105,6 +234,15 @@ type RoundTableNATS struct {
 	// +optional
 	URL string `json:"url,omitempty"`
 
+	// auth selects the credentials the operator and the table's knights
+	// connect with. Secrets are read from the RoundTable's namespace.
+	// +optional
+	Auth *NATSAuth `json:"auth,omitempty"`
+
+	// tls configures TLS for connections to the server.
+	// +optional
+	TLS *NATSTLS `json:"tls,omitempty"`
+
 	// subjectPrefix is the NATS subject prefix for this table (e.g., "fleet-a").
 	// All knights in this table use subjects under this prefix.
 	// +kubebuilder:validation:Required
@@ -129,6 +267,460 @@ type RoundTableNATS struct {
 	// +kubebuilder:validation:Enum=Limits;Interest;WorkQueue
 	// +optional
 	StreamRetention string `json:"streamRetention,omitempty"`
+
+	// stream configures the limits of the auto-created tasks and results
+	// streams. Existing streams are updated when it changes; settings a
+	// stream can't change in place (storage, retention) are reported in
+	// status.streams until the stream is recreated.
+	// +optional
+	Stream *RoundTableStreamSettings `json:"stream,omitempty"`
+
+	// topology selects how tasks are split across streams. "Shared" keeps
+	// every task in the tasksStream. "PerDomain" gives each domain of the
+	// table's knights, and each entry of domainStreams, its own stream
+	// {tasksStream}_{domain} capturing {subjectPrefix}.tasks.{domain}.>, so
+	// domains are isolated and retained separately; the tasksStream then
+	// only keeps unaddressed tasks on {subjectPrefix}.tasks.any. Knights
+	// whose spec.nats.stream is the tasksStream consume from their
+	// domain's stream. Streams are created with createStreams.
+	// +kubebuilder:default="Shared"
+	// +kubebuilder:validation:Enum=Shared;PerDomain
+	// +optional
+	Topology NATSTopology `json:"topology,omitempty"`
+
+	// domainStreams override the limits of a domain's stream under the
+	// PerDomain topology (e.g. security tasks kept 30 days, chatter one);
+	// other settings come from stream. A listed domain gets its stream
+	// even before any knight serves it.
+	// +listType=map
+	// +listMapKey=domain
+	// +optional
+	DomainStreams []RoundTableDomainStream `json:"domainStreams,omitempty"`
+
+	// deadLetter keeps tasks that exhaust their deliveries. The controller
+	// listens for JetStream MAX_DELIVERIES advisories on the tasks stream
+	// and copies each poisoned task to {subjectPrefix}.dlq.<subject> in the
+	// {tasksStream}_dlq stream. Requires createStreams.
+	// +optional
+	DeadLetter *RoundTableDeadLetter `json:"deadLetter,omitempty"`
+
+	// health sets the thresholds of the controller's NATS health probe,
+	// which reports the NATSHealthy condition and status.natsHealth.
+	// +optional
+	Health *RoundTableNATSHealth `json:"health,omitempty"`
+
+	// kvBuckets are JetStream KV buckets the operator creates for the
+	// table's knights to share state, each named {subjectPrefix}-{name}.
+	// Knights get each bucket's name in NATS_KV_{NAME} (uppercased, dashes
+	// as underscores) and all of them as name=bucket pairs in
+	// NATS_KV_BUCKETS. Buckets removed from the list are kept.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	KVBuckets []RoundTableKVBucket `json:"kvBuckets,omitempty"`
+
+	// retainStreams keeps the table's streams and KV buckets when the
+	// RoundTable is deleted. By default a finalizer deletes the streams
+	// created with createStreams and the kvBuckets before the RoundTable
+	// is removed.
+	// +kubebuilder:default=false
+	// +optional
+	RetainStreams bool `json:"retainStreams,omitempty"`
+}
+
+// RoundTableMember is a knight listed in a RoundTable's spec.knights.
+type RoundTableMember struct {
+	// name is the Knight's name.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// namespace is the Knight's namespace. Empty means the table's.
+	// +kubebuilder:default=""
+	// +optional
+	Namespace string `json:"namespace"`
+
+	// model overrides the knight's spec.model. It isn't applied while the
+	// table has a modelRollout.
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// concurrency overrides the knight's spec.concurrency.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Concurrency int32 `json:"concurrency,omitempty"`
+}
+
+// NATSTopology is how a RoundTable's tasks are split across streams.
+type NATSTopology string
+
+const (
+	NATSTopologyShared    NATSTopology = "Shared"
+	NATSTopologyPerDomain NATSTopology = "PerDomain"
+)
+
+// RoundTableDomainStream overrides the limits of one domain's stream.
+type RoundTableDomainStream struct {
+	// domain is the knight domain the stream holds tasks for.
+	// +kubebuilder:validation:MinLength=1
+	Domain string `json:"domain"`
+
+	// maxAge is the longest a task is kept, as a Go duration (e.g.
+	// "720h"). Empty uses stream.maxAge.
+	// +optional
+	MaxAge string `json:"maxAge,omitempty"`
+
+	// maxBytes caps the stream's total size in bytes. 0 uses
+	// stream.maxBytes.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxBytes int64 `json:"maxBytes,omitempty"`
+}
+
+// RoundTableKVBucket configures a shared KV bucket.
+type RoundTableKVBucket struct {
+	// name is the bucket's name within the table (e.g. "memory").
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=32
+	Name string `json:"name"`
+
+	// ttl expires keys not updated for this long, as a Go duration (e.g.
+	// "24h"). Empty keeps keys indefinitely.
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+
+	// maxBytes caps the bucket's total size in bytes. 0 means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxBytes int64 `json:"maxBytes,omitempty"`
+
+	// maxValueSize is the largest value in bytes the bucket accepts.
+	// 0 means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxValueSize int32 `json:"maxValueSize,omitempty"`
+
+	// history is the number of revisions kept per key.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	// +optional
+	History int32 `json:"history,omitempty"`
+
+	// storage selects file or memory storage. It can't change once the
+	// bucket exists.
+	// +kubebuilder:default="File"
+	// +kubebuilder:validation:Enum=File;Memory
+	// +optional
+	Storage string `json:"storage,omitempty"`
+}
+
+// RoundTableDeadLetter configures a RoundTable's dead-letter stream.
+type RoundTableDeadLetter struct {
+	// maxAge is how long dead-lettered tasks are kept, as a Go duration.
+	// +kubebuilder:default="168h"
+	// +optional
+	MaxAge string `json:"maxAge,omitempty"`
+}
+
+// RoundTableNATSHealth configures when a RoundTable's NATS is unhealthy.
+type RoundTableNATSHealth struct {
+	// maxRTT is the longest round trip to the server that is still
+	// healthy, as a Go duration.
+	// +kubebuilder:default="500ms"
+	// +optional
+	MaxRTT string `json:"maxRTT,omitempty"`
+
+	// maxConsumerLag is the most tasks a knight's consumer may have
+	// pending before NATS is considered to be falling behind.
+	// +kubebuilder:default=1000
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConsumerLag int64 `json:"maxConsumerLag,omitempty"`
+}
+
+// AnnotationRedrive re-drives a RoundTable's dead-lettered tasks. When set
+// (to any value) the controller republishes every task in the dead-letter
+// stream to its original subject, purges the stream, and removes the
+// annotation.
+const AnnotationRedrive = "ai.roundtable.io/redrive"
+
+// RoundTableStreamSettings configures the JetStream streams a RoundTable
+// creates.
+type RoundTableStreamSettings struct {
+	// replicas is the number of stream replicas on a clustered NATS server.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=5
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// storage selects file or memory storage.
+	// +kubebuilder:default="File"
+	// +kubebuilder:validation:Enum=File;Memory
+	// +optional
+	Storage string `json:"storage,omitempty"`
+
+	// maxAge is the longest a message is kept, as a Go duration (e.g.
+	// "72h"). Empty keeps messages indefinitely.
+	// +optional
+	MaxAge string `json:"maxAge,omitempty"`
+
+	// maxBytes caps the stream's total size in bytes. 0 means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxBytes int64 `json:"maxBytes,omitempty"`
+
+	// maxMsgSize is the largest message in bytes the stream accepts.
+	// 0 means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxMsgSize int32 `json:"maxMsgSize,omitempty"`
+
+	// duplicateWindow is how long published message IDs are remembered
+	// for de-duplication, as a Go duration. Empty uses the server default
+	// (2m).
+	// +optional
+	DuplicateWindow string `json:"duplicateWindow,omitempty"`
+
+	// discard selects what happens when a limit is reached: "Old" drops
+	// the oldest messages, "New" rejects new ones.
+	// +kubebuilder:default="Old"
+	// +kubebuilder:validation:Enum=Old;New
+	// +optional
+	Discard string `json:"discard,omitempty"`
+}
+
+// RoundTableStreamStatus reports a JetStream stream the RoundTable manages.
+type RoundTableStreamStatus struct {
+	// name is the stream name.
+	Name string `json:"name"`
+
+	// messages is the number of messages in the stream.
+	// +optional
+	Messages int64 `json:"messages,omitempty"`
+
+	// bytes is the stream's size in bytes.
+	// +optional
+	Bytes int64 `json:"bytes,omitempty"`
+
+	// drift lists the settings where the stream differs from the spec and
+	// couldn't be updated in place.
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+}
+
+// RoundTableDeadLetterStatus reports a RoundTable's dead-lettered tasks.
+type RoundTableDeadLetterStatus struct {
+	// stream is the dead-letter stream name.
+	Stream string `json:"stream"`
+
+	// messages is the number of tasks waiting in the dead-letter stream.
+	// +optional
+	Messages int64 `json:"messages,omitempty"`
+
+	// lastDeadLetterAt is when a task was last dead-lettered.
+	// +optional
+	LastDeadLetterAt *metav1.Time `json:"lastDeadLetterAt,omitempty"`
+
+	// lastRedriveAt is when the dead-lettered tasks were last re-driven.
+	// +optional
+	LastRedriveAt *metav1.Time `json:"lastRedriveAt,omitempty"`
+}
+
+// RoundTableHeraldStatus reports a RoundTable's task router.
+type RoundTableHeraldStatus struct {
+	// routed is the number of tasks routed to a knight.
+	// +optional
+	Routed int64 `json:"routed,omitempty"`
+
+	// pending is the number of tasks waiting to be routed.
+	// +optional
+	Pending int64 `json:"pending,omitempty"`
+
+	// lastRoutedAt is when a task was last routed.
+	// +optional
+	LastRoutedAt *metav1.Time `json:"lastRoutedAt,omitempty"`
+}
+
+// RoundTableModelRolloutStatus reports a model rollout's progress.
+type RoundTableModelRolloutStatus struct {
+	// model is the model being rolled out.
+	Model string `json:"model"`
+
+	// phase is the rollout's state.
+	Phase ModelRolloutPhase `json:"phase"`
+
+	// wave is the number of waves started.
+	// +optional
+	Wave int32 `json:"wave,omitempty"`
+
+	// updatedKnights is the number of knights running the model.
+	// +optional
+	UpdatedKnights int32 `json:"updatedKnights,omitempty"`
+
+	// totalKnights is the number of knights in the table.
+	// +optional
+	TotalKnights int32 `json:"totalKnights,omitempty"`
+
+	// failurePercent is the share of the tasks the upgraded knights
+	// finished since upgrading that failed.
+	// +optional
+	FailurePercent int32 `json:"failurePercent,omitempty"`
+
+	// lastWaveAt is when the last wave started.
+	// +optional
+	LastWaveAt *metav1.Time `json:"lastWaveAt,omitempty"`
+
+	// message describes the rollout's state.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// knights records each upgraded knight's task counts when it was
+	// upgraded, or when the rollout last resumed.
+	// +listType=atomic
+	// +optional
+	Knights []RoundTableRolloutKnight `json:"knights,omitempty"`
+}
+
+// RoundTableRolloutKnight is a knight a model rollout upgraded.
+type RoundTableRolloutKnight struct {
+	// name is the knight's name.
+	Name string `json:"name"`
+
+	// namespace is the knight's namespace.
+	Namespace string `json:"namespace"`
+
+	// tasksCompleted is the knight's completed task count at the baseline.
+	// +optional
+	TasksCompleted int64 `json:"tasksCompleted,omitempty"`
+
+	// tasksFailed is the knight's failed task count at the baseline.
+	// +optional
+	TasksFailed int64 `json:"tasksFailed,omitempty"`
+}
+
+// RoundTableNATSHealthStatus reports the controller's last NATS health
+// probe.
+type RoundTableNATSHealthStatus struct {
+	// rtt is the round trip to the server.
+	// +optional
+	RTT string `json:"rtt,omitempty"`
+
+	// maxConsumerLag is the largest number of tasks pending on a knight's
+	// consumer.
+	// +optional
+	MaxConsumerLag int64 `json:"maxConsumerLag,omitempty"`
+
+	// consumerErrors is the number of knight consumers that couldn't be
+	// inspected.
+	// +optional
+	ConsumerErrors int32 `json:"consumerErrors,omitempty"`
+
+	// checkedAt is when the probe ran.
+	// +optional
+	CheckedAt *metav1.Time `json:"checkedAt,omitempty"`
+}
+
+// RoundTableKVBucketStatus reports a shared KV bucket.
+type RoundTableKVBucketStatus struct {
+	// name is the bucket's name within the table.
+	Name string `json:"name"`
+
+	// bucket is the JetStream KV bucket name.
+	Bucket string `json:"bucket"`
+
+	// values is the number of stored values, including history.
+	// +optional
+	Values int64 `json:"values,omitempty"`
+
+	// bytes is the bucket's size in bytes.
+	// +optional
+	Bytes int64 `json:"bytes,omitempty"`
+}
+
+// RoundTableCostSample is the table's totalCost at one time.
+type RoundTableCostSample struct {
+	// time is when the sample was taken.
+	Time metav1.Time `json:"time"`
+
+	// costUSD is the table's totalCost then.
+	CostUSD string `json:"costUSD"`
+}
+
+// RoundTablePhaseTransition is one phase a RoundTable was in.
+type RoundTablePhaseTransition struct {
+	// phase is the phase the table entered.
+	Phase RoundTablePhase `json:"phase"`
+
+	// since is when the table entered the phase.
+	Since metav1.Time `json:"since"`
+
+	// until is when the table left the phase. Unset for the current phase.
+	// +optional
+	Until *metav1.Time `json:"until,omitempty"`
+
+	// duration is how long the table stayed in the phase, as a Go
+	// duration. Unset for the current phase.
+	// +optional
+	Duration string `json:"duration,omitempty"`
+
+	// costUSD is the table's totalCost on entering the phase.
+	// +optional
+	CostUSD string `json:"costUSD,omitempty"`
+}
+
+// RoundTableDomainStatus rolls up the table's knights in one domain.
+type RoundTableDomainStatus struct {
+	// domain is the knights' spec.domain.
+	Domain string `json:"domain"`
+
+	// knightsTotal is the number of knights in the domain.
+	// +optional
+	KnightsTotal int32 `json:"knightsTotal,omitempty"`
+
+	// knightsReady is the number of the domain's knights that are ready.
+	// +optional
+	KnightsReady int32 `json:"knightsReady,omitempty"`
+
+	// backlog is the number of tasks pending or unacknowledged on the
+	// domain's knight consumers.
+	// +optional
+	Backlog int64 `json:"backlog,omitempty"`
+
+	// tasksPerHour is the rate the domain completed tasks over the last
+	// sample period.
+	// +optional
+	TasksPerHour string `json:"tasksPerHour,omitempty"`
+
+	// costPerHour is the domain's spend in USD per hour over the last
+	// sample period.
+	// +optional
+	CostPerHour string `json:"costPerHour,omitempty"`
+
+	// tasksCompleted is the domain's knights' completed task count at
+	// sampledAt, the start of the next sample period.
+	// +optional
+	TasksCompleted int64 `json:"tasksCompleted,omitempty"`
+
+	// totalCost is the domain's knights' cumulative cost in USD at
+	// sampledAt.
+	// +optional
+	TotalCost string `json:"totalCost,omitempty"`
+
+	// sampledAt is when tasksCompleted and totalCost were sampled. Rates
+	// are recomputed once a sample is five minutes old.
+	// +optional
+	SampledAt *metav1.Time `json:"sampledAt,omitempty"`
+
+	// costUSD is the domain's cost in USD since the table's last cost
+	// reset, checked against its domainQuotas maxCostUSD.
+	// +optional
+	CostUSD string `json:"costUSD,omitempty"`
+
+	// costBaselineUSD is the domain's knights' cumulative cost at the
+	// table's last cost reset.
+	// +optional
+	CostBaselineUSD string `json:"costBaselineUSD,omitempty"`
 }
 
 // RoundTableDefaults defines default configuration inherited by knights in this table.
@@ -158,6 +750,11 @@ type RoundTableDefaults struct {
 	// arsenal configures the default skill arsenal for knights.
 	// +optional
 	Arsenal *KnightArsenal `json:"arsenal,omitempty"`
+
+	// missionCostBudgetUSD is the cost budget of missions referencing this
+	// table that don't set spec.costBudgetUSD.
+	// +optional
+	MissionCostBudgetUSD string `json:"missionCostBudgetUSD,omitempty"`
 }
 
 // RoundTablePolicies defines fleet-level operational policies.
@@ -170,29 +767,185 @@ type RoundTablePolicies struct {
 	MaxConcurrentTasks int32 `json:"maxConcurrentTasks,omitempty"`
 
 	// costBudgetUSD is the maximum cumulative cost in USD across all knights.
-	// When reached, all knights are suspended. "0" means unlimited.
+	// When exceeded, every knight in the table that isn't already suspended
+	// is suspended, and resumed when the cost is back under the budget
+	// (after a cost reset, or a raised budget). "0" means unlimited.
 	// +kubebuilder:default="0"
 	// +optional
 	CostBudgetUSD string `json:"costBudgetUSD,omitempty"`
 
 	// costResetSchedule is a cron expression for resetting the cost counter (e.g., "0 0 1 * *" for monthly).
+	// Knights suspended for the budget resume once a reset brings the
+	// cost back under it.
 	// +optional
 	CostResetSchedule string `json:"costResetSchedule,omitempty"`
 
 	// maxKnights is the maximum number of knights allowed in this table.
-	// 0 means unlimited.
+	// 0 means unlimited. The table's AtCapacity condition reports when it
+	// is reached.
 	// +kubebuilder:default=0
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	MaxKnights int32 `json:"maxKnights,omitempty"`
 
+	// maxKnightsPerDomain caps the knights in this table by spec.domain
+	// (e.g. {"research": 3}). Domains not listed are only bound by
+	// maxKnights. With the Knight webhook enabled, creating a knight over
+	// either limit is rejected.
+	// +optional
+	MaxKnightsPerDomain map[string]int32 `json:"maxKnightsPerDomain,omitempty"`
+
+	// scheduleHold defers the cron-triggered runs of the table's Chains
+	// while the table is OverBudget or Degraded with too few ready knights,
+	// rather than dispatching into a fleet that can't take them. A held
+	// chain is marked with the Deferred condition and runs once the table
+	// recovers.
+	// +optional
+	ScheduleHold *RoundTableScheduleHold `json:"scheduleHold,omitempty"`
+
+	// allowedDomains limits the knight domains this table's Chains and
+	// Missions may use, by their knights' or steps' domain. Empty allows
+	// every domain. With the fleet policy webhook enabled, creating a
+	// Chain or Mission outside them is rejected.
+	// +listType=set
+	// +optional
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+
+	// budgetAlerts posts a message to chat channels or webhooks when the
+	// table's BudgetAtRisk condition turns True: its burn rate projects
+	// costBudgetUSD to be exceeded before the next costResetSchedule reset.
+	// Delivery is a single best-effort attempt each time.
+	// +optional
+	BudgetAlerts []BudgetAlertNotification `json:"budgetAlerts,omitempty"`
+
+	// domainQuotas limits chain step dispatch by the knight's spec.domain
+	// (e.g. {"research": {"maxConcurrentTasks": 2}}), so one busy domain
+	// can't starve the others on a shared fleet. Steps over a quota wait
+	// in Pending. Domains not listed are unlimited.
+	// +optional
+	DomainQuotas map[string]DomainQuota `json:"domainQuotas,omitempty"`
+
 	// maxMissions is the maximum number of concurrent active missions.
+	// Missions over the cap wait in Pending until a slot frees up, oldest
+	// first. 0 means unlimited.
 	// +kubebuilder:default=5
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	MaxMissions int32 `json:"maxMissions,omitempty"`
+
+	// preemptMissions lets a mission waiting for a maxMissions slot pause
+	// the lowest-priority Active mission below its priority. The paused
+	// mission's unfinished chains are suspended until a slot frees up;
+	// completed steps are kept.
+	// +optional
+	PreemptMissions bool `json:"preemptMissions,omitempty"`
+
+	// modelTaskCostUSD is the estimated cost of one task, by model, used by
+	// mission dry runs to estimate a mission's cost (e.g.
+	// {"claude-sonnet-4-20250514": "0.04"}). Models not listed are
+	// estimated at $0.05 a task.
+	// +optional
+	ModelTaskCostUSD map[string]string `json:"modelTaskCostUSD,omitempty"`
+}
+
+// RoundTableScheduleHold configures when a RoundTable holds scheduled
+// chain runs. An OverBudget table always holds them.
+type RoundTableScheduleHold struct {
+	// minReadyPercent is the share of the table's knights that must be
+	// ready; a Degraded table below it holds scheduled runs.
+	// +kubebuilder:default=50
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MinReadyPercent int32 `json:"minReadyPercent,omitempty"`
+}
+
+// BudgetAlertNotification posts a RoundTable budget alert to a chat
+// channel or webhook.
+type BudgetAlertNotification struct {
+	// type selects the message format.
+	// +kubebuilder:validation:Required
+	Type NotificationChannelType `json:"type"`
+
+	// urlSecretRef references the Secret key (in the table's namespace)
+	// holding the webhook URL. The URL must match one of the operator's
+	// allowed URL prefixes (notify.allowedURLPrefixes Helm value).
+	// +kubebuilder:validation:Required
+	URLSecretRef corev1.SecretKeySelector `json:"urlSecretRef"`
+}
+
+// DomainQuota limits the chain tasks dispatched to one domain's knights.
+type DomainQuota struct {
+	// maxConcurrentTasks is the maximum number of the table's chain steps
+	// running on the domain's knights at once. 0 means unlimited.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrentTasks int32 `json:"maxConcurrentTasks,omitempty"`
+
+	// maxCostUSD is the domain's cost in USD, counted since the table's
+	// last cost reset, above which no more steps are dispatched to it.
+	// "0" or empty means unlimited.
+	// +optional
+	MaxCostUSD string `json:"maxCostUSD,omitempty"`
 }
 
+// SecretsMode selects how a RoundTable distributes its secrets.
+type SecretsMode string
+
+const (
+	SecretsModeEnvFrom SecretsMode = "EnvFrom"
+	SecretsModeCopy    SecretsMode = "Copy"
+)
+
+// AnnotationFleetSecrets lists, comma-separated, the RoundTable secrets
+// injected into a Knight's pod as envFrom. The RoundTable controller sets
+// it on the knights it distributes secrets to.
+const AnnotationFleetSecrets = "ai.roundtable.io/fleet-secrets"
+
+// AnnotationFleetSecretsHash is a hash of the data of a Knight's
+// AnnotationFleetSecrets secrets. It is copied to the pod template, so the
+// knight restarts when a secret rotates.
+const AnnotationFleetSecretsHash = "ai.roundtable.io/fleet-secrets-hash"
+
+// AnnotationBudgetSuspended marks a Knight suspended because its RoundTable
+// exceeded policies.costBudgetUSD. Only knights carrying it are resumed
+// when the cost is back under the budget.
+const AnnotationBudgetSuspended = "ai.roundtable.io/budget-suspended"
+
+// AnnotationTableSuspended marks a Knight suspended because its RoundTable
+// was suspended. Only knights carrying it are resumed with the table.
+const AnnotationTableSuspended = "ai.roundtable.io/table-suspended"
+
+// AnnotationResumeRollout resumes a paused modelRollout. When set (to any
+// value) the controller restarts the failure count, resumes the rollout,
+// and removes the annotation.
+const AnnotationResumeRollout = "ai.roundtable.io/resume-rollout"
+
+// AnnotationPreviousModel records the model a Knight ran before a
+// RoundTable modelRollout upgraded it.
+const AnnotationPreviousModel = "ai.roundtable.io/previous-model"
+
+// AnnotationPolicyWarnings lists, semicolon-separated, the RoundTable
+// limits a new Chain or Mission will wait on (maxMissions,
+// maxConcurrentTasks). The fleet policy webhook sets it at creation and
+// when an update changes the steps, knights or RoundTable.
+const AnnotationPolicyWarnings = "ai.roundtable.io/policy-warnings"
+
+// AnnotationRoundTables lists, comma-separated, the RoundTables (as
+// namespace/name) a Namespace lets discover and manage its knights through
+// spec.namespaces or spec.namespaceSelector.
+const AnnotationRoundTables = "ai.roundtable.io/round-tables"
+
+// ModelRolloutPhase is the state of a RoundTable's model rollout.
+// +kubebuilder:validation:Enum=Progressing;Paused;Complete
+type ModelRolloutPhase string
+
+const (
+	ModelRolloutProgressing ModelRolloutPhase = "Progressing"
+	ModelRolloutPaused      ModelRolloutPhase = "Paused"
+	ModelRolloutComplete    ModelRolloutPhase = "Complete"
+)
+
 // RoundTablePhase represents the current lifecycle phase of the RoundTable.
 // +kubebuilder:validation:Enum=Provisioning;Ready;Degraded;Suspended;OverBudget
 type RoundTablePhase string
@@ -210,6 +963,10 @@ type RoundTableKnightSummary struct {
 	// name is the knight name.
 	Name string `json:"name"`
 
+	// namespace is the knight's namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
 	// ready indicates whether this knight is ready.
 	// +optional
 	Ready bool `json:"ready,omitempty"`
@@ -217,6 +974,41 @@ type RoundTableKnightSummary struct {
 	// phase is the knight's current phase.
 	// +optional
 	Phase KnightPhase `json:"phase,omitempty"`
+
+	// domain is the knight's domain.
+	// +optional
+	Domain string `json:"domain,omitempty"`
+
+	// model is the knight's model.
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// skills are the knight's skills.
+	// +listType=atomic
+	// +optional
+	Skills []string `json:"skills,omitempty"`
+
+	// queueDepth is the number of tasks pending or unacknowledged on the
+	// knight's consumer.
+	// +optional
+	QueueDepth int64 `json:"queueDepth,omitempty"`
+
+	// lastTaskAt is when the knight last finished a task.
+	// +optional
+	LastTaskAt *metav1.Time `json:"lastTaskAt,omitempty"`
+
+	// external marks a knight in another cluster known from its
+	// federation heartbeats rather than a Knight resource.
+	// +optional
+	External bool `json:"external,omitempty"`
+
+	// cluster is an external knight's cluster.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+
+	// lastHeartbeat is when an external knight last sent a heartbeat.
+	// +optional
+	LastHeartbeat *metav1.Time `json:"lastHeartbeat,omitempty"`
 }
 
 // RoundTableStatus defines the observed state of RoundTable.
@@ -237,6 +1029,11 @@ type RoundTableStatus struct {
 	// +optional
 	Knights []RoundTableKnightSummary `json:"knights,omitempty"`
 
+	// missingKnights lists the spec.knights entries, as namespace/name,
+	// with no Knight in the table.
+	// +optional
+	MissingKnights []string `json:"missingKnights,omitempty"`
+
 	// totalTasksCompleted is the aggregate tasks completed across all knights.
 	// +optional
 	TotalTasksCompleted int64 `json:"totalTasksCompleted,omitempty"`
@@ -245,6 +1042,67 @@ type RoundTableStatus struct {
 	// +optional
 	TotalCost string `json:"totalCost,omitempty"`
 
+	// lastCostReset is when the cost counter was last reset by
+	// policies.costResetSchedule.
+	// +optional
+	LastCostReset *metav1.Time `json:"lastCostReset,omitempty"`
+
+	// costBaselineUSD is the knights' cumulative cost at the last reset;
+	// totalCost is the cost since.
+	// +optional
+	CostBaselineUSD string `json:"costBaselineUSD,omitempty"`
+
+	// costHistory samples totalCost every 15 minutes over the last day,
+	// oldest first. It restarts at each cost reset.
+	// +optional
+	CostHistory []RoundTableCostSample `json:"costHistory,omitempty"`
+
+	// spendHistory snapshots totalCost once a day over the last 30 days,
+	// oldest first. Unlike costHistory it is kept across cost resets.
+	// +optional
+	SpendHistory []RoundTableCostSample `json:"spendHistory,omitempty"`
+
+	// phaseHistory records the table's last 20 phases, oldest first: when
+	// it entered each, for how long it stayed, and its totalCost on
+	// entering it.
+	// +optional
+	PhaseHistory []RoundTablePhaseTransition `json:"phaseHistory,omitempty"`
+
+	// burnRatePerHour is the spend in USD per hour since the oldest
+	// costHistory sample.
+	// +optional
+	BurnRatePerHour string `json:"burnRatePerHour,omitempty"`
+
+	// forecastCost is the projected totalCost in USD at the next
+	// policies.costResetSchedule reset at the current burn rate. Only set
+	// with a reset schedule and a costHistory sample at least 15 minutes old.
+	// +optional
+	ForecastCost string `json:"forecastCost,omitempty"`
+
+	// streams reports the JetStream streams created for spec.nats.createStreams.
+	// +optional
+	Streams []RoundTableStreamStatus `json:"streams,omitempty"`
+
+	// deadLetter reports the dead-letter stream when spec.nats.deadLetter
+	// is set.
+	// +optional
+	DeadLetter *RoundTableDeadLetterStatus `json:"deadLetter,omitempty"`
+
+	// natsHealth reports the last NATS health probe.
+	// +optional
+	NATSHealth *RoundTableNATSHealthStatus `json:"natsHealth,omitempty"`
+
+	// kvBuckets reports the KV buckets created for spec.nats.kvBuckets.
+	// +optional
+	KVBuckets []RoundTableKVBucketStatus `json:"kvBuckets,omitempty"`
+
+	// domains rolls up the knights' readiness, backlog and throughput by
+	// domain, to show which domain is the bottleneck.
+	// +listType=map
+	// +listMapKey=domain
+	// +optional
+	Domains []RoundTableDomainStatus `json:"domains,omitempty"`
+
 	// activeMissions is the number of currently active missions under this table.
 	// +optional
 	ActiveMissions int32 `json:"activeMissions,omitempty"`
@@ -253,6 +1111,19 @@ type RoundTableStatus struct {
 	// +optional
 	WarmPool *WarmPoolStatus `json:"warmPool,omitempty"`
 
+	// herald reports the tasks routed from {subjectPrefix}.tasks.any.
+	// +optional
+	Herald *RoundTableHeraldStatus `json:"herald,omitempty"`
+
+	// modelRollout reports the progress of spec.modelRollout.
+	// +optional
+	ModelRollout *RoundTableModelRolloutStatus `json:"modelRollout,omitempty"`
+
+	// autoProvision reports each autoProvision domain's backlog and
+	// provisioned knights.
+	// +optional
+	AutoProvision []RoundTableAutoProvisionStatus `json:"autoProvision,omitempty"`
+
 	// observedGeneration is the most recent generation observed by the controller.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
@@ -264,6 +1135,82 @@ type RoundTableStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// RoundTableAutoProvision configures demand-based knight provisioning. A
+// domain's backlog is the number of messages the tasks stream holds on
+// {subjectPrefix}.tasks.{domain}.>, so it is meaningful with WorkQueue or
+// Interest retention. A knight is added when the domain has backlog and no
+// ready knight, or when its backlog has stayed at backlogThreshold or more
+// for scaleUpAfter; one is removed each time the backlog has been empty for
+// idleAfter. Only one provisioned knight per domain starts at a time.
+type RoundTableAutoProvision struct {
+	// domains lists the domains knights are provisioned for.
+	// +listType=map
+	// +listMapKey=domain
+	// +kubebuilder:validation:MinItems=1
+	Domains []AutoProvisionDomain `json:"domains"`
+
+	// backlogThreshold is the backlog that, sustained for scaleUpAfter,
+	// adds a knight to a domain that already has ready knights.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BacklogThreshold int64 `json:"backlogThreshold,omitempty"`
+
+	// scaleUpAfter is how long the backlog must stay at backlogThreshold
+	// before a knight is added, as a Go duration.
+	// +kubebuilder:default="2m"
+	// +optional
+	ScaleUpAfter string `json:"scaleUpAfter,omitempty"`
+
+	// idleAfter is how long a domain's backlog must stay empty before one
+	// of its provisioned knights is removed, as a Go duration.
+	// +kubebuilder:default="15m"
+	// +optional
+	IdleAfter string `json:"idleAfter,omitempty"`
+}
+
+// AutoProvisionDomain configures provisioning for one domain.
+type AutoProvisionDomain struct {
+	// domain is the knights' spec.domain.
+	Domain string `json:"domain"`
+
+	// templateRef names the knightTemplates entry provisioned knights are
+	// created from.
+	TemplateRef string `json:"templateRef"`
+
+	// maxKnights caps the knights provisioned for the domain. The table's
+	// maxKnights and maxKnightsPerDomain policies also apply.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	// +optional
+	MaxKnights int32 `json:"maxKnights,omitempty"`
+}
+
+// RoundTableAutoProvisionStatus reports provisioning for one domain.
+type RoundTableAutoProvisionStatus struct {
+	// domain is the provisioned domain.
+	Domain string `json:"domain"`
+
+	// backlog is the number of the domain's tasks held in the tasks stream.
+	// +optional
+	Backlog int64 `json:"backlog,omitempty"`
+
+	// knights is the number of knights provisioned for the domain.
+	// +optional
+	Knights int32 `json:"knights,omitempty"`
+
+	// backlogSince is when the backlog reached backlogThreshold, or when
+	// the last knight was added while it stayed there.
+	// +optional
+	BacklogSince *metav1.Time `json:"backlogSince,omitempty"`
+
+	// idleSince is when the backlog emptied, or when the last knight was
+	// removed while it stayed empty.
+	// +optional
+	IdleSince *metav1.Time `json:"idleSince,omitempty"`
+}
+
 // WarmPoolConfig configures the operator-wide warm pool of pre-provisioned knight pods.
 // The RoundTable controller maintains a pool of idle, pre-warmed knights that missions
 // can claim instantly instead of cold-starting ephemeral knights.
@@ -323,6 +1270,10 @@ type WarmPoolStatus struct {
 // +kubebuilder:printcolumn:name="Total",type=integer,JSONPath=`.status.knightsTotal`
 // +kubebuilder:printcolumn:name="Tasks",type=integer,JSONPath=`.status.totalTasksCompleted`
 // +kubebuilder:printcolumn:name="Cost",type=string,JSONPath=`.status.totalCost`
+// +kubebuilder:printcolumn:name="Missions",type=integer,JSONPath=`.status.activeMissions`,priority=1
+// +kubebuilder:printcolumn:name="Members",type=string,JSONPath=`.status.knights[*].name`,priority=1
+// +kubebuilder:printcolumn:name="Domains",type=string,JSONPath=`.status.domains[*].domain`,priority=1
+// +kubebuilder:printcolumn:name="Backlog",type=string,JSONPath=`.status.domains[*].backlog`,priority=1,description="Backlog per domain"
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // RoundTable is the Schema for the roundtables API.
@@ -21,11 +21,42 @@ limitations under the License.
 package v1alpha1
 
 import (
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoProvisionDomain) DeepCopyInto(out *AutoProvisionDomain) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoProvisionDomain.
+func (in *AutoProvisionDomain) DeepCopy() *AutoProvisionDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoProvisionDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BudgetAlertNotification) DeepCopyInto(out *BudgetAlertNotification) {
+	*out = *in
+	in.URLSecretRef.DeepCopyInto(&out.URLSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetAlertNotification.
+func (in *BudgetAlertNotification) DeepCopy() *BudgetAlertNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(BudgetAlertNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chain) DeepCopyInto(out *Chain) {
 	*out = *in
@@ -53,6 +84,51 @@ func (in *Chain) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainDryRunStep) DeepCopyInto(out *ChainDryRunStep) {
+	*out = *in
+	if in.Knights != nil {
+		in, out := &in.Knights, &out.Knights
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainDryRunStep.
+func (in *ChainDryRunStep) DeepCopy() *ChainDryRunStep {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainDryRunStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainInputSource) DeepCopyInto(out *ChainInputSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainInputSource.
+func (in *ChainInputSource) DeepCopy() *ChainInputSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainInputSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChainList) DeepCopyInto(out *ChainList) {
 	*out = *in
@@ -85,9 +161,75 @@ func (in *ChainList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainNotification) DeepCopyInto(out *ChainNotification) {
+	*out = *in
+	in.URLSecretRef.DeepCopyInto(&out.URLSecretRef)
+	if in.On != nil {
+		in, out := &in.On, &out.On
+		*out = make([]ChainPhase, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainNotification.
+func (in *ChainNotification) DeepCopy() *ChainNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainParameter) DeepCopyInto(out *ChainParameter) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainParameter.
+func (in *ChainParameter) DeepCopy() *ChainParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainRefTrigger) DeepCopyInto(out *ChainRefTrigger) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainRefTrigger.
+func (in *ChainRefTrigger) DeepCopy() *ChainRefTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainRefTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChainRetryPolicy) DeepCopyInto(out *ChainRetryPolicy) {
 	*out = *in
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainRetryPolicy.
@@ -100,6 +242,29 @@ func (in *ChainRetryPolicy) DeepCopy() *ChainRetryPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainRunRecord) DeepCopyInto(out *ChainRunRecord) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainRunRecord.
+func (in *ChainRunRecord) DeepCopy() *ChainRunRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainRunRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChainSpec) DeepCopyInto(out *ChainSpec) {
 	*out = *in
@@ -110,21 +275,84 @@ func (in *ChainSpec) DeepCopyInto(out *ChainSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(ChainTemplateRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = make([]ChainStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Finally != nil {
+		in, out := &in.Finally, &out.Finally
+		*out = make([]ChainStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.StartingDeadlineSeconds != nil {
 		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = new(ChainTriggers)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ChainParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InputFrom != nil {
+		in, out := &in.InputFrom, &out.InputFrom
+		*out = new(ChainInputSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutputPolicy != nil {
+		in, out := &in.OutputPolicy, &out.OutputPolicy
+		*out = new(OutputPolicy)
+		**out = **in
+	}
+	if in.SuccessfulRunsHistoryLimit != nil {
+		in, out := &in.SuccessfulRunsHistoryLimit, &out.SuccessfulRunsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedRunsHistoryLimit != nil {
+		in, out := &in.FailedRunsHistoryLimit, &out.FailedRunsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RunHistoryTTLSeconds != nil {
+		in, out := &in.RunHistoryTTLSeconds, &out.RunHistoryTTLSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	if in.RetryPolicy != nil {
 		in, out := &in.RetryPolicy, &out.RetryPolicy
 		*out = new(ChainRetryPolicy)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Notify != nil {
 		in, out := &in.Notify, &out.Notify
 		*out = new(NotifySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]ChainNotification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainSpec.
@@ -155,13 +383,55 @@ func (in *ChainStatus) DeepCopyInto(out *ChainStatus) {
 		in, out := &in.CompletedAt, &out.CompletedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.TimedOutAt != nil {
+		in, out := &in.TimedOutAt, &out.TimedOutAt
+		*out = (*in).DeepCopy()
+	}
 	if in.LastScheduledAt != nil {
 		in, out := &in.LastScheduledAt, &out.LastScheduledAt
 		*out = (*in).DeepCopy()
 	}
+	if in.UpstreamRuns != nil {
+		in, out := &in.UpstreamRuns, &out.UpstreamRuns
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TriggerConsumer != nil {
+		in, out := &in.TriggerConsumer, &out.TriggerConsumer
+		*out = new(NATSTriggerConsumer)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CurrentSteps != nil {
+		in, out := &in.CurrentSteps, &out.CurrentSteps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RunHistory != nil {
+		in, out := &in.RunHistory, &out.RunHistory
+		*out = make([]ChainRunRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]ChainDryRunStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -181,16 +451,73 @@ func (in *ChainStatus) DeepCopy() *ChainStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChainStep) DeepCopyInto(out *ChainStep) {
 	*out = *in
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobExecutor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPExecutor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KnightSelector != nil {
+		in, out := &in.KnightSelector, &out.KnightSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DependsOn != nil {
 		in, out := &in.DependsOn, &out.DependsOn
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.FanIn != nil {
+		in, out := &in.FanIn, &out.FanIn
+		*out = new(FanIn)
+		**out = **in
+	}
+	if in.Context != nil {
+		in, out := &in.Context, &out.Context
+		*out = new(StepContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OutputSchema != nil {
+		in, out := &in.OutputSchema, &out.OutputSchema
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verify != nil {
+		in, out := &in.Verify, &out.Verify
+		*out = new(StepVerification)
+		**out = **in
+	}
 	if in.Retry != nil {
 		in, out := &in.Retry, &out.Retry
 		*out = new(StepRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]StepNotification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(StepApproval)
 		**out = **in
 	}
+	if in.InputRequest != nil {
+		in, out := &in.InputRequest, &out.InputRequest
+		*out = new(StepInputRequest)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainStep.
@@ -227,54 +554,311 @@ func (in *ChainStepStatus) DeepCopy() *ChainStepStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GeneratedChain) DeepCopyInto(out *GeneratedChain) {
+func (in *ChainTemplate) DeepCopyInto(out *ChainTemplate) {
 	*out = *in
-	if in.Steps != nil {
-		in, out := &in.Steps, &out.Steps
-		*out = make([]ChainStep, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(int32)
-		**out = **in
-	}
-	if in.RetryPolicy != nil {
-		in, out := &in.RetryPolicy, &out.RetryPolicy
-		*out = new(ChainRetryPolicy)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedChain.
-func (in *GeneratedChain) DeepCopy() *GeneratedChain {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainTemplate.
+func (in *ChainTemplate) DeepCopy() *ChainTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(GeneratedChain)
+	out := new(ChainTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChainTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GeneratedSkill) DeepCopyInto(out *GeneratedSkill) {
+func (in *ChainTemplateList) DeepCopyInto(out *ChainTemplateList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChainTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedSkill.
-func (in *GeneratedSkill) DeepCopy() *GeneratedSkill {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainTemplateList.
+func (in *ChainTemplateList) DeepCopy() *ChainTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(GeneratedSkill)
+	out := new(ChainTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChainTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Knight) DeepCopyInto(out *Knight) {
+func (in *ChainTemplateRef) DeepCopyInto(out *ChainTemplateRef) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainTemplateRef.
+func (in *ChainTemplateRef) DeepCopy() *ChainTemplateRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainTemplateRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainTemplateSpec) DeepCopyInto(out *ChainTemplateSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ChainParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ChainStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = make([]ChainStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Finally != nil {
+		in, out := &in.Finally, &out.Finally
+		*out = make([]ChainStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainTemplateSpec.
+func (in *ChainTemplateSpec) DeepCopy() *ChainTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChainTriggers) DeepCopyInto(out *ChainTriggers) {
+	*out = *in
+	if in.NATS != nil {
+		in, out := &in.NATS, &out.NATS
+		*out = new(NATSTrigger)
+		**out = **in
+	}
+	if in.ChainRef != nil {
+		in, out := &in.ChainRef, &out.ChainRef
+		*out = new(ChainRefTrigger)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChainTriggers.
+func (in *ChainTriggers) DeepCopy() *ChainTriggers {
+	if in == nil {
+		return nil
+	}
+	out := new(ChainTriggers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainQuota) DeepCopyInto(out *DomainQuota) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainQuota.
+func (in *DomainQuota) DeepCopy() *DomainQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FanIn) DeepCopyInto(out *FanIn) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FanIn.
+func (in *FanIn) DeepCopy() *FanIn {
+	if in == nil {
+		return nil
+	}
+	out := new(FanIn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedChain) DeepCopyInto(out *GeneratedChain) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]ChainStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(ChainRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedChain.
+func (in *GeneratedChain) DeepCopy() *GeneratedChain {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedChain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedSkill) DeepCopyInto(out *GeneratedSkill) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedSkill.
+func (in *GeneratedSkill) DeepCopy() *GeneratedSkill {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedSkill)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPExecutor) DeepCopyInto(out *HTTPExecutor) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HTTPHeader, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPExecutor.
+func (in *HTTPExecutor) DeepCopy() *HTTPExecutor {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPExecutor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeader) DeepCopyInto(out *HTTPHeader) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeader.
+func (in *HTTPHeader) DeepCopy() *HTTPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobExecutor) DeepCopyInto(out *JobExecutor) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobExecutor.
+func (in *JobExecutor) DeepCopy() *JobExecutor {
+	if in == nil {
+		return nil
+	}
+	out := new(JobExecutor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Knight) DeepCopyInto(out *Knight) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -380,6 +964,16 @@ func (in *KnightList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KnightNATS) DeepCopyInto(out *KnightNATS) {
 	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(NATSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(NATSTLS)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Subjects != nil {
 		in, out := &in.Subjects, &out.Subjects
 		*out = make([]string, len(*in))
@@ -402,7 +996,7 @@ func (in *KnightPrompt) DeepCopyInto(out *KnightPrompt) {
 	*out = *in
 	if in.ConfigMapRef != nil {
 		in, out := &in.ConfigMapRef, &out.ConfigMapRef
-		*out = new(corev1.LocalObjectReference)
+		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
 }
@@ -480,14 +1074,14 @@ func (in *KnightSpec) DeepCopyInto(out *KnightSpec) {
 	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
-		*out = make([]corev1.EnvVar, len(*in))
+		*out = make([]v1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.EnvFrom != nil {
 		in, out := &in.EnvFrom, &out.EnvFrom
-		*out = make([]corev1.EnvFromSource, len(*in))
+		*out = make([]v1.EnvFromSource, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -534,7 +1128,7 @@ func (in *KnightSpecOverrides) DeepCopyInto(out *KnightSpecOverrides) {
 	}
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
-		*out = make([]corev1.EnvVar, len(*in))
+		*out = make([]v1.EnvVar, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -570,7 +1164,7 @@ func (in *KnightStatus) DeepCopyInto(out *KnightStatus) {
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -680,469 +1274,1614 @@ func (in *Mission) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionChainRef) DeepCopyInto(out *MissionChainRef) {
+func (in *MissionArgument) DeepCopyInto(out *MissionArgument) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionChainRef.
-func (in *MissionChainRef) DeepCopy() *MissionChainRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionArgument.
+func (in *MissionArgument) DeepCopy() *MissionArgument {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionChainRef)
+	out := new(MissionArgument)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionChainStatus) DeepCopyInto(out *MissionChainStatus) {
+func (in *MissionAudit) DeepCopyInto(out *MissionAudit) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionChainStatus.
-func (in *MissionChainStatus) DeepCopy() *MissionChainStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionAudit.
+func (in *MissionAudit) DeepCopy() *MissionAudit {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionChainStatus)
+	out := new(MissionAudit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionKnight) DeepCopyInto(out *MissionKnight) {
+func (in *MissionChainRef) DeepCopyInto(out *MissionChainRef) {
 	*out = *in
-	if in.EphemeralSpec != nil {
-		in, out := &in.EphemeralSpec, &out.EphemeralSpec
-		*out = new(KnightSpec)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SpecOverrides != nil {
-		in, out := &in.SpecOverrides, &out.SpecOverrides
-		*out = new(KnightSpecOverrides)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionKnight.
-func (in *MissionKnight) DeepCopy() *MissionKnight {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionChainRef.
+func (in *MissionChainRef) DeepCopy() *MissionChainRef {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionKnight)
+	out := new(MissionChainRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionKnightCost) DeepCopyInto(out *MissionKnightCost) {
+func (in *MissionChainRetryPolicy) DeepCopyInto(out *MissionChainRetryPolicy) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionKnightCost.
-func (in *MissionKnightCost) DeepCopy() *MissionKnightCost {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionChainRetryPolicy.
+func (in *MissionChainRetryPolicy) DeepCopy() *MissionChainRetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionKnightCost)
+	out := new(MissionChainRetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionKnightStatus) DeepCopyInto(out *MissionKnightStatus) {
+func (in *MissionChainStatus) DeepCopyInto(out *MissionChainStatus) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionKnightStatus.
-func (in *MissionKnightStatus) DeepCopy() *MissionKnightStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionChainStatus.
+func (in *MissionChainStatus) DeepCopy() *MissionChainStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionKnightStatus)
+	out := new(MissionChainStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionKnightTemplate) DeepCopyInto(out *MissionKnightTemplate) {
+func (in *MissionConsensus) DeepCopyInto(out *MissionConsensus) {
 	*out = *in
-	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Choices != nil {
+		in, out := &in.Choices, &out.Choices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Voters != nil {
+		in, out := &in.Voters, &out.Voters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionKnightTemplate.
-func (in *MissionKnightTemplate) DeepCopy() *MissionKnightTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionConsensus.
+func (in *MissionConsensus) DeepCopy() *MissionConsensus {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionKnightTemplate)
+	out := new(MissionConsensus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionList) DeepCopyInto(out *MissionList) {
+func (in *MissionConsensusStatus) DeepCopyInto(out *MissionConsensusStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Mission, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Votes != nil {
+		in, out := &in.Votes, &out.Votes
+		*out = make([]MissionVote, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionList.
-func (in *MissionList) DeepCopy() *MissionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionConsensusStatus.
+func (in *MissionConsensusStatus) DeepCopy() *MissionConsensusStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionList)
+	out := new(MissionConsensusStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MissionList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionPlanner) DeepCopyInto(out *MissionPlanner) {
+func (in *MissionDebate) DeepCopyInto(out *MissionDebate) {
 	*out = *in
-	if in.EphemeralSpec != nil {
-		in, out := &in.EphemeralSpec, &out.EphemeralSpec
-		*out = new(KnightSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Debaters != nil {
+		in, out := &in.Debaters, &out.Debaters
+		*out = make([]MissionDebater, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionPlanner.
-func (in *MissionPlanner) DeepCopy() *MissionPlanner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionDebate.
+func (in *MissionDebate) DeepCopy() *MissionDebate {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionPlanner)
+	out := new(MissionDebate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionRoundTableTemplate) DeepCopyInto(out *MissionRoundTableTemplate) {
+func (in *MissionDebateStatus) DeepCopyInto(out *MissionDebateStatus) {
 	*out = *in
-	if in.Defaults != nil {
-		in, out := &in.Defaults, &out.Defaults
-		*out = new(RoundTableDefaults)
-		(*in).DeepCopyInto(*out)
+	if in.RoundStartedAt != nil {
+		in, out := &in.RoundStartedAt, &out.RoundStartedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.Policies != nil {
-		in, out := &in.Policies, &out.Policies
-		*out = new(RoundTablePolicies)
-		**out = **in
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make([]MissionArgument, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionRoundTableTemplate.
-func (in *MissionRoundTableTemplate) DeepCopy() *MissionRoundTableTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionDebateStatus.
+func (in *MissionDebateStatus) DeepCopy() *MissionDebateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionRoundTableTemplate)
+	out := new(MissionDebateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionSpec) DeepCopyInto(out *MissionSpec) {
+func (in *MissionDebater) DeepCopyInto(out *MissionDebater) {
 	*out = *in
-	if in.Knights != nil {
-		in, out := &in.Knights, &out.Knights
-		*out = make([]MissionKnight, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionDebater.
+func (in *MissionDebater) DeepCopy() *MissionDebater {
+	if in == nil {
+		return nil
 	}
-	if in.Chains != nil {
-		in, out := &in.Chains, &out.Chains
-		*out = make([]MissionChainRef, len(*in))
-		copy(*out, *in)
+	out := new(MissionDebater)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionDebrief) DeepCopyInto(out *MissionDebrief) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionDebrief.
+func (in *MissionDebrief) DeepCopy() *MissionDebrief {
+	if in == nil {
+		return nil
 	}
-	if in.KnightTemplates != nil {
-		in, out := &in.KnightTemplates, &out.KnightTemplates
-		*out = make([]MissionKnightTemplate, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(MissionDebrief)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionDebriefStatus) DeepCopyInto(out *MissionDebriefStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
 	}
-	if in.Secrets != nil {
-		in, out := &in.Secrets, &out.Secrets
-		*out = make([]corev1.LocalObjectReference, len(*in))
+	if in.Summaries != nil {
+		in, out := &in.Summaries, &out.Summaries
+		*out = make([]MissionKnightSummary, len(*in))
 		copy(*out, *in)
 	}
-	if in.RoundTableTemplate != nil {
-		in, out := &in.RoundTableTemplate, &out.RoundTableTemplate
-		*out = new(MissionRoundTableTemplate)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionDebriefStatus.
+func (in *MissionDebriefStatus) DeepCopy() *MissionDebriefStatus {
+	if in == nil {
+		return nil
 	}
-	if in.Planner != nil {
-		in, out := &in.Planner, &out.Planner
-		*out = new(MissionPlanner)
-		(*in).DeepCopyInto(*out)
+	out := new(MissionDebriefStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionFailureNotification) DeepCopyInto(out *MissionFailureNotification) {
+	*out = *in
+	in.URLSecretRef.DeepCopyInto(&out.URLSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionFailureNotification.
+func (in *MissionFailureNotification) DeepCopy() *MissionFailureNotification {
+	if in == nil {
+		return nil
 	}
-	if in.GeneratedChains != nil {
-		in, out := &in.GeneratedChains, &out.GeneratedChains
-		*out = make([]GeneratedChain, len(*in))
+	out := new(MissionFailureNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionFailurePolicy) DeepCopyInto(out *MissionFailurePolicy) {
+	*out = *in
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]MissionFailureNotification, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.GeneratedKnights != nil {
-		in, out := &in.GeneratedKnights, &out.GeneratedKnights
-		*out = make([]MissionKnight, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.FollowUp != nil {
+		in, out := &in.FollowUp, &out.FollowUp
+		*out = new(MissionFollowUp)
+		**out = **in
 	}
-	if in.Notify != nil {
-		in, out := &in.Notify, &out.Notify
-		*out = new(NotifySpec)
+	if in.Page != nil {
+		in, out := &in.Page, &out.Page
+		*out = new(MissionPage)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionSpec.
-func (in *MissionSpec) DeepCopy() *MissionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionFailurePolicy.
+func (in *MissionFailurePolicy) DeepCopy() *MissionFailurePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionSpec)
+	out := new(MissionFailurePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MissionStatus) DeepCopyInto(out *MissionStatus) {
+func (in *MissionFollowUp) DeepCopyInto(out *MissionFollowUp) {
 	*out = *in
-	if in.KnightStatuses != nil {
-		in, out := &in.KnightStatuses, &out.KnightStatuses
-		*out = make([]MissionKnightStatus, len(*in))
-		copy(*out, *in)
-	}
-	if in.StartedAt != nil {
-		in, out := &in.StartedAt, &out.StartedAt
-		*out = (*in).DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionFollowUp.
+func (in *MissionFollowUp) DeepCopy() *MissionFollowUp {
+	if in == nil {
+		return nil
 	}
-	if in.CompletedAt != nil {
-		in, out := &in.CompletedAt, &out.CompletedAt
-		*out = (*in).DeepCopy()
+	out := new(MissionFollowUp)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionKnight) DeepCopyInto(out *MissionKnight) {
+	*out = *in
+	if in.EphemeralSpec != nil {
+		in, out := &in.EphemeralSpec, &out.EphemeralSpec
+		*out = new(KnightSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ExpiresAt != nil {
-		in, out := &in.ExpiresAt, &out.ExpiresAt
-		*out = (*in).DeepCopy()
+	if in.SpecOverrides != nil {
+		in, out := &in.SpecOverrides, &out.SpecOverrides
+		*out = new(KnightSpecOverrides)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.CostBreakdown != nil {
-		in, out := &in.CostBreakdown, &out.CostBreakdown
-		*out = make([]MissionKnightCost, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionKnight.
+func (in *MissionKnight) DeepCopy() *MissionKnight {
+	if in == nil {
+		return nil
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	out := new(MissionKnight)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionKnightCost) DeepCopyInto(out *MissionKnightCost) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionKnightCost.
+func (in *MissionKnightCost) DeepCopy() *MissionKnightCost {
+	if in == nil {
+		return nil
 	}
-	if in.ChainStatuses != nil {
-		in, out := &in.ChainStatuses, &out.ChainStatuses
-		*out = make([]MissionChainStatus, len(*in))
-		copy(*out, *in)
+	out := new(MissionKnightCost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionKnightHealth) DeepCopyInto(out *MissionKnightHealth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionKnightHealth.
+func (in *MissionKnightHealth) DeepCopy() *MissionKnightHealth {
+	if in == nil {
+		return nil
 	}
-	if in.PlanningResult != nil {
-		in, out := &in.PlanningResult, &out.PlanningResult
-		*out = new(PlanningResult)
-		(*in).DeepCopyInto(*out)
+	out := new(MissionKnightHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionKnightStatus) DeepCopyInto(out *MissionKnightStatus) {
+	*out = *in
+	if in.NotReadySince != nil {
+		in, out := &in.NotReadySince, &out.NotReadySince
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionStatus.
-func (in *MissionStatus) DeepCopy() *MissionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionKnightStatus.
+func (in *MissionKnightStatus) DeepCopy() *MissionKnightStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MissionStatus)
+	out := new(MissionKnightStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NotifySpec) DeepCopyInto(out *NotifySpec) {
+func (in *MissionKnightSummary) DeepCopyInto(out *MissionKnightSummary) {
 	*out = *in
-	if in.Webhook != nil {
-		in, out := &in.Webhook, &out.Webhook
-		*out = new(WebhookSink)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionKnightSummary.
+func (in *MissionKnightSummary) DeepCopy() *MissionKnightSummary {
+	if in == nil {
+		return nil
 	}
+	out := new(MissionKnightSummary)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifySpec.
-func (in *NotifySpec) DeepCopy() *NotifySpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionKnightTemplate) DeepCopyInto(out *MissionKnightTemplate) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionKnightTemplate.
+func (in *MissionKnightTemplate) DeepCopy() *MissionKnightTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(NotifySpec)
+	out := new(MissionKnightTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlanningResult) DeepCopyInto(out *PlanningResult) {
+func (in *MissionList) DeepCopyInto(out *MissionList) {
 	*out = *in
-	if in.CompletedAt != nil {
-		in, out := &in.CompletedAt, &out.CompletedAt
-		*out = (*in).DeepCopy()
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Mission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionList.
+func (in *MissionList) DeepCopy() *MissionList {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MissionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionPage) DeepCopyInto(out *MissionPage) {
+	*out = *in
+	in.RoutingKeySecretRef.DeepCopyInto(&out.RoutingKeySecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionPage.
+func (in *MissionPage) DeepCopy() *MissionPage {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionPage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionPhaseTransition) DeepCopyInto(out *MissionPhaseTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionPhaseTransition.
+func (in *MissionPhaseTransition) DeepCopy() *MissionPhaseTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionPhaseTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionPlan) DeepCopyInto(out *MissionPlan) {
+	*out = *in
+	if in.Knights != nil {
+		in, out := &in.Knights, &out.Knights
+		*out = make([]MissionPlanKnight, len(*in))
+		copy(*out, *in)
+	}
+	if in.Chains != nil {
+		in, out := &in.Chains, &out.Chains
+		*out = make([]MissionPlanChain, len(*in))
+		copy(*out, *in)
+	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionPlan.
+func (in *MissionPlan) DeepCopy() *MissionPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionPlanChain) DeepCopyInto(out *MissionPlanChain) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionPlanChain.
+func (in *MissionPlanChain) DeepCopy() *MissionPlanChain {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionPlanChain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionPlanKnight) DeepCopyInto(out *MissionPlanKnight) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionPlanKnight.
+func (in *MissionPlanKnight) DeepCopy() *MissionPlanKnight {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionPlanKnight)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionPlanner) DeepCopyInto(out *MissionPlanner) {
+	*out = *in
+	if in.EphemeralSpec != nil {
+		in, out := &in.EphemeralSpec, &out.EphemeralSpec
+		*out = new(KnightSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionPlanner.
+func (in *MissionPlanner) DeepCopy() *MissionPlanner {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionPlanner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionRequest) DeepCopyInto(out *MissionRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionRequest.
+func (in *MissionRequest) DeepCopy() *MissionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MissionRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionRequestList) DeepCopyInto(out *MissionRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MissionRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionRequestList.
+func (in *MissionRequestList) DeepCopy() *MissionRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MissionRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionRequestSpec) DeepCopyInto(out *MissionRequestSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionRequestSpec.
+func (in *MissionRequestSpec) DeepCopy() *MissionRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionRequestStatus) DeepCopyInto(out *MissionRequestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionRequestStatus.
+func (in *MissionRequestStatus) DeepCopy() *MissionRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionRoundTableTemplate) DeepCopyInto(out *MissionRoundTableTemplate) {
+	*out = *in
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(RoundTableDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = new(RoundTablePolicies)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionRoundTableTemplate.
+func (in *MissionRoundTableTemplate) DeepCopy() *MissionRoundTableTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionRoundTableTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionSpec) DeepCopyInto(out *MissionSpec) {
+	*out = *in
+	if in.Knights != nil {
+		in, out := &in.Knights, &out.Knights
+		*out = make([]MissionKnight, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Chains != nil {
+		in, out := &in.Chains, &out.Chains
+		*out = make([]MissionChainRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ChainParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Debrief != nil {
+		in, out := &in.Debrief, &out.Debrief
+		*out = new(MissionDebrief)
+		**out = **in
+	}
+	if in.Consensus != nil {
+		in, out := &in.Consensus, &out.Consensus
+		*out = new(MissionConsensus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Debate != nil {
+		in, out := &in.Debate, &out.Debate
+		*out = new(MissionDebate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChainRetryPolicy != nil {
+		in, out := &in.ChainRetryPolicy, &out.ChainRetryPolicy
+		*out = new(MissionChainRetryPolicy)
+		**out = **in
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(MissionAudit)
+		**out = **in
+	}
+	if in.KnightTemplates != nil {
+		in, out := &in.KnightTemplates, &out.KnightTemplates
+		*out = make([]MissionKnightTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoundTableTemplate != nil {
+		in, out := &in.RoundTableTemplate, &out.RoundTableTemplate
+		*out = new(MissionRoundTableTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Planner != nil {
+		in, out := &in.Planner, &out.Planner
+		*out = new(MissionPlanner)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GeneratedChains != nil {
+		in, out := &in.GeneratedChains, &out.GeneratedChains
+		*out = make([]GeneratedChain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GeneratedKnights != nil {
+		in, out := &in.GeneratedKnights, &out.GeneratedKnights
+		*out = make([]MissionKnight, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Notify != nil {
+		in, out := &in.Notify, &out.Notify
+		*out = new(NotifySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(MissionFailurePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KnightHealth != nil {
+		in, out := &in.KnightHealth, &out.KnightHealth
+		*out = new(MissionKnightHealth)
+		**out = **in
+	}
+	if in.Timeline != nil {
+		in, out := &in.Timeline, &out.Timeline
+		*out = new(MissionTimeline)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionSpec.
+func (in *MissionSpec) DeepCopy() *MissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionStatus) DeepCopyInto(out *MissionStatus) {
+	*out = *in
+	if in.KnightStatuses != nil {
+		in, out := &in.KnightStatuses, &out.KnightStatuses
+		*out = make([]MissionKnightStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CostBreakdown != nil {
+		in, out := &in.CostBreakdown, &out.CostBreakdown
+		*out = make([]MissionKnightCost, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ChainStatuses != nil {
+		in, out := &in.ChainStatuses, &out.ChainStatuses
+		*out = make([]MissionChainStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.PlanningResult != nil {
+		in, out := &in.PlanningResult, &out.PlanningResult
+		*out = new(PlanningResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Debrief != nil {
+		in, out := &in.Debrief, &out.Debrief
+		*out = new(MissionDebriefStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Consensus != nil {
+		in, out := &in.Consensus, &out.Consensus
+		*out = new(MissionConsensusStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Debate != nil {
+		in, out := &in.Debate, &out.Debate
+		*out = new(MissionDebateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(MissionPlan)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PhaseHistory != nil {
+		in, out := &in.PhaseHistory, &out.PhaseHistory
+		*out = make([]MissionPhaseTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionStatus.
+func (in *MissionStatus) DeepCopy() *MissionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionTimeline) DeepCopyInto(out *MissionTimeline) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionTimeline.
+func (in *MissionTimeline) DeepCopy() *MissionTimeline {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionTimeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MissionVote) DeepCopyInto(out *MissionVote) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MissionVote.
+func (in *MissionVote) DeepCopy() *MissionVote {
+	if in == nil {
+		return nil
+	}
+	out := new(MissionVote)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATSAuth) DeepCopyInto(out *NATSAuth) {
+	*out = *in
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(NATSUserAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NKeySeedSecretRef != nil {
+		in, out := &in.NKeySeedSecretRef, &out.NKeySeedSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CredsSecretRef != nil {
+		in, out := &in.CredsSecretRef, &out.CredsSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSAuth.
+func (in *NATSAuth) DeepCopy() *NATSAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(NATSAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATSTLS) DeepCopyInto(out *NATSTLS) {
+	*out = *in
+	if in.CASecretRef != nil {
+		in, out := &in.CASecretRef, &out.CASecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSTLS.
+func (in *NATSTLS) DeepCopy() *NATSTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(NATSTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATSTrigger) DeepCopyInto(out *NATSTrigger) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSTrigger.
+func (in *NATSTrigger) DeepCopy() *NATSTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(NATSTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATSTriggerConsumer) DeepCopyInto(out *NATSTriggerConsumer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSTriggerConsumer.
+func (in *NATSTriggerConsumer) DeepCopy() *NATSTriggerConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(NATSTriggerConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATSUserAuth) DeepCopyInto(out *NATSUserAuth) {
+	*out = *in
+	in.PasswordSecretRef.DeepCopyInto(&out.PasswordSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATSUserAuth.
+func (in *NATSUserAuth) DeepCopy() *NATSUserAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(NATSUserAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifySpec) DeepCopyInto(out *NotifySpec) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookSink)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifySpec.
+func (in *NotifySpec) DeepCopy() *NotifySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutputPolicy) DeepCopyInto(out *OutputPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutputPolicy.
+func (in *OutputPolicy) DeepCopy() *OutputPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OutputPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanningResult) DeepCopyInto(out *PlanningResult) {
+	*out = *in
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanningResult.
+func (in *PlanningResult) DeepCopy() *PlanningResult {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanningResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTable) DeepCopyInto(out *RoundTable) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTable.
+func (in *RoundTable) DeepCopy() *RoundTable {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoundTable) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableAutoProvision) DeepCopyInto(out *RoundTableAutoProvision) {
+	*out = *in
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]AutoProvisionDomain, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableAutoProvision.
+func (in *RoundTableAutoProvision) DeepCopy() *RoundTableAutoProvision {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableAutoProvision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableAutoProvisionStatus) DeepCopyInto(out *RoundTableAutoProvisionStatus) {
+	*out = *in
+	if in.BacklogSince != nil {
+		in, out := &in.BacklogSince, &out.BacklogSince
+		*out = (*in).DeepCopy()
+	}
+	if in.IdleSince != nil {
+		in, out := &in.IdleSince, &out.IdleSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableAutoProvisionStatus.
+func (in *RoundTableAutoProvisionStatus) DeepCopy() *RoundTableAutoProvisionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableAutoProvisionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableCostSample) DeepCopyInto(out *RoundTableCostSample) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableCostSample.
+func (in *RoundTableCostSample) DeepCopy() *RoundTableCostSample {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableCostSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableDeadLetter) DeepCopyInto(out *RoundTableDeadLetter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableDeadLetter.
+func (in *RoundTableDeadLetter) DeepCopy() *RoundTableDeadLetter {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableDeadLetter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableDeadLetterStatus) DeepCopyInto(out *RoundTableDeadLetterStatus) {
+	*out = *in
+	if in.LastDeadLetterAt != nil {
+		in, out := &in.LastDeadLetterAt, &out.LastDeadLetterAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRedriveAt != nil {
+		in, out := &in.LastRedriveAt, &out.LastRedriveAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableDeadLetterStatus.
+func (in *RoundTableDeadLetterStatus) DeepCopy() *RoundTableDeadLetterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableDeadLetterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableDefaults) DeepCopyInto(out *RoundTableDefaults) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(KnightResources)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Arsenal != nil {
+		in, out := &in.Arsenal, &out.Arsenal
+		*out = new(KnightArsenal)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableDefaults.
+func (in *RoundTableDefaults) DeepCopy() *RoundTableDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableDomainStatus) DeepCopyInto(out *RoundTableDomainStatus) {
+	*out = *in
+	if in.SampledAt != nil {
+		in, out := &in.SampledAt, &out.SampledAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableDomainStatus.
+func (in *RoundTableDomainStatus) DeepCopy() *RoundTableDomainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableDomainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableDomainStream) DeepCopyInto(out *RoundTableDomainStream) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableDomainStream.
+func (in *RoundTableDomainStream) DeepCopy() *RoundTableDomainStream {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableDomainStream)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableFederation) DeepCopyInto(out *RoundTableFederation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableFederation.
+func (in *RoundTableFederation) DeepCopy() *RoundTableFederation {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableFederation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableHerald) DeepCopyInto(out *RoundTableHerald) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableHerald.
+func (in *RoundTableHerald) DeepCopy() *RoundTableHerald {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableHerald)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableHeraldStatus) DeepCopyInto(out *RoundTableHeraldStatus) {
+	*out = *in
+	if in.LastRoutedAt != nil {
+		in, out := &in.LastRoutedAt, &out.LastRoutedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableHeraldStatus.
+func (in *RoundTableHeraldStatus) DeepCopy() *RoundTableHeraldStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableHeraldStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableKVBucket) DeepCopyInto(out *RoundTableKVBucket) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableKVBucket.
+func (in *RoundTableKVBucket) DeepCopy() *RoundTableKVBucket {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableKVBucket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableKVBucketStatus) DeepCopyInto(out *RoundTableKVBucketStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableKVBucketStatus.
+func (in *RoundTableKVBucketStatus) DeepCopy() *RoundTableKVBucketStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableKVBucketStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableKnightSummary) DeepCopyInto(out *RoundTableKnightSummary) {
+	*out = *in
+	if in.Skills != nil {
+		in, out := &in.Skills, &out.Skills
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastTaskAt != nil {
+		in, out := &in.LastTaskAt, &out.LastTaskAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastHeartbeat != nil {
+		in, out := &in.LastHeartbeat, &out.LastHeartbeat
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableKnightSummary.
+func (in *RoundTableKnightSummary) DeepCopy() *RoundTableKnightSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableKnightSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableList) DeepCopyInto(out *RoundTableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RoundTable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableList.
+func (in *RoundTableList) DeepCopy() *RoundTableList {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoundTableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableMember) DeepCopyInto(out *RoundTableMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableMember.
+func (in *RoundTableMember) DeepCopy() *RoundTableMember {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableModelRollout) DeepCopyInto(out *RoundTableModelRollout) {
+	*out = *in
+	if in.DomainOrder != nil {
+		in, out := &in.DomainOrder, &out.DomainOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableModelRollout.
+func (in *RoundTableModelRollout) DeepCopy() *RoundTableModelRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableModelRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableModelRolloutStatus) DeepCopyInto(out *RoundTableModelRolloutStatus) {
+	*out = *in
+	if in.LastWaveAt != nil {
+		in, out := &in.LastWaveAt, &out.LastWaveAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Knights != nil {
+		in, out := &in.Knights, &out.Knights
+		*out = make([]RoundTableRolloutKnight, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableModelRolloutStatus.
+func (in *RoundTableModelRolloutStatus) DeepCopy() *RoundTableModelRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableModelRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableNATS) DeepCopyInto(out *RoundTableNATS) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(NATSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(NATSTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(RoundTableStreamSettings)
+		**out = **in
+	}
+	if in.DomainStreams != nil {
+		in, out := &in.DomainStreams, &out.DomainStreams
+		*out = make([]RoundTableDomainStream, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(RoundTableDeadLetter)
+		**out = **in
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(RoundTableNATSHealth)
+		**out = **in
+	}
+	if in.KVBuckets != nil {
+		in, out := &in.KVBuckets, &out.KVBuckets
+		*out = make([]RoundTableKVBucket, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanningResult.
-func (in *PlanningResult) DeepCopy() *PlanningResult {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableNATS.
+func (in *RoundTableNATS) DeepCopy() *RoundTableNATS {
 	if in == nil {
 		return nil
 	}
-	out := new(PlanningResult)
+	out := new(RoundTableNATS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoundTable) DeepCopyInto(out *RoundTable) {
+func (in *RoundTableNATSHealth) DeepCopyInto(out *RoundTableNATSHealth) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTable.
-func (in *RoundTable) DeepCopy() *RoundTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableNATSHealth.
+func (in *RoundTableNATSHealth) DeepCopy() *RoundTableNATSHealth {
 	if in == nil {
 		return nil
 	}
-	out := new(RoundTable)
+	out := new(RoundTableNATSHealth)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RoundTable) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoundTableDefaults) DeepCopyInto(out *RoundTableDefaults) {
+func (in *RoundTableNATSHealthStatus) DeepCopyInto(out *RoundTableNATSHealthStatus) {
 	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(KnightResources)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Arsenal != nil {
-		in, out := &in.Arsenal, &out.Arsenal
-		*out = new(KnightArsenal)
-		**out = **in
+	if in.CheckedAt != nil {
+		in, out := &in.CheckedAt, &out.CheckedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableDefaults.
-func (in *RoundTableDefaults) DeepCopy() *RoundTableDefaults {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableNATSHealthStatus.
+func (in *RoundTableNATSHealthStatus) DeepCopy() *RoundTableNATSHealthStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RoundTableDefaults)
+	out := new(RoundTableNATSHealthStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoundTableKnightSummary) DeepCopyInto(out *RoundTableKnightSummary) {
+func (in *RoundTablePhaseTransition) DeepCopyInto(out *RoundTablePhaseTransition) {
 	*out = *in
+	in.Since.DeepCopyInto(&out.Since)
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableKnightSummary.
-func (in *RoundTableKnightSummary) DeepCopy() *RoundTableKnightSummary {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTablePhaseTransition.
+func (in *RoundTablePhaseTransition) DeepCopy() *RoundTablePhaseTransition {
 	if in == nil {
 		return nil
 	}
-	out := new(RoundTableKnightSummary)
+	out := new(RoundTablePhaseTransition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoundTableList) DeepCopyInto(out *RoundTableList) {
+func (in *RoundTablePolicies) DeepCopyInto(out *RoundTablePolicies) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]RoundTable, len(*in))
+	if in.MaxKnightsPerDomain != nil {
+		in, out := &in.MaxKnightsPerDomain, &out.MaxKnightsPerDomain
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ScheduleHold != nil {
+		in, out := &in.ScheduleHold, &out.ScheduleHold
+		*out = new(RoundTableScheduleHold)
+		**out = **in
+	}
+	if in.AllowedDomains != nil {
+		in, out := &in.AllowedDomains, &out.AllowedDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.BudgetAlerts != nil {
+		in, out := &in.BudgetAlerts, &out.BudgetAlerts
+		*out = make([]BudgetAlertNotification, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DomainQuotas != nil {
+		in, out := &in.DomainQuotas, &out.DomainQuotas
+		*out = make(map[string]DomainQuota, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ModelTaskCostUSD != nil {
+		in, out := &in.ModelTaskCostUSD, &out.ModelTaskCostUSD
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableList.
-func (in *RoundTableList) DeepCopy() *RoundTableList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTablePolicies.
+func (in *RoundTablePolicies) DeepCopy() *RoundTablePolicies {
 	if in == nil {
 		return nil
 	}
-	out := new(RoundTableList)
+	out := new(RoundTablePolicies)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RoundTableList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoundTableNATS) DeepCopyInto(out *RoundTableNATS) {
+func (in *RoundTableRolloutKnight) DeepCopyInto(out *RoundTableRolloutKnight) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableNATS.
-func (in *RoundTableNATS) DeepCopy() *RoundTableNATS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableRolloutKnight.
+func (in *RoundTableRolloutKnight) DeepCopy() *RoundTableRolloutKnight {
 	if in == nil {
 		return nil
 	}
-	out := new(RoundTableNATS)
+	out := new(RoundTableRolloutKnight)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoundTablePolicies) DeepCopyInto(out *RoundTablePolicies) {
+func (in *RoundTableScheduleHold) DeepCopyInto(out *RoundTableScheduleHold) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTablePolicies.
-func (in *RoundTablePolicies) DeepCopy() *RoundTablePolicies {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableScheduleHold.
+func (in *RoundTableScheduleHold) DeepCopy() *RoundTableScheduleHold {
 	if in == nil {
 		return nil
 	}
-	out := new(RoundTablePolicies)
+	out := new(RoundTableScheduleHold)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1150,7 +2889,7 @@ func (in *RoundTablePolicies) DeepCopy() *RoundTablePolicies {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoundTableSpec) DeepCopyInto(out *RoundTableSpec) {
 	*out = *in
-	out.NATS = in.NATS
+	in.NATS.DeepCopyInto(&out.NATS)
 	if in.Defaults != nil {
 		in, out := &in.Defaults, &out.Defaults
 		*out = new(RoundTableDefaults)
@@ -1159,16 +2898,31 @@ func (in *RoundTableSpec) DeepCopyInto(out *RoundTableSpec) {
 	if in.Policies != nil {
 		in, out := &in.Policies, &out.Policies
 		*out = new(RoundTablePolicies)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.KnightSelector != nil {
 		in, out := &in.KnightSelector, &out.KnightSelector
-		*out = new(v1.LabelSelector)
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Knights != nil {
+		in, out := &in.Knights, &out.Knights
+		*out = make([]RoundTableMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Secrets != nil {
 		in, out := &in.Secrets, &out.Secrets
-		*out = make([]corev1.LocalObjectReference, len(*in))
+		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.Vault != nil {
@@ -1193,6 +2947,26 @@ func (in *RoundTableSpec) DeepCopyInto(out *RoundTableSpec) {
 		*out = new(WarmPoolConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoProvision != nil {
+		in, out := &in.AutoProvision, &out.AutoProvision
+		*out = new(RoundTableAutoProvision)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Herald != nil {
+		in, out := &in.Herald, &out.Herald
+		*out = new(RoundTableHerald)
+		**out = **in
+	}
+	if in.Federation != nil {
+		in, out := &in.Federation, &out.Federation
+		*out = new(RoundTableFederation)
+		**out = **in
+	}
+	if in.ModelRollout != nil {
+		in, out := &in.ModelRollout, &out.ModelRollout
+		*out = new(RoundTableModelRollout)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableSpec.
@@ -1211,16 +2985,94 @@ func (in *RoundTableStatus) DeepCopyInto(out *RoundTableStatus) {
 	if in.Knights != nil {
 		in, out := &in.Knights, &out.Knights
 		*out = make([]RoundTableKnightSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MissingKnights != nil {
+		in, out := &in.MissingKnights, &out.MissingKnights
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastCostReset != nil {
+		in, out := &in.LastCostReset, &out.LastCostReset
+		*out = (*in).DeepCopy()
+	}
+	if in.CostHistory != nil {
+		in, out := &in.CostHistory, &out.CostHistory
+		*out = make([]RoundTableCostSample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SpendHistory != nil {
+		in, out := &in.SpendHistory, &out.SpendHistory
+		*out = make([]RoundTableCostSample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PhaseHistory != nil {
+		in, out := &in.PhaseHistory, &out.PhaseHistory
+		*out = make([]RoundTablePhaseTransition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Streams != nil {
+		in, out := &in.Streams, &out.Streams
+		*out = make([]RoundTableStreamStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeadLetter != nil {
+		in, out := &in.DeadLetter, &out.DeadLetter
+		*out = new(RoundTableDeadLetterStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NATSHealth != nil {
+		in, out := &in.NATSHealth, &out.NATSHealth
+		*out = new(RoundTableNATSHealthStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KVBuckets != nil {
+		in, out := &in.KVBuckets, &out.KVBuckets
+		*out = make([]RoundTableKVBucketStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]RoundTableDomainStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.WarmPool != nil {
 		in, out := &in.WarmPool, &out.WarmPool
 		*out = new(WarmPoolStatus)
 		**out = **in
 	}
+	if in.Herald != nil {
+		in, out := &in.Herald, &out.Herald
+		*out = new(RoundTableHeraldStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ModelRollout != nil {
+		in, out := &in.ModelRollout, &out.ModelRollout
+		*out = new(RoundTableModelRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoProvision != nil {
+		in, out := &in.AutoProvision, &out.AutoProvision
+		*out = make([]RoundTableAutoProvisionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1237,6 +3089,41 @@ func (in *RoundTableStatus) DeepCopy() *RoundTableStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableStreamSettings) DeepCopyInto(out *RoundTableStreamSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableStreamSettings.
+func (in *RoundTableStreamSettings) DeepCopy() *RoundTableStreamSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableStreamSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoundTableStreamStatus) DeepCopyInto(out *RoundTableStreamStatus) {
+	*out = *in
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoundTableStreamStatus.
+func (in *RoundTableStreamStatus) DeepCopy() *RoundTableStreamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoundTableStreamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SharedWorkspaceConfig) DeepCopyInto(out *SharedWorkspaceConfig) {
 	*out = *in
@@ -1252,9 +3139,94 @@ func (in *SharedWorkspaceConfig) DeepCopy() *SharedWorkspaceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepApproval) DeepCopyInto(out *StepApproval) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepApproval.
+func (in *StepApproval) DeepCopy() *StepApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(StepApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepContext) DeepCopyInto(out *StepContext) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepContext.
+func (in *StepContext) DeepCopy() *StepContext {
+	if in == nil {
+		return nil
+	}
+	out := new(StepContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepInputRequest) DeepCopyInto(out *StepInputRequest) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepInputRequest.
+func (in *StepInputRequest) DeepCopy() *StepInputRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(StepInputRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepNotification) DeepCopyInto(out *StepNotification) {
+	*out = *in
+	if in.On != nil {
+		in, out := &in.On, &out.On
+		*out = make([]ChainStepPhase, len(*in))
+		copy(*out, *in)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookSink)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepNotification.
+func (in *StepNotification) DeepCopy() *StepNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(StepNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StepRetry) DeepCopyInto(out *StepRetry) {
 	*out = *in
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepRetry.
@@ -1267,6 +3239,21 @@ func (in *StepRetry) DeepCopy() *StepRetry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepVerification) DeepCopyInto(out *StepVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepVerification.
+func (in *StepVerification) DeepCopy() *StepVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(StepVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WarmPoolConfig) DeepCopyInto(out *WarmPoolConfig) {
 	*out = *in
@@ -1303,7 +3290,7 @@ func (in *WebhookSink) DeepCopyInto(out *WebhookSink) {
 	*out = *in
 	if in.TokenSecretRef != nil {
 		in, out := &in.TokenSecretRef, &out.TokenSecretRef
-		*out = new(corev1.SecretKeySelector)
+		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Context != nil {
@@ -44,6 +44,8 @@ import (
 	knightpkg "github.com/dapperdivers/roundtable/internal/knight"
 	"github.com/dapperdivers/roundtable/internal/mission"
 	notifypkg "github.com/dapperdivers/roundtable/internal/notify"
+	webhookv1alpha1 "github.com/dapperdivers/roundtable/internal/webhook/v1alpha1"
+	"github.com/dapperdivers/roundtable/pkg/artifact"
 	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
 	rtruntime "github.com/dapperdivers/roundtable/pkg/runtime"
 	sandboxv1alpha1 "sigs.k8s.io/agent-sandbox/api/v1alpha1"
@@ -195,8 +197,14 @@ func main() {
 	if natsURL := os.Getenv("NATS_URL"); natsURL != "" {
 		natsConfig.URL = natsURL
 	}
+	// Credentials and TLS for the shared connection (NATS_USER,
+	// NATS_CREDS_FILE, NATS_CA_FILE, ...)
+	if err := natsConfig.LoadEnv(os.Getenv); err != nil {
+		setupLog.Error(err, "Invalid NATS credentials")
+		os.Exit(1)
+	}
 	natsProvider := natspkg.NewProvider(natsConfig, ctrl.Log.WithName("nats"))
-	setupLog.Info("NATS provider initialized", "url", natsConfig.URL)
+	setupLog.Info("NATS provider initialized", "url", natsConfig.URL, "secured", natsConfig.Secured())
 
 	// Completion webhook notifier (spec.notify on Chains/Missions). The URL
 	// allowlist is the SSRF guard — with no prefixes configured, every
@@ -253,12 +261,54 @@ func main() {
 		setupLog.Error(err, "Failed to create controller", "controller", "Knight")
 		os.Exit(1)
 	}
+	// HTTP executor steps get their own URL allowlist (SSRF guard); with no
+	// prefixes configured, every http step fails.
+	var httpStepPrefixes []string
+	for p := range strings.SplitSeq(os.Getenv("HTTP_STEP_ALLOWED_URL_PREFIXES"), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			httpStepPrefixes = append(httpStepPrefixes, p)
+		}
+	}
+
+	// NATS triggers consume over the shared connection, so they are limited
+	// to subjects under these prefixes; with none configured, no trigger
+	// fires.
+	var natsTriggerPrefixes []string
+	for p := range strings.SplitSeq(os.Getenv("NATS_TRIGGER_ALLOWED_SUBJECT_PREFIXES"), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			natsTriggerPrefixes = append(natsTriggerPrefixes, p)
+		}
+	}
+
+	// Job executor steps may only run as service accounts the operator lists;
+	// with none configured, a step setting serviceAccountName fails.
+	var jobServiceAccounts []string
+	for sa := range strings.SplitSeq(os.Getenv("JOB_STEP_SERVICE_ACCOUNTS"), ",") {
+		if sa = strings.TrimSpace(sa); sa != "" {
+			jobServiceAccounts = append(jobServiceAccounts, sa)
+		}
+	}
+
+	// Job executor steps read their output from pod logs
+	podLogs, err := controller.NewPodLogReader(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "Failed to create pod log reader")
+		os.Exit(1)
+	}
+	// Chain step artifacts and exported mission timelines share a bucket
+	artifacts := artifact.NewNATSObjectStore(natsProvider, os.Getenv("CHAIN_ARTIFACT_BUCKET"))
 	if err := (&controller.ChainReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("chain-controller"),
-		NATS:     natsProvider,
-		Notify:   notifier,
+		Client:                            mgr.GetClient(),
+		Scheme:                            mgr.GetScheme(),
+		Recorder:                          mgr.GetEventRecorderFor("chain-controller"),
+		NATS:                              natsProvider,
+		Notify:                            notifier,
+		Artifacts:                         artifacts,
+		PodLogs:                           podLogs,
+		HTTPAllowedURLPrefixes:            httpStepPrefixes,
+		NATSTriggerAllowedSubjectPrefixes: natsTriggerPrefixes,
+		JobServiceAccounts:                jobServiceAccounts,
+		JobSecurity:                       knightSecurity,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "Failed to create controller", "controller", "Chain")
 		os.Exit(1)
@@ -268,6 +318,7 @@ func main() {
 		Scheme:   mgr.GetScheme(),
 		Recorder: mgr.GetEventRecorderFor("roundtable-controller"),
 		NATS:     natsProvider,
+		Notify:   notifier,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "Failed to create controller", "controller", "RoundTable")
 		os.Exit(1)
@@ -278,12 +329,13 @@ func main() {
 		NATS:   natsProvider,
 	}
 	if err := (&controller.MissionReconciler{
-		Client:   mgr.GetClient(),
-		Scheme:   mgr.GetScheme(),
-		Recorder: mgr.GetEventRecorderFor("mission-controller"),
-		NATS:     natsProvider,
-		Notify:   notifier,
-		Planner:  missionPlanner,
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor("mission-controller"),
+		NATS:      natsProvider,
+		Notify:    notifier,
+		Planner:   missionPlanner,
+		Artifacts: artifacts,
 		Assembler: &mission.KnightAssembler{
 			Client: mgr.GetClient(),
 			Scheme: mgr.GetScheme(),
@@ -292,6 +344,30 @@ func main() {
 		setupLog.Error(err, "Failed to create controller", "controller", "Mission")
 		os.Exit(1)
 	}
+	if err := (&controller.MissionRequestReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("missionrequest-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "Failed to create controller", "controller", "MissionRequest")
+		os.Exit(1)
+	}
+	// The webhooks need serving certificates, so they are
+	// opt-in (the Helm chart sets ENABLE_WEBHOOKS with webhook.enabled).
+	if os.Getenv("ENABLE_WEBHOOKS") == "true" {
+		if err := webhookv1alpha1.SetupChainWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "Failed to create webhook", "webhook", "Chain")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupKnightWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "Failed to create webhook", "webhook", "Knight")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupFleetPolicyWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "Failed to create webhook", "webhook", "FleetPolicy")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
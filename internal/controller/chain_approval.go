@@ -0,0 +1,131 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// isApprovalStep reports whether the step is a manual approval gate rather
+// than a knight task.
+func isApprovalStep(step *aiv1alpha1.ChainStep) bool {
+	return step != nil && step.Type == aiv1alpha1.ChainStepTypeApproval
+}
+
+// approvalAnnotation returns the annotation key a human sets to decide the
+// named approval step.
+func approvalAnnotation(stepName string) string {
+	return aiv1alpha1.AnnotationApprovalPrefix + stepName
+}
+
+// approvalDecision returns the normalized decision recorded on the chain for
+// the named step, or "" when none has been made.
+func approvalDecision(chain *aiv1alpha1.Chain, stepName string) string {
+	return strings.ToLower(strings.TrimSpace(chain.Annotations[approvalAnnotation(stepName)]))
+}
+
+// requestApproval moves a ready approval step into AwaitingApproval. It
+// reports whether a decision annotation was already present: decisions are
+// only honored while the step is waiting, so a leftover from an earlier run
+// must be cleared rather than silently approving this one.
+func (r *ChainReconciler) requestApproval(chain *aiv1alpha1.Chain, ss *aiv1alpha1.ChainStepStatus, prompt string) bool {
+	now := metav1.Now()
+	ss.Phase = aiv1alpha1.ChainStepPhaseAwaitingApproval
+	ss.StartedAt = &now
+	ss.Message = prompt
+	r.Recorder.Eventf(chain, corev1.EventTypeNormal, "ApprovalRequested",
+		"Step %s is waiting for approval (annotate %s=%s|%s)", ss.Name,
+		approvalAnnotation(ss.Name), aiv1alpha1.ApprovalApprove, aiv1alpha1.ApprovalReject)
+	return approvalDecision(chain, ss.Name) != ""
+}
+
+// reconcileApproval resolves an AwaitingApproval step from its decision
+// annotation or, failing that, its timeout. It reports whether the decision
+// annotation was consumed and should be removed once the status is saved.
+func (r *ChainReconciler) reconcileApproval(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus) bool {
+	now := metav1.Now()
+
+	switch decision := approvalDecision(chain, ss.Name); decision {
+	case "":
+		// No decision yet — fall through to the timeout check.
+	case aiv1alpha1.ApprovalApprove:
+		ss.Phase = aiv1alpha1.ChainStepPhaseSucceeded
+		ss.Output = "approved"
+		ss.CompletedAt = &now
+		r.Recorder.Eventf(chain, corev1.EventTypeNormal, "StepApproved", "Step %s approved", ss.Name)
+		return true
+	case aiv1alpha1.ApprovalReject:
+		ss.Phase = aiv1alpha1.ChainStepPhaseFailed
+		ss.Error = "rejected by approver"
+		ss.CompletedAt = &now
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "StepRejected", "Step %s rejected", ss.Name)
+		return true
+	default:
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "InvalidApproval",
+			"Ignoring approval value %q for step %s (expected %s or %s)",
+			decision, ss.Name, aiv1alpha1.ApprovalApprove, aiv1alpha1.ApprovalReject)
+		return true
+	}
+
+	if step.Approval == nil || step.Approval.TimeoutSeconds == 0 || ss.StartedAt == nil {
+		return false
+	}
+	timeout := time.Duration(step.Approval.TimeoutSeconds) * time.Second
+	if time.Since(ss.StartedAt.Time) <= timeout {
+		return false
+	}
+
+	ss.CompletedAt = &now
+	if step.Approval.OnTimeout == "Approve" {
+		ss.Phase = aiv1alpha1.ChainStepPhaseSucceeded
+		ss.Output = "approved"
+		r.Recorder.Eventf(chain, corev1.EventTypeNormal, "StepApproved",
+			"Step %s auto-approved after %ds without a decision", ss.Name, step.Approval.TimeoutSeconds)
+		return false
+	}
+	ss.Phase = aiv1alpha1.ChainStepPhaseFailed
+	ss.Error = fmt.Sprintf("approval timed out after %ds", step.Approval.TimeoutSeconds)
+	r.Recorder.Eventf(chain, corev1.EventTypeWarning, "StepRejected",
+		"Step %s auto-rejected after %ds without a decision", ss.Name, step.Approval.TimeoutSeconds)
+	return false
+}
+
+// clearApprovalAnnotations removes consumed decision annotations. It must run
+// after the status update so a failed write cannot lose a decision. Failures
+// are only logged: a leftover is discarded when the step next waits.
+func (r *ChainReconciler) clearApprovalAnnotations(ctx context.Context, chain *aiv1alpha1.Chain, stepNames []string) {
+	if len(stepNames) == 0 {
+		return
+	}
+	patch := client.MergeFrom(chain.DeepCopy())
+	for _, name := range stepNames {
+		delete(chain.Annotations, approvalAnnotation(name))
+	}
+	if err := r.Patch(ctx, chain, patch); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to clear approval annotations", "steps", stepNames)
+	}
+}
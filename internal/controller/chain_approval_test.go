@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestReconcileApproval(t *testing.T) {
+	r := &ChainReconciler{Recorder: record.NewFakeRecorder(10)}
+
+	tests := []struct {
+		name       string
+		annotation string
+		approval   *aiv1alpha1.StepApproval
+		waited     time.Duration
+		wantPhase  aiv1alpha1.ChainStepPhase
+		wantClear  bool
+	}{
+		{
+			name:      "no decision yet",
+			wantPhase: aiv1alpha1.ChainStepPhaseAwaitingApproval,
+		},
+		{
+			name:       "approved",
+			annotation: "approve",
+			wantPhase:  aiv1alpha1.ChainStepPhaseSucceeded,
+			wantClear:  true,
+		},
+		{
+			name:       "rejected, case-insensitive",
+			annotation: " Reject ",
+			wantPhase:  aiv1alpha1.ChainStepPhaseFailed,
+			wantClear:  true,
+		},
+		{
+			name:       "unrecognized value is discarded",
+			annotation: "lgtm",
+			wantPhase:  aiv1alpha1.ChainStepPhaseAwaitingApproval,
+			wantClear:  true,
+		},
+		{
+			name:      "timeout rejects by default",
+			approval:  &aiv1alpha1.StepApproval{TimeoutSeconds: 60},
+			waited:    2 * time.Minute,
+			wantPhase: aiv1alpha1.ChainStepPhaseFailed,
+		},
+		{
+			name:      "timeout can approve",
+			approval:  &aiv1alpha1.StepApproval{TimeoutSeconds: 60, OnTimeout: "Approve"},
+			waited:    2 * time.Minute,
+			wantPhase: aiv1alpha1.ChainStepPhaseSucceeded,
+		},
+		{
+			name:      "within timeout keeps waiting",
+			approval:  &aiv1alpha1.StepApproval{TimeoutSeconds: 600},
+			waited:    time.Minute,
+			wantPhase: aiv1alpha1.ChainStepPhaseAwaitingApproval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &aiv1alpha1.Chain{}
+			if tt.annotation != "" {
+				chain.Annotations = map[string]string{approvalAnnotation("gate"): tt.annotation}
+			}
+			step := &aiv1alpha1.ChainStep{Name: "gate", Type: aiv1alpha1.ChainStepTypeApproval, Approval: tt.approval}
+			started := metav1.NewTime(time.Now().Add(-tt.waited))
+			ss := &aiv1alpha1.ChainStepStatus{
+				Name:      "gate",
+				Phase:     aiv1alpha1.ChainStepPhaseAwaitingApproval,
+				StartedAt: &started,
+			}
+
+			if got := r.reconcileApproval(chain, step, ss); got != tt.wantClear {
+				t.Errorf("reconcileApproval() consumed = %v, want %v", got, tt.wantClear)
+			}
+			if ss.Phase != tt.wantPhase {
+				t.Errorf("phase = %s, want %s", ss.Phase, tt.wantPhase)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// stepOutputPreviewLimit is the default bound on ChainStepStatus.Output.
+// 4000 chars allows meaningful summaries while staying well under etcd's
+// 1.5MB object limit — 10 steps × 4KB = 40KB max.
+const stepOutputPreviewLimit = 4000
+
+// kvOutputScheme prefixes references to a step's chain-outputs KV entry.
+const kvOutputScheme = "nats-kv://"
+
+// stepOutputLimit returns the most output bytes kept in a step's status.
+func stepOutputLimit(chain *aiv1alpha1.Chain) int {
+	if p := chain.Spec.OutputPolicy; p != nil && p.MaxBytes > 0 {
+		return int(p.MaxBytes)
+	}
+	return stepOutputPreviewLimit
+}
+
+// stepOutputOverflow returns where the full output of a truncated step goes.
+func stepOutputOverflow(chain *aiv1alpha1.Chain) aiv1alpha1.OutputOverflow {
+	if p := chain.Spec.OutputPolicy; p != nil && p.Overflow != "" {
+		return p.Overflow
+	}
+	return aiv1alpha1.OutputOverflowObjectStore
+}
+
+// kvOutputRef references the chain-outputs KV entry holding a step's output.
+func kvOutputRef(chain *aiv1alpha1.Chain, stepName string) string {
+	return kvOutputScheme + "chain-outputs/" + chain.Name + "." + stepName
+}
+
+// truncateOutput cuts output to at most limit bytes without splitting a
+// UTF-8 character.
+func truncateOutput(output string, limit int) string {
+	if len(output) <= limit {
+		return output
+	}
+	for limit > 0 && !utf8.RuneStart(output[limit]) {
+		limit--
+	}
+	return output[:limit]
+}
+
+// artifactKey names a step's artifact; the run ID keeps runs apart.
+func artifactKey(chain *aiv1alpha1.Chain, stepName string) string {
+	return chain.Namespace + "/" + chain.Name + "/" + chain.Status.RunID + "/" + stepName
+}
+
+// recordStepOutput sets the step's status output. Outputs over the
+// outputPolicy limit are truncated, and the full output is offloaded as the
+// policy's overflow says: to the artifact store, or the chain-outputs KV
+// entry written by storeStepOutputToKV (also the fallback when the store is
+// missing or the write fails), or nowhere.
+func (r *ChainReconciler) recordStepOutput(ctx context.Context, chain *aiv1alpha1.Chain, ss *aiv1alpha1.ChainStepStatus, output string) {
+	ss.Output = output
+	ss.Truncated = false
+	ss.ArtifactRef = ""
+	limit := stepOutputLimit(chain)
+	if len(output) <= limit {
+		return
+	}
+
+	ss.Output = truncateOutput(output, limit)
+	ss.Truncated = true
+	switch stepOutputOverflow(chain) {
+	case aiv1alpha1.OutputOverflowDrop:
+		return
+	case aiv1alpha1.OutputOverflowObjectStore:
+		if r.Artifacts != nil {
+			ref, err := r.Artifacts.Put(artifactKey(chain, ss.Name), []byte(output))
+			if err == nil {
+				ss.ArtifactRef = ref
+				return
+			}
+			logf.FromContext(ctx).Error(err, "Failed to store step artifact, falling back to KV reference", "step", ss.Name)
+		}
+	}
+	ss.ArtifactRef = kvOutputRef(chain, ss.Name)
+}
+
+// stepArtifact returns a step's full output: the offloaded output when the
+// status only holds a truncated one, otherwise the status output itself.
+func (r *ChainReconciler) stepArtifact(chain *aiv1alpha1.Chain, stepName string) (string, error) {
+	for _, ss := range chain.Status.StepStatuses {
+		if ss.Name != stepName {
+			continue
+		}
+		if ss.ArtifactRef == "" {
+			return ss.Output, nil
+		}
+		if strings.HasPrefix(ss.ArtifactRef, kvOutputScheme) {
+			return r.kvStepOutput(chain, stepName)
+		}
+		if r.Artifacts == nil {
+			return "", fmt.Errorf("step %q has artifact %s but no artifact store is configured", stepName, ss.ArtifactRef)
+		}
+		data, err := r.Artifacts.Get(ss.ArtifactRef)
+		if err != nil {
+			return "", fmt.Errorf("fetch artifact for step %q: %w", stepName, err)
+		}
+		return string(data), nil
+	}
+	return "", fmt.Errorf("unknown step %q", stepName)
+}
+
+// kvStepOutput reads a step's output from its chain-outputs KV entry. The
+// entry only holds the latest run, so an entry from another run is an error.
+func (r *ChainReconciler) kvStepOutput(chain *aiv1alpha1.Chain, stepName string) (string, error) {
+	client, err := r.natsClient()
+	if err != nil {
+		return "", err
+	}
+	key := chain.Name + "." + stepName
+	data, err := client.KVGet("chain-outputs", key)
+	if err != nil {
+		return "", fmt.Errorf("fetch output for step %q from KV: %w", stepName, err)
+	}
+	var kvValue struct {
+		Output string `json:"output"`
+		RunID  string `json:"runId"`
+	}
+	if err := json.Unmarshal(data, &kvValue); err != nil {
+		return "", fmt.Errorf("decode KV output for step %q: %w", stepName, err)
+	}
+	if kvValue.RunID != chain.Status.RunID {
+		return "", fmt.Errorf("KV output for step %q belongs to run %q, not %q", stepName, kvValue.RunID, chain.Status.RunID)
+	}
+	return kvValue.Output, nil
+}
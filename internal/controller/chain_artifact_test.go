@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// memoryStore is an in-memory artifact.Store.
+type memoryStore struct {
+	objects map[string][]byte
+	err     error
+}
+
+func (m *memoryStore) Put(key string, data []byte) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	ref := "mem://" + key
+	m.objects[ref] = data
+	return ref, nil
+}
+
+func (m *memoryStore) Get(ref string) ([]byte, error) {
+	data, ok := m.objects[ref]
+	if !ok {
+		return nil, fmt.Errorf("no object %s", ref)
+	}
+	return data, nil
+}
+
+func TestRecordStepOutputPolicy(t *testing.T) {
+	output := strings.Repeat("x", 300)
+
+	tests := []struct {
+		name      string
+		policy    *aiv1alpha1.OutputPolicy
+		storeErr  error
+		wantLen   int
+		wantRef   string
+		truncated bool
+	}{
+		{name: "default fits", wantLen: 300},
+		{name: "object store", policy: &aiv1alpha1.OutputPolicy{MaxBytes: 256}, wantLen: 256, wantRef: "mem://", truncated: true},
+		{name: "object store failure falls back to KV", policy: &aiv1alpha1.OutputPolicy{MaxBytes: 256}, storeErr: fmt.Errorf("down"),
+			wantLen: 256, wantRef: "nats-kv://chain-outputs/recon.scan", truncated: true},
+		{name: "NATS KV", policy: &aiv1alpha1.OutputPolicy{MaxBytes: 256, Overflow: aiv1alpha1.OutputOverflowNATSKV},
+			wantLen: 256, wantRef: "nats-kv://chain-outputs/recon.scan", truncated: true},
+		{name: "drop", policy: &aiv1alpha1.OutputPolicy{MaxBytes: 256, Overflow: aiv1alpha1.OutputOverflowDrop}, wantLen: 256, truncated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ChainReconciler{Artifacts: &memoryStore{objects: map[string][]byte{}, err: tt.storeErr}}
+			chain := &aiv1alpha1.Chain{}
+			chain.Name = "recon"
+			chain.Spec.OutputPolicy = tt.policy
+			ss := &aiv1alpha1.ChainStepStatus{Name: "scan"}
+
+			r.recordStepOutput(context.Background(), chain, ss, output)
+			if len(ss.Output) != tt.wantLen || ss.Truncated != tt.truncated || !strings.HasPrefix(ss.ArtifactRef, tt.wantRef) ||
+				(tt.wantRef == "" && ss.ArtifactRef != "") {
+				t.Errorf("status = %d bytes, truncated %t, artifactRef %q", len(ss.Output), ss.Truncated, ss.ArtifactRef)
+			}
+		})
+	}
+}
+
+func TestRenderTemplateFullOutput(t *testing.T) {
+	full := strings.Repeat("line\n", 100)
+	r := &ChainReconciler{Artifacts: &memoryStore{objects: map[string][]byte{}}}
+	chain := &aiv1alpha1.Chain{
+		Spec: aiv1alpha1.ChainSpec{OutputPolicy: &aiv1alpha1.OutputPolicy{MaxBytes: 256}},
+		Status: aiv1alpha1.ChainStatus{StepStatuses: []aiv1alpha1.ChainStepStatus{
+			{Name: "scan", Phase: aiv1alpha1.ChainStepPhaseSucceeded},
+			{Name: "enrich", Phase: aiv1alpha1.ChainStepPhaseSucceeded},
+		}},
+	}
+	r.recordStepOutput(context.Background(), chain, &chain.Status.StepStatuses[0], full)
+	chain.Spec.OutputPolicy.Overflow = aiv1alpha1.OutputOverflowDrop
+	r.recordStepOutput(context.Background(), chain, &chain.Status.StepStatuses[1], full)
+
+	got, err := r.renderTemplate(chain, `{{ .Steps.scan.Output | len }} {{ .Steps.scan.Truncated }} {{ .Steps.enrich.Output | len }} {{ .Steps.enrich.Truncated }}`)
+	if err != nil {
+		t.Fatalf("renderTemplate() error = %v", err)
+	}
+	if want := "500 false 256 true"; got != want {
+		t.Errorf("renderTemplate() = %q, want %q", got, want)
+	}
+}
+
+func TestTruncateOutput(t *testing.T) {
+	if got := truncateOutput("héllo", 2); got != "h" {
+		t.Errorf("truncateOutput() = %q, want the cut before a multi-byte character", got)
+	}
+	if got := truncateOutput("hello", 10); got != "hello" {
+		t.Errorf("truncateOutput() = %q, want the whole output", got)
+	}
+}
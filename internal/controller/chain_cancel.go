@@ -0,0 +1,196 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// cancelRequested reports whether the cancel annotation targets the current
+// run: its value is "true" (or empty) or matches the run ID.
+func cancelRequested(chain *aiv1alpha1.Chain) bool {
+	v, ok := chain.Annotations[aiv1alpha1.AnnotationCancel]
+	if !ok {
+		return false
+	}
+	return v == "" || v == "true" || v == chain.Status.RunID
+}
+
+// cancelRun ends the current run as Failed/Cancelled.
+func (r *ChainReconciler) cancelRun(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig) {
+	aborted := r.abortRun(ctx, chain, nc, aiv1alpha1.ReasonChainCancelled, "Chain run cancelled")
+	r.Recorder.Eventf(chain, corev1.EventTypeWarning, "Cancelled",
+		"Chain run cancelled, abort sent for %d in-flight task(s)", aborted)
+}
+
+// abortRun ends the current run as Failed with the given reason: every step
+// that has not finished is Skipped, knights running one of its tasks are
+// asked to abort it, the Jobs of running job steps are deleted, and the
+// requests of running http steps cancelled. The
+// abort is best-effort — a knight that misses it finishes the task and its
+// result is ignored. It returns the number of aborts sent.
+func (r *ChainReconciler) abortRun(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, reason, message string) int {
+	aborted := r.abortSteps(ctx, chain, nc, false, message)
+	r.failRun(chain, reason, message)
+	return aborted
+}
+
+// abortSteps skips every step of the run that has not finished, aborting
+// its task or Job, and returns the number of aborts sent. With mainOnly the
+// handler steps are left alone.
+func (r *ChainReconciler) abortSteps(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, mainOnly bool, message string) int {
+	log := logf.FromContext(ctx)
+	now := metav1.Now()
+
+	steps := allChainSteps(chain)
+	specMap := make(map[string]*aiv1alpha1.ChainStep, len(steps))
+	for i := range steps {
+		specMap[steps[i].Name] = &steps[i]
+	}
+
+	aborted := 0
+	for i := range chain.Status.StepStatuses {
+		ss := &chain.Status.StepStatuses[i]
+		if mainOnly && ss.Handler != "" {
+			continue
+		}
+		switch ss.Phase {
+		case aiv1alpha1.ChainStepPhaseSucceeded, aiv1alpha1.ChainStepPhaseFailed, aiv1alpha1.ChainStepPhaseSkipped:
+			continue
+		case aiv1alpha1.ChainStepPhaseRunning:
+			if isHTTPStep(specMap[ss.Name]) && !ss.Verifying {
+				r.cancelHTTPStep(ss.TaskID)
+				aborted++
+				break
+			}
+			if isJobStep(specMap[ss.Name]) {
+				if err := r.deleteStepJob(ctx, chain, ss); err != nil {
+					log.Error(err, "Failed to delete step Job", "step", ss.Name, "job", ss.TaskID)
+				} else {
+					aborted++
+				}
+				break
+			}
+			if err := r.publishTaskCancel(ctx, chain, nc, ss, message); err != nil {
+				log.Error(err, "Failed to publish task cancellation", "step", ss.Name, "knight", ss.Knight)
+			} else {
+				aborted++
+			}
+		}
+		ss.Phase = aiv1alpha1.ChainStepPhaseSkipped
+		ss.Message = message
+		ss.CompletedAt = &now
+	}
+	return aborted
+}
+
+// failRun ends the current run as Failed with the given reason.
+func (r *ChainReconciler) failRun(chain *aiv1alpha1.Chain, reason, message string) {
+	now := metav1.Now()
+	chain.Status.Phase = aiv1alpha1.ChainPhaseFailed
+	chain.Status.CompletedAt = &now
+	chain.Status.RunsFailed++
+	meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionChainComplete,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: chain.Generation,
+	})
+	recordRunHistory(chain)
+}
+
+// timeOutRun handles a run past its timeout. The first time, its main steps
+// are aborted and its onFailure and finally handlers started; a run with no
+// handlers to run fails straight away. Handlers still running once
+// handlerTimeout has passed are aborted too. It reports whether the run
+// ended.
+func (r *ChainReconciler) timeOutRun(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig) bool {
+	if chain.Status.TimedOutAt != nil {
+		message := fmt.Sprintf("Chain handlers timed out after %ds", int32(handlerTimeout(chain)/time.Second))
+		aborted := r.abortRun(ctx, chain, nc, aiv1alpha1.ReasonChainTimeout, message)
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "Failed", "%s, abort sent for %d in-flight task(s)", message, aborted)
+		return true
+	}
+
+	now := metav1.Now()
+	chain.Status.TimedOutAt = &now
+	message := fmt.Sprintf("Chain timed out after %ds", chain.Spec.Timeout)
+	aborted := r.abortSteps(ctx, chain, nc, true, message)
+	r.startHandlers(chain, true)
+	if handlersRunning(chain) {
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "TimedOut",
+			"%s, abort sent for %d in-flight task(s), running handlers", message, aborted)
+		return false
+	}
+	r.failRun(chain, aiv1alpha1.ReasonChainTimeout, message)
+	r.Recorder.Eventf(chain, corev1.EventTypeWarning, "Failed", "%s, abort sent for %d in-flight task(s)", message, aborted)
+	return true
+}
+
+// publishTaskCancel asks the knight running a step's task to abort it.
+func (r *ChainReconciler) publishTaskCancel(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, ss *aiv1alpha1.ChainStepStatus, reason string) error {
+	if ss.TaskID == "" || ss.Knight == "" {
+		return nil
+	}
+	knight := &aiv1alpha1.Knight{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ss.Knight, Namespace: chain.Namespace}, knight); err != nil {
+		return err
+	}
+	c, err := r.fleetClient(nc)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(natspkg.TaskControl{
+		Action:    natspkg.TaskControlCancel,
+		TaskID:    ss.TaskID,
+		ChainName: chain.Name,
+		StepName:  ss.Name,
+		RunID:     chain.Status.RunID,
+		Reason:    reason,
+	})
+	if err != nil {
+		return err
+	}
+	return c.PublishCore(natspkg.ControlSubject(nc.SubjectPrefix, knight.Spec.Domain, knight.Name), data)
+}
+
+// clearCancelAnnotation removes the cancel annotation once the cancellation
+// is recorded, so it doesn't cancel the next run.
+func (r *ChainReconciler) clearCancelAnnotation(ctx context.Context, chain *aiv1alpha1.Chain) {
+	if _, ok := chain.Annotations[aiv1alpha1.AnnotationCancel]; !ok {
+		return
+	}
+	patch := client.MergeFrom(chain.DeepCopy())
+	delete(chain.Annotations, aiv1alpha1.AnnotationCancel)
+	if err := r.Patch(ctx, chain, patch); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to clear cancel annotation")
+	}
+}
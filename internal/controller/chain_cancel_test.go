@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestCancelRequested(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{name: "no annotation", want: false},
+		{name: "true", annotations: map[string]string{aiv1alpha1.AnnotationCancel: "true"}, want: true},
+		{name: "current run", annotations: map[string]string{aiv1alpha1.AnnotationCancel: "run-2"}, want: true},
+		{name: "earlier run", annotations: map[string]string{aiv1alpha1.AnnotationCancel: "run-1"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &aiv1alpha1.Chain{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations},
+				Status:     aiv1alpha1.ChainStatus{RunID: "run-2"},
+			}
+			if got := cancelRequested(chain); got != tt.want {
+				t.Errorf("cancelRequested() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCancelRun(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add roundtable scheme: %v", err)
+	}
+	knight := &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{Name: "galahad", Namespace: "default"},
+		Spec:       aiv1alpha1.KnightSpec{Domain: "security"},
+	}
+	nc := newFakeNATSClient()
+	r := &ChainReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(knight).Build(),
+		Recorder: record.NewFakeRecorder(10),
+		NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+	}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "audit", Namespace: "default"},
+		Status: aiv1alpha1.ChainStatus{
+			Phase: aiv1alpha1.ChainPhaseRunning,
+			RunID: "run-1",
+			StepStatuses: []aiv1alpha1.ChainStepStatus{
+				{Name: "scan", Phase: aiv1alpha1.ChainStepPhaseSucceeded},
+				{Name: "analyze", Phase: aiv1alpha1.ChainStepPhaseRunning, TaskID: "task-1", Knight: "galahad"},
+				{Name: "report", Phase: aiv1alpha1.ChainStepPhasePending},
+			},
+		},
+	}
+
+	r.cancelRun(context.Background(), chain, natsConfig{SubjectPrefix: "fleet-a"})
+
+	if chain.Status.Phase != aiv1alpha1.ChainPhaseFailed {
+		t.Errorf("chain phase = %s, want Failed", chain.Status.Phase)
+	}
+	wantPhases := []aiv1alpha1.ChainStepPhase{
+		aiv1alpha1.ChainStepPhaseSucceeded, aiv1alpha1.ChainStepPhaseSkipped, aiv1alpha1.ChainStepPhaseSkipped,
+	}
+	for i, want := range wantPhases {
+		if got := chain.Status.StepStatuses[i].Phase; got != want {
+			t.Errorf("step %s phase = %s, want %s", chain.Status.StepStatuses[i].Name, got, want)
+		}
+	}
+
+	data, ok := nc.published["fleet-a.control.security.galahad"]
+	if !ok {
+		t.Fatalf("no cancellation published, got subjects %v", nc.subjects())
+	}
+	var msg natspkg.TaskControl
+	if err := json.Unmarshal(data, &msg); err != nil {
+		t.Fatalf("unmarshal control message: %v", err)
+	}
+	if msg.Action != natspkg.TaskControlCancel || msg.TaskID != "task-1" {
+		t.Errorf("control message = %+v, want cancel of task-1", msg)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// stepContextTemplate follows the task of a step with context.
+const stepContextTemplate = "\n\n## Context\n\n{{ .Context }}"
+
+// stepContextTruncated marks an output cut to fit the context budget.
+const stepContextTruncated = "\n[truncated]"
+
+// validateStepContext checks a step's context is packed into a knight task
+// and only names other steps of the chain.
+func validateStepContext(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep) error {
+	if step.Context == nil {
+		return nil
+	}
+	if !isKnightStep(step) {
+		return fmt.Errorf("step %q sets context but is not a knight step", step.Name)
+	}
+	known := make(map[string]bool)
+	for _, s := range allChainSteps(chain) {
+		known[s.Name] = true
+	}
+	for _, name := range step.Context.Steps {
+		if name == step.Name {
+			return fmt.Errorf("step %q lists itself in context", step.Name)
+		}
+		if !known[name] {
+			return fmt.Errorf("step %q context references unknown step %q", step.Name, name)
+		}
+	}
+	return nil
+}
+
+// stepContext packs the outputs step's context selects from the rendered
+// .Steps data. It is empty for steps without context.
+func stepContext(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, steps map[string]map[string]interface{}) string {
+	if step == nil || step.Context == nil {
+		return ""
+	}
+	statuses := make(map[string]*aiv1alpha1.ChainStepStatus, len(chain.Status.StepStatuses))
+	for i := range chain.Status.StepStatuses {
+		statuses[chain.Status.StepStatuses[i].Name] = &chain.Status.StepStatuses[i]
+	}
+
+	var outputs depOutputs
+	var completed []*aiv1alpha1.ChainStepStatus
+	for _, name := range step.Context.Steps {
+		ss, ok := statuses[name]
+		if !ok || ss.Phase != aiv1alpha1.ChainStepPhaseSucceeded {
+			continue
+		}
+		outputs = append(outputs, depOutput{Name: name, Output: steps[name]["Output"].(string)})
+		completed = append(completed, ss)
+	}
+	if step.Context.Order != aiv1alpha1.StepContextOrderPriority {
+		// Ties keep the listed order, so the packing is deterministic.
+		idx := make([]int, len(outputs))
+		for i := range idx {
+			idx[i] = i
+		}
+		sort.SliceStable(idx, func(a, b int) bool {
+			ta, tb := completed[idx[a]].CompletedAt, completed[idx[b]].CompletedAt
+			if ta == nil || tb == nil {
+				return ta != nil
+			}
+			return ta.After(tb.Time)
+		})
+		sorted := make(depOutputs, len(outputs))
+		for i, j := range idx {
+			sorted[i] = outputs[j]
+		}
+		outputs = sorted
+	}
+	return packContext(outputs, int(step.Context.MaxBytes))
+}
+
+// packContext joins outputs in order, one section per step, within
+// maxBytes. The first output that does not fit is cut to the remaining
+// budget and the rest are left out.
+func packContext(outputs depOutputs, maxBytes int) string {
+	var b strings.Builder
+	for _, out := range outputs {
+		head := fmt.Sprintf("### %s\n\n", out.Name)
+		if b.Len() > 0 {
+			head = "\n\n" + head
+		}
+		body := strings.TrimRight(out.Output, "\n")
+		remaining := maxBytes - b.Len() - len(head)
+		if len(body) <= remaining {
+			b.WriteString(head + body)
+			continue
+		}
+		if cut := remaining - len(stepContextTruncated); cut > 0 {
+			b.WriteString(head + truncateOutput(body, cut) + stepContextTruncated)
+		}
+		break
+	}
+	return b.String()
+}
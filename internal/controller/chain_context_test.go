@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestStepContext(t *testing.T) {
+	at := func(sec int) *metav1.Time {
+		ts := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, sec, 0, time.UTC))
+		return &ts
+	}
+	chain := &aiv1alpha1.Chain{
+		Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{
+			{Name: "scan", Task: "scan"},
+			{Name: "enrich", Task: "enrich"},
+			{Name: "triage", Task: "triage"},
+			{Name: "report", Task: "Write the report.", Context: &aiv1alpha1.StepContext{Steps: []string{"scan", "enrich", "triage"}, MaxBytes: 1000}},
+		}},
+		Status: aiv1alpha1.ChainStatus{StepStatuses: []aiv1alpha1.ChainStepStatus{
+			{Name: "scan", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Output: "ports\n", CompletedAt: at(1)},
+			{Name: "enrich", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Output: strings.Repeat("e", 40), CompletedAt: at(2)},
+			{Name: "triage", Phase: aiv1alpha1.ChainStepPhaseFailed, Error: "boom", CompletedAt: at(3)},
+		}},
+	}
+	r := &ChainReconciler{}
+	step := &chain.Spec.Steps[3]
+
+	got, err := r.renderStepTemplate(chain, step, stepTaskTemplate(step))
+	if err != nil {
+		t.Fatalf("renderStepTemplate() error = %v", err)
+	}
+	want := "Write the report.\n\n## Context\n\n### enrich\n\n" + strings.Repeat("e", 40) + "\n\n### scan\n\nports"
+	if got != want {
+		t.Errorf("newest first = %q, want %q", got, want)
+	}
+
+	step.Context.Order = aiv1alpha1.StepContextOrderPriority
+	step.Context.MaxBytes = 45
+	got, err = r.renderStepTemplate(chain, step, "{{ .Context }}")
+	if err != nil {
+		t.Fatalf("renderStepTemplate() error = %v", err)
+	}
+	if want := "### scan\n\nports\n\n### enrich\n\neeee" + stepContextTruncated; got != want {
+		t.Errorf("priority within budget = %q, want %q", got, want)
+	}
+	if len(got) > 45 {
+		t.Errorf("packed context is %d bytes, over the 45 byte budget", len(got))
+	}
+}
+
+func TestValidateStepContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		step    aiv1alpha1.ChainStep
+		wantErr bool
+	}{
+		{name: "valid", step: aiv1alpha1.ChainStep{Name: "report", Context: &aiv1alpha1.StepContext{Steps: []string{"scan"}, MaxBytes: 100}}},
+		{name: "unknown step", step: aiv1alpha1.ChainStep{Name: "report", Context: &aiv1alpha1.StepContext{Steps: []string{"nope"}, MaxBytes: 100}}, wantErr: true},
+		{name: "itself", step: aiv1alpha1.ChainStep{Name: "report", Context: &aiv1alpha1.StepContext{Steps: []string{"report"}, MaxBytes: 100}}, wantErr: true},
+		{name: "http step", step: aiv1alpha1.ChainStep{Name: "report", Executor: aiv1alpha1.StepExecutorHTTP, HTTP: &aiv1alpha1.HTTPExecutor{URL: "https://example.com"},
+			Context: &aiv1alpha1.StepContext{Steps: []string{"scan"}, MaxBytes: 100}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{{Name: "scan"}, tt.step}}}
+			if err := validateExecutors(chain); (err != nil) != tt.wantErr {
+				t.Errorf("validateExecutors() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
 	"sync"
 	"text/template"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/dapperdivers/roundtable/internal/util"
 	"github.com/robfig/cron/v3"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -38,12 +40,16 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	knightpkg "github.com/dapperdivers/roundtable/internal/knight"
 	"github.com/dapperdivers/roundtable/internal/notify"
+	"github.com/dapperdivers/roundtable/pkg/artifact"
 	"github.com/dapperdivers/roundtable/pkg/metrics"
 	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
 )
@@ -54,9 +60,10 @@ const (
 
 // natsConfig holds resolved NATS configuration for a chain's target RoundTable.
 type natsConfig struct {
-	SubjectPrefix string // e.g. "table-prefix" or "chelonian"
-	TasksStream   string // e.g. "fleet_a_tasks" or "chelonian_tasks"
-	ResultsStream string // e.g. "fleet_a_results" or "chelonian_results"
+	Conn          natspkg.Connection // the RoundTable's NATS server and credentials; empty uses the shared client
+	SubjectPrefix string             // e.g. "table-prefix" or "chelonian"
+	TasksStream   string             // e.g. "fleet_a_tasks" or "chelonian_tasks"
+	ResultsStream string             // e.g. "fleet_a_results" or "chelonian_results"
 }
 
 // ChainReconciler reconciles a Chain object.
@@ -67,10 +74,50 @@ type ChainReconciler struct {
 
 	NATS   *natspkg.Provider
 	Notify *notify.Notifier
-	cron   *cron.Cron
-	mu     sync.Mutex
+	// Artifacts stores full step outputs that exceed the status preview.
+	// Optional — without it, large outputs are only kept in NATS KV.
+	Artifacts artifact.Store
+	// PodLogs reads the stdout of job executor steps. Optional — without
+	// it, job steps must set outputFile.
+	PodLogs PodLogReader
+	// HTTPClient sends the requests of http executor steps. Optional —
+	// defaults to a plain http.Client. Its redirect policy is replaced by
+	// one that checks HTTPAllowedURLPrefixes; the step timeout bounds each
+	// request.
+	HTTPClient *http.Client
+	// HTTPAllowedURLPrefixes is the SSRF guard for http executor steps: a
+	// request URL must match one of these prefixes. Empty rejects every
+	// request.
+	HTTPAllowedURLPrefixes []string
+	// NATSTriggerAllowedSubjectPrefixes limits the subjects NATS triggers
+	// may consume: a trigger subject must start with one of these. Empty
+	// rejects every NATS trigger.
+	NATSTriggerAllowedSubjectPrefixes []string
+	// JobServiceAccounts lists the service accounts job executor steps may
+	// run as. Empty rejects every step that sets serviceAccountName.
+	JobServiceAccounts []string
+	// JobSecurity is the pod security context for job executor steps; the
+	// zero value falls back to DefaultPodSecurity.
+	JobSecurity knightpkg.PodSecurity
+	cron        *cron.Cron
+	mu          sync.Mutex
 	// cronEntries maps chain namespace/name to cron entry ID
 	cronEntries map[string]cron.EntryID
+	// cronSpecs maps chain namespace/name to the cron spec its entry was
+	// added with, so schedule or time zone changes replace the entry.
+	cronSpecs map[string]string
+	// routeCursors holds the RoundRobin position per step knight pool.
+	routeCursors map[string]int
+	// recoveredRuns maps chain namespace/name to the run ID this process
+	// has recovered, so results missed while no operator was polling are
+	// replayed once per run.
+	recoveredRuns map[string]string
+	// knightDispatches maps knight namespace/name to the times of the
+	// dispatches in its current maxTasksPerMinute window.
+	knightDispatches map[string][]time.Time
+	// httpCalls maps task ID to the http step requests sent by this
+	// process.
+	httpCalls map[string]*httpCall
 }
 
 // natsClient returns the shared NATS client, or an error if the provider is not configured.
@@ -81,14 +128,30 @@ func (r *ChainReconciler) natsClient() (natspkg.Client, error) {
 	return r.NATS.Client()
 }
 
+// fleetClient returns the NATS client for a chain's fleet: the server in its
+// RoundTable's spec.nats.url, so fleets on different servers each get their
+// tasks and results. Tasks, results, cancels, and artifact writes go through
+// it; KV step outputs and triggers use the shared client.
+func (r *ChainReconciler) fleetClient(nc natsConfig) (natspkg.Client, error) {
+	if r.NATS == nil {
+		return nil, fmt.Errorf("NATS provider not configured")
+	}
+	return r.NATS.ClientForConnection(nc.Conn)
+}
+
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=chains,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=chains/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=chains/finalizers,verbs=update
+// +kubebuilder:rbac:groups=ai.roundtable.io,resources=chaintemplates,verbs=get;list;watch
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=knights,verbs=get;list;watch
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=missions,verbs=get;list;watch
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=roundtables,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods/log,verbs=get
 
 func (r *ChainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
@@ -106,6 +169,7 @@ func (r *ChainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	// Handle deletion
 	if chain.DeletionTimestamp != nil {
 		r.removeCronEntry(req.NamespacedName)
+		r.deleteNATSTriggerConsumer(ctx, chain)
 		chain.Finalizers = util.RemoveString(chain.Finalizers, chainFinalizer)
 		if err := r.Update(ctx, chain); err != nil {
 			return ctrl.Result{}, err
@@ -138,6 +202,54 @@ func (r *ChainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, fmt.Errorf("chain %s/%s missing roundTableRef or missionRef", chain.Namespace, chain.Name)
 	}
 
+	// Expand spec.templateRef into steps (in memory only)
+	if err := r.expandChainTemplate(ctx, chain); err != nil {
+		meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionChainValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             aiv1alpha1.ReasonInvalidTemplateRef,
+			Message:            err.Error(),
+			ObservedGeneration: chain.Generation,
+		})
+		chain.Status.ObservedGeneration = chain.Generation
+		if statusErr := r.Status().Update(ctx, chain); statusErr != nil {
+			log.Error(statusErr, "Failed to update status during validation error")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Validate parameter declarations
+	if err := validateParameters(chain); err != nil {
+		meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionChainValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             aiv1alpha1.ReasonInvalidParameters,
+			Message:            err.Error(),
+			ObservedGeneration: chain.Generation,
+		})
+		chain.Status.ObservedGeneration = chain.Generation
+		if statusErr := r.Status().Update(ctx, chain); statusErr != nil {
+			log.Error(statusErr, "Failed to update status during validation error")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Validate the input source
+	if err := validateInput(chain); err != nil {
+		meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionChainValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             aiv1alpha1.ReasonInvalidInput,
+			Message:            err.Error(),
+			ObservedGeneration: chain.Generation,
+		})
+		chain.Status.ObservedGeneration = chain.Generation
+		if statusErr := r.Status().Update(ctx, chain); statusErr != nil {
+			log.Error(statusErr, "Failed to update status during validation error")
+		}
+		return ctrl.Result{}, err
+	}
+
 	// Validate knight refs
 	if err := r.validateKnightRefs(ctx, chain); err != nil {
 		// A knight that disappears after the owning mission started cleanup
@@ -165,6 +277,38 @@ func (r *ChainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, err
 	}
 
+	// Validate step executors
+	if err := validateExecutors(chain); err != nil {
+		meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionChainValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             aiv1alpha1.ReasonInvalidExecutor,
+			Message:            err.Error(),
+			ObservedGeneration: chain.Generation,
+		})
+		chain.Status.ObservedGeneration = chain.Generation
+		if statusErr := r.Status().Update(ctx, chain); statusErr != nil {
+			log.Error(statusErr, "Failed to update status during validation error")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Validate retry matchers
+	if err := validateRetryPolicies(chain); err != nil {
+		meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionChainValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             aiv1alpha1.ReasonInvalidRetryPolicy,
+			Message:            err.Error(),
+			ObservedGeneration: chain.Generation,
+		})
+		chain.Status.ObservedGeneration = chain.Generation
+		if statusErr := r.Status().Update(ctx, chain); statusErr != nil {
+			log.Error(statusErr, "Failed to update status during validation error")
+		}
+		return ctrl.Result{}, err
+	}
+
 	// Validate DAG
 	if err := r.validateDAG(chain); err != nil {
 		meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
@@ -197,6 +341,54 @@ func (r *ChainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, err
 	}
 
+	// Validate triggers
+	if err := validateTriggers(chain); err != nil {
+		meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionChainValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             aiv1alpha1.ReasonInvalidTrigger,
+			Message:            err.Error(),
+			ObservedGeneration: chain.Generation,
+		})
+		chain.Status.ObservedGeneration = chain.Generation
+		if statusErr := r.Status().Update(ctx, chain); statusErr != nil {
+			log.Error(statusErr, "Failed to update status during validation error")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Validate output schemas compile
+	if err := validateOutputSchemas(chain); err != nil {
+		meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionChainValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             aiv1alpha1.ReasonInvalidOutputSchema,
+			Message:            err.Error(),
+			ObservedGeneration: chain.Generation,
+		})
+		chain.Status.ObservedGeneration = chain.Generation
+		if statusErr := r.Status().Update(ctx, chain); statusErr != nil {
+			log.Error(statusErr, "Failed to update status during validation error")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Validate output transforms compile
+	if err := validateOutputTransforms(chain); err != nil {
+		meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionChainValid,
+			Status:             metav1.ConditionFalse,
+			Reason:             aiv1alpha1.ReasonInvalidOutputTransform,
+			Message:            err.Error(),
+			ObservedGeneration: chain.Generation,
+		})
+		chain.Status.ObservedGeneration = chain.Generation
+		if statusErr := r.Status().Update(ctx, chain); statusErr != nil {
+			log.Error(statusErr, "Failed to update status during validation error")
+		}
+		return ctrl.Result{}, err
+	}
+
 	meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
 		Type:               aiv1alpha1.ConditionChainValid,
 		Status:             metav1.ConditionTrue,
@@ -205,6 +397,17 @@ func (r *ChainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		ObservedGeneration: chain.Generation,
 	})
 
+	// Dry run: record the would-be tasks instead of running. A run that has
+	// already published tasks finishes first.
+	if chain.Spec.DryRun && !runDispatching(chain) {
+		r.removeCronEntry(req.NamespacedName)
+		chain.Status.DryRun = r.dryRunChain(ctx, chain)
+		chain.Status.Phase = aiv1alpha1.ChainPhaseIdle
+		chain.Status.ObservedGeneration = chain.Generation
+		return r.updateStatus(ctx, chain, 0)
+	}
+	chain.Status.DryRun = nil
+
 	// Handle schedule, catching up a missed fire (e.g. operator downtime)
 	if r.reconcileSchedule(ctx, chain) {
 		log.Info("Missed scheduled run detected, triggering catch-up")
@@ -219,6 +422,22 @@ func (r *ChainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return r.updateStatus(ctx, chain, 0)
 	}
 
+	// Start a scheduled run the RoundTable deferred once it recovers; a
+	// chain no longer on a schedule drops it
+	if runDeferred(chain) && chain.Status.Phase != aiv1alpha1.ChainPhaseRunning {
+		if chain.Spec.Schedule == "" {
+			r.dropDeferredRun(chain, "the chain has no schedule")
+			return r.updateStatus(ctx, chain, 0)
+		}
+		if reason, _ := r.fleetHold(ctx, chain); reason == "" {
+			log.Info("RoundTable recovered, starting deferred scheduled run")
+			if r.triggerChain(ctx, req.NamespacedName) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, nil
+		}
+	}
+
 	// Initialize status if empty
 	if chain.Status.Phase == "" {
 		chain.Status.Phase = aiv1alpha1.ChainPhaseIdle
@@ -248,24 +467,36 @@ func (r *ChainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return r.updateStatus(ctx, chain, 0)
 	}
 
+	// A cancel annotation only applies to the run in flight; drop one left
+	// on an idle or finished chain, or naming an earlier run.
+	if _, ok := chain.Annotations[aiv1alpha1.AnnotationCancel]; ok &&
+		(chain.Status.Phase != aiv1alpha1.ChainPhaseRunning || !cancelRequested(chain)) {
+		r.clearCancelAnnotation(ctx, chain)
+	}
+
 	switch chain.Status.Phase {
 	case aiv1alpha1.ChainPhaseIdle:
-		// Nothing to do unless triggered (manual trigger sets phase to Running externally)
-		return ctrl.Result{}, nil
+		// Nothing to do unless triggered (manual trigger sets phase to
+		// Running externally, event triggers are checked here)
+		return r.reconcileTriggers(ctx, chain)
 
 	case aiv1alpha1.ChainPhaseRunning:
 		return r.reconcileRunning(ctx, chain)
 
 	case aiv1alpha1.ChainPhaseSucceeded, aiv1alpha1.ChainPhaseFailed, aiv1alpha1.ChainPhasePartiallySucceeded:
-		// Terminal — only a pending completion notification still needs work.
+		// Terminal — only pending completion notifications still need work.
 		// Notification state never affects the phase itself.
+		if chainNotificationsPending(chain) {
+			r.sendChainNotifications(ctx, chain)
+			return r.updateStatus(ctx, chain, RequeueFast)
+		}
 		if notificationPending(chain.Spec.Notify, chain.Status.Conditions) {
 			completedAt := notifyCompletedAt(chain.Status.CompletedAt, chain.Status.Conditions, aiv1alpha1.ConditionChainComplete)
 			requeue := deliverNotification(ctx, r.Client, r.Recorder, r.Notify, chain,
 				&chain.Status.Conditions, chain.Generation, completedAt, chainNotifyPayload(chain))
 			return r.updateStatus(ctx, chain, requeue)
 		}
-		return ctrl.Result{}, nil
+		return r.reconcileTriggers(ctx, chain)
 
 	case aiv1alpha1.ChainPhaseSuspended:
 		return ctrl.Result{}, nil
@@ -278,6 +509,7 @@ func (r *ChainReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 // conflicts into a requeue instead of a reconcile error. On success the
 // result carries requeueAfter (zero means no requeue).
 func (r *ChainReconciler) updateStatus(ctx context.Context, chain *aiv1alpha1.Chain, requeueAfter time.Duration) (ctrl.Result, error) {
+	updateRunProgress(chain)
 	if err := r.Status().Update(ctx, chain); err != nil {
 		if apierrors.IsConflict(err) {
 			return ctrl.Result{Requeue: true}, nil
@@ -289,7 +521,26 @@ func (r *ChainReconciler) updateStatus(ctx context.Context, chain *aiv1alpha1.Ch
 
 // validateKnightRefs checks that all knightRef values resolve to Knight CRs.
 func (r *ChainReconciler) validateKnightRefs(ctx context.Context, chain *aiv1alpha1.Chain) error {
-	for _, step := range chain.Spec.Steps {
+	for _, step := range allChainSteps(chain) {
+		if step.Verify != nil {
+			judge := &aiv1alpha1.Knight{}
+			if err := r.Get(ctx, types.NamespacedName{
+				Name:      step.Verify.JudgeKnightRef,
+				Namespace: chain.Namespace,
+			}, judge); err != nil {
+				return fmt.Errorf("step %q references non-existent judge knight %q: %w", step.Name, step.Verify.JudgeKnightRef, err)
+			}
+		}
+		if !isKnightStep(&step) {
+			continue
+		}
+		if err := validateStepTarget(&step); err != nil {
+			return err
+		}
+		if selectsKnight(&step) {
+			// Resolved at dispatch time from the Ready knights.
+			continue
+		}
 		knight := &aiv1alpha1.Knight{}
 		if err := r.Get(ctx, types.NamespacedName{
 			Name:      step.KnightRef,
@@ -314,7 +565,7 @@ func (r *ChainReconciler) owningMissionInactive(ctx context.Context, chain *aiv1
 		return false
 	}
 	mission := &aiv1alpha1.Mission{}
-	if err := r.Get(ctx, types.NamespacedName{Name: missionName, Namespace: chain.Namespace}, mission); err != nil {
+	if err := r.Get(ctx, types.NamespacedName{Name: missionName, Namespace: chainMissionNamespace(chain)}, mission); err != nil {
 		// Mission deleted entirely — the chain is an orphan awaiting GC.
 		return apierrors.IsNotFound(err)
 	}
@@ -331,52 +582,78 @@ func (r *ChainReconciler) owningMissionInactive(ctx context.Context, chain *aiv1
 	return false
 }
 
-// validateDAG performs topological sort to detect cycles.
 // validateTemplates pre-parses all step task templates to catch syntax errors early.
 // Also warns about common mistakes like using lowercase field names.
 func (r *ChainReconciler) validateTemplates(chain *aiv1alpha1.Chain) error {
-	for _, step := range chain.Spec.Steps {
-		if !strings.Contains(step.Task, "{{") {
-			continue
+	return validateStepTemplates(chain)
+}
+
+// validateStepTemplates parses and dry-runs every step template against mock
+// data.
+func validateStepTemplates(chain *aiv1alpha1.Chain) error {
+	steps := allChainSteps(chain)
+
+	// Mock data for dry-run execution. JSON is an empty object: the real
+	// shape is unknown until the step runs.
+	mockSteps := make(map[string]map[string]interface{})
+	for _, s := range steps {
+		mockSteps[s.Name] = map[string]interface{}{
+			"Output":    "",
+			"Error":     "",
+			"JSON":      map[string]interface{}{},
+			"Truncated": false,
 		}
-		tmpl, err := template.New("validate").Parse(step.Task)
-		if err != nil {
-			return fmt.Errorf("step %q has invalid template: %w", step.Name, err)
-		}
-		// Dry-run execute with mock data to catch field access errors
-		mockSteps := make(map[string]map[string]string)
-		for _, s := range chain.Spec.Steps {
-			mockSteps[s.Name] = map[string]string{
-				"Output": "",
-				"Error":  "",
-			}
+	}
+	mockData := map[string]interface{}{
+		"Steps":  mockSteps,
+		"Input":  "",
+		"Params": chainParams(chain),
+		"Run":    runTemplateData(chain),
+	}
+
+	for _, step := range steps {
+		mockData["Deps"] = mockStepDeps(&step)
+		mockData["Step"] = stepTemplateData(chain, &step)
+		mockData["Context"] = ""
+		texts := []string{step.Task}
+		for _, n := range step.Notifications {
+			texts = append(texts, n.Message)
+		}
+		if step.Job != nil {
+			texts = append(texts, step.Job.Args...)
 		}
-		mockData := map[string]interface{}{
-			"Steps": mockSteps,
-			"Input": "",
+		if step.HTTP != nil {
+			texts = append(texts, step.HTTP.URL, step.HTTP.Body)
+			for _, h := range step.HTTP.Headers {
+				texts = append(texts, h.Value)
+			}
 		}
-		var buf bytes.Buffer
-		if err := tmpl.Execute(&buf, mockData); err != nil {
-			return fmt.Errorf("step %q template execution error (hint: use .Steps.stepname.Output not steps.stepname.output): %w", step.Name, err)
+		for _, text := range texts {
+			if !strings.Contains(text, "{{") {
+				continue
+			}
+			tmpl, err := template.New("validate").Funcs(validationTemplateFuncs()).Parse(text)
+			if err != nil {
+				return fmt.Errorf("step %q has invalid template: %w", step.Name, err)
+			}
+			// Dry-run execute with mock data to catch field access errors
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, mockData); err != nil {
+				return fmt.Errorf("step %q template execution error (hint: use .Steps.stepname.Output not steps.stepname.output): %w", step.Name, err)
+			}
 		}
 	}
 	return nil
 }
 
+// validateDAG performs topological sort to detect cycles.
 func (r *ChainReconciler) validateDAG(chain *aiv1alpha1.Chain) error {
-	// Convert ChainSteps to DAGNodes
-	nodes := make([]util.DAGNode, len(chain.Spec.Steps))
-	for i, step := range chain.Spec.Steps {
-		nodes[i] = util.DAGNode{
-			Name:      step.Name,
-			DependsOn: step.DependsOn,
-		}
-	}
-	return util.ValidateDAG(nodes)
+	return validateStepLists(chain)
 }
 
 // initStepStatuses initializes step status entries for all steps.
 func (r *ChainReconciler) initStepStatuses(chain *aiv1alpha1.Chain) {
+	chain.Status.TimedOutAt = nil
 	chain.Status.StepStatuses = make([]aiv1alpha1.ChainStepStatus, len(chain.Spec.Steps))
 	for i, step := range chain.Spec.Steps {
 		chain.Status.StepStatuses[i] = aiv1alpha1.ChainStepStatus{
@@ -384,6 +661,8 @@ func (r *ChainReconciler) initStepStatuses(chain *aiv1alpha1.Chain) {
 			Phase: aiv1alpha1.ChainStepPhasePending,
 		}
 	}
+	updateRunUsage(chain)
+	updateRunProgress(chain)
 }
 
 // reconcileRunning processes the DAG execution for a running chain.
@@ -408,6 +687,8 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 		// restore below only picks up outputs this run produced (none yet) —
 		// stale outputs from earlier runs can no longer masquerade as results.
 		chain.Status.RunID = string(uuid.NewUUID())
+		chain.Status.TriggeredBy = triggeredByManual
+		chain.Status.Input = ""
 
 		// Attempt to restore completed steps from NATS KV (resume capability)
 		restored := r.restoreStepOutputsFromKV(ctx, chain)
@@ -418,6 +699,13 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 		now := metav1.Now()
 		chain.Status.StartedAt = &now
 		chain.Status.ObservedGeneration = chain.Generation
+
+		// A run triggered with missing or mistyped parameters never starts.
+		if err := resolveRunParameters(chain); err != nil {
+			r.abortRun(ctx, chain, nc, aiv1alpha1.ReasonInvalidParameters, err.Error())
+			r.Recorder.Eventf(chain, corev1.EventTypeWarning, "InvalidParameters", "Chain run rejected: %v", err)
+			return r.updateStatus(ctx, chain, 0)
+		}
 		return r.updateStatus(ctx, chain, RequeueFast)
 	}
 
@@ -432,26 +720,28 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 		r.Recorder.Event(chain, corev1.EventTypeNormal, "Started", "Chain execution started")
 	}
 
-	// Check overall timeout
-	if chain.Status.StartedAt != nil {
-		elapsed := time.Since(chain.Status.StartedAt.Time)
-		if elapsed > time.Duration(chain.Spec.Timeout)*time.Second {
-			log.Info("Chain timed out", "elapsed", elapsed)
-			chain.Status.Phase = aiv1alpha1.ChainPhaseFailed
-			now := metav1.Now()
-			chain.Status.CompletedAt = &now
-			chain.Status.RunsFailed++
-			meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
-				Type:               aiv1alpha1.ConditionChainComplete,
-				Status:             metav1.ConditionTrue,
-				Reason:             aiv1alpha1.ReasonChainTimeout,
-				Message:            fmt.Sprintf("Chain timed out after %ds", chain.Spec.Timeout),
-				ObservedGeneration: chain.Generation,
-			})
-			r.Recorder.Eventf(chain, corev1.EventTypeWarning, "Failed", "Chain timed out after %ds", chain.Spec.Timeout)
-			chain.Status.ObservedGeneration = chain.Generation
-			return ctrl.Result{}, r.Status().Update(ctx, chain)
+	// Cancellation requested via annotation
+	if cancelRequested(chain) {
+		log.Info("Cancelling chain run", "runId", chain.Status.RunID)
+		r.cancelRun(ctx, chain, nc)
+		chain.Status.ObservedGeneration = chain.Generation
+		result, err := r.updateStatus(ctx, chain, 0)
+		if err == nil && !result.Requeue {
+			r.clearCancelAnnotation(ctx, chain)
+		}
+		return result, err
+	}
+
+	// Check overall timeout: the main steps are aborted and the handlers
+	// started, which then have handlerTimeout to finish
+	if deadline, ok := runDeadline(chain); ok && time.Now().After(deadline) {
+		log.Info("Chain timed out", "elapsed", time.Since(chain.Status.StartedAt.Time))
+		ended := r.timeOutRun(ctx, chain, nc)
+		chain.Status.ObservedGeneration = chain.Generation
+		if ended {
+			return r.updateStatus(ctx, chain, 0)
 		}
+		return r.updateStatus(ctx, chain, RequeueFast)
 	}
 
 	// Build step status map
@@ -460,15 +750,50 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 		statusMap[chain.Status.StepStatuses[i].Name] = &chain.Status.StepStatuses[i]
 	}
 
-	// Build spec step map
-	specMap := make(map[string]*aiv1alpha1.ChainStep)
-	for i := range chain.Spec.Steps {
-		specMap[chain.Spec.Steps[i].Name] = &chain.Spec.Steps[i]
+	// Build spec step map (main and handler steps)
+	steps := allChainSteps(chain)
+	specMap := make(map[string]*aiv1alpha1.ChainStep, len(steps))
+	for i := range steps {
+		specMap[steps[i].Name] = &steps[i]
+	}
+
+	// Phases before this pass, to detect steps that finish during it.
+	phasesBefore := stepPhases(chain)
+
+	// Approval decisions consumed this pass; their annotations are removed
+	// only after the status update succeeds.
+	var decided []string
+	saveStatus := func(requeueAfter time.Duration) (ctrl.Result, error) {
+		result, err := r.updateStatus(ctx, chain, requeueAfter)
+		if err == nil && !result.Requeue {
+			r.clearApprovalAnnotations(ctx, chain, decided)
+		}
+		return result, err
+	}
+
+	// First pass over a run since this process started: pick up results
+	// published while no operator was polling
+	if r.needsRecovery(chain) {
+		if recovered := r.recoverRun(ctx, nc, chain, specMap); recovered > 0 {
+			log.Info("Recovered in-flight chain run", "runId", chain.Status.RunID, "steps", recovered)
+		}
 	}
 
 	// Check for completed running steps (poll NATS results)
 	for i := range chain.Status.StepStatuses {
 		ss := &chain.Status.StepStatuses[i]
+		if ss.Phase == aiv1alpha1.ChainStepPhaseAwaitingApproval {
+			if spec := specMap[ss.Name]; spec != nil && r.reconcileApproval(chain, spec, ss) {
+				decided = append(decided, ss.Name)
+			}
+			continue
+		}
+		if ss.Phase == aiv1alpha1.ChainStepPhaseAwaitingInput {
+			if spec := specMap[ss.Name]; spec != nil {
+				r.reconcileInput(chain, spec, ss)
+			}
+			continue
+		}
 		if ss.Phase == aiv1alpha1.ChainStepPhaseRunning {
 			// Skip polling if no taskID — step was set to Running by a previous
 			// operator version or before the status was persisted. Requeue will
@@ -483,6 +808,9 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 				elapsed := time.Since(ss.StartedAt.Time)
 				if elapsed > time.Duration(spec.Timeout)*time.Second {
 					log.Info("Step timed out", "step", ss.Name)
+					if isHTTPStep(spec) {
+						r.cancelHTTPStep(ss.TaskID)
+					}
 					ss.Phase = aiv1alpha1.ChainStepPhaseFailed
 					ss.Error = fmt.Sprintf("step timed out after %ds", spec.Timeout)
 					now := metav1.Now()
@@ -491,6 +819,29 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 				}
 			}
 
+			// HTTP steps finish when their request does (the judge of a
+			// verifying one answers over NATS)
+			if isHTTPStep(spec) && !ss.Verifying {
+				if output, httpErr, done := r.pollHTTPStep(ss.TaskID); done {
+					r.completeStep(ctx, chain, nc, spec, ss, output, httpErr)
+				}
+				continue
+			}
+
+			// Job steps finish when their Job does (the judge of a
+			// verifying one answers over NATS)
+			if isJobStep(spec) && !ss.Verifying {
+				output, jobErr, done, err := r.pollJobStep(ctx, chain, spec, ss)
+				if err != nil {
+					log.Error(err, "Failed to poll step Job", "step", ss.Name, "job", ss.TaskID)
+					continue
+				}
+				if done {
+					r.completeStep(ctx, chain, nc, spec, ss, output, jobErr)
+				}
+				continue
+			}
+
 			// Try to get result from NATS
 			result, err := r.pollResult(ctx, nc, chain.Name, ss.Name, ss.TaskID)
 			if err != nil {
@@ -498,91 +849,43 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 				continue
 			}
 			if result != nil {
-				now := metav1.Now()
-				ss.CompletedAt = &now
-				resultErr := result.GetError()
-				resultOutput := result.GetOutput()
-				if resultErr == "" && isEmptyStepOutput(resultOutput) {
-					resultErr = "knight returned empty output"
-					r.Recorder.Eventf(chain, corev1.EventTypeWarning, "StepEmptyOutput",
-						"Step %s returned empty output, treating as failure", ss.Name)
-				}
-				if resultErr != "" {
-					ss.Phase = aiv1alpha1.ChainStepPhaseFailed
-					ss.Error = resultErr
-					// Check retry (per-step policy overrides chain-level)
-					retryPolicy := chain.Spec.RetryPolicy
-					if spec != nil && spec.Retry != nil {
-						retryPolicy = &aiv1alpha1.ChainRetryPolicy{
-							MaxRetries:     spec.Retry.MaxAttempts,
-							BackoffSeconds: spec.Retry.BackoffSeconds,
-						}
-					}
-					if retryPolicy != nil && ss.Retries < retryPolicy.MaxRetries {
-						ss.Retries++
-						ss.Phase = aiv1alpha1.ChainStepPhasePending
-						ss.CompletedAt = nil
-						ss.Error = ""
-						log.Info("Retrying step", "step", ss.Name, "retry", ss.Retries, "maxRetries", retryPolicy.MaxRetries)
-					}
-				} else {
-					ss.Phase = aiv1alpha1.ChainStepPhaseSucceeded
-					ss.Output = resultOutput
-
-					r.Recorder.Eventf(chain, corev1.EventTypeNormal, "StepCompleted", "Step %s completed", ss.Name)
-
-					// Store full output to NATS KV (best-effort)
-					if spec := specMap[ss.Name]; spec != nil {
-						r.storeStepOutputToKV(ctx, chain.Name, chain.Status.RunID, ss.Name, resultOutput, resultErr, spec.KnightRef, ss.StartedAt, &now)
-					}
-
-					// Truncate CRD status output to avoid etcd bloat (4000 chars allows
-					// meaningful summaries for template resolution while staying well
-					// under etcd's 1.5MB object limit — 10 steps × 4KB = 40KB max)
-					if len(ss.Output) > 4000 {
-						ss.Output = ss.Output[:4000] + "\n\n... [truncated — full output in NATS KV bucket 'chain-outputs', key '" + chain.Name + "." + ss.Name + "']"
-					}
-
-					// Best-effort artifact write if outputPath is set
-					if spec != nil && spec.OutputPath != "" {
-						outputPath, err := r.renderOutputPath(chain, spec)
-						if err != nil {
-							log.Error(err, "Failed to render outputPath", "step", ss.Name)
-						} else {
-							if err := r.writeArtifact(ctx, nc, chain, spec.Name, outputPath, resultOutput); err != nil {
-								log.Error(err, "Failed to dispatch artifact write", "step", ss.Name, "path", outputPath)
-							} else {
-								log.Info("Dispatched artifact write", "step", ss.Name, "path", outputPath)
-							}
-						}
-					}
-				}
+				r.applyTaskResult(ctx, chain, nc, spec, ss, result)
 			}
 		}
 	}
 
+	// Enforce the run's cost budget before dispatching more work
+	updateRunUsage(chain)
+	if budget, exceeded := costBudgetExceeded(chain); exceeded {
+		msg := fmt.Sprintf("Run cost %s USD exceeds budget %s USD", chain.Status.CostUSD, formatCostUSD(budget))
+		log.Info("Chain cost budget exceeded", "cost", chain.Status.CostUSD, "budget", budget)
+		r.abortRun(ctx, chain, nc, aiv1alpha1.ReasonChainCostBudgetExceeded, msg)
+		r.Recorder.Event(chain, corev1.EventTypeWarning, "CostBudgetExceeded", msg)
+		r.notifyFinishedSteps(ctx, chain, nc, specMap, phasesBefore)
+		chain.Status.ObservedGeneration = chain.Generation
+		return saveStatus(0)
+	}
+
 	// Find ready steps and publish
-	for i := range chain.Spec.Steps {
-		step := &chain.Spec.Steps[i]
+	quotas, err := r.loadDomainQuotas(ctx, chain)
+	if err != nil {
+		log.Error(err, "Failed to load domain quotas")
+		return saveStatus(RequeueSlow)
+	}
+	for _, step := range activeSteps(chain, statusMap) {
 		ss := statusMap[step.Name]
+		if ss == nil {
+			continue
+		}
 		if ss.Phase != aiv1alpha1.ChainStepPhasePending {
 			continue
 		}
 
 		// Check if retry backoff applies (per-step policy overrides chain-level)
 		if ss.Retries > 0 && ss.CompletedAt != nil {
-			retryPolicy := chain.Spec.RetryPolicy
-			spec := specMap[step.Name]
-			if spec != nil && spec.Retry != nil {
-				retryPolicy = &aiv1alpha1.ChainRetryPolicy{
-					BackoffSeconds: spec.Retry.BackoffSeconds,
-				}
-			}
-			if retryPolicy != nil {
-				backoff := time.Duration(retryPolicy.BackoffSeconds) * time.Second
-				if time.Since(ss.CompletedAt.Time) < backoff {
-					continue
-				}
+			backoff := retryBackoff(effectiveRetryPolicy(chain, step), ss.Retries, chain.Status.RunID+"/"+step.Name)
+			if time.Since(ss.CompletedAt.Time) < backoff {
+				continue
 			}
 		}
 
@@ -613,7 +916,7 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 		}
 
 		// Render task template
-		taskStr, err := r.renderTemplate(chain, step.Task)
+		taskStr, err := r.renderStepTemplate(chain, step, stepTaskTemplate(step))
 		if err != nil {
 			log.Error(err, "Failed to render template", "step", step.Name)
 			ss.Phase = aiv1alpha1.ChainStepPhaseFailed
@@ -623,17 +926,83 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 			continue
 		}
 
-		// Get knight domain
-		knight := &aiv1alpha1.Knight{}
-		if err := r.Get(ctx, types.NamespacedName{Name: step.KnightRef, Namespace: chain.Namespace}, knight); err != nil {
-			log.Error(err, "Failed to get knight", "knightRef", step.KnightRef)
+		if isApprovalStep(step) {
+			if r.requestApproval(chain, ss, taskStr) {
+				decided = append(decided, step.Name)
+			}
+			log.Info("Step waiting for approval", "step", step.Name)
+			continue
+		}
+		if isInputStep(step) {
+			r.requestInput(chain, ss, taskStr)
+			log.Info("Step waiting for input", "step", step.Name)
+			continue
+		}
+
+		if isJobStep(step) {
+			if sa := step.Job.ServiceAccountName; sa != "" && !r.jobServiceAccountAllowed(sa) {
+				ss.Phase = aiv1alpha1.ChainStepPhaseFailed
+				ss.Error = fmt.Sprintf("service account %q is not allowed for job steps", sa)
+				now := metav1.Now()
+				ss.CompletedAt = &now
+				continue
+			}
+			args, err := r.renderJobArgs(chain, step)
+			if err != nil {
+				log.Error(err, "Failed to render job args", "step", step.Name)
+				ss.Phase = aiv1alpha1.ChainStepPhaseFailed
+				ss.Error = fmt.Sprintf("template render error: %v", err)
+				now := metav1.Now()
+				ss.CompletedAt = &now
+				continue
+			}
+			if err := r.dispatchJobStep(ctx, chain, step, ss, taskStr, args); err != nil {
+				log.Error(err, "Failed to create step Job", "step", step.Name)
+				continue
+			}
+			log.Info("Created step Job", "step", step.Name, "job", ss.TaskID)
+			continue
+		}
+
+		// HTTP steps send their request in the background; a later pass
+		// collects the response
+		if isHTTPStep(step) {
+			now := metav1.Now()
+			ss.Phase = aiv1alpha1.ChainStepPhaseRunning
+			ss.StartedAt = &now
+			ss.CompletedAt = nil
+			ss.TaskID = stepTaskID(chain, step, ss.Retries)
+			ss.Knight = ""
+			if httpErr := r.startHTTPStep(ctx, chain, step, ss.TaskID); httpErr != "" {
+				r.completeStep(ctx, chain, nc, step, ss, "", httpErr)
+			}
+			log.Info("Sent step HTTP request", "step", step.Name, "phase", ss.Phase)
+			continue
+		}
+
+		// Resolve the knight (named, or selected from the Ready fleet)
+		knight, err := r.resolveStepKnight(ctx, chain, nc, step)
+		if err != nil {
+			log.Error(err, "Failed to resolve knight", "step", step.Name, "knightRef", step.KnightRef)
+			continue
+		}
+		if knight == nil {
+			log.Info("No eligible knight ready for step, waiting", "step", step.Name, "domain", step.Domain)
 			continue
 		}
 
-		// The run ID shares the final subject token with the timestamp (joined
-		// by "-") so the result subject keeps the same token count and the
-		// wildcard fallback in pollResult still matches.
-		taskID := fmt.Sprintf("chain-%s-%s.%s-%d", chain.Name, step.Name, chain.Status.RunID, time.Now().UnixMilli())
+		if msg := quotas.blocked(knight.Spec.Domain); msg != "" {
+			ss.Message = msg
+			log.Info("Domain quota reached, waiting", "step", step.Name, "domain", knight.Spec.Domain)
+			continue
+		}
+		if !r.takeDispatchSlot(knight, time.Now()) {
+			ss.Message = fmt.Sprintf("Waiting for knight %s rate limit (%d tasks/min)", knight.Name, knight.Spec.MaxTasksPerMinute)
+			log.Info("Knight rate limit reached, waiting", "step", step.Name, "knight", knight.Name)
+			continue
+		}
+
+		taskID := stepTaskID(chain, step, ss.Retries)
 
 		payload := natspkg.TaskPayload{
 			TaskID:    taskID,
@@ -641,60 +1010,55 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 			StepName:  step.Name,
 			RunID:     chain.Status.RunID,
 			Task:      taskStr,
+			Model:     step.Model,
+			Priority:  stepPriority(chain, step),
+			Deadline:  stepDeadline(chain, step, time.Now()),
 		}
 
-		if err := r.publishTask(ctx, nc, knight.Spec.Domain, step.KnightRef, payload); err != nil {
+		if err := r.publishTask(ctx, nc, knight.Spec.Domain, knight.Name, payload); err != nil {
 			log.Error(err, "Failed to publish task", "step", step.Name)
 			continue
 		}
+		r.auditChain(ctx, chain, natspkg.AuditKindTask, taskID, payload)
+		quotas.dispatched(knight.Spec.Domain)
 
 		now := metav1.Now()
 		ss.Phase = aiv1alpha1.ChainStepPhaseRunning
 		ss.StartedAt = &now
+		ss.CompletedAt = nil
 		ss.TaskID = taskID
-		log.Info("Published step task", "step", step.Name, "taskId", taskID, "knight", step.KnightRef)
+		ss.Knight = knight.Name
+		ss.Message = ""
+		log.Info("Published step task", "step", step.Name, "taskId", taskID, "knight", knight.Name)
 	}
 
-	// Check if all steps are terminal
-	allTerminal := true
-	anyFailed := false
-	for _, ss := range chain.Status.StepStatuses {
-		switch ss.Phase {
-		case aiv1alpha1.ChainStepPhaseSucceeded, aiv1alpha1.ChainStepPhaseFailed, aiv1alpha1.ChainStepPhaseSkipped:
-			if ss.Phase == aiv1alpha1.ChainStepPhaseFailed {
-				// Check if continueOnFailure — if not, the chain fails
-				spec := specMap[ss.Name]
-				if spec == nil || !spec.ContinueOnFailure {
-					anyFailed = true
-				}
-			}
-		default:
-			allTerminal = false
-		}
-	}
+	// Per-step notifications for steps that finished during this pass.
+	r.notifyFinishedSteps(ctx, chain, nc, specMap, phasesBefore)
 
-	// If a step failed without continueOnFailure, skip remaining pending steps and fail chain
-	if anyFailed {
-		for i := range chain.Status.StepStatuses {
-			if chain.Status.StepStatuses[i].Phase == aiv1alpha1.ChainStepPhasePending {
-				chain.Status.StepStatuses[i].Phase = aiv1alpha1.ChainStepPhaseSkipped
-			}
-		}
-		// Check if still have running steps
-		stillRunning := false
-		for _, ss := range chain.Status.StepStatuses {
-			if ss.Phase == aiv1alpha1.ChainStepPhaseRunning {
-				stillRunning = true
-				break
-			}
-		}
-		if !stillRunning {
-			allTerminal = true
+	// Settle the main steps first; handler steps only start once they are done.
+	var mainStatuses, handlerStatuses []*aiv1alpha1.ChainStepStatus
+	for i := range chain.Status.StepStatuses {
+		if chain.Status.StepStatuses[i].Handler == "" {
+			mainStatuses = append(mainStatuses, &chain.Status.StepStatuses[i])
 		} else {
-			allTerminal = false
+			handlerStatuses = append(handlerStatuses, &chain.Status.StepStatuses[i])
 		}
 	}
+	mainDone, mainFailed := settleSteps(mainStatuses, specMap)
+	if mainDone && r.startHandlers(chain, mainFailed) {
+		chain.Status.ObservedGeneration = chain.Generation
+		return saveStatus(RequeueFast)
+	}
+	handlersDone, _ := settleSteps(handlerStatuses, specMap)
+	allTerminal := mainDone && handlersDone
 
+	if allTerminal && chain.Status.TimedOutAt != nil {
+		// The handlers of a timed-out run have finished
+		r.failRun(chain, aiv1alpha1.ReasonChainTimeout, fmt.Sprintf("Chain timed out after %ds", chain.Spec.Timeout))
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "Failed", "Chain timed out after %ds, handlers finished", chain.Spec.Timeout)
+		chain.Status.ObservedGeneration = chain.Generation
+		return saveStatus(0)
+	}
 	if allTerminal {
 		now := metav1.Now()
 		chain.Status.CompletedAt = &now
@@ -772,37 +1136,194 @@ func (r *ChainReconciler) reconcileRunning(ctx context.Context, chain *aiv1alpha
 			metrics.ChainNoOpRunsTotal.WithLabelValues(chain.Name).Inc()
 		}
 
+		recordRunHistory(chain)
 		chain.Status.ObservedGeneration = chain.Generation
-		return r.updateStatus(ctx, chain, 0)
+		return saveStatus(0)
 	}
 
 	chain.Status.ObservedGeneration = chain.Generation
 
 	// Requeue to poll for results
-	return r.updateStatus(ctx, chain, RequeueDefault)
+	return saveStatus(RequeueDefault)
+}
+
+// applyTaskResult completes a knight step from its task result. Empty output
+// counts as a failure. For a verifying step the result is the judge's
+// verdict.
+func (r *ChainReconciler) applyTaskResult(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, spec *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus, result *natspkg.TaskResult) {
+	r.auditChain(ctx, chain, natspkg.AuditKindResult, ss.TaskID, result)
+	if ss.Verifying {
+		r.applyVerdict(ctx, chain, nc, spec, ss, result)
+		return
+	}
+	recordStepUsage(ss, result)
+	resultErr := result.GetError()
+	resultOutput := result.GetOutput()
+	if resultErr == "" && isEmptyStepOutput(resultOutput) {
+		resultErr = "knight returned empty output"
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "StepEmptyOutput",
+			"Step %s returned empty output, treating as failure", ss.Name)
+	}
+	r.completeStep(ctx, chain, nc, spec, ss, resultOutput, resultErr)
 }
 
-// renderTemplate renders Go templates in the task string with step outputs and input.
+// completeStep records the result of a finished step execution: a failure
+// (including output failing the step's outputSchema) is retried under the
+// retry policy; a success is sent to the judge knight if the step sets
+// verify, and otherwise stores the output and writes the outputPath
+// artifact.
+func (r *ChainReconciler) completeStep(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, spec *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus, output, resultErr string) {
+	log := logf.FromContext(ctx)
+	now := metav1.Now()
+	ss.CompletedAt = &now
+
+	if resultErr == "" {
+		resultErr = checkStepOutput(spec, output)
+	}
+	if resultErr == "" {
+		transformed, err := transformStepOutput(spec, output)
+		if err != nil {
+			resultErr = fmt.Sprintf("outputTransform failed: %v", err)
+		} else {
+			output = transformed
+		}
+	}
+	if resultErr == "" && needsVerification(spec, ss) {
+		err := r.startVerification(ctx, chain, nc, spec, ss, output)
+		if err == nil {
+			return
+		}
+		resultErr = fmt.Sprintf("verification dispatch failed: %v", err)
+	}
+	if resultErr != "" {
+		ss.Phase = aiv1alpha1.ChainStepPhaseFailed
+		ss.Error = resultErr
+		// Check retry (per-step policy overrides chain-level)
+		retryPolicy := effectiveRetryPolicy(chain, spec)
+		if retryPolicy != nil && ss.Retries < retryPolicy.MaxRetries {
+			if !retryable(retryPolicy, resultErr) {
+				log.Info("Step failure does not match retryOn, not retrying", "step", ss.Name)
+				r.Recorder.Eventf(chain, corev1.EventTypeNormal, "StepNotRetried",
+					"Step %s failed with an error not matched by retryOn", ss.Name)
+				return
+			}
+			// CompletedAt is kept: the backoff is measured from it.
+			ss.Retries++
+			ss.Phase = aiv1alpha1.ChainStepPhasePending
+			ss.Error = ""
+			log.Info("Retrying step", "step", ss.Name, "retry", ss.Retries, "maxRetries", retryPolicy.MaxRetries)
+		}
+		return
+	}
+
+	r.succeedStep(ctx, chain, nc, spec, ss, output)
+}
+
+// succeedStep marks a step Succeeded, stores its output, and writes its
+// outputPath artifact and vault note.
+func (r *ChainReconciler) succeedStep(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, spec *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus, output string) {
+	log := logf.FromContext(ctx)
+	ss.Phase = aiv1alpha1.ChainStepPhaseSucceeded
+
+	r.Recorder.Eventf(chain, corev1.EventTypeNormal, "StepCompleted", "Step %s completed", ss.Name)
+
+	// Store full output to NATS KV (best-effort)
+	r.storeStepOutputToKV(ctx, chain.Name, chain.Status.RunID, ss.Name, output, "", ss.Knight, ss.StartedAt, ss.CompletedAt)
+
+	// Keep only a preview in the CRD status to avoid etcd bloat;
+	// large outputs go to the artifact store.
+	r.recordStepOutput(ctx, chain, ss, output)
+
+	// Best-effort artifact write if outputPath is set
+	if spec != nil && spec.OutputPath != "" {
+		outputPath, err := r.renderOutputPath(chain, spec)
+		if err != nil {
+			log.Error(err, "Failed to render outputPath", "step", ss.Name)
+		} else {
+			if err := r.writeArtifact(ctx, nc, chain, spec.Name, outputPath, output); err != nil {
+				log.Error(err, "Failed to dispatch artifact write", "step", ss.Name, "path", outputPath)
+			} else {
+				log.Info("Dispatched artifact write", "step", ss.Name, "path", outputPath)
+			}
+		}
+	}
+
+	// Best-effort vault note if vaultPath is set
+	if spec != nil && spec.VaultPath != "" {
+		notePath, err := r.writeVaultNote(ctx, nc, chain, spec, ss, output)
+		if err != nil {
+			log.Error(err, "Failed to dispatch vault note write", "step", ss.Name)
+			r.Recorder.Eventf(chain, corev1.EventTypeWarning, "VaultWriteFailed", "Step %s vault note not written: %v", ss.Name, err)
+		} else {
+			log.Info("Dispatched vault note write", "step", ss.Name, "path", notePath)
+		}
+	}
+}
+
+// renderTemplate renders Go templates in the task string with step outputs,
+// input, parameters, and run metadata.
 func (r *ChainReconciler) renderTemplate(chain *aiv1alpha1.Chain, taskStr string) (string, error) {
+	return r.renderStepTemplate(chain, nil, taskStr)
+}
+
+// renderStepTemplate renders a template belonging to step, which also sees
+// the step's dependency outputs as .Deps. step may be nil.
+func (r *ChainReconciler) renderStepTemplate(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, taskStr string) (string, error) {
 	if !strings.Contains(taskStr, "{{") {
 		return taskStr, nil
 	}
 
+	allSteps := allChainSteps(chain)
+	specMap := make(map[string]*aiv1alpha1.ChainStep, len(allSteps))
+	for i := range allSteps {
+		specMap[allSteps[i].Name] = &allSteps[i]
+	}
+
 	// Build template data
-	steps := make(map[string]map[string]string)
+	steps := make(map[string]map[string]interface{})
 	for _, ss := range chain.Status.StepStatuses {
-		steps[ss.Name] = map[string]string{
-			"Output": ss.Output,
-			"Error":  ss.Error,
+		// Templates see the full output, not the truncated status copy.
+		output := ss.Output
+		if ss.ArtifactRef != "" {
+			full, err := r.stepArtifact(chain, ss.Name)
+			if err != nil {
+				return "", err
+			}
+			output = full
+		}
+		var parsed interface{} = map[string]interface{}{}
+		if parsesJSON(specMap[ss.Name]) && ss.Phase == aiv1alpha1.ChainStepPhaseSucceeded {
+			var err error
+			if parsed, err = parseStepJSON(output); err != nil {
+				return "", fmt.Errorf("step %q output is not valid JSON: %w", ss.Name, err)
+			}
+		}
+		steps[ss.Name] = map[string]interface{}{
+			"Output":    output,
+			"Error":     ss.Error,
+			"JSON":      parsed,
+			"Truncated": ss.Truncated && ss.ArtifactRef == "",
 		}
 	}
 
-	data := map[string]interface{}{
-		"Steps": steps,
-		"Input": chain.Spec.Input,
+	input, err := r.resolveRunInput(chain)
+	if err != nil {
+		return "", fmt.Errorf("inputFrom: %w", err)
 	}
 
-	tmpl, err := template.New("task").Parse(taskStr)
+	data := map[string]interface{}{
+		"Steps":   steps,
+		"Input":   input,
+		"Params":  chainParams(chain),
+		"Deps":    stepDeps(chain, step, steps),
+		"Run":     runTemplateData(chain),
+		"Step":    stepTemplateData(chain, step),
+		"Context": stepContext(chain, step, steps),
+	}
+
+	tmpl, err := template.New("task").Funcs(chainTemplateFuncs(func(step string) (string, error) {
+		return r.stepArtifact(chain, step)
+	})).Parse(taskStr)
 	if err != nil {
 		return "", fmt.Errorf("template parse error: %w", err)
 	}
@@ -827,7 +1348,12 @@ func (r *ChainReconciler) resolveNATSConfig(ctx context.Context, chain *aiv1alph
 		return natsConfig{}, fmt.Errorf("RoundTable %q not found: %w", chain.Spec.RoundTableRef, err)
 	}
 
+	conn, err := fleetConnection(ctx, r.Client, rt)
+	if err != nil {
+		return natsConfig{}, fmt.Errorf("RoundTable %q: %w", chain.Spec.RoundTableRef, err)
+	}
 	return natsConfig{
+		Conn:          conn,
 		SubjectPrefix: rt.Spec.NATS.SubjectPrefix,
 		TasksStream:   rt.Spec.NATS.TasksStream,
 		ResultsStream: rt.Spec.NATS.ResultsStream,
@@ -836,13 +1362,56 @@ func (r *ChainReconciler) resolveNATSConfig(ctx context.Context, chain *aiv1alph
 
 // publishTask publishes a task to NATS JetStream.
 func (r *ChainReconciler) publishTask(ctx context.Context, nc natsConfig, domain, knightName string, payload natspkg.TaskPayload) error {
-	client, err := r.natsClient()
+	client, err := r.fleetClient(nc)
 	if err != nil {
 		return err
 	}
 
-	subject := natspkg.TaskSubject(nc.SubjectPrefix, domain, knightName)
-	return client.PublishJSON(subject, payload)
+	msg, err := natspkg.NewTaskMsg(natspkg.TaskSubject(nc.SubjectPrefix, domain, knightName), payload)
+	if err != nil {
+		return err
+	}
+	return client.PublishMsg(msg)
+}
+
+// stepTaskID returns the task ID of a step attempt. It is deterministic, so
+// a task republished after a crash or status conflict between publishing and
+// persisting the step status carries the same ID and is deduplicated by
+// JetStream. The run ID shares the final subject token with the attempt
+// (joined by "-") so the result subject keeps the same token count and the
+// wildcard fallback in pollResult still matches.
+func stepTaskID(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, attempt int32) string {
+	return fmt.Sprintf("chain-%s-%s.%s-%d", chain.Name, step.Name, chain.Status.RunID, attempt)
+}
+
+// stepPriority returns the priority sent with a step's task: the step's
+// override, else the chain's.
+func stepPriority(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep) int32 {
+	if step.Priority != nil {
+		return *step.Priority
+	}
+	return chain.Spec.Priority
+}
+
+// stepDeadline returns when a task dispatched now stops being useful: the
+// step timeout, capped by the run's deadline (for the handlers of a
+// timed-out run, the end of their handlerTimeout). Past it the controller
+// fails the step anyway, so a knight can skip the task.
+func stepDeadline(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, now time.Time) *time.Time {
+	var deadline time.Time
+	if step.Timeout > 0 {
+		deadline = now.Add(time.Duration(step.Timeout) * time.Second)
+	}
+	if chainDeadline, ok := runDeadline(chain); ok {
+		if deadline.IsZero() || chainDeadline.Before(deadline) {
+			deadline = chainDeadline
+		}
+	}
+	if deadline.IsZero() {
+		return nil
+	}
+	deadline = deadline.UTC().Truncate(time.Second)
+	return &deadline
 }
 
 // pollResult checks for a result message for a given chain step.
@@ -850,7 +1419,7 @@ func (r *ChainReconciler) publishTask(ctx context.Context, nc natsConfig, domain
 func (r *ChainReconciler) pollResult(ctx context.Context, nc natsConfig, chainName, stepName, taskID string) (*natspkg.TaskResult, error) {
 	log := logf.FromContext(ctx)
 
-	client, err := r.natsClient()
+	client, err := r.fleetClient(nc)
 	if err != nil {
 		return nil, err
 	}
@@ -916,6 +1485,13 @@ func (r *ChainReconciler) reconcileSchedule(ctx context.Context, chain *aiv1alph
 		return false
 	}
 
+	spec, err := chainCronSpec(chain)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Invalid chain schedule", "timeZone", chain.Spec.TimeZone)
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "InvalidSchedule", "%v", err)
+		return false
+	}
+
 	r.mu.Lock()
 
 	if r.cron == nil {
@@ -923,10 +1499,18 @@ func (r *ChainReconciler) reconcileSchedule(ctx context.Context, chain *aiv1alph
 		r.cronEntries = make(map[string]cron.EntryID)
 		r.cron.Start()
 	}
+	if r.cronSpecs == nil {
+		r.cronSpecs = make(map[string]string)
+	}
+
+	if id, ok := r.cronEntries[key]; ok && r.cronSpecs[key] != spec {
+		r.cron.Remove(id)
+		delete(r.cronEntries, key)
+	}
 
 	if _, ok := r.cronEntries[key]; !ok {
 		nn := types.NamespacedName{Namespace: chain.Namespace, Name: chain.Name}
-		entryID, err := r.cron.AddFunc(chain.Spec.Schedule, func() {
+		entryID, err := r.cron.AddFunc(spec, func() {
 			r.triggerChain(context.Background(), nn)
 		})
 		if err != nil {
@@ -935,12 +1519,25 @@ func (r *ChainReconciler) reconcileSchedule(ctx context.Context, chain *aiv1alph
 			return false
 		}
 		r.cronEntries[key] = entryID
+		r.cronSpecs[key] = spec
 	}
 	r.mu.Unlock()
 
 	return r.missedSchedule(chain)
 }
 
+// chainCronSpec returns the cron spec for the chain's schedule, pinned to
+// spec.timeZone when set so it doesn't depend on the operator pod's TZ.
+func chainCronSpec(chain *aiv1alpha1.Chain) (string, error) {
+	if chain.Spec.TimeZone == "" {
+		return chain.Spec.Schedule, nil
+	}
+	if _, err := time.LoadLocation(chain.Spec.TimeZone); err != nil {
+		return "", fmt.Errorf("unknown time zone %q: %w", chain.Spec.TimeZone, err)
+	}
+	return "CRON_TZ=" + chain.Spec.TimeZone + " " + chain.Spec.Schedule, nil
+}
+
 // missedSchedule reports whether the chain's next fire after lastScheduledAt
 // has already passed without a run starting, within the optional
 // startingDeadlineSeconds window.
@@ -949,7 +1546,11 @@ func (r *ChainReconciler) missedSchedule(chain *aiv1alpha1.Chain) bool {
 		return false
 	}
 
-	sched, err := cron.ParseStandard(chain.Spec.Schedule)
+	spec, err := chainCronSpec(chain)
+	if err != nil {
+		return false
+	}
+	sched, err := cron.ParseStandard(spec)
 	if err != nil {
 		return false
 	}
@@ -968,18 +1569,29 @@ func (r *ChainReconciler) missedSchedule(chain *aiv1alpha1.Chain) bool {
 // triggerChain starts a new chain run: it resets step statuses, assigns a
 // fresh run ID, and sets the phase to Running. Called from cron goroutines
 // (with context.Background()) and from reconcile for missed-schedule catch-up.
-func (r *ChainReconciler) triggerChain(ctx context.Context, nn types.NamespacedName) {
+func (r *ChainReconciler) triggerChain(ctx context.Context, nn types.NamespacedName) bool {
 	log := logf.Log.WithName("chain-cron")
 
+	started := false
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		started = false
 		chain := &aiv1alpha1.Chain{}
 		if err := r.Get(ctx, nn, chain); err != nil {
 			return err
 		}
 
-		if chain.Spec.Suspended {
+		if chain.Spec.Suspended || chain.Spec.DryRun {
 			return nil
 		}
+		if err := r.expandChainTemplate(ctx, chain); err != nil {
+			if !runDeferred(chain) {
+				return err
+			}
+			// A deferred run that can't start is dropped, not retried.
+			log.Error(err, "Cannot start deferred run", "chain", nn.String())
+			r.dropDeferredRun(chain, err.Error())
+			return r.Status().Update(ctx, chain)
+		}
 
 		// Guard against overlapping runs: resetting step statuses while a
 		// previous run is still in flight orphans its in-progress steps and
@@ -991,25 +1603,42 @@ func (r *ChainReconciler) triggerChain(ctx context.Context, nn types.NamespacedN
 			return nil
 		}
 
-		r.initStepStatuses(chain)
-		// A new run gets its own completion notification.
-		meta.RemoveStatusCondition(&chain.Status.Conditions, aiv1alpha1.ConditionNotificationSent)
-		now := metav1.Now()
-		chain.Status.RunID = string(uuid.NewUUID())
-		chain.Status.Phase = aiv1alpha1.ChainPhaseRunning
-		chain.Status.StartedAt = &now
-		chain.Status.CompletedAt = nil
-		chain.Status.LastScheduledAt = &now
+		if reason, message := r.fleetHold(ctx, chain); reason != "" {
+			r.deferRun(chain, reason, message)
+			return r.Status().Update(ctx, chain)
+		}
+
+		if err := r.startRun(chain, triggeredBySchedule, ""); err != nil {
+			r.Recorder.Eventf(chain, corev1.EventTypeWarning, "InvalidParameters",
+				"Skipped scheduled trigger: %v", err)
+			if !runDeferred(chain) {
+				return nil
+			}
+			r.dropDeferredRun(chain, err.Error())
+			return r.Status().Update(ctx, chain)
+		}
+		chain.Status.LastScheduledAt = chain.Status.StartedAt
+		if runDeferred(chain) {
+			meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+				Type:               aiv1alpha1.ConditionChainDeferred,
+				Status:             metav1.ConditionFalse,
+				Reason:             aiv1alpha1.ReasonFleetRecovered,
+				Message:            "Deferred scheduled run started",
+				ObservedGeneration: chain.Generation,
+			})
+		}
 
 		if err := r.Status().Update(ctx, chain); err != nil {
 			return err
 		}
+		started = true
 		r.Recorder.Event(chain, corev1.EventTypeNormal, "CronTriggered", "Chain triggered by cron schedule")
 		return nil
 	})
 	if err != nil {
 		log.Error(err, "Failed to trigger chain", "chain", nn.String())
 	}
+	return started
 }
 
 // removeCronEntry removes a cron entry for a chain.
@@ -1026,6 +1655,7 @@ func (r *ChainReconciler) removeCronEntry(nn types.NamespacedName) {
 			r.cron.Remove(id)
 		}
 		delete(r.cronEntries, key)
+		delete(r.cronSpecs, key)
 	}
 }
 
@@ -1054,22 +1684,31 @@ func (r *ChainReconciler) renderOutputPath(chain *aiv1alpha1.Chain, step *aiv1al
 	return buf.String(), nil
 }
 
-// writeArtifact dispatches a write task to the outputKnight.
-func (r *ChainReconciler) writeArtifact(ctx context.Context, nc natsConfig, chain *aiv1alpha1.Chain, stepName, outputPath, content string) error {
-	client, err := r.natsClient()
-	if err != nil {
-		return err
-	}
-
+// outputKnight returns the knight that writes the chain's artifacts.
+func (r *ChainReconciler) outputKnight(ctx context.Context, chain *aiv1alpha1.Chain) (*aiv1alpha1.Knight, error) {
 	knightName := chain.Spec.OutputKnight
 	if knightName == "" {
 		knightName = "gawain"
 	}
 
-	// Get knight to find its domain
 	knight := &aiv1alpha1.Knight{}
 	if err := r.Get(ctx, types.NamespacedName{Name: knightName, Namespace: chain.Namespace}, knight); err != nil {
-		return fmt.Errorf("output knight %q not found: %w", knightName, err)
+		return nil, fmt.Errorf("output knight %q not found: %w", knightName, err)
+	}
+	return knight, nil
+}
+
+// writeArtifact dispatches a write task to the outputKnight.
+func (r *ChainReconciler) writeArtifact(ctx context.Context, nc natsConfig, chain *aiv1alpha1.Chain, stepName, outputPath, content string) error {
+	client, err := r.fleetClient(nc)
+	if err != nil {
+		return err
+	}
+
+	// Get knight to find its domain
+	knight, err := r.outputKnight(ctx, chain)
+	if err != nil {
+		return err
 	}
 
 	taskID := fmt.Sprintf("chain-%s-%s-artifact.%s-%d", chain.Name, stepName, chain.Status.RunID, time.Now().UnixMilli())
@@ -1085,8 +1724,12 @@ func (r *ChainReconciler) writeArtifact(ctx context.Context, nc natsConfig, chai
 		Task:      task,
 	}
 
-	subject := natspkg.TaskSubject(nc.SubjectPrefix, knight.Spec.Domain, knightName)
-	return client.PublishJSON(subject, payload)
+	subject := natspkg.TaskSubject(nc.SubjectPrefix, knight.Spec.Domain, knight.Name)
+	if err := client.PublishJSON(subject, payload); err != nil {
+		return err
+	}
+	r.auditChain(ctx, chain, natspkg.AuditKindTask, taskID, payload)
+	return nil
 }
 
 // emptyOutputSentinels are placeholder strings produced by knights when an
@@ -1216,10 +1859,7 @@ func (r *ChainReconciler) restoreStepOutputsFromKV(ctx context.Context, chain *a
 			log.Info("Restored failed step from KV", "step", ss.Name)
 		} else {
 			ss.Phase = aiv1alpha1.ChainStepPhaseSucceeded
-			ss.Output = output
-			if len(ss.Output) > 4000 {
-				ss.Output = ss.Output[:4000] + "\n\n... [truncated — full output in NATS KV bucket 'chain-outputs', key '" + chain.Name + "." + ss.Name + "']"
-			}
+			r.recordStepOutput(ctx, chain, ss, output)
 			log.Info("Restored successful step from KV", "step", ss.Name, "outputLen", len(output))
 		}
 
@@ -1238,6 +1878,7 @@ func (r *ChainReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	r.cron = cron.New()
 	r.cron.Start()
 	r.cronEntries = make(map[string]cron.EntryID)
+	r.cronSpecs = make(map[string]string)
 
 	// Stop the cron scheduler on manager shutdown, waiting for any in-flight
 	// trigger to finish — otherwise the cron goroutine outlives the manager.
@@ -1250,8 +1891,29 @@ func (r *ChainReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
+	if err := setupChainIndexes(context.Background(), mgr); err != nil {
+		return err
+	}
+
+	// Re-reconcile chains when a step Job they own changes, when a knight
+	// they depend on appears, becomes Ready, or changes in a way that
+	// affects knight selection, when the ChainTemplate they instantiate
+	// changes, when a chain they are triggered by succeeds, and when the
+	// RoundTable holding their deferred scheduled run changes health.
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&aiv1alpha1.Chain{}).
+		Owns(&batchv1.Job{}).
+		Watches(&aiv1alpha1.Knight{},
+			handler.EnqueueRequestsFromMapFunc(r.chainsForKnight),
+			builder.WithPredicates(knightAvailabilityChanged())).
+		Watches(&aiv1alpha1.ChainTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.chainsForTemplate)).
+		Watches(&aiv1alpha1.Chain{},
+			handler.EnqueueRequestsFromMapFunc(r.chainsTriggeredBy),
+			builder.WithPredicates(chainRunSucceeded())).
+		Watches(&aiv1alpha1.RoundTable{},
+			handler.EnqueueRequestsFromMapFunc(r.chainsDeferredBy),
+			builder.WithPredicates(roundTableHealthChanged())).
 		Named("chain").
 		Complete(r)
 }
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strconv"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// parseCostUSD parses a USD amount as stored in status; empty or invalid is 0.
+func parseCostUSD(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// formatCostUSD formats a USD amount the way the operator stores costs.
+func formatCostUSD(v float64) string {
+	return fmt.Sprintf("%.4f", v)
+}
+
+// recordStepUsage adds the cost and token usage reported with a result to
+// the step. Every attempt counts, including failed ones that are retried.
+func recordStepUsage(ss *aiv1alpha1.ChainStepStatus, result *natspkg.TaskResult) {
+	if cost := result.GetCostUSD(); cost > 0 {
+		ss.CostUSD = formatCostUSD(parseCostUSD(ss.CostUSD) + cost)
+	}
+	ss.InputTokens += result.GetInputTokens()
+	ss.OutputTokens += result.GetOutputTokens()
+}
+
+// updateRunUsage recomputes the run's totals from its step statuses.
+func updateRunUsage(chain *aiv1alpha1.Chain) {
+	var cost float64
+	var input, output int64
+	for _, ss := range chain.Status.StepStatuses {
+		cost += parseCostUSD(ss.CostUSD)
+		input += ss.InputTokens
+		output += ss.OutputTokens
+	}
+	chain.Status.CostUSD = ""
+	if cost > 0 {
+		chain.Status.CostUSD = formatCostUSD(cost)
+	}
+	chain.Status.InputTokens = input
+	chain.Status.OutputTokens = output
+}
+
+// costBudgetExceeded reports whether the run's cost has passed
+// spec.costBudgetUSD, returning the budget for messages.
+func costBudgetExceeded(chain *aiv1alpha1.Chain) (float64, bool) {
+	budget := parseCostUSD(chain.Spec.CostBudgetUSD)
+	if budget <= 0 {
+		return 0, false
+	}
+	return budget, parseCostUSD(chain.Status.CostUSD) > budget
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"testing"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestRunUsageAndBudget(t *testing.T) {
+	chain := &aiv1alpha1.Chain{
+		Spec: aiv1alpha1.ChainSpec{CostBudgetUSD: "0.05"},
+		Status: aiv1alpha1.ChainStatus{StepStatuses: []aiv1alpha1.ChainStepStatus{
+			{Name: "scan"},
+			{Name: "report"},
+		}},
+	}
+
+	// A failed attempt and its retry both count towards the step.
+	recordStepUsage(&chain.Status.StepStatuses[0], &natspkg.TaskResult{CostUSD: 0.01, InputTokens: 100, OutputTokens: 10})
+	recordStepUsage(&chain.Status.StepStatuses[0], &natspkg.TaskResult{CostUSD2: 0.015, InputTokens2: 120, OutputTokens2: 12})
+	recordStepUsage(&chain.Status.StepStatuses[1], &natspkg.TaskResult{CostUSD: 0.02})
+	updateRunUsage(chain)
+
+	if got := chain.Status.StepStatuses[0].CostUSD; got != "0.0250" {
+		t.Errorf("step costUSD = %q, want 0.0250", got)
+	}
+	if chain.Status.CostUSD != "0.0450" || chain.Status.InputTokens != 220 || chain.Status.OutputTokens != 22 {
+		t.Errorf("run usage = %s USD, %d in, %d out", chain.Status.CostUSD, chain.Status.InputTokens, chain.Status.OutputTokens)
+	}
+	if _, exceeded := costBudgetExceeded(chain); exceeded {
+		t.Error("costBudgetExceeded() = true below budget")
+	}
+
+	recordStepUsage(&chain.Status.StepStatuses[1], &natspkg.TaskResult{CostUSD: 0.01})
+	updateRunUsage(chain)
+	if _, exceeded := costBudgetExceeded(chain); !exceeded {
+		t.Errorf("costBudgetExceeded() = false at %s USD", chain.Status.CostUSD)
+	}
+
+	chain.Spec.CostBudgetUSD = "0"
+	if _, exceeded := costBudgetExceeded(chain); exceeded {
+		t.Error("costBudgetExceeded() = true with unlimited budget")
+	}
+}
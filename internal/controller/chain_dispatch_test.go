@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestStepPriorityAndDeadline(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	started := metav1.NewTime(now.Add(-9 * time.Minute))
+	chain := &aiv1alpha1.Chain{
+		Spec:   aiv1alpha1.ChainSpec{Priority: 5, Timeout: 600},
+		Status: aiv1alpha1.ChainStatus{StartedAt: &started},
+	}
+
+	override := int32(50)
+	if got := stepPriority(chain, &aiv1alpha1.ChainStep{}); got != 5 {
+		t.Errorf("stepPriority() = %d, want chain priority 5", got)
+	}
+	if got := stepPriority(chain, &aiv1alpha1.ChainStep{Priority: &override}); got != 50 {
+		t.Errorf("stepPriority() = %d, want step override 50", got)
+	}
+
+	// The step timeout fits within the chain's remaining minute.
+	if got := stepDeadline(chain, &aiv1alpha1.ChainStep{Timeout: 30}, now); !got.Equal(now.Add(30 * time.Second)) {
+		t.Errorf("stepDeadline() = %v, want step timeout", got)
+	}
+	// The chain timeout cuts a longer step short.
+	if got := stepDeadline(chain, &aiv1alpha1.ChainStep{Timeout: 300}, now); !got.Equal(now.Add(time.Minute)) {
+		t.Errorf("stepDeadline() = %v, want chain deadline", got)
+	}
+}
+
+func TestStepTaskID(t *testing.T) {
+	chain := &aiv1alpha1.Chain{Status: aiv1alpha1.ChainStatus{RunID: "run-1"}}
+	chain.Name = "recon"
+	step := &aiv1alpha1.ChainStep{Name: "scan"}
+
+	if got := stepTaskID(chain, step, 0); got != "chain-recon-scan.run-1-0" {
+		t.Errorf("stepTaskID() = %q, want chain-recon-scan.run-1-0", got)
+	}
+	if stepTaskID(chain, step, 0) != stepTaskID(chain, step, 0) {
+		t.Error("stepTaskID() should be the same for a republished attempt")
+	}
+	if stepTaskID(chain, step, 0) == stepTaskID(chain, step, 1) {
+		t.Error("stepTaskID() should differ between retries")
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// runDispatching reports whether the chain has a run that already published
+// at least one task. Such a run is left to finish when dryRun is turned on.
+func runDispatching(chain *aiv1alpha1.Chain) bool {
+	if chain.Status.Phase != aiv1alpha1.ChainPhaseRunning {
+		return false
+	}
+	for _, ss := range chain.Status.StepStatuses {
+		if ss.TaskID != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// dryRunChain computes the task each step would publish, without publishing.
+// Every step's output is replaced by a placeholder ("{}" for parseOutput json
+// steps) so downstream templates render as they would mid-run. Problems are
+// reported per step rather than failing the whole dry run.
+func (r *ChainReconciler) dryRunChain(ctx context.Context, chain *aiv1alpha1.Chain) []aiv1alpha1.ChainDryRunStep {
+	steps := allChainSteps(chain)
+
+	sim := chain.DeepCopy()
+	// Secret input stays out of the dry-run record in status.
+	if src := chain.Spec.InputFrom; src != nil && src.SecretKeyRef != nil && sim.Status.Input == "" {
+		sim.Status.Input = fmt.Sprintf("<input from secret %s>", src.SecretKeyRef.Name)
+	}
+	sim.Status.StepStatuses = make([]aiv1alpha1.ChainStepStatus, 0, len(steps))
+	for i := range steps {
+		output := fmt.Sprintf("<output of %s>", steps[i].Name)
+		if parsesJSON(&steps[i]) {
+			output = "{}"
+		}
+		sim.Status.StepStatuses = append(sim.Status.StepStatuses, aiv1alpha1.ChainStepStatus{
+			Name:   steps[i].Name,
+			Phase:  aiv1alpha1.ChainStepPhaseSucceeded,
+			Output: output,
+		})
+	}
+
+	nc, ncErr := r.resolveNATSConfig(ctx, chain)
+
+	plan := make([]aiv1alpha1.ChainDryRunStep, 0, len(steps))
+	for i := range steps {
+		step := &steps[i]
+		ds := aiv1alpha1.ChainDryRunStep{Name: step.Name, Model: step.Model}
+
+		task, err := r.renderStepTemplate(sim, step, stepTaskTemplate(step))
+		if err != nil {
+			ds.Message = fmt.Sprintf("task template failed to render: %v", err)
+		} else {
+			if len(task) > stepOutputPreviewLimit {
+				task = task[:stepOutputPreviewLimit] + "\n\n... [truncated]"
+			}
+			ds.Task = task
+		}
+
+		switch {
+		case isApprovalStep(step), isInputStep(step):
+			// Human-gated steps publish nothing; the rendered task is their prompt.
+		case isJobStep(step):
+			// Job steps publish nothing either; they run the image as a Job.
+			if _, err := r.renderJobArgs(sim, step); err != nil {
+				ds.Message = fmt.Sprintf("job args failed to render: %v", err)
+			} else if ds.Message == "" {
+				ds.Message = fmt.Sprintf("Would run image %s as a Job", step.Job.Image)
+			}
+		case isHTTPStep(step):
+			url, err := r.renderStepTemplate(sim, step, step.HTTP.URL)
+			switch {
+			case err != nil:
+				ds.Message = fmt.Sprintf("url template failed to render: %v", err)
+			case !r.httpURLAllowed(url):
+				ds.Message = fmt.Sprintf("URL %s does not match an allowed URL prefix", url)
+			case ds.Message == "":
+				ds.Message = fmt.Sprintf("Would send %s %s", httpMethod(step.HTTP), url)
+			}
+		case selectsKnight(step):
+			eligible, err := r.eligibleKnights(ctx, chain, step)
+			if err != nil {
+				ds.Message = fmt.Sprintf("failed to list knights: %v", err)
+				break
+			}
+			for _, k := range eligible {
+				ds.Knights = append(ds.Knights, k.Name)
+			}
+			if len(eligible) == 0 {
+				ds.Message = "No eligible knight is Ready; the step would wait"
+			} else if len(eligible) == 1 && ncErr == nil {
+				ds.Subject = natspkg.TaskSubject(nc.SubjectPrefix, eligible[0].Spec.Domain, eligible[0].Name)
+			}
+		default:
+			knight, err := r.resolveStepKnight(ctx, chain, nc, step)
+			if err != nil {
+				ds.Message = fmt.Sprintf("failed to resolve knight: %v", err)
+				break
+			}
+			ds.Knights = []string{knight.Name}
+			if ncErr == nil {
+				ds.Subject = natspkg.TaskSubject(nc.SubjectPrefix, knight.Spec.Domain, knight.Name)
+			}
+		}
+		if ncErr != nil && ds.Message == "" {
+			ds.Message = fmt.Sprintf("failed to resolve NATS config: %v", ncErr)
+		}
+
+		plan = append(plan, ds)
+	}
+	return plan
+}
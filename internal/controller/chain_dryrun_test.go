@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestDryRunChain(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add roundtable scheme: %v", err)
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet-a", Namespace: "default"},
+		Spec:       aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{SubjectPrefix: "fleet-a"}},
+	}
+	r := &ChainReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		rt,
+		routingTestKnight("galahad", "security", true, nil),
+	).WithStatusSubresource(&aiv1alpha1.Knight{}).Build()}
+
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "audit", Namespace: "default"},
+		Spec: aiv1alpha1.ChainSpec{
+			RoundTableRef: "fleet-a",
+			DryRun:        true,
+			Steps: []aiv1alpha1.ChainStep{
+				{Name: "scan", KnightRef: "galahad", Task: "Scan {{ .Input }}", ParseOutput: "json"},
+				{Name: "report", Domain: "research", Task: "Report on {{ .Steps.scan.Output }}", DependsOn: []string{"scan"}},
+				{Name: "ship", KnightRef: "missing", Task: "Ship it", DependsOn: []string{"report"}},
+			},
+			Input: "web-1",
+		},
+	}
+
+	plan := r.dryRunChain(context.Background(), chain)
+	if len(plan) != 3 {
+		t.Fatalf("dryRunChain() returned %d steps, want 3", len(plan))
+	}
+
+	scan := plan[0]
+	if scan.Task != "Scan web-1" || scan.Subject != "fleet-a.tasks.security.galahad" || scan.Message != "" {
+		t.Errorf("scan = %+v", scan)
+	}
+	report := plan[1]
+	if report.Task != "Report on {}" || len(report.Knights) != 0 || !strings.Contains(report.Message, "No eligible knight") {
+		t.Errorf("report = %+v", report)
+	}
+	if ship := plan[2]; !strings.Contains(ship.Message, "failed to resolve knight") {
+		t.Errorf("ship = %+v", ship)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// fanInSummaryTemplate follows a summarizing step's task: the instructions
+// come first, then every branch output.
+const fanInSummaryTemplate = "\n\n## Dependency outputs\n\n{{ .Deps.Outputs }}"
+
+// depOutput is one dependency's result as a fan-in template sees it.
+type depOutput struct {
+	Name   string
+	Output string
+	Error  string
+	JSON   interface{}
+	Failed bool
+}
+
+// depOutputs are a step's dependency results in dependsOn order. Printed
+// directly they are joined into one Markdown document, one section per
+// dependency; ranged over they give each result.
+type depOutputs []depOutput
+
+// String joins the outputs, with a heading naming each dependency.
+func (d depOutputs) String() string {
+	sections := make([]string, 0, len(d))
+	for _, dep := range d {
+		body := strings.TrimRight(dep.Output, "\n")
+		if dep.Failed {
+			body = fmt.Sprintf("(failed: %s)", dep.Error)
+		}
+		sections = append(sections, fmt.Sprintf("### %s\n\n%s", dep.Name, body))
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+// stepDeps builds the .Deps template data for step from the rendered .Steps
+// data. Dependencies that have not finished are left out.
+func stepDeps(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, steps map[string]map[string]interface{}) map[string]interface{} {
+	outputs := depOutputs{}
+	var names []string
+	if step != nil {
+		names = step.DependsOn
+		phases := make(map[string]aiv1alpha1.ChainStepPhase, len(chain.Status.StepStatuses))
+		for _, ss := range chain.Status.StepStatuses {
+			phases[ss.Name] = ss.Phase
+		}
+		for _, dep := range step.DependsOn {
+			data, ok := steps[dep]
+			phase := phases[dep]
+			if !ok || (phase != aiv1alpha1.ChainStepPhaseSucceeded && phase != aiv1alpha1.ChainStepPhaseFailed) {
+				continue
+			}
+			outputs = append(outputs, depOutput{
+				Name:   dep,
+				Output: data["Output"].(string),
+				Error:  data["Error"].(string),
+				JSON:   data["JSON"],
+				Failed: phase == aiv1alpha1.ChainStepPhaseFailed,
+			})
+		}
+	}
+	return map[string]interface{}{
+		"Names":   names,
+		"Outputs": outputs,
+	}
+}
+
+// mockStepDeps is the .Deps data templates are validated against.
+func mockStepDeps(step *aiv1alpha1.ChainStep) map[string]interface{} {
+	outputs := make(depOutputs, 0, len(step.DependsOn))
+	for _, dep := range step.DependsOn {
+		outputs = append(outputs, depOutput{Name: dep, JSON: map[string]interface{}{}})
+	}
+	return map[string]interface{}{
+		"Names":   step.DependsOn,
+		"Outputs": outputs,
+	}
+}
+
+// summarizes reports whether the step dispatches a fan-in summary task.
+func summarizes(step *aiv1alpha1.ChainStep) bool {
+	return step.FanIn != nil && step.FanIn.Summarize
+}
+
+// stepTaskTemplate returns the template the step's dispatched task is
+// rendered from; a step with context has the packed outputs appended, and a
+// summarizing step its dependency outputs.
+func stepTaskTemplate(step *aiv1alpha1.ChainStep) string {
+	task := step.Task
+	if step.Context != nil {
+		task += stepContextTemplate
+	}
+	if summarizes(step) {
+		task += fanInSummaryTemplate
+	}
+	return task
+}
+
+// validateFanIn checks a summarizing step is a knight step with at least
+// one dependency to summarize.
+func validateFanIn(step *aiv1alpha1.ChainStep) error {
+	if !summarizes(step) {
+		return nil
+	}
+	if !isKnightStep(step) {
+		return fmt.Errorf("step %q sets fanIn.summarize but is not a knight step", step.Name)
+	}
+	if len(step.DependsOn) == 0 {
+		return fmt.Errorf("step %q sets fanIn.summarize but has no dependsOn", step.Name)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"testing"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestRenderStepTemplateDeps(t *testing.T) {
+	r := &ChainReconciler{}
+	step := &aiv1alpha1.ChainStep{Name: "merge", DependsOn: []string{"web", "dns", "smtp"}, FanIn: &aiv1alpha1.FanIn{Summarize: true}}
+	chain := &aiv1alpha1.Chain{
+		Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{
+			{Name: "web"}, {Name: "dns", ContinueOnFailure: true}, {Name: "smtp"}, *step,
+		}},
+		Status: aiv1alpha1.ChainStatus{StepStatuses: []aiv1alpha1.ChainStepStatus{
+			{Name: "web", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Output: "443 open\n"},
+			{Name: "dns", Phase: aiv1alpha1.ChainStepPhaseFailed, Error: "timeout"},
+			{Name: "smtp", Phase: aiv1alpha1.ChainStepPhaseRunning},
+			{Name: "merge", Phase: aiv1alpha1.ChainStepPhasePending},
+		}},
+	}
+
+	got, err := r.renderStepTemplate(chain, step, stepTaskTemplate(step))
+	if err != nil {
+		t.Fatalf("renderStepTemplate() error = %v", err)
+	}
+	if want := "\n\n## Dependency outputs\n\n### web\n\n443 open\n\n### dns\n\n(failed: timeout)"; got != want {
+		t.Errorf("renderStepTemplate() = %q, want %q", got, want)
+	}
+
+	got, err = r.renderStepTemplate(chain, step, `{{ range .Deps.Outputs }}{{ .Name }}={{ not .Failed }} {{ end }}{{ len .Deps.Names }}`)
+	if err != nil {
+		t.Fatalf("renderStepTemplate() error = %v", err)
+	}
+	if want := "web=true dns=false 3"; got != want {
+		t.Errorf("renderStepTemplate() = %q, want %q", got, want)
+	}
+
+	if got, err := r.renderTemplate(chain, `[{{ .Deps.Outputs }}]`); err != nil || got != "[]" {
+		t.Errorf("renderTemplate() without a step = %q, %v, want no dependencies", got, err)
+	}
+}
+
+func TestValidateFanIn(t *testing.T) {
+	summarize := &aiv1alpha1.FanIn{Summarize: true}
+	tests := []struct {
+		name    string
+		step    aiv1alpha1.ChainStep
+		wantErr bool
+	}{
+		{name: "knight step", step: aiv1alpha1.ChainStep{Name: "s", KnightRef: "galahad", DependsOn: []string{"a", "b"}, FanIn: summarize}},
+		{name: "no dependencies", step: aiv1alpha1.ChainStep{Name: "s", KnightRef: "galahad", FanIn: summarize}, wantErr: true},
+		{name: "job step", step: aiv1alpha1.ChainStep{Name: "s", Executor: aiv1alpha1.StepExecutorJob, DependsOn: []string{"a"},
+			Job: &aiv1alpha1.JobExecutor{Image: "alpine"}, FanIn: summarize}, wantErr: true},
+		{name: "approval step", step: aiv1alpha1.ChainStep{Name: "s", Type: aiv1alpha1.ChainStepTypeApproval, DependsOn: []string{"a"}, FanIn: summarize}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateFanIn(&tt.step); (err != nil) != tt.wantErr {
+				t.Errorf("validateFanIn() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/util"
+)
+
+// Values of ChainStepStatus.Handler.
+const (
+	handlerOnFailure = "onFailure"
+	handlerFinally   = "finally"
+)
+
+// allChainSteps returns the main steps followed by the onFailure and finally
+// handler steps. Names are unique across all three lists.
+func allChainSteps(chain *aiv1alpha1.Chain) []aiv1alpha1.ChainStep {
+	steps := make([]aiv1alpha1.ChainStep, 0, len(chain.Spec.Steps)+len(chain.Spec.OnFailure)+len(chain.Spec.Finally))
+	steps = append(steps, chain.Spec.Steps...)
+	steps = append(steps, chain.Spec.OnFailure...)
+	return append(steps, chain.Spec.Finally...)
+}
+
+// validateStepLists checks step names are unique across the main and handler
+// lists and that each list is an acyclic graph on its own — a handler may not
+// depend on a main step, nor on a step from the other handler list, since
+// only one of them may run.
+func validateStepLists(chain *aiv1alpha1.Chain) error {
+	seen := make(map[string]bool)
+	for _, step := range allChainSteps(chain) {
+		if seen[step.Name] {
+			return fmt.Errorf("duplicate step name %q", step.Name)
+		}
+		seen[step.Name] = true
+	}
+	for _, list := range [][]aiv1alpha1.ChainStep{chain.Spec.Steps, chain.Spec.OnFailure, chain.Spec.Finally} {
+		nodes := make([]util.DAGNode, len(list))
+		for i, step := range list {
+			nodes[i] = util.DAGNode{Name: step.Name, DependsOn: step.DependsOn}
+		}
+		if err := util.ValidateDAG(nodes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// activeSteps returns the steps taking part in the current run: every main
+// step plus the handler steps that have been started.
+func activeSteps(chain *aiv1alpha1.Chain, statusMap map[string]*aiv1alpha1.ChainStepStatus) []*aiv1alpha1.ChainStep {
+	steps := make([]*aiv1alpha1.ChainStep, 0, len(chain.Spec.Steps))
+	for i := range chain.Spec.Steps {
+		steps = append(steps, &chain.Spec.Steps[i])
+	}
+	for _, list := range [][]aiv1alpha1.ChainStep{chain.Spec.OnFailure, chain.Spec.Finally} {
+		for i := range list {
+			if _, ok := statusMap[list[i].Name]; ok {
+				steps = append(steps, &list[i])
+			}
+		}
+	}
+	return steps
+}
+
+// handlersStarted reports whether this run has already started its handlers.
+func handlersStarted(chain *aiv1alpha1.Chain) bool {
+	for _, ss := range chain.Status.StepStatuses {
+		if ss.Handler != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// handlersRunning reports whether any handler step of this run has yet to
+// finish.
+func handlersRunning(chain *aiv1alpha1.Chain) bool {
+	for _, ss := range chain.Status.StepStatuses {
+		if ss.Handler == "" {
+			continue
+		}
+		switch ss.Phase {
+		case aiv1alpha1.ChainStepPhaseSucceeded, aiv1alpha1.ChainStepPhaseFailed, aiv1alpha1.ChainStepPhaseSkipped:
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// defaultHandlerTimeout applies when spec.handlerTimeout is unset.
+const defaultHandlerTimeout = 300 * time.Second
+
+// handlerTimeout returns how long the handlers of a timed-out run may run.
+func handlerTimeout(chain *aiv1alpha1.Chain) time.Duration {
+	if chain.Spec.HandlerTimeout > 0 {
+		return time.Duration(chain.Spec.HandlerTimeout) * time.Second
+	}
+	return defaultHandlerTimeout
+}
+
+// runDeadline returns when the current run times out: startedAt plus the
+// chain timeout, or once it has timed out, timedOutAt plus handlerTimeout.
+func runDeadline(chain *aiv1alpha1.Chain) (time.Time, bool) {
+	if chain.Status.TimedOutAt != nil {
+		return chain.Status.TimedOutAt.Add(handlerTimeout(chain)), true
+	}
+	if chain.Status.StartedAt == nil || chain.Spec.Timeout <= 0 {
+		return time.Time{}, false
+	}
+	return chain.Status.StartedAt.Add(time.Duration(chain.Spec.Timeout) * time.Second), true
+}
+
+// startHandlers adds Pending statuses for the handler steps that apply to the
+// run's outcome — finally always, onFailure only if the main steps failed.
+// It reports whether any handler was started.
+func (r *ChainReconciler) startHandlers(chain *aiv1alpha1.Chain, failed bool) bool {
+	if handlersStarted(chain) {
+		return false
+	}
+	started := 0
+	add := func(steps []aiv1alpha1.ChainStep, handler string) {
+		for _, step := range steps {
+			chain.Status.StepStatuses = append(chain.Status.StepStatuses, aiv1alpha1.ChainStepStatus{
+				Name:    step.Name,
+				Phase:   aiv1alpha1.ChainStepPhasePending,
+				Handler: handler,
+			})
+			started++
+		}
+	}
+	if failed {
+		add(chain.Spec.OnFailure, handlerOnFailure)
+	}
+	add(chain.Spec.Finally, handlerFinally)
+	if started > 0 {
+		r.Recorder.Eventf(chain, corev1.EventTypeNormal, "HandlersStarted",
+			"Main steps finished (failed=%t), running %d handler step(s)", failed, started)
+	}
+	return started > 0
+}
+
+// settleSteps evaluates one group of step statuses (the main steps, or the
+// handler steps). Once a step in the group fails without continueOnFailure,
+// the group's Pending and human-gated steps can never become ready, so they
+// are skipped. It reports whether every step in the group is terminal and
+// whether the group had such a hard failure.
+func settleSteps(statuses []*aiv1alpha1.ChainStepStatus, specMap map[string]*aiv1alpha1.ChainStep) (done, failed bool) {
+	for _, ss := range statuses {
+		if ss.Phase == aiv1alpha1.ChainStepPhaseFailed {
+			if spec := specMap[ss.Name]; spec == nil || !spec.ContinueOnFailure {
+				failed = true
+			}
+		}
+	}
+
+	done = true
+	for _, ss := range statuses {
+		switch ss.Phase {
+		case aiv1alpha1.ChainStepPhaseSucceeded, aiv1alpha1.ChainStepPhaseFailed, aiv1alpha1.ChainStepPhaseSkipped:
+		case aiv1alpha1.ChainStepPhasePending, aiv1alpha1.ChainStepPhaseAwaitingApproval,
+			aiv1alpha1.ChainStepPhaseAwaitingInput:
+			// Steps still waiting on a dependency or a human are moot once
+			// the group is failing.
+			if failed {
+				ss.Phase = aiv1alpha1.ChainStepPhaseSkipped
+			} else {
+				done = false
+			}
+		default:
+			done = false
+		}
+	}
+	return done, failed
+}
@@ -0,0 +1,242 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestValidateStepLists(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    aiv1alpha1.ChainSpec
+		wantErr bool
+	}{
+		{
+			name: "handlers depending within their own list",
+			spec: aiv1alpha1.ChainSpec{
+				Steps:     []aiv1alpha1.ChainStep{{Name: "deploy"}},
+				OnFailure: []aiv1alpha1.ChainStep{{Name: "rollback"}, {Name: "page", DependsOn: []string{"rollback"}}},
+				Finally:   []aiv1alpha1.ChainStep{{Name: "cleanup"}},
+			},
+		},
+		{
+			name: "duplicate name across lists",
+			spec: aiv1alpha1.ChainSpec{
+				Steps:   []aiv1alpha1.ChainStep{{Name: "cleanup"}},
+				Finally: []aiv1alpha1.ChainStep{{Name: "cleanup"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "handler depending on a main step",
+			spec: aiv1alpha1.ChainSpec{
+				Steps:   []aiv1alpha1.ChainStep{{Name: "deploy"}},
+				Finally: []aiv1alpha1.ChainStep{{Name: "cleanup", DependsOn: []string{"deploy"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "finally depending on onFailure",
+			spec: aiv1alpha1.ChainSpec{
+				Steps:     []aiv1alpha1.ChainStep{{Name: "deploy"}},
+				OnFailure: []aiv1alpha1.ChainStep{{Name: "rollback"}},
+				Finally:   []aiv1alpha1.ChainStep{{Name: "cleanup", DependsOn: []string{"rollback"}}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateStepLists(&aiv1alpha1.Chain{Spec: tt.spec})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateStepLists() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStartHandlers(t *testing.T) {
+	r := &ChainReconciler{Recorder: record.NewFakeRecorder(10)}
+	newChain := func() *aiv1alpha1.Chain {
+		return &aiv1alpha1.Chain{
+			Spec: aiv1alpha1.ChainSpec{
+				Steps:     []aiv1alpha1.ChainStep{{Name: "deploy"}},
+				OnFailure: []aiv1alpha1.ChainStep{{Name: "rollback"}},
+				Finally:   []aiv1alpha1.ChainStep{{Name: "cleanup"}},
+			},
+			Status: aiv1alpha1.ChainStatus{
+				StepStatuses: []aiv1alpha1.ChainStepStatus{{Name: "deploy", Phase: aiv1alpha1.ChainStepPhaseSucceeded}},
+			},
+		}
+	}
+
+	succeeded := newChain()
+	if !r.startHandlers(succeeded, false) {
+		t.Fatal("startHandlers() should start finally after success")
+	}
+	if got := len(succeeded.Status.StepStatuses); got != 2 || succeeded.Status.StepStatuses[1].Handler != handlerFinally {
+		t.Errorf("after success want only the finally handler, got %+v", succeeded.Status.StepStatuses)
+	}
+	if r.startHandlers(succeeded, false) {
+		t.Error("startHandlers() must not start handlers twice")
+	}
+
+	failed := newChain()
+	if !r.startHandlers(failed, true) {
+		t.Fatal("startHandlers() should start handlers after failure")
+	}
+	if got := len(failed.Status.StepStatuses); got != 3 {
+		t.Errorf("after failure want onFailure and finally handlers, got %+v", failed.Status.StepStatuses)
+	}
+
+	none := newChain()
+	none.Spec.Finally = nil
+	if r.startHandlers(none, false) {
+		t.Error("startHandlers() with only onFailure should not start anything after success")
+	}
+}
+
+func TestSettleSteps(t *testing.T) {
+	specMap := map[string]*aiv1alpha1.ChainStep{
+		"a":    {Name: "a"},
+		"b":    {Name: "b"},
+		"soft": {Name: "soft", ContinueOnFailure: true},
+	}
+	statuses := func(phases ...aiv1alpha1.ChainStepPhase) []*aiv1alpha1.ChainStepStatus {
+		names := []string{"a", "b", "soft"}
+		out := make([]*aiv1alpha1.ChainStepStatus, len(phases))
+		for i, p := range phases {
+			out[i] = &aiv1alpha1.ChainStepStatus{Name: names[i], Phase: p}
+		}
+		return out
+	}
+
+	tests := []struct {
+		name       string
+		statuses   []*aiv1alpha1.ChainStepStatus
+		wantDone   bool
+		wantFailed bool
+	}{
+		{name: "empty group", wantDone: true},
+		{name: "still running", statuses: statuses(aiv1alpha1.ChainStepPhaseSucceeded, aiv1alpha1.ChainStepPhaseRunning)},
+		{name: "all succeeded", statuses: statuses(aiv1alpha1.ChainStepPhaseSucceeded, aiv1alpha1.ChainStepPhaseSucceeded), wantDone: true},
+		{name: "hard failure skips pending", statuses: statuses(aiv1alpha1.ChainStepPhaseFailed, aiv1alpha1.ChainStepPhasePending), wantDone: true, wantFailed: true},
+		{name: "hard failure waits for running", statuses: statuses(aiv1alpha1.ChainStepPhaseFailed, aiv1alpha1.ChainStepPhaseRunning), wantFailed: true},
+		{name: "soft failure", statuses: statuses(aiv1alpha1.ChainStepPhaseSucceeded, aiv1alpha1.ChainStepPhaseSucceeded, aiv1alpha1.ChainStepPhaseFailed), wantDone: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			done, failed := settleSteps(tt.statuses, specMap)
+			if done != tt.wantDone || failed != tt.wantFailed {
+				t.Errorf("settleSteps() = (%v, %v), want (%v, %v)", done, failed, tt.wantDone, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestChainTimeoutRunsHandlers(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec:       aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{SubjectPrefix: "fleet-a"}},
+	}
+	knight := &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{Name: "galahad", Namespace: "default"},
+		Spec:       aiv1alpha1.KnightSpec{Domain: "security"},
+	}
+	started := metav1.NewTime(time.Now().Add(-15 * time.Minute))
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "deploy", Namespace: "default"},
+		Spec: aiv1alpha1.ChainSpec{
+			RoundTableRef: "fleet",
+			Timeout:       600,
+			Steps:         []aiv1alpha1.ChainStep{{Name: "apply", KnightRef: "galahad", Task: "apply"}},
+			Finally:       []aiv1alpha1.ChainStep{{Name: "cleanup", KnightRef: "galahad", Task: "delete the scratch namespace"}},
+		},
+		Status: aiv1alpha1.ChainStatus{
+			Phase:     aiv1alpha1.ChainPhaseRunning,
+			RunID:     "run-1",
+			StartedAt: &started,
+			StepStatuses: []aiv1alpha1.ChainStepStatus{
+				{Name: "apply", Phase: aiv1alpha1.ChainStepPhaseRunning, TaskID: "task-1", Knight: "galahad", StartedAt: &started},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt, knight, chain).
+		WithStatusSubresource(&aiv1alpha1.Chain{}).Build()
+	nc := newFakeNATSClient()
+	r := &ChainReconciler{Client: c, Recorder: record.NewFakeRecorder(20), NATS: natspkg.NewProviderWithClient(nc, logr.Discard())}
+	reconcile := func() *aiv1alpha1.Chain {
+		t.Helper()
+		got := &aiv1alpha1.Chain{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(chain), got); err != nil {
+			t.Fatalf("get chain: %v", err)
+		}
+		if _, err := r.reconcileRunning(ctx, got); err != nil {
+			t.Fatalf("reconcileRunning() error = %v", err)
+		}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(chain), got); err != nil {
+			t.Fatalf("get chain: %v", err)
+		}
+		return got
+	}
+
+	got := reconcile()
+	if got.Status.Phase != aiv1alpha1.ChainPhaseRunning || got.Status.TimedOutAt == nil {
+		t.Fatalf("phase = %s, timedOutAt %v, want Running with handlers to run", got.Status.Phase, got.Status.TimedOutAt)
+	}
+	if ss := got.Status.StepStatuses[0]; ss.Phase != aiv1alpha1.ChainStepPhaseSkipped {
+		t.Errorf("apply phase = %s, want Skipped", ss.Phase)
+	}
+	if _, ok := nc.published["fleet-a.control.security.galahad"]; !ok {
+		t.Errorf("no cancellation published for apply, got subjects %v", nc.subjects())
+	}
+
+	got = reconcile()
+	cleanup := got.Status.StepStatuses[len(got.Status.StepStatuses)-1]
+	if cleanup.Name != "cleanup" || cleanup.Handler != handlerFinally || cleanup.Phase != aiv1alpha1.ChainStepPhaseRunning {
+		t.Fatalf("cleanup status = %+v, want the finally step dispatched", cleanup)
+	}
+	data, ok := nc.published[natspkg.TaskSubject("fleet-a", "security", "galahad")]
+	if !ok {
+		t.Fatalf("no task published for cleanup, got subjects %v", nc.subjects())
+	}
+	var payload natspkg.TaskPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		t.Fatalf("unmarshal task: %v", err)
+	}
+	if payload.StepName != "cleanup" || payload.Deadline == nil || !payload.Deadline.After(time.Now()) {
+		t.Errorf("task = %+v, want cleanup with a deadline within handlerTimeout", payload)
+	}
+
+	// The handler deadline passes before cleanup reports back.
+	late := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+	got.Status.TimedOutAt = &late
+	if err := c.Status().Update(ctx, got); err != nil {
+		t.Fatalf("update chain status: %v", err)
+	}
+	got = reconcile()
+	cond := meta.FindStatusCondition(got.Status.Conditions, aiv1alpha1.ConditionChainComplete)
+	if got.Status.Phase != aiv1alpha1.ChainPhaseFailed || cond == nil || cond.Reason != aiv1alpha1.ReasonChainTimeout {
+		t.Errorf("phase = %s, Complete %+v, want Failed with Timeout", got.Status.Phase, cond)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// Defaults for the run history limits, matching the CRD defaults.
+const (
+	defaultSuccessfulRunsHistoryLimit = 3
+	defaultFailedRunsHistoryLimit     = 1
+)
+
+// recordRunHistory appends the just-finished run to status.runHistory and
+// prunes the history. Call it after the run's terminal phase, completedAt
+// and ChainComplete condition are set.
+func recordRunHistory(chain *aiv1alpha1.Chain) {
+	record := aiv1alpha1.ChainRunRecord{
+		RunID:       chain.Status.RunID,
+		Phase:       chain.Status.Phase,
+		StartedAt:   chain.Status.StartedAt,
+		CompletedAt: chain.Status.CompletedAt,
+		CostUSD:     chain.Status.CostUSD,
+	}
+	if cond := meta.FindStatusCondition(chain.Status.Conditions, aiv1alpha1.ConditionChainComplete); cond != nil {
+		record.Reason = cond.Reason
+	}
+	chain.Status.RunHistory = append(chain.Status.RunHistory, record)
+	pruneRunHistory(chain, time.Now())
+}
+
+// pruneRunHistory drops records past the TTL, then the oldest successful and
+// failed records beyond their limits.
+func pruneRunHistory(chain *aiv1alpha1.Chain, now time.Time) {
+	successLimit, failedLimit := int32(defaultSuccessfulRunsHistoryLimit), int32(defaultFailedRunsHistoryLimit)
+	if chain.Spec.SuccessfulRunsHistoryLimit != nil {
+		successLimit = *chain.Spec.SuccessfulRunsHistoryLimit
+	}
+	if chain.Spec.FailedRunsHistoryLimit != nil {
+		failedLimit = *chain.Spec.FailedRunsHistoryLimit
+	}
+
+	// Walk newest first so the limits keep the most recent runs.
+	var kept []aiv1alpha1.ChainRunRecord
+	var succeeded, failed int32
+	for i := len(chain.Status.RunHistory) - 1; i >= 0; i-- {
+		rec := chain.Status.RunHistory[i]
+		if ttl := chain.Spec.RunHistoryTTLSeconds; ttl != nil && rec.CompletedAt != nil &&
+			now.Sub(rec.CompletedAt.Time) > time.Duration(*ttl)*time.Second {
+			continue
+		}
+		if rec.Phase == aiv1alpha1.ChainPhaseFailed {
+			if failed >= failedLimit {
+				continue
+			}
+			failed++
+		} else {
+			if succeeded >= successLimit {
+				continue
+			}
+			succeeded++
+		}
+		kept = append(kept, rec)
+	}
+
+	// Restore oldest-first order.
+	for i, j := 0, len(kept)-1; i < j; i, j = i+1, j-1 {
+		kept[i], kept[j] = kept[j], kept[i]
+	}
+	chain.Status.RunHistory = kept
+}
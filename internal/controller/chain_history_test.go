@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestPruneRunHistory(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	record := func(id string, phase aiv1alpha1.ChainPhase, age time.Duration) aiv1alpha1.ChainRunRecord {
+		done := metav1.NewTime(now.Add(-age))
+		return aiv1alpha1.ChainRunRecord{RunID: id, Phase: phase, CompletedAt: &done}
+	}
+	history := []aiv1alpha1.ChainRunRecord{
+		record("r1", aiv1alpha1.ChainPhaseSucceeded, 6*time.Hour),
+		record("r2", aiv1alpha1.ChainPhaseFailed, 5*time.Hour),
+		record("r3", aiv1alpha1.ChainPhaseSucceeded, 4*time.Hour),
+		record("r4", aiv1alpha1.ChainPhasePartiallySucceeded, 3*time.Hour),
+		record("r5", aiv1alpha1.ChainPhaseFailed, 2*time.Hour),
+		record("r6", aiv1alpha1.ChainPhaseSucceeded, time.Hour),
+	}
+	ttl := int32(int((150 * time.Minute).Seconds()))
+	two := int32(2)
+
+	tests := []struct {
+		name string
+		spec aiv1alpha1.ChainSpec
+		want []string
+	}{
+		{name: "defaults", want: []string{"r3", "r4", "r5", "r6"}},
+		{name: "custom limits", spec: aiv1alpha1.ChainSpec{SuccessfulRunsHistoryLimit: &two, FailedRunsHistoryLimit: &two}, want: []string{"r2", "r4", "r5", "r6"}},
+		{name: "ttl", spec: aiv1alpha1.ChainSpec{RunHistoryTTLSeconds: &ttl}, want: []string{"r5", "r6"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &aiv1alpha1.Chain{Spec: tt.spec}
+			chain.Status.RunHistory = append([]aiv1alpha1.ChainRunRecord(nil), history...)
+			pruneRunHistory(chain, now)
+			var got []string
+			for _, rec := range chain.Status.RunHistory {
+				got = append(got, rec.RunID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("kept %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// fleetHold returns why the chain's RoundTable holds its scheduled runs
+// under policies.scheduleHold, or "" if it doesn't. A table that can't be
+// read doesn't hold them.
+func (r *ChainReconciler) fleetHold(ctx context.Context, chain *aiv1alpha1.Chain) (reason, message string) {
+	if chain.Spec.RoundTableRef == "" {
+		return "", ""
+	}
+	rt := &aiv1alpha1.RoundTable{}
+	if err := r.Get(ctx, types.NamespacedName{Name: chain.Spec.RoundTableRef, Namespace: chain.Namespace}, rt); err != nil {
+		return "", ""
+	}
+	if rt.Spec.Policies == nil || rt.Spec.Policies.ScheduleHold == nil {
+		return "", ""
+	}
+	switch rt.Status.Phase {
+	case aiv1alpha1.RoundTablePhaseOverBudget:
+		return aiv1alpha1.ReasonFleetOverBudget,
+			fmt.Sprintf("RoundTable %s is over its cost budget", rt.Name)
+	case aiv1alpha1.RoundTablePhaseDegraded:
+		minReady := rt.Spec.Policies.ScheduleHold.MinReadyPercent
+		if rt.Status.KnightsReady*100 < minReady*rt.Status.KnightsTotal {
+			return aiv1alpha1.ReasonFleetDegraded,
+				fmt.Sprintf("RoundTable %s has %d of %d knights ready, under %d%%",
+					rt.Name, rt.Status.KnightsReady, rt.Status.KnightsTotal, minReady)
+		}
+	}
+	return "", ""
+}
+
+// deferRun holds a scheduled run of chain: it consumes the schedule's fire
+// and marks the chain Deferred until its RoundTable recovers.
+func (r *ChainReconciler) deferRun(chain *aiv1alpha1.Chain, reason, message string) {
+	if !meta.IsStatusConditionTrue(chain.Status.Conditions, aiv1alpha1.ConditionChainDeferred) {
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "ScheduledRunDeferred", "Deferred scheduled run: %s", message)
+	}
+	now := metav1.Now()
+	chain.Status.LastScheduledAt = &now
+	meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionChainDeferred,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            fmt.Sprintf("Scheduled run deferred: %s", message),
+		ObservedGeneration: chain.Generation,
+	})
+}
+
+// dropDeferredRun clears the chain's deferred scheduled run without starting
+// it.
+func (r *ChainReconciler) dropDeferredRun(chain *aiv1alpha1.Chain, message string) {
+	r.Recorder.Eventf(chain, corev1.EventTypeWarning, "DeferredRunDropped", "Dropped deferred scheduled run: %s", message)
+	meta.SetStatusCondition(&chain.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionChainDeferred,
+		Status:             metav1.ConditionFalse,
+		Reason:             aiv1alpha1.ReasonDeferredRunDropped,
+		Message:            fmt.Sprintf("Deferred scheduled run dropped: %s", message),
+		ObservedGeneration: chain.Generation,
+	})
+}
+
+// runDeferred reports whether chain holds a deferred scheduled run.
+func runDeferred(chain *aiv1alpha1.Chain) bool {
+	return meta.IsStatusConditionTrue(chain.Status.Conditions, aiv1alpha1.ConditionChainDeferred)
+}
@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestScheduleHold(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet-a", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{Policies: &aiv1alpha1.RoundTablePolicies{
+			ScheduleHold: &aiv1alpha1.RoundTableScheduleHold{MinReadyPercent: 50},
+		}},
+		Status: aiv1alpha1.RoundTableStatus{Phase: aiv1alpha1.RoundTablePhaseDegraded, KnightsReady: 1, KnightsTotal: 4},
+	}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"},
+		Spec: aiv1alpha1.ChainSpec{
+			RoundTableRef: "fleet-a",
+			Schedule:      "0 2 * * *",
+			Steps:         []aiv1alpha1.ChainStep{{Name: "scan", KnightRef: "galahad", Task: "scan"}},
+		},
+		Status: aiv1alpha1.ChainStatus{Phase: aiv1alpha1.ChainPhaseIdle},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt, chain).
+		WithIndex(&aiv1alpha1.Chain{}, chainRoundTableIndex, chainRoundTableRef).
+		WithStatusSubresource(&aiv1alpha1.RoundTable{}, &aiv1alpha1.Chain{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &ChainReconciler{Client: c, Recorder: recorder}
+	nn := types.NamespacedName{Name: "nightly", Namespace: "default"}
+	get := func() *aiv1alpha1.Chain {
+		t.Helper()
+		got := &aiv1alpha1.Chain{}
+		if err := c.Get(ctx, nn, got); err != nil {
+			t.Fatalf("get chain: %v", err)
+		}
+		return got
+	}
+
+	r.triggerChain(ctx, nn)
+	got := get()
+	cond := meta.FindStatusCondition(got.Status.Conditions, aiv1alpha1.ConditionChainDeferred)
+	if got.Status.Phase != aiv1alpha1.ChainPhaseIdle || cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != aiv1alpha1.ReasonFleetDegraded {
+		t.Fatalf("phase = %s, Deferred %+v, want the run deferred for a degraded fleet", got.Status.Phase, cond)
+	}
+	if got.Status.LastScheduledAt == nil {
+		t.Error("lastScheduledAt unset, want the fire consumed")
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "1 of 4 knights ready") {
+		t.Errorf("events = %v, want ScheduledRunDeferred", events)
+	}
+
+	// Two of four ready is enough; the RoundTable watch finds the chain.
+	rt.Status.KnightsReady = 2
+	if err := c.Status().Update(ctx, rt); err != nil {
+		t.Fatalf("update roundtable: %v", err)
+	}
+	if requests := r.chainsDeferredBy(ctx, rt); len(requests) != 1 || requests[0].NamespacedName != nn {
+		t.Errorf("chainsDeferredBy() = %v, want the deferred chain", requests)
+	}
+	if !r.triggerChain(ctx, nn) {
+		t.Error("triggerChain() = false, want the deferred run started")
+	}
+	got = get()
+	cond = meta.FindStatusCondition(got.Status.Conditions, aiv1alpha1.ConditionChainDeferred)
+	if got.Status.Phase != aiv1alpha1.ChainPhaseRunning || got.Status.TriggeredBy != triggeredBySchedule ||
+		cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != aiv1alpha1.ReasonFleetRecovered {
+		t.Errorf("phase = %s, triggeredBy %s, Deferred %+v, want the deferred run started", got.Status.Phase, got.Status.TriggeredBy, cond)
+	}
+
+	rt.Status.Phase = aiv1alpha1.RoundTablePhaseOverBudget
+	if err := c.Status().Update(ctx, rt); err != nil {
+		t.Fatalf("update roundtable: %v", err)
+	}
+	if reason, _ := r.fleetHold(ctx, chain); reason != aiv1alpha1.ReasonFleetOverBudget {
+		t.Errorf("fleetHold() = %q over budget, want FleetOverBudget", reason)
+	}
+}
+
+func TestDeferredRunDropped(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"},
+		Spec: aiv1alpha1.ChainSpec{
+			Schedule:    "0 2 * * *",
+			TemplateRef: &aiv1alpha1.ChainTemplateRef{Name: "gone"},
+		},
+		Status: aiv1alpha1.ChainStatus{
+			Phase: aiv1alpha1.ChainPhaseIdle,
+			Conditions: []metav1.Condition{{
+				Type:               aiv1alpha1.ConditionChainDeferred,
+				Status:             metav1.ConditionTrue,
+				Reason:             aiv1alpha1.ReasonFleetDegraded,
+				LastTransitionTime: metav1.Now(),
+			}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(chain).
+		WithStatusSubresource(&aiv1alpha1.Chain{}).Build()
+	r := &ChainReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+	nn := types.NamespacedName{Name: "nightly", Namespace: "default"}
+
+	// A deferred run whose template is gone can never start.
+	if r.triggerChain(ctx, nn) {
+		t.Fatal("triggerChain() = true, want no run without the template")
+	}
+	got := &aiv1alpha1.Chain{}
+	if err := c.Get(ctx, nn, got); err != nil {
+		t.Fatalf("get chain: %v", err)
+	}
+	cond := meta.FindStatusCondition(got.Status.Conditions, aiv1alpha1.ConditionChainDeferred)
+	if runDeferred(got) || cond == nil || cond.Reason != aiv1alpha1.ReasonDeferredRunDropped {
+		t.Errorf("Deferred = %+v, want the run dropped", cond)
+	}
+}
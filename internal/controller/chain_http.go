@@ -0,0 +1,270 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+const (
+	// httpStepDefaultTimeout bounds an http step's request when the step
+	// sets no timeout.
+	httpStepDefaultTimeout = 120 * time.Second
+
+	// httpCallRetention is how long a finished request's result waits for
+	// a reconcile to collect it.
+	httpCallRetention = time.Hour
+
+	// httpStepOutputLimit caps the response body kept as the step output.
+	httpStepOutputLimit = 1 << 20
+
+	// httpStepErrorBodyLimit caps the response body quoted in a failure.
+	httpStepErrorBodyLimit = 512
+
+	// httpStepMaxRedirects caps the redirects an http step follows, as
+	// net/http does by default.
+	httpStepMaxRedirects = 10
+)
+
+// isHTTPStep reports whether the step sends an HTTP request instead of
+// being dispatched to a knight.
+func isHTTPStep(step *aiv1alpha1.ChainStep) bool {
+	return step != nil && (step.Type == "" || step.Type == aiv1alpha1.ChainStepTypeTask) &&
+		step.Executor == aiv1alpha1.StepExecutorHTTP
+}
+
+// validateHTTPExecutor checks an http step describes its request and every
+// header has exactly one value source.
+func validateHTTPExecutor(step *aiv1alpha1.ChainStep) error {
+	if step.HTTP == nil {
+		return fmt.Errorf("step %q uses the http executor but does not set http", step.Name)
+	}
+	for _, h := range step.HTTP.Headers {
+		if (h.Value == "") == (h.SecretKeyRef == nil) {
+			return fmt.Errorf("step %q header %q must set exactly one of value and secretKeyRef", step.Name, h.Name)
+		}
+	}
+	return nil
+}
+
+// httpMethod returns the step's request method, GET when unset.
+func httpMethod(spec *aiv1alpha1.HTTPExecutor) string {
+	if spec.Method == "" {
+		return http.MethodGet
+	}
+	return spec.Method
+}
+
+// httpURLAllowed reports whether an http step may send a request to url.
+// The allowlist is the SSRF guard; with no prefixes configured every
+// request is rejected.
+func (r *ChainReconciler) httpURLAllowed(url string) bool {
+	for _, prefix := range r.HTTPAllowedURLPrefixes {
+		if prefix != "" && strings.HasPrefix(url, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// secretKeyValue reads a Secret key in the given namespace. The value is
+// never logged or included in errors.
+func secretKeyValue(ctx context.Context, c client.Client, namespace string, ref *corev1.SecretKeySelector) (string, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, secret); err != nil {
+		return "", fmt.Errorf("read secret %q: %w", ref.Name, err)
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("secret %q has no key %q", ref.Name, ref.Key)
+	}
+	return string(value), nil
+}
+
+// httpCall is an http step request in flight, by task ID. The request runs
+// in its own goroutine so a slow endpoint doesn't hold a chain worker; the
+// step is completed on a later reconcile, as job steps are.
+type httpCall struct {
+	cancel     context.CancelFunc
+	done       bool
+	output     string
+	errMsg     string
+	finishedAt time.Time
+}
+
+// startHTTPStep builds a ready http step's request and sends it in the
+// background under the task ID. A request already sent under the task ID
+// (its Running status was lost to a conflict) is not sent again. It returns
+// why the step failed if the request couldn't be built.
+func (r *ChainReconciler) startHTTPStep(ctx context.Context, chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, taskID string) string {
+	r.mu.Lock()
+	_, sent := r.httpCalls[taskID]
+	r.mu.Unlock()
+	if sent {
+		return ""
+	}
+
+	req, errMsg := r.buildHTTPRequest(ctx, chain, step)
+	if errMsg != "" {
+		return errMsg
+	}
+	timeout := httpStepDefaultTimeout
+	if step.Timeout > 0 {
+		timeout = time.Duration(step.Timeout) * time.Second
+	}
+	callCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	call := &httpCall{cancel: cancel}
+
+	r.mu.Lock()
+	if r.httpCalls == nil {
+		r.httpCalls = make(map[string]*httpCall)
+	}
+	// Drop results no reconcile collected, e.g. of a deleted chain.
+	for id, c := range r.httpCalls {
+		if c.done && time.Since(c.finishedAt) > httpCallRetention {
+			delete(r.httpCalls, id)
+		}
+	}
+	r.httpCalls[taskID] = call
+	r.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		output, errMsg := r.sendHTTPRequest(req.WithContext(callCtx))
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		call.done, call.output, call.errMsg, call.finishedAt = true, output, errMsg, time.Now()
+	}()
+	return ""
+}
+
+// pollHTTPStep returns the result of the http step request sent under the
+// task ID once it has finished. A request this process doesn't know was
+// lost to an operator restart and fails the step.
+func (r *ChainReconciler) pollHTTPStep(taskID string) (output, errMsg string, done bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	call, ok := r.httpCalls[taskID]
+	if !ok {
+		return "", "request lost: the operator restarted while it was in flight", true
+	}
+	if !call.done {
+		return "", "", false
+	}
+	delete(r.httpCalls, taskID)
+	return call.output, call.errMsg, true
+}
+
+// cancelHTTPStep aborts the http step request sent under the task ID, if
+// any, and forgets it.
+func (r *ChainReconciler) cancelHTTPStep(taskID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if call, ok := r.httpCalls[taskID]; ok {
+		call.cancel()
+		delete(r.httpCalls, taskID)
+	}
+}
+
+// buildHTTPRequest renders an http step's request. It returns the request,
+// or why the step failed.
+func (r *ChainReconciler) buildHTTPRequest(ctx context.Context, chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep) (*http.Request, string) {
+	spec := step.HTTP
+	url, err := r.renderStepTemplate(chain, step, spec.URL)
+	if err != nil {
+		return nil, fmt.Sprintf("url template render error: %v", err)
+	}
+	if !r.httpURLAllowed(url) {
+		return nil, fmt.Sprintf("URL %s does not match an allowed URL prefix", url)
+	}
+	body, err := r.renderStepTemplate(chain, step, spec.Body)
+	if err != nil {
+		return nil, fmt.Sprintf("body template render error: %v", err)
+	}
+
+	var reqBody io.Reader
+	if body != "" {
+		reqBody = strings.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, httpMethod(spec), url, reqBody)
+	if err != nil {
+		return nil, fmt.Sprintf("build request: %v", err)
+	}
+	for _, h := range spec.Headers {
+		var value string
+		if h.SecretKeyRef != nil {
+			value, err = secretKeyValue(ctx, r.Client, chain.Namespace, h.SecretKeyRef)
+		} else {
+			value, err = r.renderStepTemplate(chain, step, h.Value)
+		}
+		if err != nil {
+			return nil, fmt.Sprintf("header %s: %v", h.Name, err)
+		}
+		req.Header.Set(h.Name, value)
+	}
+	return req, ""
+}
+
+// sendHTTPRequest sends an http step's request. It returns the response
+// body, or why the step failed. Redirects are followed only to URLs the
+// allowlist also allows, so an open redirect on an allowed host can't read
+// anything else.
+func (r *ChainReconciler) sendHTTPRequest(req *http.Request) (output, errMsg string) {
+	httpClient := http.Client{}
+	if r.HTTPClient != nil {
+		httpClient = *r.HTTPClient
+	}
+	httpClient.CheckRedirect = func(next *http.Request, via []*http.Request) error {
+		if !r.httpURLAllowed(next.URL.String()) {
+			return fmt.Errorf("redirect to %s does not match an allowed URL prefix", next.URL)
+		}
+		if len(via) >= httpStepMaxRedirects {
+			return fmt.Errorf("stopped after %d redirects", httpStepMaxRedirects)
+		}
+		return nil
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Sprintf("%s %s: %v", req.Method, req.URL, err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, httpStepOutputLimit))
+	if err != nil {
+		return "", fmt.Sprintf("%s %s: read response: %v", req.Method, req.URL, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if len(data) > httpStepErrorBodyLimit {
+			data = data[:httpStepErrorBodyLimit]
+		}
+		return "", fmt.Sprintf("%s %s returned %s: %s", req.Method, req.URL, resp.Status, data)
+	}
+	return string(data), ""
+}
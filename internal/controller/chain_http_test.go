@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestHTTPStep(t *testing.T) {
+	metadataHit := false
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		metadataHit = true
+		_, _ = w.Write([]byte("iam-credentials"))
+	}))
+	defer metadata.Close()
+
+	var gotMethod, gotPath, gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if to := req.URL.Query().Get("to"); req.URL.Path == "/redirect" && to != "" {
+			http.Redirect(w, req, to, http.StatusFound)
+			return
+		}
+		body, _ := io.ReadAll(req.Body)
+		gotMethod, gotPath, gotAuth, gotBody = req.Method, req.URL.Path, req.Header.Get("Authorization"), string(body)
+		if req.URL.Path == "/missing" {
+			http.Error(w, "no such ticket", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"key": "SEC-42"}`))
+	}))
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "jira", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("Bearer s3cret")},
+	}
+	r := &ChainReconciler{
+		Client:                 fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build(),
+		HTTPClient:             server.Client(),
+		HTTPAllowedURLPrefixes: []string{server.URL + "/"},
+	}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "triage", Namespace: "default"},
+		Status: aiv1alpha1.ChainStatus{StepStatuses: []aiv1alpha1.ChainStepStatus{
+			{Name: "summarize", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Output: "Open SSH port"},
+		}},
+	}
+	step := &aiv1alpha1.ChainStep{
+		Name:     "ticket",
+		Executor: aiv1alpha1.StepExecutorHTTP,
+		HTTP: &aiv1alpha1.HTTPExecutor{
+			URL:     server.URL + "/issues",
+			Method:  http.MethodPost,
+			Headers: []aiv1alpha1.HTTPHeader{{Name: "Authorization", SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "jira"}, Key: "token"}}},
+			Body:    `{"summary": "{{ .Steps.summarize.Output }}"}`,
+		},
+	}
+
+	send := func(taskID string) (string, string) {
+		t.Helper()
+		if errMsg := r.startHTTPStep(context.Background(), chain, step, taskID); errMsg != "" {
+			return "", errMsg
+		}
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			if output, errMsg, done := r.pollHTTPStep(taskID); done {
+				return output, errMsg
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatalf("request %s did not finish", taskID)
+		return "", ""
+	}
+
+	output, errMsg := send("task-1")
+	if errMsg != "" || output != `{"key": "SEC-42"}` {
+		t.Fatalf("http step = %q, %q, want the response body", output, errMsg)
+	}
+	if gotMethod != http.MethodPost || gotPath != "/issues" || gotAuth != "Bearer s3cret" || gotBody != `{"summary": "Open SSH port"}` {
+		t.Errorf("request = %s %s auth %q body %q", gotMethod, gotPath, gotAuth, gotBody)
+	}
+
+	step.HTTP.URL = server.URL + "/missing"
+	if _, errMsg := send("task-2"); !strings.Contains(errMsg, "404") || !strings.Contains(errMsg, "no such ticket") {
+		t.Errorf("http step for a 404 = %q, want the status and body", errMsg)
+	}
+
+	// Redirects are followed only within the allowlist.
+	step.HTTP.URL = server.URL + "/redirect?to=" + server.URL + "/issues"
+	if output, errMsg := send("task-redirect"); errMsg != "" || output != `{"key": "SEC-42"}` {
+		t.Errorf("http step through an allowed redirect = %q, %q, want the response body", output, errMsg)
+	}
+	step.HTTP.URL = server.URL + "/redirect?to=" + metadata.URL + "/latest/meta-data"
+	if output, errMsg := send("task-open-redirect"); !strings.Contains(errMsg, "redirect to") || output != "" {
+		t.Errorf("http step through an open redirect = %q, %q, want the redirect refused", output, errMsg)
+	}
+	if metadataHit {
+		t.Error("http step followed a redirect outside the allowlist")
+	}
+
+	step.HTTP.URL = "http://169.254.169.254/latest/meta-data"
+	if _, errMsg := send("task-3"); !strings.Contains(errMsg, "allowed URL prefix") {
+		t.Errorf("http step for a disallowed URL = %q, want rejection", errMsg)
+	}
+
+	// A request this process never sent was lost to a restart.
+	if _, errMsg, done := r.pollHTTPStep("task-4"); !done || !strings.Contains(errMsg, "request lost") {
+		t.Errorf("pollHTTPStep() for an unknown task = %q, %t, want the step failed", errMsg, done)
+	}
+}
+
+func TestHTTPStepDoesNotBlock(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-release:
+		case <-req.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	r := &ChainReconciler{HTTPClient: server.Client(), HTTPAllowedURLPrefixes: []string{server.URL + "/"}}
+	step := &aiv1alpha1.ChainStep{
+		Name:     "slow",
+		Executor: aiv1alpha1.StepExecutorHTTP,
+		Timeout:  60,
+		HTTP:     &aiv1alpha1.HTTPExecutor{URL: server.URL + "/slow"},
+	}
+	started := time.Now()
+	if errMsg := r.startHTTPStep(context.Background(), &aiv1alpha1.Chain{}, step, "task-1"); errMsg != "" {
+		t.Fatalf("startHTTPStep() = %q", errMsg)
+	}
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Errorf("startHTTPStep() took %v, want it to return before the response", elapsed)
+	}
+	if _, _, done := r.pollHTTPStep("task-1"); done {
+		t.Error("pollHTTPStep() done before the response")
+	}
+	r.cancelHTTPStep("task-1")
+	if _, errMsg, done := r.pollHTTPStep("task-1"); !done || errMsg == "" {
+		t.Errorf("pollHTTPStep() after cancel = %q, %t, want the request forgotten", errMsg, done)
+	}
+}
+
+func TestValidateHTTPExecutor(t *testing.T) {
+	secretRef := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "jira"}, Key: "token"}
+	tests := []struct {
+		name    string
+		step    aiv1alpha1.ChainStep
+		wantErr bool
+	}{
+		{name: "valid", step: aiv1alpha1.ChainStep{Name: "s", Executor: aiv1alpha1.StepExecutorHTTP, HTTP: &aiv1alpha1.HTTPExecutor{
+			URL: "https://example.com", Headers: []aiv1alpha1.HTTPHeader{{Name: "Authorization", SecretKeyRef: secretRef}},
+		}}},
+		{name: "no request", step: aiv1alpha1.ChainStep{Name: "s", Executor: aiv1alpha1.StepExecutorHTTP}, wantErr: true},
+		{name: "header with both values", step: aiv1alpha1.ChainStep{Name: "s", Executor: aiv1alpha1.StepExecutorHTTP, HTTP: &aiv1alpha1.HTTPExecutor{
+			URL: "https://example.com", Headers: []aiv1alpha1.HTTPHeader{{Name: "Authorization", Value: "x", SecretKeyRef: secretRef}},
+		}}, wantErr: true},
+		{name: "with knight", step: aiv1alpha1.ChainStep{Name: "s", Executor: aiv1alpha1.StepExecutorHTTP, KnightRef: "galahad", HTTP: &aiv1alpha1.HTTPExecutor{
+			URL: "https://example.com",
+		}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{tt.step}}}
+			if err := validateExecutors(chain); (err != nil) != tt.wantErr {
+				t.Errorf("validateExecutors() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
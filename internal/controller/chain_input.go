@@ -0,0 +1,88 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// isInputStep reports whether the step waits for a human-supplied value.
+func isInputStep(step *aiv1alpha1.ChainStep) bool {
+	return step != nil && step.Type == aiv1alpha1.ChainStepTypeInput
+}
+
+// isKnightStep reports whether the step dispatches a task to a knight. An
+// unset type predates step types and is treated as a task, and an unset
+// executor as the knight executor.
+func isKnightStep(step *aiv1alpha1.ChainStep) bool {
+	return step != nil && (step.Type == "" || step.Type == aiv1alpha1.ChainStepTypeTask) &&
+		(step.Executor == "" || step.Executor == aiv1alpha1.StepExecutorKnight)
+}
+
+// requestInput moves a ready input step into AwaitingInput. The value is
+// supplied by patching the step's status output (status subresource), so any
+// output left from a previous run is cleared first.
+func (r *ChainReconciler) requestInput(chain *aiv1alpha1.Chain, ss *aiv1alpha1.ChainStepStatus, prompt string) {
+	now := metav1.Now()
+	ss.Phase = aiv1alpha1.ChainStepPhaseAwaitingInput
+	ss.StartedAt = &now
+	ss.Output = ""
+	ss.Message = prompt
+	r.Recorder.Eventf(chain, corev1.EventTypeNormal, "InputRequested",
+		"Step %s is waiting for input (set its status.stepStatuses output)", ss.Name)
+}
+
+// reconcileInput completes an AwaitingInput step once a human has written a
+// value into its output, or applies the timeout (default value or failure).
+func (r *ChainReconciler) reconcileInput(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus) {
+	now := metav1.Now()
+
+	if ss.Output != "" {
+		ss.Phase = aiv1alpha1.ChainStepPhaseSucceeded
+		ss.CompletedAt = &now
+		// The value may be sensitive — never echo it into Events.
+		r.Recorder.Eventf(chain, corev1.EventTypeNormal, "InputReceived", "Step %s received input", ss.Name)
+		return
+	}
+
+	req := step.InputRequest
+	if req == nil || req.TimeoutSeconds == 0 || ss.StartedAt == nil {
+		return
+	}
+	if time.Since(ss.StartedAt.Time) <= time.Duration(req.TimeoutSeconds)*time.Second {
+		return
+	}
+
+	ss.CompletedAt = &now
+	if req.Default != nil {
+		ss.Phase = aiv1alpha1.ChainStepPhaseSucceeded
+		ss.Output = *req.Default
+		r.Recorder.Eventf(chain, corev1.EventTypeNormal, "InputDefaulted",
+			"Step %s used its default value after %ds without input", ss.Name, req.TimeoutSeconds)
+		return
+	}
+	ss.Phase = aiv1alpha1.ChainStepPhaseFailed
+	ss.Error = fmt.Sprintf("input timed out after %ds", req.TimeoutSeconds)
+	r.Recorder.Eventf(chain, corev1.EventTypeWarning, "InputTimedOut",
+		"Step %s received no input within %ds", ss.Name, req.TimeoutSeconds)
+}
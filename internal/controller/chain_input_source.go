@@ -0,0 +1,77 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// validateInput checks spec.inputFrom selects exactly one key and is not
+// combined with spec.input.
+func validateInput(chain *aiv1alpha1.Chain) error {
+	src := chain.Spec.InputFrom
+	if src == nil {
+		return nil
+	}
+	if chain.Spec.Input != "" {
+		return fmt.Errorf("input and inputFrom are mutually exclusive")
+	}
+	if (src.ConfigMapKeyRef == nil) == (src.SecretKeyRef == nil) {
+		return fmt.Errorf("inputFrom must set exactly one of configMapKeyRef and secretKeyRef")
+	}
+	return nil
+}
+
+// configMapKeyValue reads a ConfigMap key in the given namespace.
+func configMapKeyValue(ctx context.Context, c client.Client, namespace string, ref *corev1.ConfigMapKeySelector) (string, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, cm); err != nil {
+		return "", fmt.Errorf("read configmap %q: %w", ref.Name, err)
+	}
+	if value, ok := cm.Data[ref.Key]; ok {
+		return value, nil
+	}
+	if value, ok := cm.BinaryData[ref.Key]; ok {
+		return string(value), nil
+	}
+	return "", fmt.Errorf("configmap %q has no key %q", ref.Name, ref.Key)
+}
+
+// resolveRunInput returns the input of the current run: the trigger's, else
+// the key selected by spec.inputFrom, else spec.input. Templates have no
+// context to pass, and the read is served from the informer cache.
+func (r *ChainReconciler) resolveRunInput(chain *aiv1alpha1.Chain) (string, error) {
+	src := chain.Spec.InputFrom
+	if chain.Status.Input != "" || src == nil {
+		return runInput(chain), nil
+	}
+	ctx := context.Background()
+	if src.SecretKeyRef != nil {
+		return secretKeyValue(ctx, r.Client, chain.Namespace, src.SecretKeyRef)
+	}
+	if src.ConfigMapKeyRef != nil {
+		return configMapKeyValue(ctx, r.Client, chain.Namespace, src.ConfigMapKeyRef)
+	}
+	return "", nil
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestResolveRunInput(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "targets", Namespace: "default"},
+		Data:       map[string]string{"hosts": "10.0.0.1\n10.0.0.2"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "shodan", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("s3cret")},
+	}
+	r := &ChainReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm, secret).Build()}
+	chain := &aiv1alpha1.Chain{ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default"}}
+
+	chain.Spec.InputFrom = &aiv1alpha1.ChainInputSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "targets"}, Key: "hosts",
+	}}
+	if got, err := r.renderTemplate(chain, "Scan: {{ .Input }}"); err != nil || got != "Scan: 10.0.0.1\n10.0.0.2" {
+		t.Errorf("renderTemplate() with a configMapKeyRef = %q, %v", got, err)
+	}
+
+	chain.Spec.InputFrom = &aiv1alpha1.ChainInputSource{SecretKeyRef: &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "shodan"}, Key: "token",
+	}}
+	if got, err := r.resolveRunInput(chain); err != nil || got != "s3cret" {
+		t.Errorf("resolveRunInput() with a secretKeyRef = %q, %v", got, err)
+	}
+
+	chain.Status.Input = `{"host": "10.0.0.9"}`
+	if got, err := r.resolveRunInput(chain); err != nil || got != chain.Status.Input {
+		t.Errorf("resolveRunInput() with a trigger input = %q, %v, want the trigger's", got, err)
+	}
+
+	chain.Status.Input = ""
+	chain.Spec.InputFrom.SecretKeyRef.Key = "missing"
+	if _, err := r.renderTemplate(chain, "{{ .Input }}"); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("renderTemplate() with a missing key error = %v", err)
+	}
+}
+
+func TestValidateInput(t *testing.T) {
+	cmRef := &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "targets"}, Key: "hosts"}
+	secretRef := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "shodan"}, Key: "token"}
+	tests := []struct {
+		name    string
+		spec    aiv1alpha1.ChainSpec
+		wantErr bool
+	}{
+		{name: "inline", spec: aiv1alpha1.ChainSpec{Input: "x"}},
+		{name: "configmap", spec: aiv1alpha1.ChainSpec{InputFrom: &aiv1alpha1.ChainInputSource{ConfigMapKeyRef: cmRef}}},
+		{name: "both sources", spec: aiv1alpha1.ChainSpec{InputFrom: &aiv1alpha1.ChainInputSource{ConfigMapKeyRef: cmRef, SecretKeyRef: secretRef}}, wantErr: true},
+		{name: "no source", spec: aiv1alpha1.ChainSpec{InputFrom: &aiv1alpha1.ChainInputSource{}}, wantErr: true},
+		{name: "input and inputFrom", spec: aiv1alpha1.ChainSpec{Input: "x", InputFrom: &aiv1alpha1.ChainInputSource{SecretKeyRef: secretRef}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateInput(&aiv1alpha1.Chain{Spec: tt.spec}); (err != nil) != tt.wantErr {
+				t.Errorf("validateInput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestReconcileInput(t *testing.T) {
+	r := &ChainReconciler{Recorder: record.NewFakeRecorder(10)}
+	fallback := "us-east-1"
+
+	tests := []struct {
+		name       string
+		output     string
+		req        *aiv1alpha1.StepInputRequest
+		waited     time.Duration
+		wantPhase  aiv1alpha1.ChainStepPhase
+		wantOutput string
+	}{
+		{
+			name:      "no input yet",
+			wantPhase: aiv1alpha1.ChainStepPhaseAwaitingInput,
+		},
+		{
+			name:       "input supplied",
+			output:     "prod-cluster",
+			wantPhase:  aiv1alpha1.ChainStepPhaseSucceeded,
+			wantOutput: "prod-cluster",
+		},
+		{
+			name:      "timeout without default fails",
+			req:       &aiv1alpha1.StepInputRequest{TimeoutSeconds: 60},
+			waited:    2 * time.Minute,
+			wantPhase: aiv1alpha1.ChainStepPhaseFailed,
+		},
+		{
+			name:       "timeout with default succeeds",
+			req:        &aiv1alpha1.StepInputRequest{TimeoutSeconds: 60, Default: &fallback},
+			waited:     2 * time.Minute,
+			wantPhase:  aiv1alpha1.ChainStepPhaseSucceeded,
+			wantOutput: fallback,
+		},
+		{
+			name:      "within timeout keeps waiting",
+			req:       &aiv1alpha1.StepInputRequest{TimeoutSeconds: 600},
+			waited:    time.Minute,
+			wantPhase: aiv1alpha1.ChainStepPhaseAwaitingInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step := &aiv1alpha1.ChainStep{Name: "region", Type: aiv1alpha1.ChainStepTypeInput, InputRequest: tt.req}
+			started := metav1.NewTime(time.Now().Add(-tt.waited))
+			ss := &aiv1alpha1.ChainStepStatus{
+				Name:      "region",
+				Phase:     aiv1alpha1.ChainStepPhaseAwaitingInput,
+				StartedAt: &started,
+				Output:    tt.output,
+			}
+
+			r.reconcileInput(&aiv1alpha1.Chain{}, step, ss)
+			if ss.Phase != tt.wantPhase {
+				t.Errorf("phase = %s, want %s", ss.Phase, tt.wantPhase)
+			}
+			if ss.Output != tt.wantOutput {
+				t.Errorf("output = %q, want %q", ss.Output, tt.wantOutput)
+			}
+		})
+	}
+}
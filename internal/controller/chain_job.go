@@ -0,0 +1,324 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	knightpkg "github.com/dapperdivers/roundtable/internal/knight"
+)
+
+const (
+	// stepJobContainer is the name of the container in a job step's pod.
+	stepJobContainer = "step"
+
+	// stepJobTTL keeps finished step Jobs around briefly for log inspection
+	// before Kubernetes garbage-collects them.
+	stepJobTTL int32 = 3600
+
+	// stepJobOutputLimit caps the stdout read back as a job step's output.
+	stepJobOutputLimit int64 = 1 << 20
+)
+
+// PodLogReader reads a container's logs. The chain controller uses it to
+// capture the stdout of job executor steps.
+type PodLogReader interface {
+	ReadPodLogs(ctx context.Context, namespace, pod, container string, limitBytes int64) (string, error)
+}
+
+// clientsetPodLogReader reads pod logs through the Kubernetes API; the
+// controller-runtime client has no logs subresource support.
+type clientsetPodLogReader struct {
+	clientset kubernetes.Interface
+}
+
+// NewPodLogReader returns a PodLogReader using the given REST config.
+func NewPodLogReader(cfg *rest.Config) (PodLogReader, error) {
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &clientsetPodLogReader{clientset: clientset}, nil
+}
+
+func (c *clientsetPodLogReader) ReadPodLogs(ctx context.Context, namespace, pod, container string, limitBytes int64) (string, error) {
+	data, err := c.clientset.CoreV1().Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+		Container:  container,
+		LimitBytes: &limitBytes,
+	}).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// isJobStep reports whether the step runs as a Kubernetes Job instead of
+// being dispatched to a knight.
+func isJobStep(step *aiv1alpha1.ChainStep) bool {
+	return step != nil && (step.Type == "" || step.Type == aiv1alpha1.ChainStepTypeTask) &&
+		step.Executor == aiv1alpha1.StepExecutorJob
+}
+
+// validateExecutors checks every job or http executor step describes its
+// container or request, and names no knight.
+func validateExecutors(chain *aiv1alpha1.Chain) error {
+	for _, step := range allChainSteps(chain) {
+		if step.Job != nil && step.Executor != aiv1alpha1.StepExecutorJob {
+			return fmt.Errorf("step %q sets job but its executor is not job", step.Name)
+		}
+		if step.HTTP != nil && step.Executor != aiv1alpha1.StepExecutorHTTP {
+			return fmt.Errorf("step %q sets http but its executor is not http", step.Name)
+		}
+		if err := validateFanIn(&step); err != nil {
+			return err
+		}
+		if err := validateVerify(&step); err != nil {
+			return err
+		}
+		if err := validateStepContext(chain, &step); err != nil {
+			return err
+		}
+		switch {
+		case isJobStep(&step):
+			if step.Job == nil {
+				return fmt.Errorf("step %q uses the job executor but does not set job", step.Name)
+			}
+		case isHTTPStep(&step):
+			if err := validateHTTPExecutor(&step); err != nil {
+				return err
+			}
+		default:
+			continue
+		}
+		if step.KnightRef != "" || step.KnightSelector != nil || step.Domain != "" {
+			return fmt.Errorf("%s step %q must not set knightRef, knightSelector, or domain", step.Executor, step.Name)
+		}
+	}
+	return nil
+}
+
+// stepJobName returns the name of the Job for one attempt of a step. It is
+// derived from the run, step, and attempt so a Job created before a failed
+// status update is found again rather than duplicated.
+func stepJobName(chain *aiv1alpha1.Chain, stepName string, attempt int32) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%d", chain.UID, chain.Status.RunID, stepName, attempt)))
+
+	prefix := strings.Map(func(c rune) rune {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			return c
+		case c >= 'A' && c <= 'Z':
+			return c + ('a' - 'A')
+		default:
+			return '-'
+		}
+	}, chain.Name+"-"+stepName)
+	if len(prefix) > 50 {
+		prefix = prefix[:50]
+	}
+	return strings.Trim(prefix, "-") + "-" + hex.EncodeToString(sum[:])[:10]
+}
+
+// renderJobArgs renders the step's container arguments as templates.
+func (r *ChainReconciler) renderJobArgs(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep) ([]string, error) {
+	args := make([]string, 0, len(step.Job.Args))
+	for _, arg := range step.Job.Args {
+		rendered, err := r.renderStepTemplate(chain, step, arg)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, rendered)
+	}
+	return args, nil
+}
+
+// jobServiceAccountAllowed reports whether a job step may run as the
+// service account. Chain authors could otherwise borrow any service
+// account in the namespace, so only those the operator lists are allowed.
+func (r *ChainReconciler) jobServiceAccountAllowed(name string) bool {
+	return slices.Contains(r.JobServiceAccounts, name)
+}
+
+// buildStepJob constructs the Job for a job step attempt. It runs once —
+// retries go through the step's retry policy — and is killed when the step
+// times out. The pod runs non-root with every capability dropped, and only
+// mounts a service account token when the step names an allowed service
+// account.
+func buildStepJob(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, security knightpkg.PodSecurity, name, task string, args []string) *batchv1.Job {
+	spec := step.Job
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "chain-step",
+		"app.kubernetes.io/instance":   chain.Name,
+		"app.kubernetes.io/managed-by": "roundtable-operator",
+		"roundtable.io/purpose":        "chain-step",
+	}
+
+	env := []corev1.EnvVar{
+		{Name: "CHAIN_NAME", Value: chain.Name},
+		{Name: "STEP_NAME", Value: step.Name},
+		{Name: "RUN_ID", Value: chain.Status.RunID},
+		{Name: "TASK", Value: task},
+	}
+	env = append(env, spec.Env...)
+
+	container := corev1.Container{
+		Name:    stepJobContainer,
+		Image:   spec.Image,
+		Command: spec.Command,
+		Args:    args,
+		Env:     env,
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+			RunAsNonRoot:             ptr.To(true),
+			Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+			SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		},
+	}
+	if spec.OutputFile != "" {
+		container.TerminationMessagePath = spec.OutputFile
+	}
+	if spec.Resources != nil {
+		container.Resources = *spec.Resources
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: chain.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(int32(0)),
+			TTLSecondsAfterFinished: ptr.To(stepJobTTL),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy:                corev1.RestartPolicyNever,
+					ServiceAccountName:           spec.ServiceAccountName,
+					AutomountServiceAccountToken: ptr.To(spec.ServiceAccountName != ""),
+					SecurityContext:              security.PodSecurityContext(),
+					Containers:                   []corev1.Container{container},
+				},
+			},
+		},
+	}
+	if step.Timeout > 0 {
+		job.Spec.ActiveDeadlineSeconds = ptr.To(int64(step.Timeout))
+	}
+	return job
+}
+
+// dispatchJobStep creates the Job for a ready job step and marks it Running.
+func (r *ChainReconciler) dispatchJobStep(ctx context.Context, chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus, task string, args []string) error {
+	job := buildStepJob(chain, step, r.JobSecurity, stepJobName(chain, step.Name, ss.Retries), task, args)
+	if err := controllerutil.SetControllerReference(chain, job, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("step Job create failed: %w", err)
+	}
+
+	now := metav1.Now()
+	ss.Phase = aiv1alpha1.ChainStepPhaseRunning
+	ss.StartedAt = &now
+	ss.CompletedAt = nil
+	ss.TaskID = job.Name
+	ss.Knight = ""
+	return nil
+}
+
+// pollJobStep checks a running job step's Job. done is false while the Job
+// is still running; once it finishes, output holds the step output or
+// errMsg why it failed.
+func (r *ChainReconciler) pollJobStep(ctx context.Context, chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus) (output, errMsg string, done bool, err error) {
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ss.TaskID, Namespace: chain.Namespace}, job); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", fmt.Sprintf("job %s not found", ss.TaskID), true, nil
+		}
+		return "", "", false, err
+	}
+
+	if job.Status.Succeeded > 0 {
+		output, err := r.jobOutput(ctx, job, step)
+		if err != nil {
+			return "", "", false, err
+		}
+		return output, "", true, nil
+	}
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return "", fmt.Sprintf("job %s failed (%s): %s", job.Name, c.Reason, c.Message), true, nil
+		}
+	}
+	return "", "", false, nil
+}
+
+// jobOutput reads a succeeded Job's output: the termination message when
+// the step sets outputFile, else the container's stdout.
+func (r *ChainReconciler) jobOutput(ctx context.Context, job *batchv1.Job, step *aiv1alpha1.ChainStep) (string, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(job.Namespace),
+		client.MatchingLabels{batchv1.JobNameLabel: job.Name}); err != nil {
+		return "", err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+		if step.Job.OutputFile != "" {
+			for _, cs := range pod.Status.ContainerStatuses {
+				if cs.Name == stepJobContainer && cs.State.Terminated != nil {
+					return cs.State.Terminated.Message, nil
+				}
+			}
+			return "", fmt.Errorf("pod %s has no terminated %s container", pod.Name, stepJobContainer)
+		}
+		if r.PodLogs == nil {
+			return "", fmt.Errorf("pod log reader not configured")
+		}
+		return r.PodLogs.ReadPodLogs(ctx, pod.Namespace, pod.Name, stepJobContainer, stepJobOutputLimit)
+	}
+	return "", fmt.Errorf("no succeeded pod found for job %s", job.Name)
+}
+
+// deleteStepJob deletes a job step's Job, and with it the running pod.
+func (r *ChainReconciler) deleteStepJob(ctx context.Context, chain *aiv1alpha1.Chain, ss *aiv1alpha1.ChainStepStatus) error {
+	if ss.TaskID == "" {
+		return nil
+	}
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: ss.TaskID, Namespace: chain.Namespace}}
+	err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	return client.IgnoreNotFound(err)
+}
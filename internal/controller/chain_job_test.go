@@ -0,0 +1,214 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	knightpkg "github.com/dapperdivers/roundtable/internal/knight"
+)
+
+type fakePodLogs struct {
+	logs string
+}
+
+func (f *fakePodLogs) ReadPodLogs(_ context.Context, _, _, _ string, _ int64) (string, error) {
+	return f.logs, nil
+}
+
+func TestJobStep(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add roundtable scheme: %v", err)
+	}
+
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default", UID: "chain-uid"},
+		Spec: aiv1alpha1.ChainSpec{
+			Parameters: []aiv1alpha1.ChainParameter{{Name: "target"}},
+			Steps: []aiv1alpha1.ChainStep{{
+				Name:     "port_scan",
+				Task:     "scan the target",
+				Executor: aiv1alpha1.StepExecutorJob,
+				Timeout:  300,
+				Job: &aiv1alpha1.JobExecutor{
+					Image:   "instrumentisto/nmap",
+					Command: []string{"nmap"},
+					Args:    []string{"-Pn", "{{ .Params.target }}"},
+				},
+			}},
+		},
+		Status: aiv1alpha1.ChainStatus{RunID: "run-1", Parameters: map[string]string{"target": "example.com"}},
+	}
+	logs := &fakePodLogs{logs: "22/tcp open ssh\n"}
+	r := &ChainReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(chain).Build(),
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+		PodLogs:  logs,
+	}
+	ctx := context.Background()
+	step := &chain.Spec.Steps[0]
+	ss := &aiv1alpha1.ChainStepStatus{Name: step.Name, Phase: aiv1alpha1.ChainStepPhasePending}
+
+	args, err := r.renderJobArgs(chain, step)
+	if err != nil {
+		t.Fatalf("renderJobArgs() error = %v", err)
+	}
+	if err := r.dispatchJobStep(ctx, chain, step, ss, "scan the target", args); err != nil {
+		t.Fatalf("dispatchJobStep() error = %v", err)
+	}
+	if ss.Phase != aiv1alpha1.ChainStepPhaseRunning || !strings.HasPrefix(ss.TaskID, "recon-port-scan-") {
+		t.Fatalf("step status = %+v, want Running with the Job name as taskId", ss)
+	}
+
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, types.NamespacedName{Name: ss.TaskID, Namespace: "default"}, job); err != nil {
+		t.Fatalf("Job not created: %v", err)
+	}
+	container := job.Spec.Template.Spec.Containers[0]
+	if got := strings.Join(container.Args, " "); got != "-Pn example.com" {
+		t.Errorf("container args = %q, want rendered parameters", got)
+	}
+	if *job.Spec.BackoffLimit != 0 || *job.Spec.ActiveDeadlineSeconds != 300 {
+		t.Errorf("Job backoffLimit = %d, activeDeadlineSeconds = %d", *job.Spec.BackoffLimit, *job.Spec.ActiveDeadlineSeconds)
+	}
+	if len(job.OwnerReferences) != 1 || job.OwnerReferences[0].Name != "recon" {
+		t.Errorf("Job owner references = %v, want the chain", job.OwnerReferences)
+	}
+	podSpec := job.Spec.Template.Spec
+	if podSpec.AutomountServiceAccountToken == nil || *podSpec.AutomountServiceAccountToken {
+		t.Error("Job mounts a service account token, want none without serviceAccountName")
+	}
+	if sc := podSpec.SecurityContext; sc == nil || sc.RunAsUser == nil || *sc.RunAsUser == 0 {
+		t.Errorf("pod security context = %+v, want the knight defaults", sc)
+	}
+	if sc := container.SecurityContext; sc == nil || sc.Capabilities == nil || len(sc.Capabilities.Drop) != 1 ||
+		sc.Capabilities.Drop[0] != "ALL" || sc.SeccompProfile == nil || *sc.AllowPrivilegeEscalation {
+		t.Errorf("container security context = %+v, want capabilities dropped", sc)
+	}
+
+	// Dispatching the same attempt again finds the existing Job.
+	if err := r.dispatchJobStep(ctx, chain, step, ss, "scan the target", args); err != nil {
+		t.Fatalf("dispatchJobStep() again error = %v", err)
+	}
+
+	if _, _, done, err := r.pollJobStep(ctx, chain, step, ss); err != nil || done {
+		t.Fatalf("pollJobStep() on a running Job = done %t, err %v", done, err)
+	}
+
+	job.Status.Succeeded = 1
+	if err := r.Status().Update(ctx, job); err != nil {
+		t.Fatalf("failed to update Job status: %v", err)
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ss.TaskID + "-abcde", Namespace: "default",
+			Labels: map[string]string{batchv1.JobNameLabel: ss.TaskID},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodSucceeded,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  stepJobContainer,
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: `{"open": [22]}`}},
+			}},
+		},
+	}
+	if err := r.Create(ctx, pod); err != nil {
+		t.Fatalf("failed to create pod: %v", err)
+	}
+
+	output, errMsg, done, err := r.pollJobStep(ctx, chain, step, ss)
+	if err != nil || !done || errMsg != "" || output != logs.logs {
+		t.Errorf("pollJobStep() = %q, %q, %t, %v, want stdout", output, errMsg, done, err)
+	}
+
+	step.Job.OutputFile = "/tmp/result.json"
+	if output, _, _, _ := r.pollJobStep(ctx, chain, step, ss); output != `{"open": [22]}` {
+		t.Errorf("pollJobStep() with outputFile = %q, want the termination message", output)
+	}
+
+	if err := r.deleteStepJob(ctx, chain, ss); err != nil {
+		t.Fatalf("deleteStepJob() error = %v", err)
+	}
+	if _, errMsg, done, _ := r.pollJobStep(ctx, chain, step, ss); !done || errMsg == "" {
+		t.Errorf("pollJobStep() for a deleted Job = %q, done %t, want a failure", errMsg, done)
+	}
+}
+
+func TestJobServiceAccountAllowed(t *testing.T) {
+	r := &ChainReconciler{}
+	if r.jobServiceAccountAllowed("default") {
+		t.Error("jobServiceAccountAllowed() = true with no allowlist, want every service account rejected")
+	}
+	r.JobServiceAccounts = []string{"chain-scanner"}
+	if !r.jobServiceAccountAllowed("chain-scanner") || r.jobServiceAccountAllowed("operator") {
+		t.Error("jobServiceAccountAllowed() does not follow the allowlist")
+	}
+
+	step := &aiv1alpha1.ChainStep{Name: "scan", Job: &aiv1alpha1.JobExecutor{Image: "nmap", ServiceAccountName: "chain-scanner"}}
+	job := buildStepJob(&aiv1alpha1.Chain{}, step, knightpkg.PodSecurity{}, "scan-1", "scan", nil)
+	if token := job.Spec.Template.Spec.AutomountServiceAccountToken; token == nil || !*token {
+		t.Error("Job for an allowed service account does not mount its token")
+	}
+}
+
+func TestPollJobStepFailed(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon-plan-0123456789", Namespace: "default"},
+		Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+			Type: batchv1.JobFailed, Status: corev1.ConditionTrue,
+			Reason: "DeadlineExceeded", Message: "Job was active longer than specified deadline",
+		}}},
+	}
+	r := &ChainReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(job).Build()}
+	chain := &aiv1alpha1.Chain{ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default"}}
+	step := &aiv1alpha1.ChainStep{Name: "plan", Executor: aiv1alpha1.StepExecutorJob, Job: &aiv1alpha1.JobExecutor{Image: "hashicorp/terraform"}}
+
+	_, errMsg, done, err := r.pollJobStep(context.Background(), chain, step, &aiv1alpha1.ChainStepStatus{TaskID: job.Name})
+	if err != nil || !done || !strings.Contains(errMsg, "DeadlineExceeded") {
+		t.Errorf("pollJobStep() = %q, done %t, err %v, want the failure reason", errMsg, done, err)
+	}
+}
+
+func TestValidateExecutors(t *testing.T) {
+	job := &aiv1alpha1.JobExecutor{Image: "hashicorp/terraform"}
+	tests := []struct {
+		name    string
+		step    aiv1alpha1.ChainStep
+		wantErr bool
+	}{
+		{name: "knight", step: aiv1alpha1.ChainStep{Name: "s", KnightRef: "galahad"}},
+		{name: "job", step: aiv1alpha1.ChainStep{Name: "s", Executor: aiv1alpha1.StepExecutorJob, Job: job}},
+		{name: "job without spec", step: aiv1alpha1.ChainStep{Name: "s", Executor: aiv1alpha1.StepExecutorJob}, wantErr: true},
+		{name: "job with knight", step: aiv1alpha1.ChainStep{Name: "s", Executor: aiv1alpha1.StepExecutorJob, Job: job, KnightRef: "galahad"}, wantErr: true},
+		{name: "spec without executor", step: aiv1alpha1.ChainStep{Name: "s", KnightRef: "galahad", Job: job}, wantErr: true},
+		{name: "approval with verify", step: aiv1alpha1.ChainStep{Name: "s", Type: aiv1alpha1.ChainStepTypeApproval,
+			Verify: &aiv1alpha1.StepVerification{JudgeKnightRef: "percival", Criteria: "x"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{tt.step}}}
+			if err := validateExecutors(chain); (err != nil) != tt.wantErr {
+				t.Errorf("validateExecutors() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
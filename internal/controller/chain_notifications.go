@@ -0,0 +1,119 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/notify"
+)
+
+// chainNotificationsPending reports whether the finished run's
+// spec.notifications have not been sent yet.
+func chainNotificationsPending(chain *aiv1alpha1.Chain) bool {
+	return len(chain.Spec.Notifications) > 0 && chain.Status.RunID != "" &&
+		chain.Status.NotifiedRunID != chain.Status.RunID
+}
+
+// sendChainNotifications posts the finished run's summary to every channel
+// selecting its phase and marks the run notified. Delivery is best-effort:
+// failures only produce warning Events.
+func (r *ChainReconciler) sendChainNotifications(ctx context.Context, chain *aiv1alpha1.Chain) {
+	summary := chainRunSummary(chain)
+	for i, n := range chain.Spec.Notifications {
+		if len(n.On) > 0 && !slices.Contains(n.On, chain.Status.Phase) {
+			continue
+		}
+		if err := r.sendChainNotification(ctx, chain, n, summary); err != nil {
+			logf.FromContext(ctx).Error(err, "Chain notification failed", "notification", i, "type", n.Type)
+			r.Recorder.Eventf(chain, corev1.EventTypeWarning, "ChainNotificationFailed",
+				"Notification %d (%s) failed: %v", i, n.Type, err)
+		}
+	}
+	chain.Status.NotifiedRunID = chain.Status.RunID
+}
+
+// sendChainNotification delivers the summary to one channel. The URL is
+// read from its Secret and never logged.
+func (r *ChainReconciler) sendChainNotification(ctx context.Context, chain *aiv1alpha1.Chain, n aiv1alpha1.ChainNotification, summary string) error {
+	url, err := secretKeyValue(ctx, r.Client, chain.Namespace, &n.URLSecretRef)
+	if err != nil {
+		return err
+	}
+	url = strings.TrimSpace(url)
+	if r.Notify == nil || !r.Notify.URLAllowed(url) {
+		return fmt.Errorf("URL in secret %q does not match the operator's allowed URL prefixes", n.URLSecretRef.Name)
+	}
+	switch n.Type {
+	case aiv1alpha1.NotificationChannelSlack:
+		return r.Notify.PostSlack(ctx, url, summary)
+	case aiv1alpha1.NotificationChannelDiscord:
+		return r.Notify.PostDiscord(ctx, url, summary)
+	default:
+		payload := chainNotifyPayload(chain)
+		payload.Output, payload.Truncated = notify.Truncate(summary)
+		payload.OutputRef = nil
+		return r.Notify.Deliver(ctx, url, "", payload)
+	}
+}
+
+// chainRunSummary describes a finished run for a chat message: outcome,
+// duration, cost, and every failed step with its error.
+func chainRunSummary(chain *aiv1alpha1.Chain) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Chain %s/%s %s", chain.Namespace, chain.Name, chain.Status.Phase)
+	if chain.Status.RunID != "" {
+		fmt.Fprintf(&b, " (run %s)", chain.Status.RunID)
+	}
+	b.WriteString("\n")
+
+	var details []string
+	if chain.Status.StartedAt != nil && chain.Status.CompletedAt != nil {
+		details = append(details, "Duration: "+chain.Status.CompletedAt.Sub(chain.Status.StartedAt.Time).Round(time.Second).String())
+	}
+	if chain.Status.CostUSD != "" {
+		details = append(details, "Cost: $"+chain.Status.CostUSD)
+	}
+	if chain.Status.Progress != "" {
+		details = append(details, "Progress: "+chain.Status.Progress)
+	}
+	if len(details) > 0 {
+		b.WriteString(strings.Join(details, " | "))
+		b.WriteString("\n")
+	}
+
+	for _, ss := range chain.Status.StepStatuses {
+		if ss.Phase == aiv1alpha1.ChainStepPhaseFailed {
+			fmt.Fprintf(&b, "Failed step %s: %s\n", ss.Name, ss.Error)
+		}
+	}
+	if chain.Status.Phase != aiv1alpha1.ChainPhaseSucceeded {
+		if cond := meta.FindStatusCondition(chain.Status.Conditions, aiv1alpha1.ConditionChainComplete); cond != nil && cond.Message != "" {
+			fmt.Fprintf(&b, "Reason: %s\n", cond.Message)
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
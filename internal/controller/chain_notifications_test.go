@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/notify"
+)
+
+func TestSendChainNotifications(t *testing.T) {
+	received := map[string]map[string]interface{}{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		received[req.URL.Path] = body
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "hooks", Namespace: "ai"},
+		Data: map[string][]byte{
+			"slack":   []byte(server.URL + "/slack\n"),
+			"discord": []byte(server.URL + "/discord"),
+			"webhook": []byte(server.URL + "/webhook"),
+			"blocked": []byte("http://169.254.169.254/hook"),
+		},
+	}
+	recorder := record.NewFakeRecorder(10)
+	r := &ChainReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build(),
+		Recorder: recorder,
+		Notify:   notify.NewNotifier([]string{server.URL}),
+	}
+	ref := func(key string) corev1.SecretKeySelector {
+		return corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "hooks"}, Key: key}
+	}
+
+	started := time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "ai"},
+		Spec: aiv1alpha1.ChainSpec{Notifications: []aiv1alpha1.ChainNotification{
+			{Type: aiv1alpha1.NotificationChannelSlack, URLSecretRef: ref("slack")},
+			{Type: aiv1alpha1.NotificationChannelDiscord, URLSecretRef: ref("discord"), On: []aiv1alpha1.ChainPhase{aiv1alpha1.ChainPhaseFailed}},
+			{Type: aiv1alpha1.NotificationChannelWebhook, URLSecretRef: ref("webhook"), On: []aiv1alpha1.ChainPhase{aiv1alpha1.ChainPhaseSucceeded}},
+			{Type: aiv1alpha1.NotificationChannelSlack, URLSecretRef: ref("blocked")},
+		}},
+		Status: aiv1alpha1.ChainStatus{
+			Phase:       aiv1alpha1.ChainPhaseFailed,
+			RunID:       "run-1",
+			StartedAt:   &metav1.Time{Time: started},
+			CompletedAt: &metav1.Time{Time: started.Add(252 * time.Second)},
+			CostUSD:     "0.42",
+			StepStatuses: []aiv1alpha1.ChainStepStatus{
+				{Name: "scan", Phase: aiv1alpha1.ChainStepPhaseSucceeded},
+				{Name: "report", Phase: aiv1alpha1.ChainStepPhaseFailed, Error: "step timed out after 120s"},
+			},
+		},
+	}
+
+	if !chainNotificationsPending(chain) {
+		t.Fatal("chainNotificationsPending() = false for a run not yet notified")
+	}
+	r.sendChainNotifications(context.Background(), chain)
+	if chainNotificationsPending(chain) {
+		t.Error("chainNotificationsPending() = true after sending")
+	}
+
+	text, _ := received["/slack"]["text"].(string)
+	for _, want := range []string{"Chain ai/recon Failed (run run-1)", "Duration: 4m12s", "Cost: $0.42", "Failed step report: step timed out after 120s"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Slack message %q does not contain %q", text, want)
+		}
+	}
+	if content, _ := received["/discord"]["content"].(string); content != text {
+		t.Errorf("Discord message = %q, want the summary", content)
+	}
+	if _, ok := received["/webhook"]; ok {
+		t.Error("webhook notified for a phase it did not select")
+	}
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, "ChainNotificationFailed") || strings.Contains(event, "169.254") {
+			t.Errorf("event = %q, want a failure that does not reveal the URL", event)
+		}
+	default:
+		t.Error("no event for the URL outside the allowlist")
+	}
+}
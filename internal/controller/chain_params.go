@@ -0,0 +1,174 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// parseParamValue converts a parameter value to its declared type. The empty
+// string is the zero value of every type.
+func parseParamValue(p aiv1alpha1.ChainParameter, value string) (interface{}, error) {
+	switch p.Type {
+	case aiv1alpha1.ChainParameterTypeNumber:
+		if value == "" {
+			return float64(0), nil
+		}
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q must be a number, got %q", p.Name, value)
+		}
+		return n, nil
+	case aiv1alpha1.ChainParameterTypeBoolean:
+		if value == "" {
+			return false, nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q must be a boolean, got %q", p.Name, value)
+		}
+		return b, nil
+	case aiv1alpha1.ChainParameterTypeArray:
+		list := []interface{}{}
+		if value == "" {
+			return list, nil
+		}
+		if err := json.Unmarshal([]byte(value), &list); err != nil {
+			return nil, fmt.Errorf("parameter %q must be a JSON array, got %q", p.Name, value)
+		}
+		return list, nil
+	default:
+		return value, nil
+	}
+}
+
+// validateParameters checks parameter names are unique and defaults match
+// their declared type.
+func validateParameters(chain *aiv1alpha1.Chain) error {
+	seen := make(map[string]bool, len(chain.Spec.Parameters))
+	for _, p := range chain.Spec.Parameters {
+		if seen[p.Name] {
+			return fmt.Errorf("duplicate parameter %q", p.Name)
+		}
+		seen[p.Name] = true
+		if p.Default != nil {
+			if _, err := parseParamValue(p, *p.Default); err != nil {
+				return fmt.Errorf("default of %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveParameters returns the value of every declared parameter: the
+// supplied value, else its default, else "". It fails on a required
+// parameter with neither, on a value that doesn't match its type, and on
+// values for undeclared parameters.
+func resolveParameters(declared []aiv1alpha1.ChainParameter, values map[string]string) (map[string]string, error) {
+	resolved := make(map[string]string, len(declared))
+	var missing []string
+	for _, p := range declared {
+		value, ok := values[p.Name]
+		switch {
+		case ok:
+		case p.Default != nil:
+			value = *p.Default
+		case p.Required:
+			missing = append(missing, p.Name)
+		}
+		if _, err := parseParamValue(p, value); err != nil {
+			return nil, err
+		}
+		resolved[p.Name] = value
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing required parameters: %v", missing)
+	}
+
+	var unknown []string
+	for name := range values {
+		if _, ok := resolved[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown parameters: %v", unknown)
+	}
+	return resolved, nil
+}
+
+// parametersAnnotation decodes the parameters annotation. JSON strings are
+// unquoted; any other JSON value is kept as its JSON text.
+func parametersAnnotation(chain *aiv1alpha1.Chain) (map[string]string, error) {
+	raw, ok := chain.Annotations[aiv1alpha1.AnnotationParameters]
+	if !ok {
+		return nil, nil
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal([]byte(raw), &fields); err != nil {
+		return nil, fmt.Errorf("%s annotation is not a JSON object: %w", aiv1alpha1.AnnotationParameters, err)
+	}
+	values := make(map[string]string, len(fields))
+	for name, field := range fields {
+		var s string
+		if err := json.Unmarshal(field, &s); err == nil {
+			values[name] = s
+		} else {
+			values[name] = string(field)
+		}
+	}
+	return values, nil
+}
+
+// resolveRunParameters records the parameter values of a run being
+// triggered in status.parameters, rejecting the run if they are invalid.
+func resolveRunParameters(chain *aiv1alpha1.Chain) error {
+	values, err := parametersAnnotation(chain)
+	if err != nil {
+		return err
+	}
+	resolved, err := resolveParameters(chain.Spec.Parameters, values)
+	if err != nil {
+		return err
+	}
+	chain.Status.Parameters = resolved
+	return nil
+}
+
+// chainParams returns the typed parameter values templates read as .Params:
+// the run's resolved values, or the defaults before any run.
+func chainParams(chain *aiv1alpha1.Chain) map[string]interface{} {
+	params := make(map[string]interface{}, len(chain.Spec.Parameters))
+	for _, p := range chain.Spec.Parameters {
+		value, ok := chain.Status.Parameters[p.Name]
+		if !ok && p.Default != nil {
+			value = *p.Default
+		}
+		typed, err := parseParamValue(p, value)
+		if err != nil {
+			typed = value
+		}
+		params[p.Name] = typed
+	}
+	return params
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestResolveRunParameters(t *testing.T) {
+	standard := "standard"
+	declared := []aiv1alpha1.ChainParameter{
+		{Name: "target", Type: aiv1alpha1.ChainParameterTypeString, Required: true},
+		{Name: "depth", Type: aiv1alpha1.ChainParameterTypeString, Default: &standard},
+		{Name: "ports", Type: aiv1alpha1.ChainParameterTypeArray},
+		{Name: "threshold", Type: aiv1alpha1.ChainParameterTypeNumber},
+		{Name: "verbose", Type: aiv1alpha1.ChainParameterTypeBoolean},
+	}
+
+	tests := []struct {
+		name       string
+		annotation string
+		want       map[string]string
+		wantErr    string
+	}{
+		{
+			name:       "typed values",
+			annotation: `{"target": "example.com", "ports": [22, 443], "threshold": 0.5, "verbose": true}`,
+			want:       map[string]string{"target": "example.com", "depth": "standard", "ports": "[22, 443]", "threshold": "0.5", "verbose": "true"},
+		},
+		{name: "no annotation", wantErr: "missing required parameters: [target]"},
+		{name: "wrong type", annotation: `{"target": "x", "threshold": "high"}`, wantErr: `"threshold" must be a number`},
+		{name: "unknown", annotation: `{"target": "x", "port": 22}`, wantErr: "unknown parameters: [port]"},
+		{name: "not an object", annotation: `target=x`, wantErr: "not a JSON object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{Parameters: declared}}
+			if tt.annotation != "" {
+				chain.Annotations = map[string]string{aiv1alpha1.AnnotationParameters: tt.annotation}
+			}
+			err := resolveRunParameters(chain)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("resolveRunParameters() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveRunParameters() error = %v", err)
+			}
+			for name, want := range tt.want {
+				if got := chain.Status.Parameters[name]; got != want {
+					t.Errorf("status.parameters[%s] = %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderTemplateParams(t *testing.T) {
+	r := &ChainReconciler{}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon"},
+		Spec: aiv1alpha1.ChainSpec{Parameters: []aiv1alpha1.ChainParameter{
+			{Name: "target", Type: aiv1alpha1.ChainParameterTypeString},
+			{Name: "ports", Type: aiv1alpha1.ChainParameterTypeArray},
+			{Name: "deep", Type: aiv1alpha1.ChainParameterTypeBoolean},
+		}},
+		Status: aiv1alpha1.ChainStatus{Parameters: map[string]string{
+			"target": "example.com", "ports": "[22, 443]", "deep": "true",
+		}},
+	}
+
+	got, err := r.renderTemplate(chain, `{{ .Params.target }}:{{ range .Params.ports }} {{ . }}{{ end }}{{ if .Params.deep }} (deep){{ end }}`)
+	if err != nil {
+		t.Fatalf("renderTemplate() error = %v", err)
+	}
+	if want := "example.com: 22 443 (deep)"; got != want {
+		t.Errorf("renderTemplate() = %q, want %q", got, want)
+	}
+}
+
+func TestValidateParameters(t *testing.T) {
+	bad := "lots"
+	chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{Parameters: []aiv1alpha1.ChainParameter{
+		{Name: "count", Type: aiv1alpha1.ChainParameterTypeNumber, Default: &bad},
+	}}}
+	if err := validateParameters(chain); err == nil {
+		t.Error("validateParameters() with a mistyped default expected error")
+	}
+
+	chain.Spec.Parameters = []aiv1alpha1.ChainParameter{{Name: "target"}, {Name: "target"}}
+	if err := validateParameters(chain); err == nil {
+		t.Error("validateParameters() with a duplicate name expected error")
+	}
+}
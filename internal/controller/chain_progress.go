@@ -0,0 +1,49 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// updateRunProgress recomputes the run's progress summary from its step
+// statuses. Handler steps count once they have been started.
+func updateRunProgress(chain *aiv1alpha1.Chain) {
+	total := len(chain.Status.StepStatuses)
+	if total == 0 {
+		chain.Status.Progress = ""
+		chain.Status.CurrentSteps = nil
+		chain.Status.PercentComplete = 0
+		return
+	}
+
+	var done int
+	var current []string
+	for _, ss := range chain.Status.StepStatuses {
+		switch ss.Phase {
+		case aiv1alpha1.ChainStepPhaseSucceeded, aiv1alpha1.ChainStepPhaseFailed, aiv1alpha1.ChainStepPhaseSkipped:
+			done++
+		case aiv1alpha1.ChainStepPhaseRunning, aiv1alpha1.ChainStepPhaseAwaitingApproval, aiv1alpha1.ChainStepPhaseAwaitingInput:
+			current = append(current, ss.Name)
+		}
+	}
+	chain.Status.Progress = fmt.Sprintf("%d/%d steps", done, total)
+	chain.Status.CurrentSteps = current
+	chain.Status.PercentComplete = int32(done * 100 / total)
+}
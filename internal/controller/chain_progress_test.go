@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"slices"
+	"testing"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestUpdateRunProgress(t *testing.T) {
+	chain := &aiv1alpha1.Chain{Status: aiv1alpha1.ChainStatus{StepStatuses: []aiv1alpha1.ChainStepStatus{
+		{Name: "scan", Phase: aiv1alpha1.ChainStepPhaseSucceeded},
+		{Name: "enrich", Phase: aiv1alpha1.ChainStepPhaseSkipped},
+		{Name: "analyze", Phase: aiv1alpha1.ChainStepPhaseRunning},
+		{Name: "approve", Phase: aiv1alpha1.ChainStepPhaseAwaitingApproval},
+		{Name: "report", Phase: aiv1alpha1.ChainStepPhasePending},
+	}}}
+
+	updateRunProgress(chain)
+	if chain.Status.Progress != "2/5 steps" || chain.Status.PercentComplete != 40 ||
+		!slices.Equal(chain.Status.CurrentSteps, []string{"analyze", "approve"}) {
+		t.Errorf("progress = %q, %d%%, current %v", chain.Status.Progress, chain.Status.PercentComplete, chain.Status.CurrentSteps)
+	}
+
+	chain.Status.StepStatuses = nil
+	updateRunProgress(chain)
+	if chain.Status.Progress != "" || chain.Status.CurrentSteps != nil || chain.Status.PercentComplete != 0 {
+		t.Errorf("progress without a run = %q, %d%%, current %v", chain.Status.Progress, chain.Status.PercentComplete, chain.Status.CurrentSteps)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// domainQuotas checks step dispatches against the chain's RoundTable
+// policies.domainQuotas. It is loaded once per reconcile pass and counts
+// the dispatches it allows, so steps published in the same pass count
+// against the quota before the chain's status is saved. A nil
+// domainQuotas allows everything.
+type domainQuotas struct {
+	quotas  map[string]aiv1alpha1.DomainQuota
+	running map[string]int32
+	costs   map[string]string
+}
+
+// loadDomainQuotas loads the quotas of the chain's RoundTable with the
+// tasks running in each domain: the running steps of every chain of the
+// table on a knight of that domain. It returns nil when the table has no
+// quotas.
+func (r *ChainReconciler) loadDomainQuotas(ctx context.Context, chain *aiv1alpha1.Chain) (*domainQuotas, error) {
+	if chain.Spec.RoundTableRef == "" {
+		return nil, nil
+	}
+	rt := &aiv1alpha1.RoundTable{}
+	if err := r.Get(ctx, types.NamespacedName{Name: chain.Spec.RoundTableRef, Namespace: chain.Namespace}, rt); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	if rt.Spec.Policies == nil || len(rt.Spec.Policies.DomainQuotas) == 0 {
+		return nil, nil
+	}
+
+	knights, err := tableKnights(ctx, r.Client, rt)
+	if err != nil {
+		return nil, err
+	}
+	domains := make(map[string]string, len(knights))
+	for _, k := range knights {
+		domains[k.Name] = k.Spec.Domain
+	}
+	chains := &aiv1alpha1.ChainList{}
+	if err := r.List(ctx, chains, client.InNamespace(chain.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list chains: %w", err)
+	}
+
+	q := &domainQuotas{
+		quotas:  rt.Spec.Policies.DomainQuotas,
+		running: map[string]int32{},
+		costs:   map[string]string{},
+	}
+	count := func(c *aiv1alpha1.Chain) {
+		for _, ss := range c.Status.StepStatuses {
+			if ss.Phase == aiv1alpha1.ChainStepPhaseRunning && ss.Knight != "" {
+				if domain, ok := domains[ss.Knight]; ok {
+					q.running[domain]++
+				}
+			}
+		}
+	}
+	// This chain's status may be ahead of the cache.
+	count(chain)
+	for i := range chains.Items {
+		c := &chains.Items[i]
+		if c.Name != chain.Name && c.Spec.RoundTableRef == rt.Name {
+			count(c)
+		}
+	}
+	for _, d := range rt.Status.Domains {
+		q.costs[d.Domain] = d.CostUSD
+	}
+	return q, nil
+}
+
+// blocked returns why a step may not be dispatched to a knight of domain
+// now, or "" if it may.
+func (q *domainQuotas) blocked(domain string) string {
+	if q == nil {
+		return ""
+	}
+	quota, ok := q.quotas[domain]
+	if !ok {
+		return ""
+	}
+	if quota.MaxConcurrentTasks > 0 && q.running[domain] >= quota.MaxConcurrentTasks {
+		return fmt.Sprintf("Waiting for domain %s quota (%d concurrent tasks)", domain, quota.MaxConcurrentTasks)
+	}
+	if limit := parseCostUSD(quota.MaxCostUSD); limit > 0 && parseCostUSD(q.costs[domain]) >= limit {
+		return fmt.Sprintf("Domain %s is over its cost quota ($%s)", domain, quota.MaxCostUSD)
+	}
+	return ""
+}
+
+// dispatched counts a step dispatched to a knight of domain.
+func (q *domainQuotas) dispatched(domain string) {
+	if q != nil {
+		q.running[domain]++
+	}
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestDomainQuotas(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{Policies: &aiv1alpha1.RoundTablePolicies{
+			DomainQuotas: map[string]aiv1alpha1.DomainQuota{
+				"research": {MaxConcurrentTasks: 2},
+				"security": {MaxCostUSD: "5.00"},
+			},
+		}},
+		Status: aiv1alpha1.RoundTableStatus{Domains: []aiv1alpha1.RoundTableDomainStatus{
+			{Domain: "security", CostUSD: "5.2000"},
+		}},
+	}
+	knight := func(name, domain string) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       aiv1alpha1.KnightSpec{Domain: domain},
+		}
+	}
+	chain := func(name, table string, knights ...string) *aiv1alpha1.Chain {
+		c := &aiv1alpha1.Chain{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       aiv1alpha1.ChainSpec{RoundTableRef: table},
+		}
+		for _, k := range knights {
+			c.Status.StepStatuses = append(c.Status.StepStatuses,
+				aiv1alpha1.ChainStepStatus{Name: k, Phase: aiv1alpha1.ChainStepPhaseRunning, Knight: k})
+		}
+		return c
+	}
+	r := &ChainReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt,
+		knight("galahad", "research"), knight("percival", "research"), knight("gawain", "ops"),
+		chain("digest", "fleet", "galahad"),
+		chain("elsewhere", "other-fleet", "percival"),
+	).Build()}
+
+	q, err := r.loadDomainQuotas(context.Background(), chain("recon", "fleet", "gawain"))
+	if err != nil {
+		t.Fatalf("loadDomainQuotas() error = %v", err)
+	}
+	if msg := q.blocked("research"); msg != "" {
+		t.Errorf("blocked(research) = %q, want one of two slots free", msg)
+	}
+	q.dispatched("research")
+	if msg := q.blocked("research"); !strings.Contains(msg, "2 concurrent tasks") {
+		t.Errorf("blocked(research) = %q, want the concurrency quota", msg)
+	}
+	if msg := q.blocked("security"); !strings.Contains(msg, "cost quota") {
+		t.Errorf("blocked(security) = %q, want the cost quota", msg)
+	}
+	if msg := q.blocked("ops"); msg != "" {
+		t.Errorf("blocked(ops) = %q, want a domain without a quota unlimited", msg)
+	}
+
+	q, err = r.loadDomainQuotas(context.Background(), chain("recon", "other-fleet"))
+	if err != nil || q != nil {
+		t.Errorf("loadDomainQuotas() = %v, %v, want none for a missing table", q, err)
+	}
+	if msg := q.blocked("research"); msg != "" {
+		t.Errorf("blocked() on nil quotas = %q, want none", msg)
+	}
+}
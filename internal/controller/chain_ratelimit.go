@@ -0,0 +1,57 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// knightRateWindow is the window a knight's maxTasksPerMinute is counted
+// over.
+const knightRateWindow = time.Minute
+
+// takeDispatchSlot reports whether a step task may be dispatched to the
+// knight now under its maxTasksPerMinute, and counts the dispatch if so.
+// The window is shared by every chain and kept in memory, so it starts
+// empty when the operator restarts.
+func (r *ChainReconciler) takeDispatchSlot(knight *aiv1alpha1.Knight, now time.Time) bool {
+	limit := int(knight.Spec.MaxTasksPerMinute)
+	if limit <= 0 {
+		return true
+	}
+	key := knight.Namespace + "/" + knight.Name
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.knightDispatches == nil {
+		r.knightDispatches = make(map[string][]time.Time)
+	}
+	var recent []time.Time
+	for _, t := range r.knightDispatches[key] {
+		if now.Sub(t) < knightRateWindow {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= limit {
+		r.knightDispatches[key] = recent
+		return false
+	}
+	r.knightDispatches[key] = append(recent, now)
+	return true
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestTakeDispatchSlot(t *testing.T) {
+	r := &ChainReconciler{}
+	knight := &aiv1alpha1.Knight{}
+	knight.Name = "galahad"
+	knight.Namespace = "default"
+	now := time.Now()
+
+	for i := 0; i < 5; i++ {
+		if !r.takeDispatchSlot(knight, now) {
+			t.Fatalf("takeDispatchSlot() without a limit = false on dispatch %d", i)
+		}
+	}
+
+	knight.Spec.MaxTasksPerMinute = 2
+	r = &ChainReconciler{}
+	if !r.takeDispatchSlot(knight, now) || !r.takeDispatchSlot(knight, now.Add(10*time.Second)) {
+		t.Fatal("takeDispatchSlot() = false under the limit")
+	}
+	if r.takeDispatchSlot(knight, now.Add(30*time.Second)) {
+		t.Error("takeDispatchSlot() = true over the limit")
+	}
+	other := knight.DeepCopy()
+	other.Name = "percival"
+	if !r.takeDispatchSlot(other, now.Add(30*time.Second)) {
+		t.Error("takeDispatchSlot() = false for another knight")
+	}
+	if !r.takeDispatchSlot(knight, now.Add(61*time.Second)) {
+		t.Error("takeDispatchSlot() = false once the first dispatch left the window")
+	}
+	if r.takeDispatchSlot(knight, now.Add(65*time.Second)) {
+		t.Error("takeDispatchSlot() = true with the window full again")
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// recoveryReplayTimeout bounds the wait for a replayed result per step.
+const recoveryReplayTimeout = 500 * time.Millisecond
+
+// processStartedAt is when this operator process started. Runs started
+// after it were polled from the beginning and need no recovery.
+var processStartedAt = time.Now()
+
+// needsRecovery reports whether the chain's current run started before this
+// operator process and has not been recovered yet, and marks it recovered.
+func (r *ChainReconciler) needsRecovery(chain *aiv1alpha1.Chain) bool {
+	if chain.Status.StartedAt == nil || !chain.Status.StartedAt.Time.Before(processStartedAt) {
+		return false
+	}
+	key := chain.Namespace + "/" + chain.Name
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.recoveredRuns == nil {
+		r.recoveredRuns = make(map[string]string)
+	}
+	if r.recoveredRuns[key] == chain.Status.RunID {
+		return false
+	}
+	r.recoveredRuns[key] = chain.Status.RunID
+	return true
+}
+
+// recoverRun rebuilds the state of a run this process did not start, e.g.
+// after an operator restart. It replays the results stream from the run's
+// startedAt for every knight step (or step with its judge) that is Running,
+// or Pending with a task that may have been published before its status was
+// persisted (task IDs are deterministic per attempt), and completes the
+// steps whose results arrived while nobody was polling. It returns the
+// number of steps recovered.
+func (r *ChainReconciler) recoverRun(ctx context.Context, nc natsConfig, chain *aiv1alpha1.Chain, specMap map[string]*aiv1alpha1.ChainStep) int {
+	log := logf.FromContext(ctx)
+	if chain.Status.StartedAt == nil {
+		return 0
+	}
+	client, err := r.fleetClient(nc)
+	if err != nil {
+		log.Error(err, "Failed to connect NATS for run recovery")
+		return 0
+	}
+
+	recovered := 0
+	for i := range chain.Status.StepStatuses {
+		ss := &chain.Status.StepStatuses[i]
+		spec := specMap[ss.Name]
+		if !isKnightStep(spec) && !ss.Verifying {
+			continue
+		}
+		taskID := ss.TaskID
+		switch ss.Phase {
+		case aiv1alpha1.ChainStepPhaseRunning:
+		case aiv1alpha1.ChainStepPhasePending:
+			taskID = stepTaskID(chain, spec, ss.Retries)
+		default:
+			continue
+		}
+		if taskID == "" {
+			continue
+		}
+
+		result := replayResult(client, nc, taskID, chain.Status.StartedAt.Time)
+		if result == nil {
+			continue
+		}
+		if ss.StartedAt == nil {
+			ss.StartedAt = chain.Status.StartedAt.DeepCopy()
+		}
+		ss.TaskID = taskID
+		r.applyTaskResult(ctx, chain, nc, spec, ss, result)
+		log.Info("Recovered step result from the results stream", "step", ss.Name, "taskId", taskID, "phase", ss.Phase)
+		recovered++
+	}
+
+	if recovered > 0 {
+		r.Recorder.Eventf(chain, corev1.EventTypeNormal, "RunRecovered",
+			"Recovered %d step result(s) published since the run started", recovered)
+	}
+	return recovered
+}
+
+// replayResult returns the result of a task stored in the results stream at
+// or after since, or nil if there is none.
+func replayResult(client natspkg.Client, nc natsConfig, taskID string, since time.Time) *natspkg.TaskResult {
+	msg, err := client.PollMessage(natspkg.ResultSubject(nc.SubjectPrefix, taskID), recoveryReplayTimeout,
+		natspkg.WithBindStream(nc.ResultsStream),
+		natspkg.WithAckExplicit(),
+		natspkg.WithStartTime(since.Add(-time.Second)),
+		natspkg.WithFallbackAutoDetect(),
+	)
+	if err != nil || msg == nil {
+		return nil
+	}
+	var result natspkg.TaskResult
+	if err := json.Unmarshal(msg.Data, &result); err != nil {
+		return nil
+	}
+	// Ack the message (required for WorkQueue retention)
+	_ = msg.Ack()
+	return &result
+}
@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestRecoverRun(t *testing.T) {
+	started := metav1.NewTime(processStartedAt.Add(-time.Hour))
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "audit", Namespace: "default"},
+		Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{
+			{Name: "scan", KnightRef: "galahad"},
+			{Name: "analyze", KnightRef: "galahad"},
+			{Name: "report", KnightRef: "gawain", DependsOn: []string{"scan", "analyze"}},
+		}},
+		Status: aiv1alpha1.ChainStatus{
+			Phase:     aiv1alpha1.ChainPhaseRunning,
+			RunID:     "run-1",
+			StartedAt: &started,
+		},
+	}
+	chain.Status.StepStatuses = []aiv1alpha1.ChainStepStatus{
+		{Name: "scan", Phase: aiv1alpha1.ChainStepPhaseRunning, TaskID: stepTaskID(chain, &chain.Spec.Steps[0], 0)},
+		// Published before the operator stopped, but its status was never persisted.
+		{Name: "analyze", Phase: aiv1alpha1.ChainStepPhasePending},
+		{Name: "report", Phase: aiv1alpha1.ChainStepPhasePending},
+	}
+
+	nc := newFakeNATSClient()
+	nc.messages = map[string]*nats.Msg{}
+	for i, output := range []string{"22/tcp open", "no CVEs"} {
+		taskID := stepTaskID(chain, &chain.Spec.Steps[i], 0)
+		data, _ := json.Marshal(natspkg.TaskResult{TaskID: taskID, Output: output})
+		nc.messages[natspkg.ResultSubject("fleet-a", taskID)] = &nats.Msg{Data: data}
+	}
+	r := &ChainReconciler{
+		Recorder: record.NewFakeRecorder(10),
+		NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+	}
+	specMap := map[string]*aiv1alpha1.ChainStep{}
+	for i := range chain.Spec.Steps {
+		specMap[chain.Spec.Steps[i].Name] = &chain.Spec.Steps[i]
+	}
+
+	if !r.needsRecovery(chain) {
+		t.Fatal("needsRecovery() = false for a run started before the operator")
+	}
+	if r.needsRecovery(chain) {
+		t.Error("needsRecovery() = true for a run already recovered")
+	}
+
+	if got := r.recoverRun(context.Background(), natsConfig{SubjectPrefix: "fleet-a"}, chain, specMap); got != 2 {
+		t.Fatalf("recoverRun() = %d, want 2", got)
+	}
+	scan, analyze, report := chain.Status.StepStatuses[0], chain.Status.StepStatuses[1], chain.Status.StepStatuses[2]
+	if scan.Phase != aiv1alpha1.ChainStepPhaseSucceeded || scan.Output != "22/tcp open" {
+		t.Errorf("scan = %s %q, want the replayed result", scan.Phase, scan.Output)
+	}
+	if analyze.Phase != aiv1alpha1.ChainStepPhaseSucceeded || analyze.TaskID != "chain-audit-analyze.run-1-0" || analyze.StartedAt == nil {
+		t.Errorf("analyze = %+v, want the result of the unpersisted dispatch", analyze)
+	}
+	if report.Phase != aiv1alpha1.ChainStepPhasePending {
+		t.Errorf("report = %s, want Pending", report.Phase)
+	}
+
+	fresh := chain.DeepCopy()
+	fresh.Status.RunID = "run-2"
+	now := metav1.Now()
+	fresh.Status.StartedAt = &now
+	if r.needsRecovery(fresh) {
+		t.Error("needsRecovery() = true for a run started by this operator")
+	}
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"time"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// effectiveRetryPolicy returns the retry policy for a step: the chain-level
+// policy, with a per-step retry overriding the attempt count and base delay.
+// Returns nil when the step may not be retried.
+func effectiveRetryPolicy(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep) *aiv1alpha1.ChainRetryPolicy {
+	if step == nil || step.Retry == nil {
+		return chain.Spec.RetryPolicy
+	}
+	policy := aiv1alpha1.ChainRetryPolicy{}
+	if chain.Spec.RetryPolicy != nil {
+		policy = *chain.Spec.RetryPolicy
+	}
+	policy.MaxRetries = step.Retry.MaxAttempts
+	policy.BackoffSeconds = step.Retry.BackoffSeconds
+	if len(step.Retry.RetryOn) > 0 {
+		policy.RetryOn = step.Retry.RetryOn
+	}
+	return &policy
+}
+
+// retryOnClasses maps the retryOn error classes to the patterns they match.
+var retryOnClasses = map[string]string{
+	aiv1alpha1.RetryOnTimeout:   `timed? ?out|deadline exceeded`,
+	aiv1alpha1.RetryOnRateLimit: `rate.?limit|too many requests|\b429\b|overloaded`,
+	aiv1alpha1.RetryOnNetwork:   `connection (refused|reset)|no such host|unexpected EOF|\b50[234]\b|unavailable`,
+}
+
+// retryOnPattern compiles a retryOn entry: an error class or a regular
+// expression, matched case-insensitively.
+func retryOnPattern(entry string) (*regexp.Regexp, error) {
+	if class, ok := retryOnClasses[entry]; ok {
+		entry = class
+	}
+	return regexp.Compile("(?i)" + entry)
+}
+
+// retryable reports whether a step failure may be retried under the policy's
+// retryOn list.
+func retryable(policy *aiv1alpha1.ChainRetryPolicy, errMsg string) bool {
+	if len(policy.RetryOn) == 0 {
+		return true
+	}
+	for _, entry := range policy.RetryOn {
+		if re, err := retryOnPattern(entry); err == nil && re.MatchString(errMsg) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRetryPolicies checks every retryOn entry compiles.
+func validateRetryPolicies(chain *aiv1alpha1.Chain) error {
+	check := func(owner string, entries []string) error {
+		for _, entry := range entries {
+			if _, err := retryOnPattern(entry); err != nil {
+				return fmt.Errorf("%s retryOn %q is not an error class or valid regular expression: %w", owner, entry, err)
+			}
+		}
+		return nil
+	}
+	if chain.Spec.RetryPolicy != nil {
+		if err := check("retryPolicy", chain.Spec.RetryPolicy.RetryOn); err != nil {
+			return err
+		}
+	}
+	for _, step := range allChainSteps(chain) {
+		if step.Retry != nil {
+			if err := check(fmt.Sprintf("step %q", step.Name), step.Retry.RetryOn); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// maxRetryBackoff caps the delay between retries when the policy sets no
+// maxBackoffSeconds: no chain runs longer than a day.
+const maxRetryBackoff = 24 * time.Hour
+
+// retryBackoff returns the delay before retry number attempt (1-based). The
+// jitter is derived from seed rather than drawn at random so the delay stays
+// stable across the reconciles that poll it, while differing between steps
+// and runs.
+func retryBackoff(policy *aiv1alpha1.ChainRetryPolicy, attempt int32, seed string) time.Duration {
+	if policy == nil {
+		return 0
+	}
+	delay := time.Duration(policy.BackoffSeconds) * time.Second
+	maxDelay := time.Duration(policy.MaxBackoffSeconds) * time.Second
+	if maxDelay <= 0 {
+		maxDelay = maxRetryBackoff
+	}
+
+	if policy.BackoffStrategy == aiv1alpha1.BackoffStrategyExponential {
+		multiplier := time.Duration(policy.BackoffMultiplier)
+		if multiplier < 1 {
+			multiplier = 2
+		}
+		for i := int32(1); i < attempt; i++ {
+			// Stop at the cap before the multiplication can overflow.
+			if delay > maxDelay/multiplier {
+				delay = maxDelay
+				break
+			}
+			delay *= multiplier
+		}
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	if policy.JitterPercent > 0 && delay > 0 {
+		h := fnv.New64a()
+		_, _ = fmt.Fprintf(h, "%s/%d", seed, attempt)
+		// fraction in [-1, 1)
+		fraction := float64(h.Sum64()%2000)/1000 - 1
+		delay += time.Duration(fraction * float64(policy.JitterPercent) / 100 * float64(delay))
+	}
+	return delay
+}
@@ -0,0 +1,150 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *aiv1alpha1.ChainRetryPolicy
+		attempt int32
+		want    time.Duration
+	}{
+		{
+			name:    "no policy",
+			attempt: 1,
+			want:    0,
+		},
+		{
+			name:    "fixed",
+			policy:  &aiv1alpha1.ChainRetryPolicy{BackoffSeconds: 30},
+			attempt: 3,
+			want:    30 * time.Second,
+		},
+		{
+			name:    "exponential first attempt uses base delay",
+			policy:  &aiv1alpha1.ChainRetryPolicy{BackoffSeconds: 10, BackoffStrategy: aiv1alpha1.BackoffStrategyExponential, BackoffMultiplier: 3},
+			attempt: 1,
+			want:    10 * time.Second,
+		},
+		{
+			name:    "exponential grows by multiplier",
+			policy:  &aiv1alpha1.ChainRetryPolicy{BackoffSeconds: 10, BackoffStrategy: aiv1alpha1.BackoffStrategyExponential, BackoffMultiplier: 3},
+			attempt: 3,
+			want:    90 * time.Second,
+		},
+		{
+			name:    "exponential defaults multiplier to 2",
+			policy:  &aiv1alpha1.ChainRetryPolicy{BackoffSeconds: 10, BackoffStrategy: aiv1alpha1.BackoffStrategyExponential},
+			attempt: 4,
+			want:    80 * time.Second,
+		},
+		{
+			name:    "exponential capped by maxBackoffSeconds",
+			policy:  &aiv1alpha1.ChainRetryPolicy{BackoffSeconds: 10, BackoffStrategy: aiv1alpha1.BackoffStrategyExponential, MaxBackoffSeconds: 60},
+			attempt: 10,
+			want:    60 * time.Second,
+		},
+		{
+			name:    "exponential without a cap stops at a day",
+			policy:  &aiv1alpha1.ChainRetryPolicy{BackoffSeconds: 3600, BackoffStrategy: aiv1alpha1.BackoffStrategyExponential, BackoffMultiplier: 10},
+			attempt: 1000,
+			want:    24 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryBackoff(tt.policy, tt.attempt, "run/step"); got != tt.want {
+				t.Errorf("retryBackoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryBackoffJitter(t *testing.T) {
+	policy := &aiv1alpha1.ChainRetryPolicy{BackoffSeconds: 100, JitterPercent: 20}
+
+	first := retryBackoff(policy, 1, "run-a/step")
+	if first < 80*time.Second || first > 120*time.Second {
+		t.Errorf("retryBackoff() = %v, want within ±20%% of 100s", first)
+	}
+	if again := retryBackoff(policy, 1, "run-a/step"); again != first {
+		t.Errorf("retryBackoff() not stable for the same seed: %v != %v", again, first)
+	}
+
+	distinct := map[time.Duration]bool{}
+	for _, seed := range []string{"run-a/step", "run-b/step", "run-c/step", "run-d/step"} {
+		distinct[retryBackoff(policy, 1, seed)] = true
+	}
+	if len(distinct) < 2 {
+		t.Error("retryBackoff() jitter should differ between seeds")
+	}
+}
+
+func TestEffectiveRetryPolicy(t *testing.T) {
+	chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{
+		RetryPolicy: &aiv1alpha1.ChainRetryPolicy{
+			MaxRetries:      1,
+			BackoffSeconds:  30,
+			BackoffStrategy: aiv1alpha1.BackoffStrategyExponential,
+			JitterPercent:   10,
+		},
+	}}
+
+	if got := effectiveRetryPolicy(chain, &aiv1alpha1.ChainStep{Name: "plain"}); got != chain.Spec.RetryPolicy {
+		t.Errorf("step without retry should use the chain policy, got %+v", got)
+	}
+
+	got := effectiveRetryPolicy(chain, &aiv1alpha1.ChainStep{
+		Name:  "override",
+		Retry: &aiv1alpha1.StepRetry{MaxAttempts: 5, BackoffSeconds: 5},
+	})
+	if got.MaxRetries != 5 || got.BackoffSeconds != 5 {
+		t.Errorf("step retry should override attempts and delay, got %+v", got)
+	}
+	if got.BackoffStrategy != aiv1alpha1.BackoffStrategyExponential || got.JitterPercent != 10 {
+		t.Errorf("step retry should inherit strategy and jitter, got %+v", got)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	policy := &aiv1alpha1.ChainRetryPolicy{RetryOn: []string{aiv1alpha1.RetryOnTimeout, aiv1alpha1.RetryOnRateLimit, "quota exhausted"}}
+	tests := []struct {
+		errMsg string
+		want   bool
+	}{
+		{errMsg: "task timed out after 300s", want: true},
+		{errMsg: "provider returned 429 Too Many Requests", want: true},
+		{errMsg: "Quota Exhausted for model", want: true},
+		{errMsg: "target unreachable", want: false},
+		{errMsg: "permission denied", want: false},
+	}
+	for _, tt := range tests {
+		if got := retryable(policy, tt.errMsg); got != tt.want {
+			t.Errorf("retryable(%q) = %t, want %t", tt.errMsg, got, tt.want)
+		}
+	}
+	if !retryable(&aiv1alpha1.ChainRetryPolicy{}, "permission denied") {
+		t.Error("retryable() without retryOn should retry every failure")
+	}
+
+	chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{
+		RetryPolicy: policy,
+		Steps:       []aiv1alpha1.ChainStep{{Name: "scan", Retry: &aiv1alpha1.StepRetry{MaxAttempts: 2, RetryOn: []string{"flaky"}}}},
+	}}
+	if got := effectiveRetryPolicy(chain, &chain.Spec.Steps[0]); len(got.RetryOn) != 1 || got.RetryOn[0] != "flaky" {
+		t.Errorf("step retryOn should override the chain's, got %v", got.RetryOn)
+	}
+	if err := validateRetryPolicies(chain); err != nil {
+		t.Errorf("validateRetryPolicies() error = %v", err)
+	}
+	chain.Spec.Steps[0].Retry.RetryOn = []string{"(unclosed"}
+	if err := validateRetryPolicies(chain); err == nil {
+		t.Error("validateRetryPolicies() accepted an invalid regular expression")
+	}
+}
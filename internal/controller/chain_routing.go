@@ -0,0 +1,205 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// selectsKnight reports whether the step's knight is chosen at dispatch time
+// (knightSelector and/or domain) rather than named by knightRef.
+func selectsKnight(step *aiv1alpha1.ChainStep) bool {
+	return step.KnightRef == "" && (step.KnightSelector != nil || step.Domain != "")
+}
+
+// validateStepTarget checks a task step names its knight in exactly one way.
+func validateStepTarget(step *aiv1alpha1.ChainStep) error {
+	if step.KnightRef != "" && (step.KnightSelector != nil || step.Domain != "") {
+		return fmt.Errorf("step %q sets knightRef together with knightSelector/domain", step.Name)
+	}
+	if step.KnightRef == "" && !selectsKnight(step) {
+		return fmt.Errorf("step %q has no knightRef, knightSelector, or domain", step.Name)
+	}
+	if step.KnightSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(step.KnightSelector); err != nil {
+			return fmt.Errorf("step %q has invalid knightSelector: %w", step.Name, err)
+		}
+	}
+	return nil
+}
+
+// eligibleKnights lists the knights a selecting step may be dispatched to:
+// Ready, not suspended, matching the step's knightSelector and domain, and
+// not an ephemeral knight owned by a different mission. Sorted by name.
+func (r *ChainReconciler) eligibleKnights(ctx context.Context, chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep) ([]aiv1alpha1.Knight, error) {
+	opts := []client.ListOption{client.InNamespace(chain.Namespace)}
+	if step.KnightSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(step.KnightSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid knightSelector: %w", err)
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: selector})
+	}
+
+	list := &aiv1alpha1.KnightList{}
+	if err := r.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+
+	chainMission := chain.Labels[aiv1alpha1.LabelMission]
+	var eligible []aiv1alpha1.Knight
+	for _, k := range list.Items {
+		if !k.Status.Ready || k.Spec.Suspended {
+			continue
+		}
+		if step.Domain != "" && k.Spec.Domain != step.Domain {
+			continue
+		}
+		if m := k.Labels[aiv1alpha1.LabelMission]; m != "" && m != chainMission {
+			continue
+		}
+		eligible = append(eligible, k)
+	}
+	sort.Slice(eligible, func(i, j int) bool { return eligible[i].Name < eligible[j].Name })
+	return eligible, nil
+}
+
+// resolveStepKnight returns the knight to dispatch a task step to. For a
+// selecting step it routes among the eligible knights by the step's routing
+// strategy, and returns nil (and no error) while none is eligible, so the
+// step stays Pending until one becomes Ready. Backlogs are read from the
+// chain's fleet NATS server nc.
+func (r *ChainReconciler) resolveStepKnight(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, step *aiv1alpha1.ChainStep) (*aiv1alpha1.Knight, error) {
+	if !selectsKnight(step) {
+		knight := &aiv1alpha1.Knight{}
+		if err := r.Get(ctx, types.NamespacedName{Name: step.KnightRef, Namespace: chain.Namespace}, knight); err != nil {
+			return nil, err
+		}
+		return knight, nil
+	}
+
+	eligible, err := r.eligibleKnights(ctx, chain, step)
+	if err != nil || len(eligible) == 0 {
+		return nil, err
+	}
+	if len(eligible) == 1 {
+		return &eligible[0], nil
+	}
+
+	if step.Routing == aiv1alpha1.KnightRoutingRoundRobin {
+		return &eligible[r.nextRouteCursor(routePoolKey(chain, step), len(eligible))], nil
+	}
+	return &eligible[leastLoaded(eligible, r.knightBacklogs(ctx, nc, eligible))], nil
+}
+
+// routePoolKey identifies the pool of knights a selecting step draws from, so
+// RoundRobin rotates across every chain sharing the same selector and domain.
+func routePoolKey(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep) string {
+	selector := ""
+	if step.KnightSelector != nil {
+		selector = metav1.FormatLabelSelector(step.KnightSelector)
+	}
+	return chain.Namespace + "/" + step.Domain + "/" + selector
+}
+
+// nextRouteCursor returns the RoundRobin index for a pool of n knights and
+// advances it. Cursors are in-memory and restart from zero with the operator.
+func (r *ChainReconciler) nextRouteCursor(key string, n int) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.routeCursors == nil {
+		r.routeCursors = make(map[string]int)
+	}
+	i := r.routeCursors[key] % n
+	r.routeCursors[key] = i + 1
+	return i
+}
+
+// knightBacklogs returns each knight's JetStream backlog on the fleet NATS
+// server nc, routing by name if it is unavailable.
+func (r *ChainReconciler) knightBacklogs(ctx context.Context, nc natsConfig, knights []aiv1alpha1.Knight) map[types.NamespacedName]uint64 {
+	client, err := r.fleetClient(nc)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Cannot inspect knight backlogs, routing by name")
+		return map[types.NamespacedName]uint64{}
+	}
+	return consumerBacklogs(ctx, client, knights)
+}
+
+// consumerBacklogs returns each knight's JetStream backlog (pending plus
+// unacknowledged tasks on its consumer), keyed by namespace and name since a
+// table's knights span namespaces. Knights whose consumer can't be
+// inspected are left out.
+func consumerBacklogs(ctx context.Context, client natspkg.Client, knights []aiv1alpha1.Knight) map[types.NamespacedName]uint64 {
+	log := logf.FromContext(ctx)
+	backlogs := make(map[types.NamespacedName]uint64, len(knights))
+	for _, k := range knights {
+		consumer := k.Status.NATSConsumer
+		if consumer == "" {
+			consumer = natspkg.KnightConsumerName(k.Name)
+		}
+		info, err := client.ConsumerInfo(knightStream(&k), consumer)
+		if err != nil {
+			log.V(1).Info("Failed to get knight consumer info", "knight", k.Name, "error", err.Error())
+			continue
+		}
+		backlogs[knightKey(&k)] = info.NumPending + uint64(info.NumAckPending)
+	}
+	return backlogs
+}
+
+// knightKey identifies k in a backlog map.
+func knightKey(k *aiv1alpha1.Knight) types.NamespacedName {
+	return types.NamespacedName{Namespace: k.Namespace, Name: k.Name}
+}
+
+// knightStream returns the stream k consumes tasks from, as last recorded
+// by the knight controller.
+func knightStream(k *aiv1alpha1.Knight) string {
+	if k.Status.NATSStream != "" {
+		return k.Status.NATSStream
+	}
+	return k.Spec.NATS.Stream
+}
+
+// leastLoaded returns the index of the knight with the smallest backlog.
+// Knights with an unknown backlog rank after all known ones; ties keep the
+// name order of knights.
+func leastLoaded(knights []aiv1alpha1.Knight, backlogs map[types.NamespacedName]uint64) int {
+	best := 0
+	bestBacklog, bestKnown := backlogs[knightKey(&knights[0])]
+	for i := 1; i < len(knights); i++ {
+		backlog, known := backlogs[knightKey(&knights[i])]
+		if !known {
+			continue
+		}
+		if !bestKnown || backlog < bestBacklog {
+			best, bestBacklog, bestKnown = i, backlog, true
+		}
+	}
+	return best
+}
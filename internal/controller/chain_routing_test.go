@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func routingTestKnight(name, domain string, ready bool, labels map[string]string) *aiv1alpha1.Knight {
+	return &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Spec:       aiv1alpha1.KnightSpec{Domain: domain},
+		Status:     aiv1alpha1.KnightStatus{Ready: ready},
+	}
+}
+
+func TestResolveStepKnight(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add roundtable scheme: %v", err)
+	}
+	suspended := routingTestKnight("aaron", "security", true, map[string]string{"tier": "senior"})
+	suspended.Spec.Suspended = true
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		suspended,
+		routingTestKnight("galahad", "security", true, map[string]string{"tier": "senior"}),
+		routingTestKnight("gawain", "security", false, map[string]string{"tier": "senior"}),
+		routingTestKnight("kay", "security", true, map[string]string{aiv1alpha1.LabelMission: "other"}),
+		routingTestKnight("lancelot", "research", true, map[string]string{"tier": "senior"}),
+	).WithStatusSubresource(&aiv1alpha1.Knight{}).Build()
+	r := &ChainReconciler{Client: c}
+	chain := &aiv1alpha1.Chain{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "default"}}
+
+	tests := []struct {
+		name string
+		step aiv1alpha1.ChainStep
+		want string
+	}{
+		{name: "knightRef", step: aiv1alpha1.ChainStep{KnightRef: "gawain"}, want: "gawain"},
+		{name: "domain", step: aiv1alpha1.ChainStep{Domain: "security"}, want: "galahad"},
+		{name: "selector", step: aiv1alpha1.ChainStep{KnightSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"tier": "senior"},
+		}}, want: "galahad"},
+		{name: "selector and domain", step: aiv1alpha1.ChainStep{Domain: "research", KnightSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"tier": "senior"},
+		}}, want: "lancelot"},
+		{name: "none ready", step: aiv1alpha1.ChainStep{Domain: "ops"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			knight, err := r.resolveStepKnight(context.Background(), chain, natsConfig{}, &tt.step)
+			if err != nil {
+				t.Fatalf("resolveStepKnight() error = %v", err)
+			}
+			got := ""
+			if knight != nil {
+				got = knight.Name
+			}
+			if got != tt.want {
+				t.Errorf("resolveStepKnight() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// With the fleet's NATS reachable, the least-loaded knight wins.
+	nc := newFakeNATSClient()
+	nc.consumers = map[string]*nats.ConsumerInfo{
+		natspkg.KnightConsumerName("galahad"):  {NumPending: 3},
+		natspkg.KnightConsumerName("lancelot"): {NumPending: 1},
+	}
+	r.NATS = natspkg.NewProviderWithClient(nc, logr.Discard())
+	step := &aiv1alpha1.ChainStep{KnightSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "senior"}}}
+	if knight, err := r.resolveStepKnight(context.Background(), chain, natsConfig{}, step); err != nil || knight == nil || knight.Name != "lancelot" {
+		t.Errorf("resolveStepKnight() = %v, %v, want lancelot by backlog", knight, err)
+	}
+}
+
+func TestValidateStepTarget(t *testing.T) {
+	tests := []struct {
+		name    string
+		step    aiv1alpha1.ChainStep
+		wantErr bool
+	}{
+		{name: "knightRef", step: aiv1alpha1.ChainStep{KnightRef: "galahad"}},
+		{name: "domain", step: aiv1alpha1.ChainStep{Domain: "security"}},
+		{name: "nothing", step: aiv1alpha1.ChainStep{}, wantErr: true},
+		{name: "knightRef and domain", step: aiv1alpha1.ChainStep{KnightRef: "galahad", Domain: "security"}, wantErr: true},
+		{name: "bad selector", step: aiv1alpha1.ChainStep{KnightSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Bogus"}},
+		}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateStepTarget(&tt.step); (err != nil) != tt.wantErr {
+				t.Errorf("validateStepTarget() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLeastLoaded(t *testing.T) {
+	knights := []aiv1alpha1.Knight{
+		*routingTestKnight("galahad", "security", true, nil),
+		*routingTestKnight("gawain", "security", true, nil),
+		*routingTestKnight("percival", "security", true, nil),
+	}
+
+	tests := []struct {
+		name     string
+		backlogs map[string]uint64
+		want     string
+	}{
+		{name: "smallest backlog", backlogs: map[string]uint64{"galahad": 5, "gawain": 1, "percival": 3}, want: "gawain"},
+		{name: "tie keeps name order", backlogs: map[string]uint64{"galahad": 2, "gawain": 2, "percival": 2}, want: "galahad"},
+		{name: "unknown ranks last", backlogs: map[string]uint64{"percival": 9}, want: "percival"},
+		{name: "all unknown", backlogs: map[string]uint64{}, want: "galahad"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backlogs := map[types.NamespacedName]uint64{}
+			for name, backlog := range tt.backlogs {
+				backlogs[types.NamespacedName{Namespace: "default", Name: name}] = backlog
+			}
+			if got := knights[leastLoaded(knights, backlogs)].Name; got != tt.want {
+				t.Errorf("leastLoaded() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Same-named knights in other namespaces keep their own backlogs.
+	twin := *routingTestKnight("galahad", "security", true, nil)
+	twin.Namespace = "team-red"
+	backlogs := map[types.NamespacedName]uint64{
+		{Namespace: "default", Name: "galahad"}:  1,
+		{Namespace: "team-red", Name: "galahad"}: 0,
+	}
+	if got := leastLoaded([]aiv1alpha1.Knight{knights[0], twin}, backlogs); got != 1 {
+		t.Errorf("leastLoaded() = %d, want the idle team-red/galahad", got)
+	}
+}
+
+func TestNextRouteCursor(t *testing.T) {
+	r := &ChainReconciler{}
+	var got []int
+	for range 4 {
+		got = append(got, r.nextRouteCursor("default/security/", 3))
+	}
+	if want := []int{0, 1, 2, 0}; !slices.Equal(got, want) {
+		t.Errorf("nextRouteCursor() sequence = %v, want %v", got, want)
+	}
+	if i := r.nextRouteCursor("default/research/", 3); i != 0 {
+		t.Errorf("nextRouteCursor() for a new pool = %d, want 0", i)
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// outputSchemaURL is the in-memory location the step schema is compiled at.
+const outputSchemaURL = "mem://outputSchema.json"
+
+// compileOutputSchema compiles a step's outputSchema. The compiler gets no
+// URL loaders, so a $ref can't make the operator read files or fetch URLs.
+func compileOutputSchema(step *aiv1alpha1.ChainStep) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(step.OutputSchema.Raw))
+	if err != nil {
+		return nil, err
+	}
+	c := jsonschema.NewCompiler()
+	c.UseLoader(jsonschema.SchemeURLLoader{})
+	if err := c.AddResource(outputSchemaURL, doc); err != nil {
+		return nil, err
+	}
+	return c.Compile(outputSchemaURL)
+}
+
+// validateOutputSchemas checks every step's outputSchema compiles.
+func validateOutputSchemas(chain *aiv1alpha1.Chain) error {
+	for _, step := range allChainSteps(chain) {
+		if step.OutputSchema == nil {
+			continue
+		}
+		if _, err := compileOutputSchema(&step); err != nil {
+			return fmt.Errorf("step %q has invalid outputSchema: %w", step.Name, err)
+		}
+	}
+	return nil
+}
+
+// checkStepOutput validates a step result against its parseOutput and
+// outputSchema settings. It returns the failure message, or "" when the
+// result is acceptable.
+func checkStepOutput(step *aiv1alpha1.ChainStep, output string) string {
+	if !parsesJSON(step) && (step == nil || step.OutputSchema == nil) {
+		return ""
+	}
+	parsed, err := parseStepJSON(output)
+	if err != nil {
+		return fmt.Sprintf("output is not valid JSON: %v", err)
+	}
+	if step.OutputSchema == nil {
+		return ""
+	}
+	schema, err := compileOutputSchema(step)
+	if err != nil {
+		return fmt.Sprintf("invalid outputSchema: %v", err)
+	}
+	if err := schema.Validate(parsed); err != nil {
+		return fmt.Sprintf("output does not match outputSchema: %v", err)
+	}
+	return ""
+}
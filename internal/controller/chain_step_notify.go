@@ -0,0 +1,170 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/notify"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// stepPhases snapshots each step's phase so finished steps can be detected
+// after a reconcile pass.
+func stepPhases(chain *aiv1alpha1.Chain) map[string]aiv1alpha1.ChainStepPhase {
+	phases := make(map[string]aiv1alpha1.ChainStepPhase, len(chain.Status.StepStatuses))
+	for _, ss := range chain.Status.StepStatuses {
+		phases[ss.Name] = ss.Phase
+	}
+	return phases
+}
+
+// notifyFinishedSteps fires the notifications of every step that reached
+// Succeeded or Failed during this pass (a failure that is retried goes back
+// to Pending and does not count). Delivery is best-effort: failures only
+// produce warning Events.
+func (r *ChainReconciler) notifyFinishedSteps(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, specMap map[string]*aiv1alpha1.ChainStep, before map[string]aiv1alpha1.ChainStepPhase) {
+	for i := range chain.Status.StepStatuses {
+		ss := &chain.Status.StepStatuses[i]
+		if ss.Phase == before[ss.Name] {
+			continue
+		}
+		if ss.Phase != aiv1alpha1.ChainStepPhaseSucceeded && ss.Phase != aiv1alpha1.ChainStepPhaseFailed {
+			continue
+		}
+		spec := specMap[ss.Name]
+		if spec == nil {
+			continue
+		}
+		for _, n := range spec.Notifications {
+			if len(n.On) > 0 && !slices.Contains(n.On, ss.Phase) {
+				continue
+			}
+			if err := r.sendStepNotification(ctx, chain, nc, spec, ss, n); err != nil {
+				logf.FromContext(ctx).Error(err, "Step notification failed", "step", ss.Name)
+				r.Recorder.Eventf(chain, corev1.EventTypeWarning, "StepNotificationFailed",
+					"Step %s notification failed: %v", ss.Name, err)
+			}
+		}
+	}
+}
+
+// sendStepNotification delivers one step notification to its webhook and/or
+// NATS subject. The subject is published on the fleet's connection and must
+// fall under the fleet's notifications prefix, so a chain can't inject
+// tasks or results.
+func (r *ChainReconciler) sendStepNotification(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, step *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus, n aiv1alpha1.StepNotification) error {
+	payload, err := r.stepNotifyPayload(chain, step, ss, n)
+	if err != nil {
+		return err
+	}
+
+	if n.Webhook != nil {
+		if r.Notify == nil || !r.Notify.URLAllowed(n.Webhook.URL) {
+			return fmt.Errorf("webhook URL %q does not match the operator's allowed URL prefixes", n.Webhook.URL)
+		}
+		token, err := webhookToken(ctx, r.Client, chain.Namespace, n.Webhook)
+		if err != nil {
+			return err
+		}
+		if err := r.Notify.Deliver(ctx, n.Webhook.URL, token, payload); err != nil {
+			return err
+		}
+	}
+
+	if n.NATSSubject != "" {
+		if prefix := natspkg.NotificationSubjectPrefix(nc.SubjectPrefix); !strings.HasPrefix(n.NATSSubject, prefix) {
+			return fmt.Errorf("NATS subject %q is not under %s", n.NATSSubject, prefix)
+		}
+		client, err := r.fleetClient(nc)
+		if err != nil {
+			return err
+		}
+		if err := client.PublishJSON(n.NATSSubject, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateStepNotifications checks every step notification's natsSubject
+// is a plain subject: no wildcards, whitespace, or empty tokens. Whether it
+// falls under the fleet's notifications prefix depends on the RoundTable,
+// so that is checked by the fleet policy webhook and on delivery.
+func validateStepNotifications(chain *aiv1alpha1.Chain) error {
+	for _, step := range allChainSteps(chain) {
+		for _, n := range step.Notifications {
+			if n.NATSSubject == "" {
+				continue
+			}
+			if strings.ContainsAny(n.NATSSubject, "*> \t\r\n") || slices.Contains(strings.Split(n.NATSSubject, "."), "") {
+				return fmt.Errorf("step %q notification natsSubject %q is not a valid subject", step.Name, n.NATSSubject)
+			}
+		}
+	}
+	return nil
+}
+
+// stepNotifyPayload builds the roundtable.notify/v1 payload for a finished
+// step. Kind is "ChainStep" and Steps carries the single step.
+func (r *ChainReconciler) stepNotifyPayload(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus, n aiv1alpha1.StepNotification) (notify.Payload, error) {
+	output := ss.Output
+	if ss.Phase == aiv1alpha1.ChainStepPhaseFailed {
+		output = ss.Error
+	}
+	if n.Message != "" {
+		rendered, err := r.renderStepTemplate(chain, step, n.Message)
+		if err != nil {
+			return notify.Payload{}, fmt.Errorf("render notification message: %w", err)
+		}
+		output = rendered
+	}
+	output, truncated := notify.Truncate(output)
+
+	var webhookContext map[string]string
+	if n.Webhook != nil {
+		webhookContext = n.Webhook.Context
+	}
+
+	return notify.Payload{
+		Schema:        notify.SchemaV1,
+		Kind:          "ChainStep",
+		Name:          chain.Name,
+		Namespace:     chain.Namespace,
+		UID:           string(chain.UID),
+		Phase:         string(ss.Phase),
+		RoundTableRef: chain.Spec.RoundTableRef,
+		StartedAt:     ss.StartedAt,
+		FinishedAt:    ss.CompletedAt,
+		Steps: []notify.StepSummary{{
+			Name:   ss.Name,
+			Knight: ss.Knight,
+			Phase:  string(ss.Phase),
+		}},
+		Output:         output,
+		Truncated:      truncated,
+		Context:        webhookContext,
+		IdempotencyKey: string(chain.UID) + "/" + chain.Status.RunID + "/" + ss.Name + "/" + string(ss.Phase),
+	}, nil
+}
@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/notify"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestNotifyFinishedSteps(t *testing.T) {
+	var received []notify.Payload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p notify.Payload
+		_ = json.NewDecoder(r.Body).Decode(&p)
+		received = append(received, p)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	r := &ChainReconciler{
+		Recorder: record.NewFakeRecorder(10),
+		Notify:   notify.NewNotifier([]string{server.URL}),
+	}
+
+	chain := &aiv1alpha1.Chain{
+		Spec: aiv1alpha1.ChainSpec{
+			Steps: []aiv1alpha1.ChainStep{
+				{
+					Name: "recon",
+					Notifications: []aiv1alpha1.StepNotification{{
+						Webhook: &aiv1alpha1.WebhookSink{URL: server.URL + "/hook"},
+						Message: "recon: {{ .Steps.recon.Output | upper }}",
+					}},
+				},
+				{
+					Name: "report",
+					Notifications: []aiv1alpha1.StepNotification{{
+						On:      []aiv1alpha1.ChainStepPhase{aiv1alpha1.ChainStepPhaseFailed},
+						Webhook: &aiv1alpha1.WebhookSink{URL: server.URL + "/hook"},
+					}},
+				},
+			},
+		},
+		Status: aiv1alpha1.ChainStatus{
+			RunID: "run-1",
+			StepStatuses: []aiv1alpha1.ChainStepStatus{
+				{Name: "recon", Phase: aiv1alpha1.ChainStepPhaseRunning},
+				{Name: "report", Phase: aiv1alpha1.ChainStepPhaseRunning},
+			},
+		},
+	}
+	specMap := map[string]*aiv1alpha1.ChainStep{
+		"recon":  &chain.Spec.Steps[0],
+		"report": &chain.Spec.Steps[1],
+	}
+	before := stepPhases(chain)
+
+	chain.Status.StepStatuses[0].Phase = aiv1alpha1.ChainStepPhaseSucceeded
+	chain.Status.StepStatuses[0].Output = "two hosts"
+	chain.Status.StepStatuses[1].Phase = aiv1alpha1.ChainStepPhaseSucceeded
+	r.notifyFinishedSteps(context.Background(), chain, natsConfig{}, specMap, before)
+
+	if len(received) != 1 {
+		t.Fatalf("got %d notifications, want 1 (report only notifies on failure)", len(received))
+	}
+	got := received[0]
+	if got.Kind != "ChainStep" || got.Phase != "Succeeded" || got.Output != "recon: TWO HOSTS" {
+		t.Errorf("unexpected payload %+v", got)
+	}
+	if got.IdempotencyKey != "/run-1/recon/Succeeded" {
+		t.Errorf("IdempotencyKey = %q", got.IdempotencyKey)
+	}
+
+	// Nothing changed since the last snapshot — no repeat deliveries.
+	r.notifyFinishedSteps(context.Background(), chain, natsConfig{}, specMap, stepPhases(chain))
+	if len(received) != 1 {
+		t.Errorf("got %d notifications after a no-op pass, want 1", len(received))
+	}
+}
+
+func TestStepNotificationNATSSubject(t *testing.T) {
+	nc := newFakeNATSClient()
+	r := &ChainReconciler{
+		Recorder: record.NewFakeRecorder(10),
+		NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+	}
+	chain := &aiv1alpha1.Chain{Status: aiv1alpha1.ChainStatus{RunID: "run-1"}}
+	step := &aiv1alpha1.ChainStep{Name: "recon"}
+	ss := &aiv1alpha1.ChainStepStatus{Name: "recon", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Output: "two hosts"}
+	fleet := natsConfig{SubjectPrefix: "fleet-a"}
+
+	n := aiv1alpha1.StepNotification{NATSSubject: "fleet-a.notifications.recon"}
+	if err := r.sendStepNotification(context.Background(), chain, fleet, step, ss, n); err != nil {
+		t.Fatalf("sendStepNotification() error = %v", err)
+	}
+	if _, ok := nc.published["fleet-a.notifications.recon"]; !ok {
+		t.Errorf("published %v, want the notification subject", nc.subjects())
+	}
+
+	for _, subject := range []string{"fleet-a.tasks.security.galahad", "fleet-b.notifications.recon"} {
+		n.NATSSubject = subject
+		if err := r.sendStepNotification(context.Background(), chain, fleet, step, ss, n); err == nil {
+			t.Errorf("sendStepNotification() to %s succeeded, want it refused", subject)
+		}
+		if _, ok := nc.published[subject]; ok {
+			t.Errorf("published to %s, want nothing outside the notifications prefix", subject)
+		}
+	}
+}
+
+func TestValidateStepNotifications(t *testing.T) {
+	for subject, valid := range map[string]bool{
+		"fleet-a.notifications.recon": true,
+		"fleet-a.notifications.>":     false,
+		"fleet-a.*.recon":             false,
+		"fleet-a..recon":              false,
+		"fleet a.recon":               false,
+	} {
+		chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{{
+			Name: "recon", Notifications: []aiv1alpha1.StepNotification{{NATSSubject: subject}},
+		}}}}
+		if err := validateStepNotifications(chain); (err == nil) != valid {
+			t.Errorf("validateStepNotifications(%q) error = %v, want valid %t", subject, err, valid)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/json"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig/v3"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// parseOutputJSON is the ChainStep.parseOutput value for JSON results.
+const parseOutputJSON = "json"
+
+// parsesJSON reports whether the step's result is decoded as JSON.
+func parsesJSON(step *aiv1alpha1.ChainStep) bool {
+	return step != nil && step.ParseOutput == parseOutputJSON
+}
+
+// parseStepJSON decodes a step result as JSON. Knights often wrap JSON in a
+// markdown code fence, so a surrounding ``` / ```json fence is stripped first.
+func parseStepJSON(output string) (interface{}, error) {
+	trimmed := strings.TrimSpace(output)
+	if rest, ok := strings.CutPrefix(trimmed, "```"); ok {
+		rest = strings.TrimPrefix(rest, "json")
+		rest, _ = strings.CutSuffix(strings.TrimSpace(rest), "```")
+		trimmed = strings.TrimSpace(rest)
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(trimmed), &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// unsafeSprigFuncs are removed from the sprig library: anyone who can
+// create a Chain controls its templates, and these would expose the
+// operator's environment or make network lookups from the operator pod.
+var unsafeSprigFuncs = []string{"env", "expandenv", "getHostByName"}
+
+// chainTemplateFuncs returns the functions available to step task templates:
+// the sprig library (minus unsafeSprigFuncs) plus artifact, which resolves a
+// step's full output. Validation passes a stub artifact so that checking a
+// template never touches the artifact store.
+func chainTemplateFuncs(artifact func(step string) (string, error)) template.FuncMap {
+	funcs := sprig.TxtFuncMap()
+	for _, name := range unsafeSprigFuncs {
+		delete(funcs, name)
+	}
+	funcs["artifact"] = artifact
+	return funcs
+}
+
+// validationTemplateFuncs is chainTemplateFuncs with side-effect-free stubs.
+func validationTemplateFuncs() template.FuncMap {
+	return chainTemplateFuncs(func(string) (string, error) { return "", nil })
+}
+
+// runTemplateData is the .Run template data: the chain and its current run.
+func runTemplateData(chain *aiv1alpha1.Chain) map[string]interface{} {
+	var startedAt time.Time
+	if chain.Status.StartedAt != nil {
+		startedAt = chain.Status.StartedAt.Time
+	}
+	return map[string]interface{}{
+		"ID":          chain.Status.RunID,
+		"Chain":       chain.Name,
+		"Namespace":   chain.Namespace,
+		"TriggeredBy": chain.Status.TriggeredBy,
+		"StartedAt":   startedAt,
+	}
+}
+
+// stepTemplateData is the .Step template data for the step a template
+// belongs to. Attempt counts from 1 and includes retries; it is 0 for a
+// template rendered outside a step.
+func stepTemplateData(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep) map[string]interface{} {
+	data := map[string]interface{}{"Name": "", "Attempt": int32(0)}
+	if step == nil {
+		return data
+	}
+	data["Name"] = step.Name
+	data["Attempt"] = int32(1)
+	for _, ss := range chain.Status.StepStatuses {
+		if ss.Name == step.Name {
+			data["Attempt"] = ss.Retries + 1
+			break
+		}
+	}
+	return data
+}
@@ -0,0 +1,190 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestParseStepJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		wantErr bool
+	}{
+		{name: "object", output: `{"openPorts": [22, 443]}`},
+		{name: "array", output: `[1, 2, 3]`},
+		{name: "json code fence", output: "```json\n{\"ok\": true}\n```"},
+		{name: "bare code fence", output: "```\n{\"ok\": true}\n```"},
+		{name: "prose", output: "The scan found two open ports.", wantErr: true},
+		{name: "empty", output: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseStepJSON(tt.output)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseStepJSON(%q) error = %v, wantErr %v", tt.output, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRenderTemplateJSONOutput(t *testing.T) {
+	r := &ChainReconciler{}
+	chain := &aiv1alpha1.Chain{
+		Spec: aiv1alpha1.ChainSpec{
+			Steps: []aiv1alpha1.ChainStep{
+				{Name: "recon", ParseOutput: "json"},
+				{Name: "report", DependsOn: []string{"recon"}},
+			},
+		},
+		Status: aiv1alpha1.ChainStatus{
+			StepStatuses: []aiv1alpha1.ChainStepStatus{
+				{Name: "recon", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Output: "```json\n{\"host\": \"web-1\", \"openPorts\": [22, 443]}\n```"},
+				{Name: "report", Phase: aiv1alpha1.ChainStepPhasePending},
+			},
+		},
+	}
+
+	got, err := r.renderTemplate(chain, `{{ .Steps.recon.JSON.host }}: {{ range .Steps.recon.JSON.openPorts }}{{ . }} {{ end }}`)
+	if err != nil {
+		t.Fatalf("renderTemplate() error = %v", err)
+	}
+	if want := "web-1: 22 443 "; got != want {
+		t.Errorf("renderTemplate() = %q, want %q", got, want)
+	}
+
+	chain.Status.StepStatuses[0].Output = "not json"
+	if _, err := r.renderTemplate(chain, `{{ .Steps.recon.JSON.host }}`); err == nil {
+		t.Error("renderTemplate() with invalid JSON output expected error")
+	}
+}
+
+func TestRenderTemplateSprigFuncs(t *testing.T) {
+	r := &ChainReconciler{}
+	chain := &aiv1alpha1.Chain{
+		Spec: aiv1alpha1.ChainSpec{Input: "  alpha,beta  "},
+		Status: aiv1alpha1.ChainStatus{
+			StepStatuses: []aiv1alpha1.ChainStepStatus{
+				{Name: "scan", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Output: "Found 3 issues"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		tmpl string
+		want string
+	}{
+		{name: "trim and split", tmpl: `{{ range splitList "," (trim .Input) }}[{{ . }}]{{ end }}`, want: "[alpha][beta]"},
+		{name: "default", tmpl: `{{ .Steps.scan.Error | default "none" }}`, want: "none"},
+		{name: "regexMatch", tmpl: `{{ regexMatch "[0-9]+ issues" .Steps.scan.Output }}`, want: "true"},
+		{name: "toJson", tmpl: `{{ list 1 2 | toJson }}`, want: "[1,2]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.renderTemplate(chain, tt.tmpl)
+			if err != nil {
+				t.Fatalf("renderTemplate() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("renderTemplate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderTemplateRunMetadata(t *testing.T) {
+	r := &ChainReconciler{}
+	step := &aiv1alpha1.ChainStep{Name: "scan", Task: "Write results to /data/runs/{{ .Run.ID }}/{{ .Step.Name }}-{{ .Step.Attempt }}"}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "ai"},
+		Spec:       aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{*step}},
+		Status: aiv1alpha1.ChainStatus{
+			RunID:        "run-1",
+			TriggeredBy:  "schedule",
+			StartedAt:    &metav1.Time{Time: time.Date(2026, 3, 1, 6, 0, 0, 0, time.UTC)},
+			StepStatuses: []aiv1alpha1.ChainStepStatus{{Name: "scan", Phase: aiv1alpha1.ChainStepPhasePending, Retries: 2}},
+		},
+	}
+
+	got, err := r.renderStepTemplate(chain, step, step.Task)
+	if err != nil {
+		t.Fatalf("renderStepTemplate() error = %v", err)
+	}
+	if want := "Write results to /data/runs/run-1/scan-3"; got != want {
+		t.Errorf("renderStepTemplate() = %q, want %q", got, want)
+	}
+
+	got, err = r.renderTemplate(chain, `{{ .Run.Namespace }}/{{ .Run.Chain }} {{ .Run.TriggeredBy }} {{ .Run.StartedAt | date "2006-01-02" }} [{{ .Step.Name }}]`)
+	if err != nil {
+		t.Fatalf("renderTemplate() error = %v", err)
+	}
+	if want := "ai/recon schedule 2026-03-01 []"; got != want {
+		t.Errorf("renderTemplate() = %q, want %q", got, want)
+	}
+
+	if err := validateStepTemplates(chain); err != nil {
+		t.Errorf("validateStepTemplates() error = %v", err)
+	}
+}
+
+func TestChainTemplateFuncsExcludeUnsafe(t *testing.T) {
+	funcs := validationTemplateFuncs()
+	for _, name := range unsafeSprigFuncs {
+		if _, ok := funcs[name]; ok {
+			t.Errorf("template func %q must not be available to chain templates", name)
+		}
+	}
+}
+
+func TestCheckStepOutputSchema(t *testing.T) {
+	step := &aiv1alpha1.ChainStep{
+		Name: "scan",
+		OutputSchema: &runtime.RawExtension{Raw: []byte(`{
+			"type": "object",
+			"required": ["host", "openPorts"],
+			"properties": {
+				"host": {"type": "string"},
+				"openPorts": {"type": "array", "items": {"type": "integer"}}
+			}
+		}`)},
+	}
+
+	tests := []struct {
+		name    string
+		output  string
+		wantErr string
+	}{
+		{name: "matches", output: "```json\n{\"host\": \"web-1\", \"openPorts\": [22, 443]}\n```"},
+		{name: "missing field", output: `{"host": "web-1"}`, wantErr: "does not match outputSchema"},
+		{name: "wrong type", output: `{"host": "web-1", "openPorts": ["22"]}`, wantErr: "does not match outputSchema"},
+		{name: "not json", output: "Ports 22 and 443 are open.", wantErr: "not valid JSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkStepOutput(step, tt.output)
+			if (got == "") != (tt.wantErr == "") || !strings.Contains(got, tt.wantErr) {
+				t.Errorf("checkStepOutput() = %q, want error containing %q", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOutputSchemaRefsNotLoaded(t *testing.T) {
+	chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{{
+		Name:         "scan",
+		OutputSchema: &runtime.RawExtension{Raw: []byte(`{"$ref": "file:///etc/passwd"}`)},
+	}}}}
+	if err := validateOutputSchemas(chain); err == nil {
+		t.Error("validateOutputSchemas() with a file $ref expected error")
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// expandChainTemplate fills a chain that sets spec.templateRef with the
+// template's steps, handlers, and parameters. Only the in-memory object is
+// changed; the stored spec keeps just the reference.
+func (r *ChainReconciler) expandChainTemplate(ctx context.Context, chain *aiv1alpha1.Chain) error {
+	return expandTemplateRef(ctx, r.Client, chain)
+}
+
+// expandTemplateRef is expandChainTemplate reading the template with c.
+func expandTemplateRef(ctx context.Context, c client.Reader, chain *aiv1alpha1.Chain) error {
+	ref := chain.Spec.TemplateRef
+	if ref == nil {
+		if len(chain.Spec.Steps) == 0 {
+			return fmt.Errorf("chain has neither steps nor templateRef")
+		}
+		return nil
+	}
+	if len(chain.Spec.Steps) > 0 {
+		return fmt.Errorf("chain sets both steps and templateRef")
+	}
+
+	tmpl := &aiv1alpha1.ChainTemplate{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: chain.Namespace}, tmpl); err != nil {
+		return fmt.Errorf("chainTemplate %q: %w", ref.Name, err)
+	}
+	params, err := resolveParameters(tmpl.Spec.Parameters, ref.Parameters)
+	if err != nil {
+		return fmt.Errorf("chainTemplate %q: %w", ref.Name, err)
+	}
+
+	// The template's parameters become chain parameters defaulting to the
+	// values the chain supplied.
+	declared := make([]aiv1alpha1.ChainParameter, 0, len(tmpl.Spec.Parameters)+len(chain.Spec.Parameters))
+	for _, p := range tmpl.Spec.Parameters {
+		value := params[p.Name]
+		p.Default = &value
+		declared = append(declared, p)
+	}
+	for _, p := range chain.Spec.Parameters {
+		if _, ok := params[p.Name]; ok {
+			return fmt.Errorf("parameter %q is declared by both the chain and chainTemplate %q", p.Name, ref.Name)
+		}
+		declared = append(declared, p)
+	}
+
+	chain.Spec.Steps = tmpl.Spec.Steps
+	if len(chain.Spec.OnFailure) == 0 {
+		chain.Spec.OnFailure = tmpl.Spec.OnFailure
+	}
+	if len(chain.Spec.Finally) == 0 {
+		chain.Spec.Finally = tmpl.Spec.Finally
+	}
+	chain.Spec.Parameters = declared
+	return nil
+}
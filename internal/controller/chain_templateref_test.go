@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestExpandChainTemplate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add roundtable scheme: %v", err)
+	}
+	depth := "standard"
+	tmpl := &aiv1alpha1.ChainTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon-report", Namespace: "default"},
+		Spec: aiv1alpha1.ChainTemplateSpec{
+			Parameters: []aiv1alpha1.ChainParameter{
+				{Name: "target", Required: true},
+				{Name: "depth", Default: &depth},
+			},
+			Steps: []aiv1alpha1.ChainStep{
+				{Name: "recon", KnightRef: "tristan", Task: "Run a {{ .Params.depth }} recon of {{ .Params.target }}."},
+			},
+			Finally: []aiv1alpha1.ChainStep{{Name: "cleanup", KnightRef: "gawain", Task: "Clean up."}},
+		},
+	}
+	r := &ChainReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(tmpl).Build()}
+
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon-example", Namespace: "default"},
+		Spec: aiv1alpha1.ChainSpec{TemplateRef: &aiv1alpha1.ChainTemplateRef{
+			Name:       "recon-report",
+			Parameters: map[string]string{"target": "example.com"},
+		}},
+	}
+	if err := r.expandChainTemplate(context.Background(), chain); err != nil {
+		t.Fatalf("expandChainTemplate() error = %v", err)
+	}
+	if len(chain.Spec.Steps) != 1 || len(chain.Spec.Finally) != 1 {
+		t.Fatalf("expanded steps = %d, finally = %d, want 1 and 1", len(chain.Spec.Steps), len(chain.Spec.Finally))
+	}
+	got, err := r.renderTemplate(chain, chain.Spec.Steps[0].Task)
+	if err != nil {
+		t.Fatalf("renderTemplate() error = %v", err)
+	}
+	if want := "Run a standard recon of example.com."; got != want {
+		t.Errorf("renderTemplate() = %q, want %q", got, want)
+	}
+
+	tests := []struct {
+		name    string
+		spec    aiv1alpha1.ChainSpec
+		wantErr string
+	}{
+		{name: "no steps or template", spec: aiv1alpha1.ChainSpec{}, wantErr: "neither steps nor templateRef"},
+		{name: "steps and template", spec: aiv1alpha1.ChainSpec{
+			Steps:       []aiv1alpha1.ChainStep{{Name: "a", KnightRef: "gawain"}},
+			TemplateRef: &aiv1alpha1.ChainTemplateRef{Name: "recon-report"},
+		}, wantErr: "both steps and templateRef"},
+		{name: "missing template", spec: aiv1alpha1.ChainSpec{
+			TemplateRef: &aiv1alpha1.ChainTemplateRef{Name: "absent"},
+		}, wantErr: "not found"},
+		{name: "missing required parameter", spec: aiv1alpha1.ChainSpec{
+			TemplateRef: &aiv1alpha1.ChainTemplateRef{Name: "recon-report"},
+		}, wantErr: "missing required parameters: [target]"},
+		{name: "unknown parameter", spec: aiv1alpha1.ChainSpec{
+			TemplateRef: &aiv1alpha1.ChainTemplateRef{Name: "recon-report", Parameters: map[string]string{"target": "x", "port": "22"}},
+		}, wantErr: "unknown parameters: [port]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &aiv1alpha1.Chain{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "default"}, Spec: tt.spec}
+			err := r.expandChainTemplate(context.Background(), chain)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expandChainTemplate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// compileOutputTransform compiles a step's outputTransform expression,
+// with the CEL string extensions (split, trim, ...) available.
+func compileOutputTransform(expr string) (cel.Program, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("output", cel.StringType),
+		cel.Variable("json", cel.DynType),
+		ext.Strings(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+	return env.Program(ast)
+}
+
+// validateOutputTransforms checks every step's outputTransform compiles.
+func validateOutputTransforms(chain *aiv1alpha1.Chain) error {
+	for _, step := range allChainSteps(chain) {
+		if step.OutputTransform == "" {
+			continue
+		}
+		if _, err := compileOutputTransform(step.OutputTransform); err != nil {
+			return fmt.Errorf("step %q has invalid outputTransform: %w", step.Name, err)
+		}
+	}
+	return nil
+}
+
+// transformStepOutput applies the step's outputTransform to a successful
+// result. A string result is returned as is; anything else is encoded as
+// JSON.
+func transformStepOutput(step *aiv1alpha1.ChainStep, output string) (string, error) {
+	if step == nil || step.OutputTransform == "" {
+		return output, nil
+	}
+	prg, err := compileOutputTransform(step.OutputTransform)
+	if err != nil {
+		return "", err
+	}
+	var parsed interface{}
+	if v, err := parseStepJSON(output); err == nil {
+		parsed = v
+	}
+	val, _, err := prg.Eval(map[string]interface{}{"output": output, "json": parsed})
+	if err != nil {
+		return "", err
+	}
+	if s, ok := val.Value().(string); ok {
+		return s, nil
+	}
+	native, err := val.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return "", fmt.Errorf("result is not JSON-encodable: %w", err)
+	}
+	data, err := protojson.Marshal(native.(*structpb.Value))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"testing"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestTransformStepOutput(t *testing.T) {
+	const result = "```json\n{\"host\": \"10.0.0.5\", \"findings\": [{\"id\": \"CVE-1\"}], \"noise\": \"x\"}\n```"
+	tests := []struct {
+		name      string
+		transform string
+		output    string
+		want      string
+		wantErr   bool
+	}{
+		{name: "none", output: result, want: result},
+		{name: "JSON field", transform: "json.findings", output: result, want: `[{"id":"CVE-1"}]`},
+		{name: "string field", transform: "json.host", output: result, want: "10.0.0.5"},
+		{name: "text output", transform: `output.split("\n")[0]`, output: "first\nsecond", want: "first"},
+		{name: "map", transform: `{"count": size(json.findings)}`, output: result, want: `{"count":1}`},
+		{name: "missing field", transform: "json.missing", output: result, wantErr: true},
+		{name: "not JSON", transform: "json.findings", output: "plain text", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transformStepOutput(&aiv1alpha1.ChainStep{Name: "s", OutputTransform: tt.transform}, tt.output)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("transformStepOutput() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("transformStepOutput() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateOutputTransforms(t *testing.T) {
+	chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{
+		{Name: "scan", OutputTransform: "json.findings"},
+	}}}
+	if err := validateOutputTransforms(chain); err != nil {
+		t.Errorf("validateOutputTransforms() error = %v", err)
+	}
+	chain.Spec.Steps[0].OutputTransform = "json.findings["
+	if err := validateOutputTransforms(chain); err == nil {
+		t.Error("validateOutputTransforms() accepted an expression that does not parse")
+	}
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// Values of ChainStatus.TriggeredBy for non-event triggers.
+const (
+	triggeredBySchedule = "schedule"
+	triggeredByManual   = "manual"
+)
+
+// startRun begins a new run of the chain, recording what triggered it and
+// the input it supplied. It fails, leaving the chain unchanged, if the run's
+// parameters are invalid.
+func (r *ChainReconciler) startRun(chain *aiv1alpha1.Chain, triggeredBy, input string) error {
+	if err := resolveRunParameters(chain); err != nil {
+		return err
+	}
+	r.initStepStatuses(chain)
+	// A new run gets its own completion notification.
+	meta.RemoveStatusCondition(&chain.Status.Conditions, aiv1alpha1.ConditionNotificationSent)
+	now := metav1.Now()
+	chain.Status.RunID = string(uuid.NewUUID())
+	chain.Status.Phase = aiv1alpha1.ChainPhaseRunning
+	chain.Status.StartedAt = &now
+	chain.Status.CompletedAt = nil
+	chain.Status.TriggeredBy = triggeredBy
+	chain.Status.Input = input
+	return nil
+}
+
+// runInput returns the input of the current run: the trigger's, else
+// spec.input.
+func runInput(chain *aiv1alpha1.Chain) string {
+	if chain.Status.Input != "" {
+		return chain.Status.Input
+	}
+	return chain.Spec.Input
+}
+
+// reconcileTriggers checks the event triggers of a chain that is not
+// running, starting a run if one fired.
+func (r *ChainReconciler) reconcileTriggers(ctx context.Context, chain *aiv1alpha1.Chain) (ctrl.Result, error) {
+	triggers := chain.Spec.Triggers
+	if triggers == nil || triggers.NATS == nil {
+		if r.dropStaleTriggerConsumer(ctx, chain, "", "") {
+			if err := r.Status().Update(ctx, chain); err != nil {
+				if apierrors.IsConflict(err) {
+					return ctrl.Result{Requeue: true}, nil
+				}
+				return ctrl.Result{}, err
+			}
+		}
+	}
+	if triggers == nil {
+		return ctrl.Result{}, nil
+	}
+	if triggers.ChainRef != nil {
+		started, err := r.reconcileChainRefTrigger(ctx, chain, triggers.ChainRef)
+		if err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, err
+		}
+		if started {
+			return ctrl.Result{RequeueAfter: RequeueFast}, nil
+		}
+	}
+	if triggers.NATS != nil {
+		return r.reconcileNATSTrigger(ctx, chain, triggers.NATS)
+	}
+	return ctrl.Result{}, nil
+}
+
+// reconcileChainRefTrigger starts a run for the earliest upstream run that
+// succeeded and hasn't been handled yet. It reports whether a run started.
+func (r *ChainReconciler) reconcileChainRefTrigger(ctx context.Context, chain *aiv1alpha1.Chain, trigger *aiv1alpha1.ChainRefTrigger) (bool, error) {
+	upstreams, err := r.upstreamChains(ctx, chain, trigger)
+	if err != nil {
+		return false, err
+	}
+
+	var next *aiv1alpha1.Chain
+	for i := range upstreams {
+		u := &upstreams[i]
+		if u.Status.Phase != aiv1alpha1.ChainPhaseSucceeded || u.Status.RunID == "" || u.Status.CompletedAt == nil ||
+			!u.Status.CompletedAt.After(chain.CreationTimestamp.Time) ||
+			chain.Status.UpstreamRuns[u.Name] == u.Status.RunID {
+			continue
+		}
+		if next == nil || u.Status.CompletedAt.Before(next.Status.CompletedAt) {
+			next = u
+		}
+	}
+	if next == nil {
+		return false, nil
+	}
+
+	if chain.Status.UpstreamRuns == nil {
+		chain.Status.UpstreamRuns = make(map[string]string)
+	}
+	chain.Status.UpstreamRuns[next.Name] = next.Status.RunID
+
+	started := true
+	if err := r.startRun(chain, "chain:"+next.Name, r.upstreamRunInput(ctx, next)); err != nil {
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "InvalidParameters",
+			"Skipped trigger by chain %s: %v", next.Name, err)
+		started = false
+	}
+	chain.Status.ObservedGeneration = chain.Generation
+	if err := r.Status().Update(ctx, chain); err != nil {
+		return false, err
+	}
+	if started {
+		r.Recorder.Eventf(chain, corev1.EventTypeNormal, "ChainTriggered",
+			"Chain triggered by run %s of chain %s", next.Status.RunID, next.Name)
+	}
+	return started, nil
+}
+
+// upstreamChains returns the chains a chainRef trigger listens to, never
+// including the chain itself.
+func (r *ChainReconciler) upstreamChains(ctx context.Context, chain *aiv1alpha1.Chain, trigger *aiv1alpha1.ChainRefTrigger) ([]aiv1alpha1.Chain, error) {
+	if trigger.Name != "" {
+		if trigger.Name == chain.Name {
+			return nil, nil
+		}
+		upstream := &aiv1alpha1.Chain{}
+		if err := r.Get(ctx, types.NamespacedName{Name: trigger.Name, Namespace: chain.Namespace}, upstream); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		return []aiv1alpha1.Chain{*upstream}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(trigger.Selector)
+	if err != nil {
+		return nil, err
+	}
+	list := &aiv1alpha1.ChainList{}
+	if err := r.List(ctx, list, client.InNamespace(chain.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	upstreams := make([]aiv1alpha1.Chain, 0, len(list.Items))
+	for _, u := range list.Items {
+		if u.Name != chain.Name {
+			upstreams = append(upstreams, u)
+		}
+	}
+	return upstreams, nil
+}
+
+// upstreamRunInput builds the input handed to a chain triggered by an
+// upstream run: its name, run ID, and each succeeded step's full output.
+func (r *ChainReconciler) upstreamRunInput(ctx context.Context, upstream *aiv1alpha1.Chain) string {
+	outputs := make(map[string]string)
+	for _, ss := range upstream.Status.StepStatuses {
+		if ss.Phase != aiv1alpha1.ChainStepPhaseSucceeded {
+			continue
+		}
+		output, err := r.stepArtifact(upstream, ss.Name)
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Using output preview of upstream step", "chain", upstream.Name, "step", ss.Name)
+			output = ss.Output
+		}
+		outputs[ss.Name] = output
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"chain":   upstream.Name,
+		"runId":   upstream.Status.RunID,
+		"outputs": outputs,
+	})
+	return string(data)
+}
+
+// reconcileNATSTrigger pulls the next message from the trigger's durable
+// consumer and starts a run if it passes the filter. The consumer is created
+// on first use, delivering only messages published from then on, and
+// recorded in status so it is deleted when the trigger changes. A message is
+// acknowledged once its run is recorded, so it is redelivered if the status
+// update fails.
+func (r *ChainReconciler) reconcileNATSTrigger(ctx context.Context, chain *aiv1alpha1.Chain, trigger *aiv1alpha1.NATSTrigger) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	// The shared connection reaches every fleet's tasks and results, so a
+	// trigger only consumes subjects the operator allows.
+	if !r.natsTriggerSubjectAllowed(trigger.Subject) {
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "NATSTriggerFailed",
+			"NATS trigger: subject %s does not match an allowed subject prefix", trigger.Subject)
+		if r.dropStaleTriggerConsumer(ctx, chain, "", "") {
+			return r.updateTriggerConsumer(ctx, chain, RequeueSlow)
+		}
+		return ctrl.Result{RequeueAfter: RequeueSlow}, nil
+	}
+
+	client, err := r.natsClient()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	stream := trigger.Stream
+	if stream == "" {
+		if stream, err = client.StreamNameBySubject(trigger.Subject); err != nil {
+			r.Recorder.Eventf(chain, corev1.EventTypeWarning, "NATSTriggerFailed", "NATS trigger: %v", err)
+			return ctrl.Result{RequeueAfter: RequeueSlow}, nil
+		}
+	}
+	stale := r.dropStaleTriggerConsumer(ctx, chain, stream, trigger.Subject)
+	consumer := natspkg.ChainTriggerConsumerName(chain.Namespace, chain.Name)
+	if err := client.EnsureConsumer(stream, consumer, natspkg.ConsumerConfig{
+		FilterSubject: trigger.Subject,
+		AckPolicy:     natspkg.AckExplicit,
+		DeliverPolicy: natspkg.DeliverNew,
+	}); err != nil {
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "NATSTriggerFailed", "NATS trigger: %v", err)
+		if stale {
+			return r.updateTriggerConsumer(ctx, chain, RequeueSlow)
+		}
+		return ctrl.Result{RequeueAfter: RequeueSlow}, nil
+	}
+	if chain.Status.TriggerConsumer == nil {
+		chain.Status.TriggerConsumer = &aiv1alpha1.NATSTriggerConsumer{Stream: stream, Subject: trigger.Subject}
+		return r.updateTriggerConsumer(ctx, chain, RequeueFast)
+	}
+
+	msg, err := client.FetchMessage(stream, consumer, RequeueFast)
+	if err != nil {
+		log.Error(err, "Failed to fetch NATS trigger message", "subject", trigger.Subject)
+		return ctrl.Result{RequeueAfter: RequeueSlow}, nil
+	}
+	if msg == nil {
+		return ctrl.Result{RequeueAfter: RequeueDefault}, nil
+	}
+
+	ack := func() {
+		if err := msg.Ack(); err != nil {
+			log.Error(err, "Failed to ack NATS trigger message", "subject", msg.Subject)
+		}
+	}
+
+	match, err := natsTriggerMatches(trigger.Filter, msg.Subject, msg.Data)
+	if err != nil {
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "NATSTriggerFailed",
+			"Dropped message on %s: filter failed: %v", msg.Subject, err)
+	}
+	if !match {
+		ack()
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if err := r.startRun(chain, "nats:"+msg.Subject, string(msg.Data)); err != nil {
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "InvalidParameters",
+			"Dropped message on %s: %v", msg.Subject, err)
+		ack()
+		return ctrl.Result{Requeue: true}, nil
+	}
+	chain.Status.ObservedGeneration = chain.Generation
+	if err := r.Status().Update(ctx, chain); err != nil {
+		_ = msg.Nak()
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	ack()
+	r.Recorder.Eventf(chain, corev1.EventTypeNormal, "NATSTriggered", "Chain triggered by message on %s", msg.Subject)
+	return ctrl.Result{RequeueAfter: RequeueFast}, nil
+}
+
+// natsTriggerMatches evaluates a NATS trigger filter against a message. An
+// empty filter matches every message.
+func natsTriggerMatches(filter, subject string, data []byte) (bool, error) {
+	if filter == "" {
+		return true, nil
+	}
+	tmpl, err := template.New("filter").Funcs(validationTemplateFuncs()).Parse(filter)
+	if err != nil {
+		return false, err
+	}
+
+	var parsed interface{} = map[string]interface{}{}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		parsed = map[string]interface{}{}
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]interface{}{
+		"Subject": subject,
+		"Data":    string(data),
+		"JSON":    parsed,
+	}); err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(buf.String()) == "true", nil
+}
+
+// validateTriggers checks the NATS trigger filter parses and the chainRef
+// trigger names its upstream chains in exactly one way.
+func validateTriggers(chain *aiv1alpha1.Chain) error {
+	triggers := chain.Spec.Triggers
+	if triggers == nil {
+		return nil
+	}
+	if triggers.NATS != nil {
+		if _, err := template.New("filter").Funcs(validationTemplateFuncs()).Parse(triggers.NATS.Filter); err != nil {
+			return fmt.Errorf("triggers.nats.filter is not a valid template: %w", err)
+		}
+	}
+	if ref := triggers.ChainRef; ref != nil {
+		if (ref.Name == "") == (ref.Selector == nil) {
+			return fmt.Errorf("triggers.chainRef must set exactly one of name and selector")
+		}
+		if ref.Name == chain.Name {
+			return fmt.Errorf("triggers.chainRef names the chain itself")
+		}
+		if ref.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(ref.Selector); err != nil {
+				return fmt.Errorf("triggers.chainRef has invalid selector: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// natsTriggerSubjectAllowed reports whether a NATS trigger may consume
+// subject. With no prefixes configured every subject is rejected.
+func (r *ChainReconciler) natsTriggerSubjectAllowed(subject string) bool {
+	for _, prefix := range r.NATSTriggerAllowedSubjectPrefixes {
+		if prefix != "" && strings.HasPrefix(subject, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// dropStaleTriggerConsumer deletes the trigger consumer recorded in status
+// unless it reads subject from stream, clearing the record. It reports
+// whether the status changed; a consumer that can't be reached stays
+// recorded for the next attempt.
+func (r *ChainReconciler) dropStaleTriggerConsumer(ctx context.Context, chain *aiv1alpha1.Chain, stream, subject string) bool {
+	recorded := chain.Status.TriggerConsumer
+	if recorded == nil || (recorded.Stream == stream && recorded.Subject == subject) {
+		return false
+	}
+	client, err := r.natsClient()
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Cannot delete stale NATS trigger consumer")
+		return false
+	}
+	_ = client.DeleteConsumer(recorded.Stream, natspkg.ChainTriggerConsumerName(chain.Namespace, chain.Name))
+	chain.Status.TriggerConsumer = nil
+	return true
+}
+
+// updateTriggerConsumer saves a change to status.triggerConsumer.
+func (r *ChainReconciler) updateTriggerConsumer(ctx context.Context, chain *aiv1alpha1.Chain, requeue time.Duration) (ctrl.Result, error) {
+	if err := r.Status().Update(ctx, chain); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: requeue}, nil
+}
+
+// deleteNATSTriggerConsumer removes a deleted chain's trigger consumer.
+// Best-effort: a consumer left behind only holds undelivered messages.
+func (r *ChainReconciler) deleteNATSTriggerConsumer(ctx context.Context, chain *aiv1alpha1.Chain) {
+	if chain.Status.TriggerConsumer == nil && (chain.Spec.Triggers == nil || chain.Spec.Triggers.NATS == nil) {
+		return
+	}
+	log := logf.FromContext(ctx)
+	client, err := r.natsClient()
+	if err != nil {
+		log.Error(err, "Cannot delete NATS trigger consumer")
+		return
+	}
+	consumer := natspkg.ChainTriggerConsumerName(chain.Namespace, chain.Name)
+	if recorded := chain.Status.TriggerConsumer; recorded != nil {
+		_ = client.DeleteConsumer(recorded.Stream, consumer)
+		return
+	}
+	stream := chain.Spec.Triggers.NATS.Stream
+	if stream == "" {
+		if stream, err = client.StreamNameBySubject(chain.Spec.Triggers.NATS.Subject); err != nil {
+			log.Error(err, "Cannot delete NATS trigger consumer")
+			return
+		}
+	}
+	_ = client.DeleteConsumer(stream, consumer)
+}
@@ -0,0 +1,233 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestNATSTriggerMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		data    string
+		want    bool
+		wantErr bool
+	}{
+		{name: "no filter", data: "anything", want: true},
+		{name: "json field", filter: `{{ eq .JSON.severity "critical" }}`, data: `{"severity": "critical"}`, want: true},
+		{name: "json field mismatch", filter: `{{ eq .JSON.severity "critical" }}`, data: `{"severity": "low"}`, want: false},
+		{name: "subject", filter: `{{ hasSuffix ".high" .Subject }}`, data: "x", want: true},
+		{name: "raw data", filter: `{{ contains "CVE" .Data }}`, data: "New CVE published", want: true},
+		{name: "not json", filter: `{{ eq (.JSON.severity | default "") "critical" }}`, data: "plain text", want: false},
+		{name: "error", filter: `{{ fail "boom" }}`, data: "x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := natsTriggerMatches(tt.filter, "alerts.security.high", []byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("natsTriggerMatches() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("natsTriggerMatches() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStartRunInput(t *testing.T) {
+	r := &ChainReconciler{Recorder: record.NewFakeRecorder(10)}
+	chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{
+		Input: "default input",
+		Steps: []aiv1alpha1.ChainStep{{Name: "triage", KnightRef: "galahad"}},
+	}}
+
+	if err := r.startRun(chain, "nats:alerts.security.high", `{"severity": "critical"}`); err != nil {
+		t.Fatalf("startRun() error = %v", err)
+	}
+	if chain.Status.Phase != aiv1alpha1.ChainPhaseRunning || chain.Status.RunID == "" || len(chain.Status.StepStatuses) != 1 {
+		t.Fatalf("startRun() status = %+v, want a new Running run", chain.Status)
+	}
+	if got := runInput(chain); got != `{"severity": "critical"}` {
+		t.Errorf("runInput() = %q, want the message payload", got)
+	}
+
+	if err := r.startRun(chain, triggeredBySchedule, ""); err != nil {
+		t.Fatalf("startRun() error = %v", err)
+	}
+	if got := runInput(chain); got != "default input" {
+		t.Errorf("runInput() = %q, want spec.input", got)
+	}
+
+	chain.Spec.Parameters = []aiv1alpha1.ChainParameter{{Name: "target", Required: true}}
+	runID := chain.Status.RunID
+	if err := r.startRun(chain, triggeredBySchedule, ""); err == nil {
+		t.Error("startRun() with a missing required parameter expected error")
+	}
+	if chain.Status.RunID != runID {
+		t.Error("startRun() changed the status of a rejected run")
+	}
+}
+
+func TestReconcileChainRefTrigger(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add roundtable scheme: %v", err)
+	}
+	created := metav1.NewTime(time.Now().Add(-time.Hour))
+	completed := metav1.NewTime(time.Now())
+	upstream := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default"},
+		Status: aiv1alpha1.ChainStatus{
+			Phase:       aiv1alpha1.ChainPhaseSucceeded,
+			RunID:       "run-1",
+			CompletedAt: &completed,
+			StepStatuses: []aiv1alpha1.ChainStepStatus{
+				{Name: "scan", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Output: "22/tcp open"},
+				{Name: "enrich", Phase: aiv1alpha1.ChainStepPhaseSkipped},
+			},
+		},
+	}
+	downstream := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "report", Namespace: "default", CreationTimestamp: created},
+		Spec: aiv1alpha1.ChainSpec{
+			Steps:    []aiv1alpha1.ChainStep{{Name: "write", KnightRef: "gawain"}},
+			Triggers: &aiv1alpha1.ChainTriggers{ChainRef: &aiv1alpha1.ChainRefTrigger{Name: "recon"}},
+		},
+		Status: aiv1alpha1.ChainStatus{Phase: aiv1alpha1.ChainPhaseIdle},
+	}
+	r := &ChainReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithStatusSubresource(&aiv1alpha1.Chain{}).
+			WithObjects(upstream, downstream).Build(),
+		Recorder: record.NewFakeRecorder(10),
+	}
+
+	started, err := r.reconcileChainRefTrigger(context.Background(), downstream, downstream.Spec.Triggers.ChainRef)
+	if err != nil || !started {
+		t.Fatalf("reconcileChainRefTrigger() = %t, %v, want a started run", started, err)
+	}
+	if downstream.Status.TriggeredBy != "chain:recon" || downstream.Status.UpstreamRuns["recon"] != "run-1" {
+		t.Errorf("status = triggeredBy %q, upstreamRuns %v", downstream.Status.TriggeredBy, downstream.Status.UpstreamRuns)
+	}
+	if want := `{"chain":"recon","outputs":{"scan":"22/tcp open"},"runId":"run-1"}`; runInput(downstream) != want {
+		t.Errorf("runInput() = %s, want %s", runInput(downstream), want)
+	}
+
+	// The same upstream run never triggers twice.
+	downstream.Status.Phase = aiv1alpha1.ChainPhaseSucceeded
+	started, err = r.reconcileChainRefTrigger(context.Background(), downstream, downstream.Spec.Triggers.ChainRef)
+	if err != nil || started {
+		t.Errorf("reconcileChainRefTrigger() for a handled run = %t, %v, want no run", started, err)
+	}
+}
+
+func TestValidateChainRefTrigger(t *testing.T) {
+	tests := []struct {
+		name    string
+		trigger aiv1alpha1.ChainRefTrigger
+		wantErr bool
+	}{
+		{name: "name", trigger: aiv1alpha1.ChainRefTrigger{Name: "recon"}},
+		{name: "selector", trigger: aiv1alpha1.ChainRefTrigger{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "red"}}}},
+		{name: "neither", wantErr: true},
+		{name: "both", trigger: aiv1alpha1.ChainRefTrigger{Name: "recon", Selector: &metav1.LabelSelector{}}, wantErr: true},
+		{name: "itself", trigger: aiv1alpha1.ChainRefTrigger{Name: "report"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &aiv1alpha1.Chain{
+				ObjectMeta: metav1.ObjectMeta{Name: "report"},
+				Spec:       aiv1alpha1.ChainSpec{Triggers: &aiv1alpha1.ChainTriggers{ChainRef: &tt.trigger}},
+			}
+			if err := validateTriggers(chain); (err != nil) != tt.wantErr {
+				t.Errorf("validateTriggers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReconcileNATSTrigger(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add roundtable scheme: %v", err)
+	}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "triage", Namespace: "default"},
+		Spec: aiv1alpha1.ChainSpec{
+			Steps: []aiv1alpha1.ChainStep{{Name: "triage", KnightRef: "galahad"}},
+			Triggers: &aiv1alpha1.ChainTriggers{NATS: &aiv1alpha1.NATSTrigger{
+				Subject: "alerts.security.>", Stream: "alerts",
+			}},
+		},
+		Status: aiv1alpha1.ChainStatus{Phase: aiv1alpha1.ChainPhaseIdle},
+	}
+	nc := newFakeNATSClient()
+	r := &ChainReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithStatusSubresource(&aiv1alpha1.Chain{}).
+			WithObjects(chain).Build(),
+		Recorder:                          record.NewFakeRecorder(10),
+		NATS:                              natspkg.NewProviderWithClient(nc, logr.Discard()),
+		NATSTriggerAllowedSubjectPrefixes: []string{"alerts."},
+	}
+	ctx := context.Background()
+	consumer := natspkg.ChainTriggerConsumerName("default", "triage")
+
+	if _, err := r.reconcileTriggers(ctx, chain); err != nil {
+		t.Fatalf("reconcileTriggers() error = %v", err)
+	}
+	if rec := chain.Status.TriggerConsumer; rec == nil || rec.Stream != "alerts" || rec.Subject != "alerts.security.>" {
+		t.Fatalf("triggerConsumer = %+v, want the alerts consumer recorded", rec)
+	}
+
+	// Another fleet's results are off limits.
+	chain.Spec.Triggers.NATS = &aiv1alpha1.NATSTrigger{Subject: "fleet-b.results.>", Stream: "fleet_b_results"}
+	if _, err := r.reconcileTriggers(ctx, chain); err != nil {
+		t.Fatalf("reconcileTriggers() error = %v", err)
+	}
+	if chain.Status.TriggerConsumer != nil || len(nc.ensured) != 0 {
+		t.Errorf("triggerConsumer = %+v, consumers %v, want the old consumer deleted and none created",
+			chain.Status.TriggerConsumer, nc.ensured)
+	}
+	if events := drainEvents(r.Recorder.(*record.FakeRecorder)); !strings.Contains(strings.Join(events, "\n"), "NATSTriggerFailed") {
+		t.Errorf("events = %v, want NATSTriggerFailed", events)
+	}
+
+	// Editing the subject replaces the consumer.
+	chain.Spec.Triggers.NATS = &aiv1alpha1.NATSTrigger{Subject: "alerts.security.>", Stream: "alerts"}
+	if _, err := r.reconcileTriggers(ctx, chain); err != nil {
+		t.Fatalf("reconcileTriggers() error = %v", err)
+	}
+	nc.deleted = nil
+	chain.Spec.Triggers.NATS.Subject = "alerts.network.>"
+	if _, err := r.reconcileTriggers(ctx, chain); err != nil {
+		t.Fatalf("reconcileTriggers() error = %v", err)
+	}
+	if len(nc.deleted) != 1 || nc.deleted[0] != "alerts/"+consumer ||
+		chain.Status.TriggerConsumer == nil || chain.Status.TriggerConsumer.Subject != "alerts.network.>" {
+		t.Errorf("deleted %v, triggerConsumer %+v, want the consumer recreated for the new subject",
+			nc.deleted, chain.Status.TriggerConsumer)
+	}
+
+	// Removing the trigger deletes its consumer.
+	chain.Spec.Triggers = nil
+	if _, err := r.reconcileTriggers(ctx, chain); err != nil {
+		t.Fatalf("reconcileTriggers() error = %v", err)
+	}
+	if chain.Status.TriggerConsumer != nil || len(nc.ensured) != 0 {
+		t.Errorf("triggerConsumer = %+v, consumers %v, want none after the trigger is removed",
+			chain.Status.TriggerConsumer, nc.ensured)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// ValidateChainSpec runs the checks on a Chain spec that need no cluster
+// state: parameters, input source, executors, retry matchers, step graph,
+// templates, triggers, output schemas and transforms, and schedule. The
+// validating webhook uses it to reject a broken Chain at apply time; the
+// reconciler still runs the same checks in case the webhook is not
+// installed. Steps from a templateRef are expanded, and checked, only by
+// the reconciler.
+func ValidateChainSpec(chain *aiv1alpha1.Chain) error {
+	var errs []error
+	for _, check := range []func(*aiv1alpha1.Chain) error{
+		validateParameters,
+		validateInput,
+		validateExecutors,
+		validateRetryPolicies,
+		validateStepLists,
+		validateStepTemplates,
+		validateStepNotifications,
+		validateTriggers,
+		validateOutputSchemas,
+		validateOutputTransforms,
+		validateSchedule,
+	} {
+		if err := check(chain); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateSchedule checks spec.schedule parses as a standard cron expression
+// in spec.timeZone.
+func validateSchedule(chain *aiv1alpha1.Chain) error {
+	if chain.Spec.Schedule == "" {
+		return nil
+	}
+	spec, err := chainCronSpec(chain)
+	if err != nil {
+		return err
+	}
+	if _, err := cron.ParseStandard(spec); err != nil {
+		return fmt.Errorf("invalid schedule %q: %w", chain.Spec.Schedule, err)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	knightpkg "github.com/dapperdivers/roundtable/internal/knight"
+)
+
+// vaultMountPath is where knight pods mount the shared vault.
+const vaultMountPath = "/vault"
+
+// renderVaultPath renders a step's vaultPath and checks it stays inside
+// the vault.
+func renderVaultPath(chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, now time.Time) (string, error) {
+	tmpl, err := template.New("vaultPath").Parse(step.VaultPath)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]string{
+		"Date":  now.UTC().Format("2006-01-02"),
+		"Chain": chain.Name,
+		"Step":  step.Name,
+		"RunID": chain.Status.RunID,
+	}); err != nil {
+		return "", err
+	}
+
+	notePath := path.Clean(buf.String())
+	if path.IsAbs(notePath) || notePath == ".." || strings.HasPrefix(notePath, "../") {
+		return "", fmt.Errorf("vaultPath %q is outside the vault", notePath)
+	}
+	if !strings.HasSuffix(notePath, ".md") {
+		notePath += ".md"
+	}
+	return notePath, nil
+}
+
+// vaultWritable reports whether a knight with this vault mount can write
+// the note at notePath.
+func vaultWritable(vault *aiv1alpha1.KnightVault, notePath string) bool {
+	if vault == nil {
+		return false
+	}
+	if !vault.ReadOnly {
+		return true
+	}
+	for _, wp := range vault.WritablePaths {
+		if strings.HasPrefix(notePath, strings.TrimSuffix(wp, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// vaultNote formats a step output as a Markdown note with frontmatter that
+// Obsidian can query.
+func vaultNote(chain *aiv1alpha1.Chain, ss *aiv1alpha1.ChainStepStatus, output string, now time.Time) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "chain: %s\n", chain.Name)
+	fmt.Fprintf(&b, "step: %s\n", ss.Name)
+	fmt.Fprintf(&b, "runId: %s\n", chain.Status.RunID)
+	if ss.Knight != "" {
+		fmt.Fprintf(&b, "knight: %s\n", ss.Knight)
+	}
+	fmt.Fprintf(&b, "date: %s\n", now.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "tags: [roundtable, chain/%s]\n", chain.Name)
+	b.WriteString("---\n\n")
+	fmt.Fprintf(&b, "# %s / %s\n\n", chain.Name, ss.Name)
+	b.WriteString(output)
+	if !strings.HasSuffix(output, "\n") {
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// writeVaultNote dispatches a task asking the outputKnight to write the
+// step's output as a note at its vaultPath.
+func (r *ChainReconciler) writeVaultNote(ctx context.Context, nc natsConfig, chain *aiv1alpha1.Chain, step *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus, output string) (string, error) {
+	now := time.Now()
+	notePath, err := renderVaultPath(chain, step, now)
+	if err != nil {
+		return "", err
+	}
+	knight, err := r.outputKnight(ctx, chain)
+	if err != nil {
+		return "", err
+	}
+	if !vaultWritable(knightpkg.InheritedVault(ctx, r.Client, knight), notePath) {
+		return "", fmt.Errorf("output knight %q cannot write %s to the vault", knight.Name, notePath)
+	}
+	filePath := vaultMountPath + "/" + notePath
+	return filePath, r.writeArtifact(ctx, nc, chain, step.Name, filePath, vaultNote(chain, ss, output, now))
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestRenderVaultPath(t *testing.T) {
+	chain := &aiv1alpha1.Chain{Status: aiv1alpha1.ChainStatus{RunID: "run-1"}}
+	chain.Name = "recon"
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		vaultPath string
+		want      string
+		wantErr   bool
+	}{
+		{vaultPath: "Roundtable/Chains/{{ .Chain }}/{{ .Date }}-{{ .Step }}", want: "Roundtable/Chains/recon/2026-03-01-scan.md"},
+		{vaultPath: "Roundtable/{{ .RunID }}.md", want: "Roundtable/run-1.md"},
+		{vaultPath: "/etc/passwd", wantErr: true},
+		{vaultPath: "Roundtable/../../escape", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := renderVaultPath(chain, &aiv1alpha1.ChainStep{Name: "scan", VaultPath: tt.vaultPath}, now)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("renderVaultPath(%q) = %q, %v", tt.vaultPath, got, err)
+		}
+	}
+}
+
+func TestVaultWritable(t *testing.T) {
+	vault := &aiv1alpha1.KnightVault{ReadOnly: true, WritablePaths: []string{"Briefings/", "Roundtable"}}
+	if !vaultWritable(vault, "Roundtable/Chains/recon.md") {
+		t.Error("vaultWritable() = false for a note under a writable path")
+	}
+	if vaultWritable(vault, "Private/recon.md") || vaultWritable(nil, "Roundtable/recon.md") {
+		t.Error("vaultWritable() = true for a read-only path")
+	}
+	if !vaultWritable(&aiv1alpha1.KnightVault{}, "Private/recon.md") {
+		t.Error("vaultWritable() = false for a writable vault")
+	}
+}
+
+func TestVaultNote(t *testing.T) {
+	chain := &aiv1alpha1.Chain{Status: aiv1alpha1.ChainStatus{RunID: "run-1"}}
+	chain.Name = "recon"
+	note := vaultNote(chain, &aiv1alpha1.ChainStepStatus{Name: "scan", Knight: "galahad"}, "22/tcp open", time.Now())
+	if !strings.HasPrefix(note, "---\nchain: recon\nstep: scan\nrunId: run-1\nknight: galahad\n") || !strings.HasSuffix(note, "# recon / scan\n\n22/tcp open\n") {
+		t.Errorf("vaultNote() = %q", note)
+	}
+}
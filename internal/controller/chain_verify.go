@@ -0,0 +1,144 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// validateVerify checks verify is only set on steps that produce an output
+// for the judge.
+func validateVerify(step *aiv1alpha1.ChainStep) error {
+	if step.Verify != nil && (isApprovalStep(step) || isInputStep(step)) {
+		return fmt.Errorf("step %q sets verify but is a %s step", step.Name, step.Type)
+	}
+	return nil
+}
+
+// needsVerification reports whether a successful result still has to be
+// judged before the step succeeds.
+func needsVerification(step *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus) bool {
+	return step != nil && step.Verify != nil && !ss.Verifying
+}
+
+// verificationTask is the prompt sent to the judge knight.
+func verificationTask(step *aiv1alpha1.ChainStep, output string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "You are verifying the output of the chain step %q.\n\n", step.Name)
+	fmt.Fprintf(&b, "## Criteria\n\n%s\n\n", step.Verify.Criteria)
+	fmt.Fprintf(&b, "## Output\n\n%s\n\n", output)
+	b.WriteString(`Reply with only a JSON object: {"pass": true or false, "reason": "<one sentence>"}`)
+	return b.String()
+}
+
+// parseVerdict reads the judge's answer. The JSON object asked for is
+// preferred; a reply starting with PASS or FAIL is accepted too.
+func parseVerdict(output string) (pass bool, reason string, err error) {
+	if parsed, jsonErr := parseStepJSON(output); jsonErr == nil {
+		if obj, ok := parsed.(map[string]interface{}); ok {
+			if p, ok := obj["pass"].(bool); ok {
+				reason, _ := obj["reason"].(string)
+				return p, reason, nil
+			}
+		}
+	}
+	trimmed := strings.TrimSpace(output)
+	upper := strings.ToUpper(trimmed)
+	for _, verdict := range []string{"PASS", "FAIL"} {
+		if strings.HasPrefix(upper, verdict) {
+			reason := strings.TrimLeft(trimmed[len(verdict):], " :.-\n")
+			return verdict == "PASS", reason, nil
+		}
+	}
+	return false, "", fmt.Errorf("judge returned no verdict")
+}
+
+// startVerification publishes the judge task for a successful result and
+// keeps the step Running until the verdict arrives. The candidate output is
+// recorded now so the verdict can be applied after an operator restart.
+func (r *ChainReconciler) startVerification(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, step *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus, output string) error {
+	judge := &aiv1alpha1.Knight{}
+	if err := r.Get(ctx, types.NamespacedName{Name: step.Verify.JudgeKnightRef, Namespace: chain.Namespace}, judge); err != nil {
+		return fmt.Errorf("get judge knight %q: %w", step.Verify.JudgeKnightRef, err)
+	}
+
+	taskID := stepTaskID(chain, step, ss.Retries) + "-verify"
+	payload := natspkg.TaskPayload{
+		TaskID:    taskID,
+		ChainName: chain.Name,
+		StepName:  step.Name,
+		RunID:     chain.Status.RunID,
+		Task:      verificationTask(step, output),
+		Priority:  stepPriority(chain, step),
+		Deadline:  stepDeadline(chain, step, time.Now()),
+	}
+	if err := r.publishTask(ctx, nc, judge.Spec.Domain, judge.Name, payload); err != nil {
+		return err
+	}
+	r.auditChain(ctx, chain, natspkg.AuditKindTask, taskID, payload)
+
+	r.storeStepOutputToKV(ctx, chain.Name, chain.Status.RunID, ss.Name, output, "", ss.Knight, ss.StartedAt, nil)
+	r.recordStepOutput(ctx, chain, ss, output)
+	ss.Phase = aiv1alpha1.ChainStepPhaseRunning
+	ss.CompletedAt = nil
+	ss.TaskID = taskID
+	ss.Verifying = true
+	logf.FromContext(ctx).Info("Dispatched step verification", "step", ss.Name, "judge", judge.Name, "taskId", taskID)
+	return nil
+}
+
+// applyVerdict finishes a verifying step from the judge's result: a pass
+// succeeds the step with its recorded output, anything else fails it.
+func (r *ChainReconciler) applyVerdict(ctx context.Context, chain *aiv1alpha1.Chain, nc natsConfig, step *aiv1alpha1.ChainStep, ss *aiv1alpha1.ChainStepStatus, result *natspkg.TaskResult) {
+	recordStepUsage(ss, result)
+	ss.Verifying = false
+
+	pass, reason, err := parseVerdict(result.GetOutput())
+	switch {
+	case result.GetError() != "":
+		err = fmt.Errorf("judge failed: %s", result.GetError())
+	case err == nil && !pass:
+		err = fmt.Errorf("verification failed: %s", reason)
+	}
+	if err != nil {
+		r.Recorder.Eventf(chain, corev1.EventTypeWarning, "StepVerificationFailed", "Step %s: %v", ss.Name, err)
+		ss.Output, ss.Truncated, ss.ArtifactRef = "", false, ""
+		r.completeStep(ctx, chain, nc, step, ss, "", err.Error())
+		return
+	}
+
+	output, err := r.stepArtifact(chain, ss.Name)
+	if err != nil {
+		r.completeStep(ctx, chain, nc, step, ss, "", fmt.Sprintf("read verified output: %v", err))
+		return
+	}
+	ss.Message = "Verified: " + reason
+	now := metav1.Now()
+	ss.CompletedAt = &now
+	r.succeedStep(ctx, chain, nc, step, ss, output)
+}
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestStepVerification(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add roundtable scheme: %v", err)
+	}
+	judge := &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{Name: "percival", Namespace: "default"},
+		Spec:       aiv1alpha1.KnightSpec{Domain: "quality"},
+	}
+	nc := newFakeNATSClient()
+	r := &ChainReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(judge).Build(),
+		Recorder: record.NewFakeRecorder(10),
+		NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+	}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "audit", Namespace: "default"},
+		Spec: aiv1alpha1.ChainSpec{
+			RetryPolicy: &aiv1alpha1.ChainRetryPolicy{MaxRetries: 1},
+			Steps: []aiv1alpha1.ChainStep{{
+				Name: "report", KnightRef: "gawain", Task: "write the report",
+				Verify: &aiv1alpha1.StepVerification{JudgeKnightRef: "percival", Criteria: "Every finding has a CVSS score"},
+			}},
+		},
+		Status: aiv1alpha1.ChainStatus{
+			RunID:        "run-1",
+			StepStatuses: []aiv1alpha1.ChainStepStatus{{Name: "report", Phase: aiv1alpha1.ChainStepPhaseRunning, Knight: "gawain"}},
+		},
+	}
+	ctx := context.Background()
+	cfg := natsConfig{SubjectPrefix: "fleet-a"}
+	spec, ss := &chain.Spec.Steps[0], &chain.Status.StepStatuses[0]
+
+	r.completeStep(ctx, chain, cfg, spec, ss, "draft without scores", "")
+	if ss.Phase != aiv1alpha1.ChainStepPhaseRunning || !ss.Verifying || ss.TaskID != "chain-audit-report.run-1-0-verify" {
+		t.Fatalf("step = %s verifying %t task %q, want Running with the judge task", ss.Phase, ss.Verifying, ss.TaskID)
+	}
+	task, ok := nc.published["fleet-a.tasks.quality.percival"]
+	if !ok || !strings.Contains(string(task), "draft without scores") || !strings.Contains(string(task), "CVSS score") {
+		t.Errorf("judge task = %s, want the output and criteria", task)
+	}
+
+	r.applyTaskResult(ctx, chain, cfg, spec, ss, &natspkg.TaskResult{Output: `{"pass": false, "reason": "no CVSS scores"}`})
+	if ss.Phase != aiv1alpha1.ChainStepPhasePending || ss.Retries != 1 || ss.Verifying || ss.Output != "" {
+		t.Fatalf("step after a fail verdict = %+v, want a retry without the rejected output", ss)
+	}
+
+	ss.Phase = aiv1alpha1.ChainStepPhaseRunning
+	r.completeStep(ctx, chain, cfg, spec, ss, "draft with scores", "")
+	if ss.TaskID != "chain-audit-report.run-1-1-verify" {
+		t.Errorf("retry judge task = %q", ss.TaskID)
+	}
+	r.applyTaskResult(ctx, chain, cfg, spec, ss, &natspkg.TaskResult{Output: "PASS: all findings scored"})
+	if ss.Phase != aiv1alpha1.ChainStepPhaseSucceeded || ss.Output != "draft with scores" || ss.Message != "Verified: all findings scored" {
+		t.Errorf("step after a pass verdict = %s %q %q, want Succeeded with the verified output", ss.Phase, ss.Output, ss.Message)
+	}
+}
+
+func TestParseVerdict(t *testing.T) {
+	tests := []struct {
+		output   string
+		wantPass bool
+		wantErr  bool
+	}{
+		{output: `{"pass": true, "reason": "ok"}`, wantPass: true},
+		{output: "```json\n{\"pass\": false}\n```"},
+		{output: "fail - missing section"},
+		{output: "Looks good to me", wantErr: true},
+	}
+	for _, tt := range tests {
+		pass, _, err := parseVerdict(tt.output)
+		if pass != tt.wantPass || (err != nil) != tt.wantErr {
+			t.Errorf("parseVerdict(%q) = %t, %v", tt.output, pass, err)
+		}
+	}
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+const (
+	// chainKnightIndex indexes Chains by the knights their steps name.
+	chainKnightIndex = "chain.knightRefs"
+
+	// chainSelectsKnights is indexed for Chains with a knightSelector/domain
+	// step, and for templated Chains whose steps aren't known until
+	// expansion: any knight change may affect them.
+	chainSelectsKnights = "*"
+
+	// chainTemplateIndex indexes Chains by spec.templateRef.name.
+	chainTemplateIndex = "chain.templateRef"
+
+	// chainTriggerIndex indexes Chains by the upstream chain their chainRef
+	// trigger names, or chainSelectsUpstreams for a selector.
+	chainTriggerIndex = "chain.triggers.chainRef"
+
+	// chainSelectsUpstreams is indexed for Chains whose chainRef trigger
+	// uses a selector: any chain's success may trigger them.
+	chainSelectsUpstreams = "*"
+
+	// chainRoundTableIndex indexes Chains by spec.roundTableRef.
+	chainRoundTableIndex = "chain.roundTableRef"
+)
+
+// chainKnightRefs is the chainKnightIndex extractor.
+func chainKnightRefs(obj client.Object) []string {
+	chain, ok := obj.(*aiv1alpha1.Chain)
+	if !ok {
+		return nil
+	}
+	if chain.Spec.TemplateRef != nil {
+		return []string{chainSelectsKnights}
+	}
+	seen := make(map[string]bool)
+	var refs []string
+	for _, step := range allChainSteps(chain) {
+		if !isKnightStep(&step) {
+			continue
+		}
+		ref := step.KnightRef
+		if selectsKnight(&step) {
+			ref = chainSelectsKnights
+		}
+		if ref != "" && !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// chainsForKnight maps a Knight to the Chains in its namespace that name it
+// or select knights dynamically.
+func (r *ChainReconciler) chainsForKnight(ctx context.Context, obj client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	seen := make(map[types.NamespacedName]bool)
+	for _, key := range []string{obj.GetName(), chainSelectsKnights} {
+		chains := &aiv1alpha1.ChainList{}
+		if err := r.List(ctx, chains, client.InNamespace(obj.GetNamespace()), client.MatchingFields{chainKnightIndex: key}); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to list chains for knight", "knight", obj.GetName())
+			return nil
+		}
+		for _, chain := range chains.Items {
+			nn := types.NamespacedName{Namespace: chain.Namespace, Name: chain.Name}
+			if !seen[nn] {
+				seen[nn] = true
+				requests = append(requests, reconcile.Request{NamespacedName: nn})
+			}
+		}
+	}
+	return requests
+}
+
+// chainTemplateRef is the chainTemplateIndex extractor.
+func chainTemplateRef(obj client.Object) []string {
+	chain, ok := obj.(*aiv1alpha1.Chain)
+	if !ok || chain.Spec.TemplateRef == nil {
+		return nil
+	}
+	return []string{chain.Spec.TemplateRef.Name}
+}
+
+// chainsForTemplate maps a ChainTemplate to the Chains that instantiate it.
+func (r *ChainReconciler) chainsForTemplate(ctx context.Context, obj client.Object) []reconcile.Request {
+	chains := &aiv1alpha1.ChainList{}
+	if err := r.List(ctx, chains, client.InNamespace(obj.GetNamespace()), client.MatchingFields{chainTemplateIndex: obj.GetName()}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list chains for template", "chainTemplate", obj.GetName())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(chains.Items))
+	for _, chain := range chains.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: chain.Namespace, Name: chain.Name},
+		})
+	}
+	return requests
+}
+
+// chainTriggerRef is the chainTriggerIndex extractor.
+func chainTriggerRef(obj client.Object) []string {
+	chain, ok := obj.(*aiv1alpha1.Chain)
+	if !ok || chain.Spec.Triggers == nil || chain.Spec.Triggers.ChainRef == nil {
+		return nil
+	}
+	if chain.Spec.Triggers.ChainRef.Selector != nil {
+		return []string{chainSelectsUpstreams}
+	}
+	return []string{chain.Spec.Triggers.ChainRef.Name}
+}
+
+// chainsTriggeredBy maps a Chain to the other Chains in its namespace whose
+// chainRef trigger may match it.
+func (r *ChainReconciler) chainsTriggeredBy(ctx context.Context, obj client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, key := range []string{obj.GetName(), chainSelectsUpstreams} {
+		chains := &aiv1alpha1.ChainList{}
+		if err := r.List(ctx, chains, client.InNamespace(obj.GetNamespace()), client.MatchingFields{chainTriggerIndex: key}); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to list chains triggered by chain", "chain", obj.GetName())
+			return nil
+		}
+		for _, chain := range chains.Items {
+			if chain.Name != obj.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: chain.Namespace, Name: chain.Name},
+				})
+			}
+		}
+	}
+	return requests
+}
+
+// chainRoundTableRef is the chainRoundTableIndex extractor.
+func chainRoundTableRef(obj client.Object) []string {
+	chain, ok := obj.(*aiv1alpha1.Chain)
+	if !ok || chain.Spec.RoundTableRef == "" {
+		return nil
+	}
+	return []string{chain.Spec.RoundTableRef}
+}
+
+// chainsDeferredBy maps a RoundTable to the Chains on it holding a
+// deferred scheduled run.
+func (r *ChainReconciler) chainsDeferredBy(ctx context.Context, obj client.Object) []reconcile.Request {
+	chains := &aiv1alpha1.ChainList{}
+	if err := r.List(ctx, chains, client.InNamespace(obj.GetNamespace()), client.MatchingFields{chainRoundTableIndex: obj.GetName()}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list chains for roundtable", "roundtable", obj.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, chain := range chains.Items {
+		if runDeferred(&chain) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: chain.Namespace, Name: chain.Name},
+			})
+		}
+	}
+	return requests
+}
+
+// roundTableHealthChanged passes RoundTable updates that change its phase
+// or ready knights, which decide whether it holds scheduled runs.
+func roundTableHealthChanged() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldRT, ok := e.ObjectOld.(*aiv1alpha1.RoundTable)
+			if !ok {
+				return false
+			}
+			newRT, ok := e.ObjectNew.(*aiv1alpha1.RoundTable)
+			if !ok {
+				return false
+			}
+			return oldRT.Status.Phase != newRT.Status.Phase ||
+				oldRT.Status.KnightsReady != newRT.Status.KnightsReady ||
+				oldRT.Generation != newRT.Generation
+		},
+	}
+}
+
+// chainRunSucceeded passes Chain updates in which a run reaches Succeeded.
+func chainRunSucceeded() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldChain, ok := e.ObjectOld.(*aiv1alpha1.Chain)
+			if !ok {
+				return false
+			}
+			newChain, ok := e.ObjectNew.(*aiv1alpha1.Chain)
+			if !ok {
+				return false
+			}
+			return newChain.Status.Phase == aiv1alpha1.ChainPhaseSucceeded &&
+				(oldChain.Status.Phase != aiv1alpha1.ChainPhaseSucceeded || oldChain.Status.RunID != newChain.Status.RunID)
+		},
+	}
+}
+
+// knightAvailabilityChanged passes Knight creates and deletes, and updates
+// that change whether or where a chain can dispatch to the knight.
+func knightAvailabilityChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldKnight, ok := e.ObjectOld.(*aiv1alpha1.Knight)
+			if !ok {
+				return false
+			}
+			newKnight, ok := e.ObjectNew.(*aiv1alpha1.Knight)
+			if !ok {
+				return false
+			}
+			return oldKnight.Status.Ready != newKnight.Status.Ready ||
+				oldKnight.Spec.Suspended != newKnight.Spec.Suspended ||
+				oldKnight.Spec.Domain != newKnight.Spec.Domain ||
+				!maps.Equal(oldKnight.Labels, newKnight.Labels)
+		},
+	}
+}
+
+// setupChainIndexes registers the field indexes the Chain controller's
+// watches rely on.
+func setupChainIndexes(ctx context.Context, mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &aiv1alpha1.Chain{}, chainKnightIndex, chainKnightRefs); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &aiv1alpha1.Chain{}, chainTemplateIndex, chainTemplateRef); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &aiv1alpha1.Chain{}, chainTriggerIndex, chainTriggerRef); err != nil {
+		return err
+	}
+	return mgr.GetFieldIndexer().IndexField(ctx, &aiv1alpha1.Chain{}, chainRoundTableIndex, chainRoundTableRef)
+}
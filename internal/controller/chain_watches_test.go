@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestChainsForKnight(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add roundtable scheme: %v", err)
+	}
+	chain := func(name string, steps ...aiv1alpha1.ChainStep) *aiv1alpha1.Chain {
+		return &aiv1alpha1.Chain{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       aiv1alpha1.ChainSpec{Steps: steps},
+		}
+	}
+	r := &ChainReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).
+		WithIndex(&aiv1alpha1.Chain{}, chainKnightIndex, chainKnightRefs).
+		WithObjects(
+			chain("named", aiv1alpha1.ChainStep{Name: "a", KnightRef: "galahad"}),
+			chain("other", aiv1alpha1.ChainStep{Name: "a", KnightRef: "gawain"}),
+			chain("selecting", aiv1alpha1.ChainStep{Name: "a", Domain: "security"}),
+			chain("gated", aiv1alpha1.ChainStep{Name: "a", Type: aiv1alpha1.ChainStepTypeApproval}),
+		).Build()}
+
+	knight := routingTestKnight("galahad", "security", true, nil)
+	var got []string
+	for _, req := range r.chainsForKnight(context.Background(), knight) {
+		got = append(got, req.Name)
+	}
+	slices.Sort(got)
+	if want := []string{"named", "selecting"}; !slices.Equal(got, want) {
+		t.Errorf("chainsForKnight() = %v, want %v", got, want)
+	}
+}
+
+func TestKnightAvailabilityChanged(t *testing.T) {
+	p := knightAvailabilityChanged()
+	old := routingTestKnight("galahad", "security", false, nil)
+
+	ready := old.DeepCopy()
+	ready.Status.Ready = true
+	if !p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: ready}) {
+		t.Error("Ready change should trigger chain reconciles")
+	}
+
+	bumped := old.DeepCopy()
+	bumped.Status.ObservedGeneration++
+	if p.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: bumped}) {
+		t.Error("unrelated status change should not trigger chain reconciles")
+	}
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// fleetUse is what a new Chain or Mission asks of its RoundTable.
+type fleetUse struct {
+	// knights are the existing knights it names.
+	knights []string
+	// domains are the domains it dispatches to without naming a knight.
+	domains []string
+	// mission counts it against maxMissions.
+	mission bool
+	// notifySubjects are the NATS subjects its steps notify on.
+	notifySubjects []string
+}
+
+// CheckChainPolicies checks a new Chain against its RoundTable's policies;
+// see checkFleetPolicies. Every step counts, handlers and judges included,
+// and a Chain using a templateRef is checked with the template's steps. It
+// is used by the fleet policy webhook.
+func CheckChainPolicies(ctx context.Context, c client.Reader, chain *aiv1alpha1.Chain) ([]string, error) {
+	if chain.Spec.RoundTableRef == "" {
+		return nil, nil
+	}
+	if chain.Spec.TemplateRef != nil {
+		chain = chain.DeepCopy()
+		if err := expandTemplateRef(ctx, c, chain); err != nil {
+			return nil, fmt.Errorf("cannot check the chain's steps against RoundTable %s: %w", chain.Spec.RoundTableRef, err)
+		}
+	}
+	var use fleetUse
+	for _, step := range allChainSteps(chain) {
+		if step.KnightRef != "" {
+			use.knights = append(use.knights, step.KnightRef)
+		} else if step.Domain != "" {
+			use.domains = append(use.domains, step.Domain)
+		}
+		if step.Verify != nil && step.Verify.JudgeKnightRef != "" {
+			use.knights = append(use.knights, step.Verify.JudgeKnightRef)
+		}
+		for _, n := range step.Notifications {
+			if n.NATSSubject != "" {
+				use.notifySubjects = append(use.notifySubjects, n.NATSSubject)
+			}
+		}
+	}
+	return checkFleetPolicies(ctx, c, chain.Namespace, chain.Spec.RoundTableRef, use)
+}
+
+// CheckMissionPolicies checks a new Mission against its RoundTable's
+// policies; see checkFleetPolicies. It is used by the fleet policy webhook.
+func CheckMissionPolicies(ctx context.Context, c client.Reader, mission *aiv1alpha1.Mission) ([]string, error) {
+	use := fleetUse{mission: true}
+	for _, k := range mission.Spec.Knights {
+		switch {
+		case !k.Ephemeral:
+			use.knights = append(use.knights, k.Name)
+		case k.EphemeralSpec != nil && k.EphemeralSpec.Domain != "":
+			use.domains = append(use.domains, k.EphemeralSpec.Domain)
+		}
+	}
+	return checkFleetPolicies(ctx, c, mission.Namespace, mission.Spec.RoundTableRef, use)
+}
+
+// checkFleetPolicies returns an error for what RoundTable table won't
+// allow: existing knights outside the table, domains outside
+// policies.allowedDomains, notification subjects outside its notifications
+// prefix, or a table over its cost budget. The warnings are the limits the
+// work will wait on: a table at maxMissions, or with
+// maxConcurrentTasks already queued on its knights. Knights that don't
+// exist and a missing table are left to the reconcilers.
+func checkFleetPolicies(ctx context.Context, c client.Reader, namespace, table string, use fleetUse) ([]string, error) {
+	if table == "" {
+		return nil, nil
+	}
+	rt := &aiv1alpha1.RoundTable{}
+	if err := c.Get(ctx, types.NamespacedName{Name: table, Namespace: namespace}, rt); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	if rt.Status.Phase == aiv1alpha1.RoundTablePhaseOverBudget {
+		return nil, fmt.Errorf("RoundTable %s is over its cost budget ($%s spent)", rt.Name, rt.Status.TotalCost)
+	}
+	prefix := natspkg.NotificationSubjectPrefix(rt.Spec.NATS.SubjectPrefix)
+	for _, subject := range use.notifySubjects {
+		if !strings.HasPrefix(subject, prefix) {
+			return nil, fmt.Errorf("notification subject %s is not under RoundTable %s's %s", subject, rt.Name, prefix)
+		}
+	}
+	var allowed []string
+	if rt.Spec.Policies != nil {
+		allowed = rt.Spec.Policies.AllowedDomains
+	}
+
+	namespaces, err := tableNamespaces(ctx, c, rt)
+	if err != nil {
+		return nil, err
+	}
+	domains := slices.Clone(use.domains)
+	for _, name := range use.knights {
+		knight := &aiv1alpha1.Knight{}
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, knight); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		selected, err := roundTableSelects(rt, namespaces, knight)
+		if err != nil {
+			return nil, err
+		}
+		if !selected {
+			return nil, fmt.Errorf("knight %s is not a member of RoundTable %s", name, rt.Name)
+		}
+		domains = append(domains, knight.Spec.Domain)
+	}
+	if len(allowed) > 0 {
+		for _, domain := range domains {
+			if !slices.Contains(allowed, domain) {
+				return nil, fmt.Errorf("domain %s is not in RoundTable %s's allowedDomains %v", domain, rt.Name, allowed)
+			}
+		}
+	}
+
+	p := rt.Spec.Policies
+	if p == nil {
+		return nil, nil
+	}
+	var warnings []string
+	if use.mission && p.MaxMissions > 0 && rt.Status.ActiveMissions >= p.MaxMissions {
+		warnings = append(warnings, fmt.Sprintf("RoundTable %s has %d of %d active missions; the mission waits in Pending for a slot",
+			rt.Name, rt.Status.ActiveMissions, p.MaxMissions))
+	}
+	if p.MaxConcurrentTasks > 0 {
+		var queued int64
+		for _, k := range rt.Status.Knights {
+			queued += k.QueueDepth
+		}
+		if queued >= int64(p.MaxConcurrentTasks) {
+			warnings = append(warnings, fmt.Sprintf("RoundTable %s has %d tasks queued, at maxConcurrentTasks %d; new tasks wait behind them",
+				rt.Name, queued, p.MaxConcurrentTasks))
+		}
+	}
+	return warnings, nil
+}
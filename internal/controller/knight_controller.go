@@ -33,9 +33,13 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
 	knightpkg "github.com/dapperdivers/roundtable/internal/knight"
@@ -482,6 +486,9 @@ func (r *KnightReconciler) reconcileDeployment(ctx context.Context, knight *aiv1
 	if hasNixTools {
 		podAnnotations[nixToolsHashAnnotation] = knightpkg.NixToolsHash(knight)
 	}
+	if hash := knight.Annotations[aiv1alpha1.AnnotationFleetSecretsHash]; hash != "" {
+		podAnnotations[aiv1alpha1.AnnotationFleetSecretsHash] = hash
+	}
 	desired.Spec.Template.ObjectMeta.Annotations = podAnnotations
 	desired.Spec.Template.Spec = r.BuildPodSpec(ctx, knight)
 
@@ -581,8 +588,11 @@ func (r *KnightReconciler) BuildPodSpec(ctx context.Context, k *aiv1alpha1.Knigh
 		WithWorkspace().
 		WithConfig(configMapName).
 		WithNixStore().
-		WithVault().
+		WithVault(ctx).
 		WithSharedWorkspace(ctx).
+		WithNATSAuth(ctx).
+		WithKVBuckets(ctx).
+		WithFleetSecrets().
 		WithArsenal().
 		WithSkillFilter().
 		WithGitSync()
@@ -654,6 +664,7 @@ func (r *KnightReconciler) updateStatus(ctx context.Context, knight *aiv1alpha1.
 		consumerName = fmt.Sprintf("knight-%s", knight.Name)
 	}
 	knight.Status.NATSConsumer = consumerName
+	knight.Status.NATSStream = knightpkg.TasksStream(ctx, r.Client, knight)
 	knight.Status.ObservedGeneration = knight.Generation
 
 	// Update Prometheus metrics
@@ -690,10 +701,32 @@ func (r *KnightReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&batchv1.Job{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&sandboxv1alpha1.Sandbox{}).
+		// Knights inherit table defaults such as the vault, so a spec change
+		// on their RoundTable re-renders them.
+		Watches(&aiv1alpha1.RoundTable{},
+			handler.EnqueueRequestsFromMapFunc(r.knightsForTable),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Named("knight").
 		Complete(r)
 }
 
+// knightsForTable maps a RoundTable to the knights labelled with it.
+func (r *KnightReconciler) knightsForTable(ctx context.Context, obj client.Object) []reconcile.Request {
+	knights := &aiv1alpha1.KnightList{}
+	if err := r.List(ctx, knights, client.InNamespace(obj.GetNamespace()),
+		client.MatchingLabels{"ai.roundtable.io/table": obj.GetName()}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list knights for roundtable", "roundTable", obj.GetName())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(knights.Items))
+	for _, k := range knights.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: k.Namespace, Name: k.Name},
+		})
+	}
+	return requests
+}
+
 // deriveResultsPrefix is a re-export from the knight package for backward compatibility with tests.
 func deriveResultsPrefix(subjects []string) string {
 	return knightpkg.DeriveResultsPrefix(subjects)
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/robfig/cron/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
@@ -76,3 +77,25 @@ func TestMissedSchedule(t *testing.T) {
 		})
 	}
 }
+
+func TestChainCronSpecTimeZone(t *testing.T) {
+	chain := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{Schedule: "0 9 * * 1", TimeZone: "America/New_York"}}
+	spec, err := chainCronSpec(chain)
+	if err != nil {
+		t.Fatalf("chainCronSpec() error = %v", err)
+	}
+	sched, err := cron.ParseStandard(spec)
+	if err != nil {
+		t.Fatalf("ParseStandard(%q) error = %v", spec, err)
+	}
+	// Monday 2026-01-05 00:00 UTC; 9am EST that day is 14:00 UTC.
+	got := sched.Next(time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)).UTC()
+	if want := time.Date(2026, 1, 5, 14, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("next run = %v, want %v", got, want)
+	}
+
+	chain.Spec.TimeZone = "Mars/Olympus_Mons"
+	if _, err := chainCronSpec(chain); err == nil {
+		t.Error("chainCronSpec() with unknown time zone expected error")
+	}
+}
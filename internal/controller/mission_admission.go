@@ -0,0 +1,290 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// missionHoldsSlot reports whether a mission counts toward its
+// RoundTable's maxMissions: it has left Pending, has not yet finished, and
+// is not paused by a higher-priority mission.
+func missionHoldsSlot(mission *aiv1alpha1.Mission) bool {
+	switch mission.Status.Phase {
+	case aiv1alpha1.MissionPhaseProvisioning, aiv1alpha1.MissionPhasePlanning,
+		aiv1alpha1.MissionPhaseAssembling, aiv1alpha1.MissionPhaseBriefing,
+		aiv1alpha1.MissionPhaseActive, aiv1alpha1.MissionPhaseVoting, aiv1alpha1.MissionPhaseDebating, aiv1alpha1.MissionPhaseDebriefing:
+		return !missionPreempted(mission)
+	}
+	return false
+}
+
+// missionQueued reports whether a Pending mission is waiting for a slot.
+func missionQueued(mission *aiv1alpha1.Mission) bool {
+	return mission.Status.Phase == aiv1alpha1.MissionPhasePending &&
+		meta.IsStatusConditionFalse(mission.Status.Conditions, aiv1alpha1.ConditionMissionAdmitted)
+}
+
+// missionPreempted reports whether an Active mission is paused by a
+// higher-priority mission.
+func missionPreempted(mission *aiv1alpha1.Mission) bool {
+	return mission.Status.Phase == aiv1alpha1.MissionPhaseActive &&
+		meta.IsStatusConditionTrue(mission.Status.Conditions, aiv1alpha1.ConditionMissionPreempted)
+}
+
+// queuedAhead reports whether other gets a free slot before mission:
+// higher priority first, then the older mission.
+func queuedAhead(other, mission *aiv1alpha1.Mission) bool {
+	if other.Spec.Priority != mission.Spec.Priority {
+		return other.Spec.Priority > mission.Spec.Priority
+	}
+	if !other.CreationTimestamp.Equal(&mission.CreationTimestamp) {
+		return other.CreationTimestamp.Before(&mission.CreationTimestamp)
+	}
+	return other.Name < mission.Name
+}
+
+// limitingRoundTable returns the mission's RoundTable and the other missions
+// under it when the table sets maxMissions, or a nil RoundTable when the
+// mission is not limited: it has no roundTableRef, or the RoundTable does
+// not exist yet or sets no cap.
+func (r *MissionReconciler) limitingRoundTable(ctx context.Context, mission *aiv1alpha1.Mission) (*aiv1alpha1.RoundTable, []aiv1alpha1.Mission, error) {
+	if mission.Spec.RoundTableRef == "" {
+		return nil, nil, nil
+	}
+	rt := &aiv1alpha1.RoundTable{}
+	if err := r.Get(ctx, types.NamespacedName{Name: mission.Spec.RoundTableRef, Namespace: mission.Namespace}, rt); err != nil {
+		return nil, nil, client.IgnoreNotFound(err)
+	}
+	if rt.Spec.Policies == nil || rt.Spec.Policies.MaxMissions == 0 {
+		return nil, nil, nil
+	}
+
+	list := &aiv1alpha1.MissionList{}
+	if err := r.List(ctx, list, client.InNamespace(mission.Namespace)); err != nil {
+		return nil, nil, fmt.Errorf("failed to list missions: %w", err)
+	}
+	others := make([]aiv1alpha1.Mission, 0, len(list.Items))
+	for _, other := range list.Items {
+		if other.Name != mission.Name && other.Spec.RoundTableRef == rt.Name {
+			others = append(others, other)
+		}
+	}
+	return rt, others, nil
+}
+
+// missionSlots counts the missions holding a slot and the queued missions
+// ahead of mission.
+func missionSlots(others []aiv1alpha1.Mission, mission *aiv1alpha1.Mission) (active, ahead int32) {
+	for i := range others {
+		switch other := &others[i]; {
+		case missionHoldsSlot(other):
+			active++
+		case missionQueued(other) && queuedAhead(other, mission):
+			ahead++
+		}
+	}
+	return active, ahead
+}
+
+// capacityMessage describes why a mission is waiting for a slot.
+func capacityMessage(rt *aiv1alpha1.RoundTable, active, ahead int32) string {
+	msg := fmt.Sprintf("RoundTable %s is running %d of %d missions", rt.Name, active, rt.Spec.Policies.MaxMissions)
+	if ahead > 0 {
+		msg += fmt.Sprintf("; %d queued ahead", ahead)
+	}
+	return msg
+}
+
+// preemptionCandidate returns the Active mission to pause for mission: the
+// lowest-priority one below mission's priority, the newest on a tie.
+func preemptionCandidate(others []aiv1alpha1.Mission, mission *aiv1alpha1.Mission) *aiv1alpha1.Mission {
+	var victim *aiv1alpha1.Mission
+	for i := range others {
+		other := &others[i]
+		if other.Status.Phase != aiv1alpha1.MissionPhaseActive || missionPreempted(other) ||
+			other.Spec.Priority >= mission.Spec.Priority {
+			continue
+		}
+		if victim == nil || other.Spec.Priority < victim.Spec.Priority ||
+			(other.Spec.Priority == victim.Spec.Priority && victim.CreationTimestamp.Before(&other.CreationTimestamp)) {
+			victim = other
+		}
+	}
+	return victim
+}
+
+// admitMission holds a mission in Pending while its RoundTable is at its
+// maxMissions cap, preempting a lower-priority mission when the RoundTable
+// allows it. It returns handled=false once the mission may proceed.
+func (r *MissionReconciler) admitMission(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool, error) {
+	rt, others, err := r.limitingRoundTable(ctx, mission)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	var active, ahead int32
+	if rt != nil {
+		active, ahead = missionSlots(others, mission)
+	}
+	if rt == nil || active+ahead < rt.Spec.Policies.MaxMissions {
+		if missionQueued(mission) {
+			meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+				Type:               aiv1alpha1.ConditionMissionAdmitted,
+				Status:             metav1.ConditionTrue,
+				Reason:             aiv1alpha1.ReasonMissionAdmitted,
+				Message:            "Mission slot available",
+				ObservedGeneration: mission.Generation,
+			})
+		}
+		return ctrl.Result{}, false, nil
+	}
+
+	msg := capacityMessage(rt, active, ahead)
+	if !missionQueued(mission) {
+		logf.FromContext(ctx).Info("Mission queued for a RoundTable slot", "mission", mission.Name, "reason", msg)
+		r.Recorder.Event(mission, corev1.EventTypeNormal, "MissionQueued", msg)
+	}
+	meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionMissionAdmitted,
+		Status:             metav1.ConditionFalse,
+		Reason:             aiv1alpha1.ReasonRoundTableAtCapacity,
+		Message:            msg,
+		ObservedGeneration: mission.Generation,
+	})
+	mission.Status.ObservedGeneration = mission.Generation
+	if err := r.Status().Update(ctx, mission); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, true, nil
+		}
+		return ctrl.Result{}, true, err
+	}
+
+	// Only the mission next in line preempts, so one freed slot is not
+	// taken twice.
+	if rt.Spec.Policies.PreemptMissions && ahead == 0 {
+		if victim := preemptionCandidate(others, mission); victim != nil {
+			if err := r.preemptMission(ctx, victim, mission); err != nil {
+				if apierrors.IsConflict(err) {
+					return ctrl.Result{Requeue: true}, true, nil
+				}
+				return ctrl.Result{}, true, err
+			}
+			return ctrl.Result{RequeueAfter: RequeueFast}, true, nil
+		}
+	}
+	return ctrl.Result{RequeueAfter: RequeueModerate}, true, nil
+}
+
+// setMissionChainsSuspended suspends or resumes the mission's chains.
+// Finished chains are left alone, so a resumed mission does not re-run
+// them. A resumed chain keeps the steps it completed.
+func (r *MissionReconciler) setMissionChainsSuspended(ctx context.Context, mission *aiv1alpha1.Mission, suspended bool) error {
+	for _, cs := range mission.Status.ChainStatuses {
+		if cs.ChainCRName == "" {
+			continue
+		}
+		chain := &aiv1alpha1.Chain{}
+		if err := r.Get(ctx, types.NamespacedName{Name: cs.ChainCRName, Namespace: workNamespace(mission)}, chain); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return err
+		}
+		if chain.Spec.Suspended == suspended ||
+			chain.Status.Phase == aiv1alpha1.ChainPhaseSucceeded || chain.Status.Phase == aiv1alpha1.ChainPhaseFailed {
+			continue
+		}
+		chain.Spec.Suspended = suspended
+		if err := r.Update(ctx, chain); err != nil {
+			return fmt.Errorf("failed to update chain %s: %w", chain.Name, err)
+		}
+	}
+	return nil
+}
+
+// preemptMission pauses victim so mission can take its slot.
+func (r *MissionReconciler) preemptMission(ctx context.Context, victim, mission *aiv1alpha1.Mission) error {
+	if err := r.setMissionChainsSuspended(ctx, victim, true); err != nil {
+		return err
+	}
+	msg := fmt.Sprintf("Paused for mission %s (priority %d > %d)", mission.Name, mission.Spec.Priority, victim.Spec.Priority)
+	meta.SetStatusCondition(&victim.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionMissionPreempted,
+		Status:             metav1.ConditionTrue,
+		Reason:             aiv1alpha1.ReasonMissionPreempted,
+		Message:            msg,
+		ObservedGeneration: victim.Generation,
+	})
+	if err := r.Status().Update(ctx, victim); err != nil {
+		return err
+	}
+	logf.FromContext(ctx).Info("Preempted lower-priority mission", "mission", mission.Name, "preempted", victim.Name)
+	r.Recorder.Event(victim, corev1.EventTypeWarning, "MissionPreempted", msg)
+	r.Recorder.Eventf(mission, corev1.EventTypeNormal, "Preempting", "Pausing lower-priority mission %s", victim.Name)
+	return nil
+}
+
+// resumePreemptedMission keeps a preempted mission paused until a slot is
+// free again, then resumes its chains. It returns handled=false when the
+// mission is not preempted.
+func (r *MissionReconciler) resumePreemptedMission(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool, error) {
+	if !missionPreempted(mission) {
+		return ctrl.Result{}, false, nil
+	}
+	rt, others, err := r.limitingRoundTable(ctx, mission)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	if rt != nil {
+		if active, ahead := missionSlots(others, mission); active+ahead >= rt.Spec.Policies.MaxMissions {
+			return ctrl.Result{RequeueAfter: RequeueModerate}, true, nil
+		}
+	}
+
+	if err := r.setMissionChainsSuspended(ctx, mission, false); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionMissionPreempted,
+		Status:             metav1.ConditionFalse,
+		Reason:             aiv1alpha1.ReasonMissionResumed,
+		Message:            "Mission slot available",
+		ObservedGeneration: mission.Generation,
+	})
+	mission.Status.ObservedGeneration = mission.Generation
+	if err := r.Status().Update(ctx, mission); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, true, nil
+		}
+		return ctrl.Result{}, true, err
+	}
+	logf.FromContext(ctx).Info("Resumed preempted mission", "mission", mission.Name)
+	r.Recorder.Event(mission, corev1.EventTypeNormal, "MissionResumed", "Mission slot available, chains resumed")
+	return ctrl.Result{RequeueAfter: RequeueFast}, true, nil
+}
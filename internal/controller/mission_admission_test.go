@@ -0,0 +1,171 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestMissionAdmission(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	created := time.Now().Add(-time.Hour)
+	newMission := func(name string, age time.Duration, phase aiv1alpha1.MissionPhase) *aiv1alpha1.Mission {
+		return &aiv1alpha1.Mission{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", CreationTimestamp: metav1.NewTime(created.Add(age))},
+			Spec:       aiv1alpha1.MissionSpec{RoundTableRef: "fleet"},
+			Status:     aiv1alpha1.MissionStatus{Phase: phase},
+		}
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec:       aiv1alpha1.RoundTableSpec{Policies: &aiv1alpha1.RoundTablePolicies{MaxMissions: 1}},
+	}
+	running := newMission("running", 0, aiv1alpha1.MissionPhaseActive)
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(rt, running,
+			newMission("first", time.Minute, aiv1alpha1.MissionPhasePending),
+			newMission("second", 2*time.Minute, aiv1alpha1.MissionPhasePending)).
+		WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionReconciler{Client: c, Recorder: recorder}
+
+	pending := func(name string) *aiv1alpha1.Mission {
+		t.Helper()
+		m := &aiv1alpha1.Mission{}
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, m); err != nil {
+			t.Fatalf("get mission %s: %v", name, err)
+		}
+		if _, err := r.reconcilePending(ctx, m); err != nil {
+			t.Fatalf("reconcilePending(%s) error = %v", name, err)
+		}
+		return m
+	}
+
+	for _, name := range []string{"second", "first"} {
+		m := pending(name)
+		cond := meta.FindStatusCondition(m.Status.Conditions, aiv1alpha1.ConditionMissionAdmitted)
+		if m.Status.Phase != aiv1alpha1.MissionPhasePending || cond == nil || cond.Reason != aiv1alpha1.ReasonRoundTableAtCapacity {
+			t.Fatalf("%s: phase %s, admitted %+v, want queued", name, m.Status.Phase, cond)
+		}
+	}
+	if events := drainEvents(recorder); len(events) != 2 || !strings.Contains(events[0], "MissionQueued") {
+		t.Errorf("events = %v, want one MissionQueued per mission", events)
+	}
+
+	running.Status.Phase = aiv1alpha1.MissionPhaseSucceeded
+	if err := c.Status().Update(ctx, running); err != nil {
+		t.Fatalf("update running mission: %v", err)
+	}
+	if m := pending("second"); m.Status.Phase != aiv1alpha1.MissionPhasePending {
+		t.Errorf("second: phase %s, want it to wait behind first", m.Status.Phase)
+	}
+	m := pending("first")
+	if m.Status.Phase != aiv1alpha1.MissionPhaseProvisioning ||
+		!meta.IsStatusConditionTrue(m.Status.Conditions, aiv1alpha1.ConditionMissionAdmitted) {
+		t.Errorf("first: phase %s, conditions %+v, want admitted", m.Status.Phase, m.Status.Conditions)
+	}
+	if m := pending("second"); m.Status.Phase != aiv1alpha1.MissionPhasePending {
+		t.Errorf("second: phase %s, want it queued while first holds the slot", m.Status.Phase)
+	}
+}
+
+func TestMissionPreemption(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	newMission := func(name string, priority int32, phase aiv1alpha1.MissionPhase) *aiv1alpha1.Mission {
+		return &aiv1alpha1.Mission{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       aiv1alpha1.MissionSpec{RoundTableRef: "fleet", Priority: priority},
+			Status:     aiv1alpha1.MissionStatus{Phase: phase},
+		}
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{Policies: &aiv1alpha1.RoundTablePolicies{
+			MaxMissions: 1, PreemptMissions: true,
+		}},
+	}
+	housekeeping := newMission("housekeeping", 0, aiv1alpha1.MissionPhaseActive)
+	housekeeping.Status.ChainStatuses = []aiv1alpha1.MissionChainStatus{
+		{Name: "sweep", ChainCRName: "mission-housekeeping-sweep", Phase: aiv1alpha1.ChainPhaseRunning},
+		{Name: "setup", ChainCRName: "mission-housekeeping-setup", Phase: aiv1alpha1.ChainPhaseSucceeded},
+	}
+	sweep := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "mission-housekeeping-sweep", Namespace: "default"},
+		Status:     aiv1alpha1.ChainStatus{Phase: aiv1alpha1.ChainPhaseRunning},
+	}
+	setup := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "mission-housekeeping-setup", Namespace: "default"},
+		Status:     aiv1alpha1.ChainStatus{Phase: aiv1alpha1.ChainPhaseSucceeded},
+	}
+	incident := newMission("incident", 900, aiv1alpha1.MissionPhasePending)
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(rt, housekeeping, incident, sweep, setup).
+		WithStatusSubresource(&aiv1alpha1.Mission{}, &aiv1alpha1.Chain{}).Build()
+	r := &MissionReconciler{Client: c, Recorder: record.NewFakeRecorder(10)}
+
+	get := func(obj client.Object, name string) {
+		t.Helper()
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, obj); err != nil {
+			t.Fatalf("get %s: %v", name, err)
+		}
+	}
+
+	if _, err := r.reconcilePending(ctx, incident); err != nil {
+		t.Fatalf("reconcilePending() error = %v", err)
+	}
+	get(housekeeping, "housekeeping")
+	if !missionPreempted(housekeeping) {
+		t.Fatalf("housekeeping conditions = %+v, want preempted", housekeeping.Status.Conditions)
+	}
+	get(sweep, "mission-housekeeping-sweep")
+	get(setup, "mission-housekeeping-setup")
+	if !sweep.Spec.Suspended || setup.Spec.Suspended {
+		t.Errorf("suspended: sweep %t, setup %t, want only the unfinished chain", sweep.Spec.Suspended, setup.Spec.Suspended)
+	}
+
+	get(incident, "incident")
+	if _, err := r.reconcilePending(ctx, incident); err != nil {
+		t.Fatalf("reconcilePending() error = %v", err)
+	}
+	if incident.Status.Phase != aiv1alpha1.MissionPhaseProvisioning {
+		t.Fatalf("incident phase = %s, want admitted into the freed slot", incident.Status.Phase)
+	}
+
+	if _, err := r.reconcileActive(ctx, housekeeping); err != nil {
+		t.Fatalf("reconcileActive() error = %v", err)
+	}
+	if !missionPreempted(housekeeping) {
+		t.Fatal("housekeeping resumed while incident holds the slot")
+	}
+
+	incident.Status.Phase = aiv1alpha1.MissionPhaseSucceeded
+	if err := c.Status().Update(ctx, incident); err != nil {
+		t.Fatalf("update incident: %v", err)
+	}
+	if _, err := r.reconcileActive(ctx, housekeeping); err != nil {
+		t.Fatalf("reconcileActive() error = %v", err)
+	}
+	get(sweep, "mission-housekeeping-sweep")
+	if missionPreempted(housekeeping) || sweep.Spec.Suspended {
+		t.Errorf("housekeeping preempted %t, sweep suspended %t, want resumed", missionPreempted(housekeeping), sweep.Spec.Suspended)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// ensureAuditStream creates the stream archiving an audited mission's tasks
+// and results, and records it in status. Unlike the mission's other
+// streams it is never deleted with the mission: messages expire after
+// spec.audit.retentionDays.
+func (r *MissionReconciler) ensureAuditStream(mission *aiv1alpha1.Mission) error {
+	if mission.Spec.Audit == nil || mission.Status.AuditStream != "" {
+		return nil
+	}
+	client, err := r.natsClient()
+	if err != nil {
+		return err
+	}
+
+	cfg := natspkg.StreamConfig{
+		Name:      natspkg.AuditStreamName(mission.Namespace, mission.Name),
+		Subjects:  []string{natspkg.AuditStreamSubject(mission.Namespace, mission.Name)},
+		Retention: natspkg.RetentionLimits,
+		MaxAge:    time.Duration(mission.Spec.Audit.RetentionDays) * 24 * time.Hour,
+		Storage:   natspkg.StorageFile,
+	}
+	if err := client.CreateStream(cfg); err != nil {
+		return fmt.Errorf("audit stream %s: %w", cfg.Name, err)
+	}
+	mission.Status.AuditStream = cfg.Name
+	return nil
+}
+
+// archiveAudit copies a task or result of an audited mission into its
+// audit stream. The task has already been dispatched, or its result
+// received, so a failure is logged rather than returned.
+func archiveAudit(ctx context.Context, client natspkg.Client, mission *aiv1alpha1.Mission, kind, taskID string, v interface{}) {
+	if mission.Spec.Audit == nil {
+		return
+	}
+	subject := natspkg.AuditSubject(mission.Namespace, mission.Name, kind, taskID)
+	if err := client.PublishJSON(subject, v); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to archive mission message", "mission", mission.Name, "subject", subject)
+	}
+}
+
+// auditMission archives a task or result the mission controller exchanged
+// for an audited mission.
+func (r *MissionReconciler) auditMission(ctx context.Context, mission *aiv1alpha1.Mission, kind, taskID string, v interface{}) {
+	if mission.Spec.Audit == nil {
+		return
+	}
+	client, err := r.natsClient()
+	if err != nil {
+		return
+	}
+	archiveAudit(ctx, client, mission, kind, taskID, v)
+}
+
+// auditChain archives a task or result of a chain run for an audited
+// mission. Chains that belong to no mission are not archived.
+func (r *ChainReconciler) auditChain(ctx context.Context, chain *aiv1alpha1.Chain, kind, taskID string, v interface{}) {
+	if chain.Spec.MissionRef == "" {
+		return
+	}
+	mission := &aiv1alpha1.Mission{}
+	if err := r.Get(ctx, types.NamespacedName{Name: chain.Spec.MissionRef, Namespace: chainMissionNamespace(chain)}, mission); err != nil ||
+		mission.Spec.Audit == nil {
+		return
+	}
+	client, err := r.natsClient()
+	if err != nil {
+		return
+	}
+	archiveAudit(ctx, client, mission, kind, taskID, v)
+}
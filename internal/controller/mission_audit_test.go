@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestMissionAudit(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	knight := &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{Name: "galahad", Namespace: "default"},
+		Spec: aiv1alpha1.KnightSpec{Domain: "security", NATS: aiv1alpha1.KnightNATS{
+			Subjects:      []string{"fleet-a.tasks.security.galahad"},
+			ResultsStream: "fleet_a_results",
+		}},
+	}
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default"},
+		Spec: aiv1alpha1.MissionSpec{
+			Knights: []aiv1alpha1.MissionKnight{{Name: "galahad"}},
+			Audit:   &aiv1alpha1.MissionAudit{RetentionDays: 30},
+		},
+	}
+	nc := newFakeNATSClient()
+	nc.messages = map[string]*nats.Msg{}
+	r := &MissionReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(mission, knight).Build(),
+		Recorder: record.NewFakeRecorder(10),
+		NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+	}
+	ctx := context.Background()
+
+	if err := r.ensureAuditStream(mission); err != nil {
+		t.Fatalf("ensureAuditStream() error = %v", err)
+	}
+	cfg, ok := nc.streams["audit_default_recon"]
+	if !ok || mission.Status.AuditStream != cfg.Name || cfg.Subjects[0] != "audit.default.recon.>" || cfg.MaxAge != 30*24*time.Hour {
+		t.Fatalf("audit stream = %+v, status %q", cfg, mission.Status.AuditStream)
+	}
+
+	mk := mission.Spec.Knights[0]
+	if err := r.dispatchMissionTask(ctx, nc, natsPrefix(mission), mission, mk, "t1", "debrief", "Summarize your work"); err != nil {
+		t.Fatalf("dispatchMissionTask() error = %v", err)
+	}
+	var task natspkg.TaskPayload
+	if err := json.Unmarshal(nc.published["audit.default.recon.task.t1"], &task); err != nil || task.Task != "Summarize your work" {
+		t.Errorf("archived task = %+v, %v", task, err)
+	}
+
+	data, _ := json.Marshal(natspkg.TaskResult{TaskID: "t1", Output: "All clear"})
+	nc.messages[natspkg.ResultSubject("fleet-a", "t1")] = &nats.Msg{Data: data}
+	if _, err := r.pollMissionTaskResult(ctx, nc, natsPrefix(mission), mission, mk, "t1"); err != nil {
+		t.Fatalf("pollMissionTaskResult() error = %v", err)
+	}
+	var result natspkg.TaskResult
+	if err := json.Unmarshal(nc.published["audit.default.recon.result.t1"], &result); err != nil || result.Output != "All clear" {
+		t.Errorf("archived result = %+v, %v", result, err)
+	}
+
+	mission.Spec.Audit = nil
+	if err := r.dispatchMissionTask(ctx, nc, natsPrefix(mission), mission, mk, "t2", "debrief", "Again"); err != nil {
+		t.Fatalf("dispatchMissionTask() error = %v", err)
+	}
+	if _, ok := nc.published["audit.default.recon.task.t2"]; ok {
+		t.Error("task of an unaudited mission was archived")
+	}
+}
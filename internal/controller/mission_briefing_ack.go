@@ -0,0 +1,151 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/status"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// briefingAckEnabled reports whether the mission waits for knights to
+// acknowledge its briefing before starting.
+func briefingAckEnabled(mission *aiv1alpha1.Mission) bool {
+	return mission.Spec.BriefingAckTimeout > 0 && mission.Spec.Briefing != ""
+}
+
+// briefingAckSubject returns the subject a mission knight acknowledges the
+// briefing on. It sits under the mission prefix so the mission stream
+// captures acks published before the operator polls for them.
+func briefingAckSubject(mission *aiv1alpha1.Mission, knight string) string {
+	return natspkg.BriefingAckSubject(natsPrefix(mission), knight)
+}
+
+// pollBriefingAck reports whether the knight has published its briefing
+// acknowledgment.
+func pollBriefingAck(ctx context.Context, nc natspkg.Client, mission *aiv1alpha1.Mission, knight string) bool {
+	consumer := fmt.Sprintf("mission-%s-ack-%s", mission.Name, knight)
+	opts := []natspkg.SubscribeOption{
+		natspkg.WithDurable(consumer),
+		natspkg.WithAckExplicit(),
+		natspkg.WithDeliverAll(),
+		natspkg.WithFallbackAutoDetect(),
+	}
+	stream := mission.Status.NATSMissionStream
+	if stream != "" {
+		opts = append(opts, natspkg.WithBindStream(stream))
+	}
+	msg, err := nc.PollMessage(briefingAckSubject(mission, knight), time.Second, opts...)
+	if err != nil || msg == nil {
+		return false
+	}
+	if err := msg.Ack(); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to ack briefing acknowledgment", "knight", knight, "error", err.Error())
+	}
+	if stream != "" {
+		_ = nc.DeleteConsumer(stream, consumer)
+	}
+	return true
+}
+
+// awaitBriefingAcks holds a briefed mission until every knight briefed
+// acknowledges, failing it once spec.briefingAckTimeout passes. It returns
+// handled=false when all knights have acknowledged and the mission may go
+// Active.
+func (r *MissionReconciler) awaitBriefingAcks(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool, error) {
+	log := logf.FromContext(ctx)
+
+	nc, err := r.natsClient()
+	if err != nil {
+		log.Error(err, "Cannot collect briefing acknowledgments without NATS")
+		return ctrl.Result{RequeueAfter: RequeueDefault}, true, nil
+	}
+
+	var pending []string
+	for i := range mission.Status.KnightStatuses {
+		ks := &mission.Status.KnightStatuses[i]
+		if ks.Ephemeral || ks.BriefingAcknowledged {
+			continue
+		}
+		if pollBriefingAck(ctx, nc, mission, ks.Name) {
+			log.Info("Knight acknowledged briefing", "knight", ks.Name)
+			ks.BriefingAcknowledged = true
+			continue
+		}
+		pending = append(pending, ks.Name)
+	}
+
+	if len(pending) == 0 {
+		meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionBriefingAcknowledged,
+			Status:             metav1.ConditionTrue,
+			Reason:             aiv1alpha1.ReasonBriefingAcknowledged,
+			Message:            "All knights acknowledged the briefing",
+			ObservedGeneration: mission.Generation,
+		})
+		return ctrl.Result{}, false, nil
+	}
+
+	waiting := strings.Join(pending, ", ")
+	timeout := time.Duration(mission.Spec.BriefingAckTimeout) * time.Second
+	briefed := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionBriefingPublished)
+	if briefed != nil && time.Since(briefed.LastTransitionTime.Time) > timeout {
+		msg := fmt.Sprintf("Knights did not acknowledge the briefing within %ds: %s", mission.Spec.BriefingAckTimeout, waiting)
+		log.Info("Briefing acknowledgment timed out", "mission", mission.Name, "knights", waiting)
+		err := status.ForMission(mission).
+			Failed(msg).
+			Condition(aiv1alpha1.ConditionBriefingAcknowledged, aiv1alpha1.ReasonBriefingAckTimeout, msg, metav1.ConditionFalse).
+			Condition(aiv1alpha1.ConditionMissionComplete, aiv1alpha1.ReasonBriefingAckTimeout, msg, metav1.ConditionTrue).
+			Apply(ctx, r.Client)
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, true, nil
+		}
+		if err == nil {
+			r.Recorder.Event(mission, corev1.EventTypeWarning, "BriefingNotAcknowledged", msg)
+			r.recordPhaseTransition(mission)
+		}
+		return ctrl.Result{RequeueAfter: RequeueFast}, true, err
+	}
+
+	meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionBriefingAcknowledged,
+		Status:             metav1.ConditionFalse,
+		Reason:             aiv1alpha1.ReasonBriefingAckPending,
+		Message:            fmt.Sprintf("Awaiting briefing acknowledgment from: %s", waiting),
+		ObservedGeneration: mission.Generation,
+	})
+	mission.Status.ObservedGeneration = mission.Generation
+	if err := r.Status().Update(ctx, mission); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, true, nil
+		}
+		return ctrl.Result{}, true, err
+	}
+	return ctrl.Result{RequeueAfter: RequeueMedium}, true, nil
+}
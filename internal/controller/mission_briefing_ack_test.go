@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestBriefingAckGate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	knights := []*aiv1alpha1.Knight{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "galahad", Namespace: "default"},
+			Spec: aiv1alpha1.KnightSpec{Domain: "security", NATS: aiv1alpha1.KnightNATS{
+				Subjects: []string{"fleet-a.tasks.security.galahad"},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "percival", Namespace: "default"},
+			Spec: aiv1alpha1.KnightSpec{Domain: "security", NATS: aiv1alpha1.KnightNATS{
+				Subjects: []string{"fleet-a.tasks.security.percival"},
+			}},
+		},
+	}
+	newMission := func() *aiv1alpha1.Mission {
+		return &aiv1alpha1.Mission{
+			ObjectMeta: metav1.ObjectMeta{Name: "quest", Namespace: "default", Generation: 1},
+			Spec: aiv1alpha1.MissionSpec{
+				Briefing:           "Hold the bridge",
+				BriefingAckTimeout: 60,
+				Knights:            []aiv1alpha1.MissionKnight{{Name: "galahad"}, {Name: "percival"}},
+			},
+			Status: aiv1alpha1.MissionStatus{
+				Phase: aiv1alpha1.MissionPhaseBriefing,
+				KnightStatuses: []aiv1alpha1.MissionKnightStatus{
+					{Name: "galahad"}, {Name: "percival"},
+				},
+			},
+		}
+	}
+	newReconciler := func(mission *aiv1alpha1.Mission, nc *fakeNATSClient) *MissionReconciler {
+		c := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(mission, knights[0], knights[1]).
+			WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+		return &MissionReconciler{
+			Client:   c,
+			Scheme:   scheme,
+			Recorder: record.NewFakeRecorder(10),
+			NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+		}
+	}
+
+	t.Run("waits for every knight", func(t *testing.T) {
+		nc := newFakeNATSClient()
+		nc.messages = map[string]*nats.Msg{
+			natspkg.BriefingAckSubject("mission-quest", "galahad"): {Data: []byte("ack")},
+		}
+		mission := newMission()
+		r := newReconciler(mission, nc)
+
+		if _, err := r.reconcileBriefing(ctx, mission); err != nil {
+			t.Fatalf("reconcileBriefing() error = %v", err)
+		}
+		var task natspkg.TaskPayload
+		if err := json.Unmarshal(nc.published["fleet-a.tasks.security.percival"], &task); err != nil {
+			t.Fatalf("briefing not published: %v", err)
+		}
+		if task.AckSubject != "mission-quest.briefing.ack.percival" {
+			t.Errorf("briefing ackSubject = %q", task.AckSubject)
+		}
+		if mission.Status.Phase != aiv1alpha1.MissionPhaseBriefing {
+			t.Fatalf("phase = %s, want Briefing while percival has not acknowledged", mission.Status.Phase)
+		}
+		if ks := mission.Status.KnightStatuses; !ks[0].BriefingAcknowledged || ks[1].BriefingAcknowledged {
+			t.Errorf("knight statuses = %+v, want only galahad acknowledged", ks)
+		}
+		cond := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionBriefingAcknowledged)
+		if cond == nil || cond.Reason != aiv1alpha1.ReasonBriefingAckPending || !strings.Contains(cond.Message, "percival") {
+			t.Errorf("condition = %+v, want pending on percival", cond)
+		}
+
+		delete(nc.published, "fleet-a.tasks.security.percival")
+		nc.messages[natspkg.BriefingAckSubject("mission-quest", "percival")] = &nats.Msg{Data: []byte("ack")}
+		if _, err := r.reconcileBriefing(ctx, mission); err != nil {
+			t.Fatalf("reconcileBriefing() error = %v", err)
+		}
+		if _, ok := nc.published["fleet-a.tasks.security.percival"]; ok {
+			t.Error("briefing published again while waiting for acknowledgments")
+		}
+		if mission.Status.Phase != aiv1alpha1.MissionPhaseActive {
+			t.Errorf("phase = %s, want Active once every knight acknowledged", mission.Status.Phase)
+		}
+	})
+
+	t.Run("fails the mission after the timeout", func(t *testing.T) {
+		mission := newMission()
+		mission.Status.Conditions = []metav1.Condition{{
+			Type:               aiv1alpha1.ConditionBriefingPublished,
+			Status:             metav1.ConditionTrue,
+			Reason:             aiv1alpha1.ReasonBriefingPublished,
+			ObservedGeneration: 1,
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+		}}
+		nc := newFakeNATSClient()
+		nc.messages = map[string]*nats.Msg{
+			natspkg.BriefingAckSubject("mission-quest", "percival"): {Data: []byte("ack")},
+		}
+		r := newReconciler(mission, nc)
+
+		if _, err := r.reconcileBriefing(ctx, mission); err != nil {
+			t.Fatalf("reconcileBriefing() error = %v", err)
+		}
+		if mission.Status.Phase != aiv1alpha1.MissionPhaseFailed {
+			t.Fatalf("phase = %s, want Failed", mission.Status.Phase)
+		}
+		cond := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionComplete)
+		if cond == nil || cond.Reason != aiv1alpha1.ReasonBriefingAckTimeout || !strings.Contains(cond.Message, "galahad") ||
+			strings.Contains(cond.Message, "percival") {
+			t.Errorf("complete condition = %+v, want a timeout naming only galahad", cond)
+		}
+		if !mission.Status.KnightStatuses[1].BriefingAcknowledged {
+			t.Error("percival's acknowledgment not recorded")
+		}
+	})
+}
@@ -35,16 +35,36 @@ import (
 	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
 )
 
-// fakeNATSClient is an in-memory natspkg.Client that records publishes and
-// fails subjects matched by failSubject.
+// fakeNATSClient is an in-memory natspkg.Client that records publishes
+// and streams, fails subjects matched by failSubject, and serves messages
+// to polls, from fetched to durable consumer fetches, and from stored to
+// stream reads. KV buckets are recorded in buckets and KV reads served
+// from values.
 type fakeNATSClient struct {
 	mu          sync.Mutex
 	published   map[string][]byte
 	failSubject func(subject string) bool
+	messages    map[string]*nats.Msg
+	streams     map[string]natspkg.StreamConfig
+	fetched     map[string][]*nats.Msg
+	stored      map[string][]*nats.RawStreamMsg
+	sent        []*nats.Msg
+	buckets     map[string]natspkg.KeyValueConfig
+	values      map[string]map[string][]byte
+	consumers   map[string]*nats.ConsumerInfo
+	ensured     map[string]string
+	deleted     []string
+	backlogs    map[string]uint64
+	rtt         time.Duration
 }
 
 func newFakeNATSClient() *fakeNATSClient {
-	return &fakeNATSClient{published: map[string][]byte{}}
+	return &fakeNATSClient{
+		published: map[string][]byte{},
+		streams:   map[string]natspkg.StreamConfig{},
+		stored:    map[string][]*nats.RawStreamMsg{},
+		buckets:   map[string]natspkg.KeyValueConfig{},
+	}
 }
 
 func (f *fakeNATSClient) subjects() []string {
@@ -60,6 +80,11 @@ func (f *fakeNATSClient) subjects() []string {
 func (f *fakeNATSClient) Connect() error    { return nil }
 func (f *fakeNATSClient) Close() error      { return nil }
 func (f *fakeNATSClient) IsConnected() bool { return true }
+func (f *fakeNATSClient) RTT() (time.Duration, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rtt, nil
+}
 
 func (f *fakeNATSClient) Publish(subject string, data []byte) error {
 	if f.failSubject != nil && f.failSubject(subject) {
@@ -79,25 +104,174 @@ func (f *fakeNATSClient) PublishJSON(subject string, v interface{}) error {
 	return f.Publish(subject, data)
 }
 
+func (f *fakeNATSClient) PublishMsg(msg *nats.Msg) error {
+	if err := f.Publish(msg.Subject, msg.Data); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, msg)
+	return nil
+}
+
+func (f *fakeNATSClient) PublishCore(subject string, data []byte) error {
+	return f.Publish(subject, data)
+}
+
 func (f *fakeNATSClient) Subscribe(string, ...natspkg.SubscribeOption) (*nats.Subscription, error) {
 	return nil, fmt.Errorf("not implemented")
 }
-func (f *fakeNATSClient) CreateStream(natspkg.StreamConfig) error { return nil }
-func (f *fakeNATSClient) DeleteStream(string) error               { return nil }
-func (f *fakeNATSClient) StreamInfo(string) (*nats.StreamInfo, error) {
-	return nil, fmt.Errorf("not implemented")
+func (f *fakeNATSClient) CreateStream(cfg natspkg.StreamConfig) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.streams[cfg.Name] = cfg
+	return nil
+}
+
+func (f *fakeNATSClient) DeleteStream(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.streams[name]; !ok {
+		return fmt.Errorf("failed to delete stream %s: %w", name, nats.ErrStreamNotFound)
+	}
+	delete(f.streams, name)
+	return nil
+}
+
+func (f *fakeNATSClient) UpdateStream(cfg natspkg.StreamConfig) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.streams[cfg.Name]; !ok {
+		return fmt.Errorf("failed to update stream %s: %w", cfg.Name, nats.ErrStreamNotFound)
+	}
+	f.streams[cfg.Name] = cfg
+	return nil
+}
+
+func (f *fakeNATSClient) StreamInfo(name string) (*nats.StreamInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cfg, ok := f.streams[name]
+	if !ok {
+		return nil, fmt.Errorf("failed to get stream info for %s: %w", name, nats.ErrStreamNotFound)
+	}
+	info := &nats.StreamInfo{Config: *cfg.NATSConfig()}
+	if msgs := f.stored[name]; len(msgs) > 0 {
+		info.State = nats.StreamState{Msgs: uint64(len(msgs)), FirstSeq: msgs[0].Sequence, LastSeq: msgs[len(msgs)-1].Sequence}
+	}
+	return info, nil
+}
+func (f *fakeNATSClient) SubjectMessages(_, filter string) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.backlogs[filter], nil
+}
+
+func (f *fakeNATSClient) PurgeStream(name string, before uint64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var kept []*nats.RawStreamMsg
+	for _, msg := range f.stored[name] {
+		if before > 0 && msg.Sequence >= before {
+			kept = append(kept, msg)
+		}
+	}
+	f.stored[name] = kept
+	return nil
+}
+func (f *fakeNATSClient) GetMessage(stream string, seq uint64) (*nats.RawStreamMsg, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, msg := range f.stored[stream] {
+		if msg.Sequence == seq {
+			return msg, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to get message %d from stream %s: %w", seq, stream, nats.ErrMsgNotFound)
 }
-func (f *fakeNATSClient) EnsureConsumer(string, string, natspkg.ConsumerConfig) error { return nil }
-func (f *fakeNATSClient) DeleteConsumer(string, string) error                         { return nil }
-func (f *fakeNATSClient) PollMessage(string, time.Duration, ...natspkg.SubscribeOption) (*nats.Msg, error) {
+func (f *fakeNATSClient) EnsureConsumer(stream, name string, _ natspkg.ConsumerConfig) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.ensured == nil {
+		f.ensured = map[string]string{}
+	}
+	f.ensured[stream+"/"+name] = stream
+	return nil
+}
+func (f *fakeNATSClient) DeleteConsumer(stream, name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.ensured, stream+"/"+name)
+	f.deleted = append(f.deleted, stream+"/"+name)
+	return nil
+}
+func (f *fakeNATSClient) ConsumerInfo(_, consumer string) (*nats.ConsumerInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if info, ok := f.consumers[consumer]; ok {
+		return info, nil
+	}
+	return nil, nats.ErrConsumerNotFound
+}
+func (f *fakeNATSClient) PollMessage(subject string, _ time.Duration, _ ...natspkg.SubscribeOption) (*nats.Msg, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if msg, ok := f.messages[subject]; ok {
+		return msg, nil
+	}
 	return nil, fmt.Errorf("not implemented")
 }
+func (f *fakeNATSClient) FetchMessage(_, consumer string, _ time.Duration) (*nats.Msg, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	queue := f.fetched[consumer]
+	if len(queue) == 0 {
+		return nil, nil
+	}
+	f.fetched[consumer] = queue[1:]
+	return queue[0], nil
+}
+func (f *fakeNATSClient) StreamNameBySubject(string) (string, error) {
+	return "", fmt.Errorf("not implemented")
+}
 func (f *fakeNATSClient) KVPut(string, string, []byte) error { return nil }
-func (f *fakeNATSClient) KVGet(string, string) ([]byte, error) {
+func (f *fakeNATSClient) KVGet(bucket, key string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if value, ok := f.values[bucket][key]; ok {
+		return value, nil
+	}
+	return nil, fmt.Errorf("not found")
+}
+func (f *fakeNATSClient) KVDelete(string, string) error { return nil }
+func (f *fakeNATSClient) KVKeys(bucket string) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	keys := make([]string, 0, len(f.values[bucket]))
+	for key := range f.values[bucket] {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+func (f *fakeNATSClient) EnsureKeyValue(cfg natspkg.KeyValueConfig) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.buckets[cfg.Bucket] = cfg
+	return nil
+}
+func (f *fakeNATSClient) DeleteKeyValue(bucket string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.buckets[bucket]; !ok {
+		return fmt.Errorf("failed to delete KV bucket %s: %w", bucket, nats.ErrBucketNotFound)
+	}
+	delete(f.buckets, bucket)
+	return nil
+}
+func (f *fakeNATSClient) ObjectPut(string, string, []byte) error { return nil }
+func (f *fakeNATSClient) ObjectGet(string, string) ([]byte, error) {
 	return nil, fmt.Errorf("not found")
 }
-func (f *fakeNATSClient) KVDelete(string, string) error   { return nil }
-func (f *fakeNATSClient) KVKeys(string) ([]string, error) { return nil, nil }
 
 var _ = Describe("MissionReconciler.publishBriefing", func() {
 	const namespace = "default"
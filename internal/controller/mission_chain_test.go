@@ -0,0 +1,153 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestMissionChainSpec(t *testing.T) {
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "quest", Namespace: "default"},
+		Spec:       aiv1alpha1.MissionSpec{RoundTableRef: "quest-rt"},
+	}
+	source := &aiv1alpha1.Chain{
+		Spec: aiv1alpha1.ChainSpec{
+			Description:   "Nightly audit",
+			Steps:         []aiv1alpha1.ChainStep{{Name: "scan", KnightRef: "galahad", Task: "scan {{ .Input }}"}},
+			Schedule:      "0 2 * * *",
+			Triggers:      &aiv1alpha1.ChainTriggers{},
+			Suspended:     true,
+			RoundTableRef: "fleet-a",
+			InputFrom: &aiv1alpha1.ChainInputSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "targets"}, Key: "hosts",
+			}},
+			OutputPolicy:  &aiv1alpha1.OutputPolicy{MaxBytes: 1024},
+			CostBudgetUSD: "5.00",
+		},
+	}
+
+	spec := missionChainSpec(mission, aiv1alpha1.MissionChainRef{Name: "audit", InputOverride: "10.0.0.0/24"}, source)
+	if spec.Input != "10.0.0.0/24" || spec.InputFrom != nil {
+		t.Errorf("input = %q, inputFrom %v, want the override alone", spec.Input, spec.InputFrom)
+	}
+	if spec.Schedule != "" || spec.Triggers != nil || spec.Suspended {
+		t.Errorf("copy keeps schedule %q, triggers %v, suspended %t", spec.Schedule, spec.Triggers, spec.Suspended)
+	}
+	if spec.RoundTableRef != "quest-rt" || spec.MissionRef != "quest" {
+		t.Errorf("roundTableRef = %q, missionRef %q", spec.RoundTableRef, spec.MissionRef)
+	}
+	if spec.OutputPolicy == nil || spec.CostBudgetUSD != "5.00" || len(spec.Steps) != 1 {
+		t.Errorf("copy lost the source's steps or policies: %+v", spec)
+	}
+
+	spec.Steps[0].Task = "changed"
+	if source.Spec.Steps[0].Task != "scan {{ .Input }}" {
+		t.Error("editing the copy changed the source chain")
+	}
+
+	spec = missionChainSpec(mission, aiv1alpha1.MissionChainRef{Name: "audit"}, source)
+	if spec.InputFrom == nil {
+		t.Error("copy without an inputOverride dropped the source's inputFrom")
+	}
+
+	mission.Spec.Priority = 500
+	source.Spec.Priority = 100
+	source.Spec.Steps = append(source.Spec.Steps,
+		aiv1alpha1.ChainStep{Name: "low", Priority: ptr.To[int32](10)},
+		aiv1alpha1.ChainStep{Name: "urgent", Priority: ptr.To[int32](900)})
+	spec = missionChainSpec(mission, aiv1alpha1.MissionChainRef{Name: "audit"}, source)
+	if spec.Priority != 500 || *spec.Steps[1].Priority != 500 || *spec.Steps[2].Priority != 900 {
+		t.Errorf("priorities = %d, %d, %d, want the mission's priority as a floor",
+			spec.Priority, *spec.Steps[1].Priority, *spec.Steps[2].Priority)
+	}
+	if *source.Spec.Steps[1].Priority != 10 {
+		t.Error("raising the copy's step priority changed the source chain")
+	}
+}
+
+func TestReconcileMissionChainsSetupFirst(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	source := func(name string) *aiv1alpha1.Chain {
+		return &aiv1alpha1.Chain{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{{Name: "run", KnightRef: "galahad", Task: name}}},
+		}
+	}
+	newMission := func() *aiv1alpha1.Mission {
+		return &aiv1alpha1.Mission{
+			ObjectMeta: metav1.ObjectMeta{Name: "quest", Namespace: "default"},
+			Spec: aiv1alpha1.MissionSpec{Chains: []aiv1alpha1.MissionChainRef{
+				{Name: "scan"},
+				{Name: "prep", Phase: "Setup"},
+			}},
+		}
+	}
+	ctx := context.Background()
+	key := func(name string) types.NamespacedName {
+		return types.NamespacedName{Name: "mission-quest-" + name, Namespace: "default"}
+	}
+	finishPrep := func(t *testing.T, r *MissionReconciler, phase aiv1alpha1.ChainPhase) {
+		t.Helper()
+		chain := &aiv1alpha1.Chain{}
+		if err := r.Get(ctx, key("prep"), chain); err != nil {
+			t.Fatalf("setup chain not created: %v", err)
+		}
+		chain.Status.Phase = phase
+		if err := r.Status().Update(ctx, chain); err != nil {
+			t.Fatalf("update setup chain: %v", err)
+		}
+	}
+
+	for _, tt := range []struct {
+		name       string
+		prepPhase  aiv1alpha1.ChainPhase
+		wantFailed bool
+		wantActive bool
+	}{
+		{name: "active starts after setup succeeds", prepPhase: aiv1alpha1.ChainPhaseSucceeded, wantActive: true},
+		{name: "setup failure fails fast", prepPhase: aiv1alpha1.ChainPhaseFailed, wantFailed: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &MissionReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(source("prep"), source("scan")).
+					WithStatusSubresource(&aiv1alpha1.Chain{}).Build(),
+				Scheme:   scheme,
+				Recorder: record.NewFakeRecorder(10),
+			}
+			mission := newMission()
+
+			if complete, failed, err := r.reconcileMissionChains(ctx, mission); err != nil || complete || failed {
+				t.Fatalf("reconcileMissionChains() = %t, %t, %v while setup runs", complete, failed, err)
+			}
+			if err := r.Get(ctx, key("scan"), &aiv1alpha1.Chain{}); !apierrors.IsNotFound(err) {
+				t.Fatalf("active chain created before setup finished: %v", err)
+			}
+
+			finishPrep(t, r, tt.prepPhase)
+			_, failed, err := r.reconcileMissionChains(ctx, mission)
+			if err != nil || failed != tt.wantFailed {
+				t.Fatalf("reconcileMissionChains() failed = %t, %v, want %t", failed, err, tt.wantFailed)
+			}
+			if err := r.Get(ctx, key("scan"), &aiv1alpha1.Chain{}); (err == nil) != tt.wantActive {
+				t.Errorf("active chain exists = %t, want %t", err == nil, tt.wantActive)
+			}
+			if got := failedSetupChain(mission); (got == "prep") != tt.wantFailed {
+				t.Errorf("failedSetupChain() = %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+const (
+	// missionChatArchiveLimit caps the chat messages archived per mission.
+	missionChatArchiveLimit = 1000
+
+	// missionChatFetchTimeout is how long the archive waits for the next
+	// chat message before treating the channel as drained.
+	missionChatFetchTimeout = 500 * time.Millisecond
+)
+
+// archiveMissionChat reads the mission's chat channel back from the
+// mission stream, oldest first. Messages that are not ChatMessage JSON are
+// kept as plain text. Archiving is best-effort: a failure returns what was
+// read so far.
+func archiveMissionChat(ctx context.Context, client natspkg.Client, mission *aiv1alpha1.Mission) []natspkg.ChatMessage {
+	stream, subject := mission.Status.NATSMissionStream, mission.Status.ChatSubject
+	if stream == "" || subject == "" {
+		return nil
+	}
+	log := logf.FromContext(ctx)
+
+	consumer := "mission-" + mission.Name + "-chat-archive"
+	if err := client.EnsureConsumer(stream, consumer, natspkg.ConsumerConfig{
+		FilterSubject: subject,
+		AckPolicy:     natspkg.AckExplicit,
+		DeliverPolicy: natspkg.DeliverAll,
+	}); err != nil {
+		log.Error(err, "Failed to create chat archive consumer", "subject", subject)
+		return nil
+	}
+	defer func() { _ = client.DeleteConsumer(stream, consumer) }()
+
+	var chat []natspkg.ChatMessage
+	for len(chat) < missionChatArchiveLimit {
+		msg, err := client.FetchMessage(stream, consumer, missionChatFetchTimeout)
+		if err != nil {
+			log.Error(err, "Failed to read mission chat", "subject", subject)
+			break
+		}
+		if msg == nil {
+			break
+		}
+		var cm natspkg.ChatMessage
+		if err := json.Unmarshal(msg.Data, &cm); err != nil || cm.Message == "" {
+			cm = natspkg.ChatMessage{Message: string(msg.Data)}
+		}
+		chat = append(chat, cm)
+		_ = msg.Ack()
+	}
+	return chat
+}
@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestMissionChat(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	knight := &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{Name: "galahad", Namespace: "default"},
+		Spec: aiv1alpha1.KnightSpec{Domain: "security", NATS: aiv1alpha1.KnightNATS{
+			Subjects: []string{"fleet-a.tasks.security.galahad"},
+		}},
+	}
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "quest", Namespace: "default", UID: "quest-uid-1"},
+		Spec: aiv1alpha1.MissionSpec{
+			Briefing: "Hold the bridge",
+			Knights:  []aiv1alpha1.MissionKnight{{Name: "galahad"}},
+		},
+	}
+	nc := newFakeNATSClient()
+	r := &MissionReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(knight).Build(),
+		Recorder: record.NewFakeRecorder(10),
+		NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+	}
+
+	r.ensureMissionStream(ctx, mission)
+	if mission.Status.ChatSubject != "mission-quest.chat" {
+		t.Fatalf("chatSubject = %q, want mission-quest.chat", mission.Status.ChatSubject)
+	}
+
+	if err := r.publishBriefing(ctx, mission); err != nil {
+		t.Fatalf("publishBriefing() error = %v", err)
+	}
+	var task natspkg.TaskPayload
+	if err := json.Unmarshal(nc.published["fleet-a.tasks.security.galahad"], &task); err != nil {
+		t.Fatalf("briefing not published: %v", err)
+	}
+	if task.ChatSubject != "mission-quest.chat" {
+		t.Errorf("briefing chatSubject = %q, want the mission chat subject", task.ChatSubject)
+	}
+
+	finding, _ := json.Marshal(natspkg.ChatMessage{Knight: "galahad", Message: "Port 22 is open"})
+	nc.fetched = map[string][]*nats.Msg{
+		"mission-quest-chat-archive": {{Data: finding}, {Data: []byte("ack, checking")}},
+	}
+	chat := archiveMissionChat(ctx, nc, mission)
+	want := []natspkg.ChatMessage{
+		{Knight: "galahad", Message: "Port 22 is open"},
+		{Message: "ack, checking"},
+	}
+	if len(chat) != len(want) {
+		t.Fatalf("archiveMissionChat() = %+v, want %+v", chat, want)
+	}
+	for i := range want {
+		if chat[i].Knight != want[i].Knight || chat[i].Message != want[i].Message {
+			t.Errorf("chat[%d] = %+v, want %+v", i, chat[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+const (
+	// missionRationaleLimit caps each vote's rationale kept in status.
+	missionRationaleLimit = 2 << 10
+
+	// defaultConsensusTimeout applies when spec.consensus.timeout is unset.
+	defaultConsensusTimeout = 600 * time.Second
+)
+
+// consensusTimeout returns how long the vote may take.
+func consensusTimeout(consensus *aiv1alpha1.MissionConsensus) time.Duration {
+	if consensus.Timeout > 0 {
+		return time.Duration(consensus.Timeout) * time.Second
+	}
+	return defaultConsensusTimeout
+}
+
+// consensusVoters returns the knights asked to vote: spec.consensus.voters,
+// or every mission knight.
+func consensusVoters(mission *aiv1alpha1.Mission) []string {
+	if voters := mission.Spec.Consensus.Voters; len(voters) > 0 {
+		return voters
+	}
+	voters := make([]string, 0, len(mission.Spec.Knights))
+	for _, mk := range mission.Spec.Knights {
+		voters = append(voters, mk.Name)
+	}
+	return voters
+}
+
+// validateConsensus checks the vote can be held by the mission's knights.
+// Meta-missions get their knights from the planner, so only the quorum
+// against explicit voters can be checked for them.
+func validateConsensus(mission *aiv1alpha1.Mission, knightNames map[string]bool) string {
+	c := mission.Spec.Consensus
+	if c == nil {
+		return ""
+	}
+	if !mission.Spec.MetaMission {
+		for _, v := range c.Voters {
+			if !knightNames[v] {
+				return fmt.Sprintf("Consensus voter %s is not a mission knight", v)
+			}
+		}
+	}
+	if voters := consensusVoters(mission); (len(c.Voters) > 0 || !mission.Spec.MetaMission) && int(c.Quorum) > len(voters) {
+		return fmt.Sprintf("Consensus quorum %d exceeds the %d voters", c.Quorum, len(voters))
+	}
+	return ""
+}
+
+// voteTaskID names a voter's vote task.
+func voteTaskID(mission *aiv1alpha1.Mission, knight string) string {
+	return fmt.Sprintf("mission-%s-vote-%s-gen%d", mission.Name, knight, mission.Generation)
+}
+
+// voteTask asks a knight for its vote.
+func voteTask(mission *aiv1alpha1.Mission) string {
+	c := mission.Spec.Consensus
+	var b strings.Builder
+	b.WriteString(missionHeader(mission))
+	b.WriteString("\n\nAnswer the question below on your own judgment; other knights answer it independently.\n\n")
+	b.WriteString(c.Prompt)
+	if len(c.Choices) > 0 {
+		fmt.Fprintf(&b, "\n\nYour vote must be one of: %s.", strings.Join(c.Choices, ", "))
+	}
+	b.WriteString("\n\nReply with a JSON object only: {\"vote\": \"<your answer>\", \"rationale\": \"<why>\"}")
+	return b.String()
+}
+
+// parseVote reads a knight's vote from its answer: the JSON object the
+// task asks for, or, when choices are set, a bare answer naming one. The
+// vote is normalized to the matching choice.
+func parseVote(consensus *aiv1alpha1.MissionConsensus, output string) (vote, rationale string, err error) {
+	var answer struct {
+		Vote      string `json:"vote"`
+		Rationale string `json:"rationale"`
+	}
+	start, end := strings.Index(output, "{"), strings.LastIndex(output, "}")
+	if start >= 0 && end > start && json.Unmarshal([]byte(output[start:end+1]), &answer) == nil && answer.Vote != "" {
+		vote, rationale = strings.TrimSpace(answer.Vote), answer.Rationale
+	} else if len(consensus.Choices) > 0 {
+		vote = strings.TrimSpace(strings.SplitN(strings.TrimSpace(output), "\n", 2)[0])
+	} else {
+		return "", "", fmt.Errorf("no structured vote in the answer")
+	}
+
+	if len(consensus.Choices) == 0 {
+		return vote, rationale, nil
+	}
+	for _, choice := range consensus.Choices {
+		if strings.EqualFold(strings.Trim(vote, ".\"' "), choice) {
+			return choice, rationale, nil
+		}
+	}
+	return "", rationale, fmt.Errorf("vote %q is not one of the choices", vote)
+}
+
+// tallyVotes counts the valid votes and returns the vote that reached the
+// quorum, "" if none did or two tied at it, and a summary of the count.
+func tallyVotes(consensus *aiv1alpha1.MissionConsensus, votes []aiv1alpha1.MissionVote, voters int) (string, string) {
+	counts := map[string]int{}
+	// Free-form answers agree when they match case-insensitively; the first
+	// spelling seen names them.
+	spelling := map[string]string{}
+	for _, v := range votes {
+		if v.Vote == "" {
+			continue
+		}
+		key := strings.ToLower(v.Vote)
+		if _, ok := spelling[key]; !ok {
+			spelling[key] = v.Vote
+		}
+		counts[key]++
+	}
+
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	parts := make([]string, 0, len(keys)+1)
+	cast := 0
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s %d", spelling[k], counts[k]))
+		cast += counts[k]
+	}
+	if abstained := voters - cast; abstained > 0 {
+		parts = append(parts, fmt.Sprintf("%d abstained", abstained))
+	}
+	tally := strings.Join(parts, ", ")
+
+	if len(keys) == 0 || counts[keys[0]] < int(consensus.Quorum) ||
+		(len(keys) > 1 && counts[keys[1]] == counts[keys[0]]) {
+		return "", tally
+	}
+	return spelling[keys[0]], tally
+}
+
+// consensusReport is the mission result for a vote: the decision and every
+// voter's answer.
+func consensusReport(mission *aiv1alpha1.Mission) string {
+	cs := mission.Status.Consensus
+	var b strings.Builder
+	if cs.Decision != "" {
+		fmt.Fprintf(&b, "Consensus reached: %s (%s)", cs.Decision, cs.Tally)
+	} else {
+		fmt.Fprintf(&b, "No consensus: no vote reached the quorum of %d (%s)", mission.Spec.Consensus.Quorum, cs.Tally)
+	}
+	for _, v := range cs.Votes {
+		switch {
+		case v.Error != "":
+			fmt.Fprintf(&b, "\n\n### %s\n\n(no vote: %s)", v.Knight, v.Error)
+		default:
+			fmt.Fprintf(&b, "\n\n### %s: %s\n\n%s", v.Knight, v.Vote, strings.TrimRight(v.Rationale, "\n"))
+		}
+	}
+	return b.String()
+}
+
+// reconcileVoting runs the Voting phase: it sends the consensus prompt to
+// every voter, collects their votes until all have answered or the timeout
+// passes, and closes the vote. A vote that reaches the quorum succeeds the
+// mission with it as the result; otherwise the mission fails.
+func (r *MissionReconciler) reconcileVoting(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	spec := mission.Spec.Consensus
+	if spec == nil {
+		return r.closeVote(ctx, mission)
+	}
+	voters := consensusVoters(mission)
+	nc, prefix, err := r.knightNATS(ctx, mission)
+	if err != nil {
+		log.Error(err, "Cannot hold the mission vote without NATS")
+		return ctrl.Result{RequeueAfter: RequeueSlow}, nil
+	}
+
+	cs := mission.Status.Consensus
+	if cs == nil || cs.StartedAt == nil {
+		now := metav1.Now()
+		cs = &aiv1alpha1.MissionConsensusStatus{StartedAt: &now}
+		task := voteTask(mission)
+		for _, name := range voters {
+			mk, ok := missionKnightByName(mission, name)
+			if !ok {
+				cs.Votes = append(cs.Votes, aiv1alpha1.MissionVote{Knight: name, Error: "not a mission knight"})
+				continue
+			}
+			if err := r.dispatchMissionTask(ctx, nc, prefix, mission, mk, voteTaskID(mission, name), "vote", task); err != nil {
+				log.Error(err, "Failed to dispatch vote task", "knight", name)
+				cs.Votes = append(cs.Votes, aiv1alpha1.MissionVote{
+					Knight: name,
+					Error:  fmt.Sprintf("vote task not delivered: %v", err),
+				})
+			}
+		}
+		mission.Status.Consensus = cs
+		r.Recorder.Eventf(mission, corev1.EventTypeNormal, "VotingStarted",
+			"Asked %d knights to vote; quorum is %d", len(voters), spec.Quorum)
+		return r.updateDebrief(ctx, mission, RequeueFast)
+	}
+
+	answered := make(map[string]bool, len(cs.Votes))
+	for _, v := range cs.Votes {
+		answered[v.Knight] = true
+	}
+	for _, name := range voters {
+		if answered[name] {
+			continue
+		}
+		mk, _ := missionKnightByName(mission, name)
+		result, err := r.pollMissionTaskResult(ctx, nc, prefix, mission, mk, voteTaskID(mission, name))
+		if err != nil {
+			log.Error(err, "Failed to read vote", "knight", name)
+			continue
+		}
+		if result == nil {
+			continue
+		}
+		v := aiv1alpha1.MissionVote{Knight: name, Error: result.GetError()}
+		if v.Error == "" {
+			vote, rationale, err := parseVote(spec, result.GetOutput())
+			v.Vote, v.Rationale = vote, truncateOutput(rationale, missionRationaleLimit)
+			if err != nil {
+				v.Error = err.Error()
+			}
+		}
+		cs.Votes = append(cs.Votes, v)
+	}
+	if len(cs.Votes) < len(voters) && time.Since(cs.StartedAt.Time) < consensusTimeout(spec) {
+		return r.updateDebrief(ctx, mission, RequeueDefault)
+	}
+	return r.closeVote(ctx, mission)
+}
+
+// closeVote tallies the votes, records the outcome and moves the mission
+// on to Debriefing or its terminal phase.
+func (r *MissionReconciler) closeVote(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
+	outcome, reason := aiv1alpha1.MissionPhaseSucceeded, aiv1alpha1.ReasonMissionSucceeded
+	if spec := mission.Spec.Consensus; spec != nil {
+		if mission.Status.Consensus == nil {
+			mission.Status.Consensus = &aiv1alpha1.MissionConsensusStatus{}
+		}
+		cs := mission.Status.Consensus
+		cs.Decision, cs.Tally = tallyVotes(spec, cs.Votes, len(consensusVoters(mission)))
+		mission.Status.Result = truncateOutput(consensusReport(mission), missionReportLimit)
+		if cs.Decision == "" {
+			outcome, reason = aiv1alpha1.MissionPhaseFailed, aiv1alpha1.ReasonMissionNoConsensus
+			r.Recorder.Eventf(mission, corev1.EventTypeWarning, "NoConsensus",
+				"No vote reached the quorum of %d: %s", spec.Quorum, cs.Tally)
+		} else {
+			r.Recorder.Eventf(mission, corev1.EventTypeNormal, "ConsensusReached",
+				"Knights agreed on %q: %s", cs.Decision, cs.Tally)
+		}
+	}
+	return r.finishDeliberation(ctx, mission, outcome, reason)
+}
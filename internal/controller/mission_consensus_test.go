@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestParseVote(t *testing.T) {
+	choices := &aiv1alpha1.MissionConsensus{Choices: []string{"Approve", "Reject"}}
+	free := &aiv1alpha1.MissionConsensus{}
+	tests := []struct {
+		name      string
+		consensus *aiv1alpha1.MissionConsensus
+		output    string
+		vote      string
+		rationale string
+		wantErr   bool
+	}{
+		{"json", choices, `{"vote": "approve", "rationale": "no findings"}`, "Approve", "no findings", false},
+		{"fenced json", free, "```json\n{\"vote\": \"CVE-2026-1234\", \"rationale\": \"matches\"}\n```", "CVE-2026-1234", "matches", false},
+		{"bare choice", choices, "Reject.\nThe patch is incomplete.", "Reject", "", false},
+		{"unknown choice", choices, `{"vote": "abstain"}`, "", "", true},
+		{"free form without json", free, "I think it is fine", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vote, rationale, err := parseVote(tt.consensus, tt.output)
+			if (err != nil) != tt.wantErr || vote != tt.vote || rationale != tt.rationale {
+				t.Errorf("parseVote() = %q, %q, %v, want %q, %q, error %t", vote, rationale, err, tt.vote, tt.rationale, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTallyVotes(t *testing.T) {
+	votes := func(vs ...string) []aiv1alpha1.MissionVote {
+		var out []aiv1alpha1.MissionVote
+		for i, v := range vs {
+			out = append(out, aiv1alpha1.MissionVote{Knight: string(rune('a' + i)), Vote: v})
+		}
+		return out
+	}
+	tests := []struct {
+		name     string
+		quorum   int32
+		votes    []aiv1alpha1.MissionVote
+		voters   int
+		decision string
+		tally    string
+	}{
+		{"quorum reached", 2, votes("Approve", "Reject", "Approve"), 3, "Approve", "Approve 2, Reject 1"},
+		{"free-form answers agree case-insensitively", 2, votes("Yes", "yes"), 3, "Yes", "Yes 2, 1 abstained"},
+		{"short of quorum", 3, votes("Approve", "Approve", "Reject"), 4, "", "Approve 2, Reject 1, 1 abstained"},
+		{"tie at quorum", 2, votes("Approve", "Reject", "Approve", "Reject"), 4, "", "Approve 2, Reject 2"},
+		{"invalid votes abstain", 1, []aiv1alpha1.MissionVote{{Knight: "a", Error: "no structured vote"}}, 1, "", "1 abstained"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision, tally := tallyVotes(&aiv1alpha1.MissionConsensus{Quorum: tt.quorum}, tt.votes, tt.voters)
+			if decision != tt.decision || tally != tt.tally {
+				t.Errorf("tallyVotes() = %q, %q, want %q, %q", decision, tally, tt.decision, tt.tally)
+			}
+		})
+	}
+}
+
+func TestCloseVote(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "verdict", Namespace: "default"},
+		Spec: aiv1alpha1.MissionSpec{
+			RoundTableRef: "fleet",
+			Knights:       []aiv1alpha1.MissionKnight{{Name: "galahad"}, {Name: "percival"}, {Name: "bors"}},
+			Consensus:     &aiv1alpha1.MissionConsensus{Prompt: "Ship it?", Choices: []string{"Approve", "Reject"}, Quorum: 2},
+		},
+		Status: aiv1alpha1.MissionStatus{
+			Phase: aiv1alpha1.MissionPhaseVoting,
+			Consensus: &aiv1alpha1.MissionConsensusStatus{Votes: []aiv1alpha1.MissionVote{
+				{Knight: "galahad", Vote: "Approve", Rationale: "tests pass"},
+				{Knight: "percival", Vote: "Reject", Rationale: "missing docs"},
+			}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mission).
+		WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionReconciler{Client: c, Recorder: recorder}
+
+	if _, err := r.closeVote(context.Background(), mission); err != nil {
+		t.Fatalf("closeVote() error = %v", err)
+	}
+	if mission.Status.Phase != aiv1alpha1.MissionPhaseFailed {
+		t.Errorf("phase = %s, want Failed without a quorum", mission.Status.Phase)
+	}
+	cond := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionComplete)
+	if cond == nil || cond.Reason != aiv1alpha1.ReasonMissionNoConsensus {
+		t.Errorf("Complete condition = %+v, want NoConsensus", cond)
+	}
+	if !strings.Contains(mission.Status.Result, "### percival: Reject\n\nmissing docs") {
+		t.Errorf("result = %q, want every vote listed", mission.Status.Result)
+	}
+	events := strings.Join(drainEvents(recorder), "\n")
+	if !strings.Contains(events, "NoConsensus") {
+		t.Errorf("events = %v, want NoConsensus", events)
+	}
+}
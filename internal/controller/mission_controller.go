@@ -31,15 +31,19 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
 	"github.com/dapperdivers/roundtable/internal/mission"
 	"github.com/dapperdivers/roundtable/internal/notify"
 	"github.com/dapperdivers/roundtable/internal/status"
+	"github.com/dapperdivers/roundtable/pkg/artifact"
 	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
 )
 
@@ -57,6 +61,8 @@ type MissionReconciler struct {
 	Notify    *notify.Notifier
 	Planner   *mission.Planner
 	Assembler *mission.KnightAssembler
+	// Artifacts stores exported mission timelines for the ObjectStore target.
+	Artifacts artifact.Store
 	mu        sync.Mutex
 }
 
@@ -75,7 +81,8 @@ func (r *MissionReconciler) natsClient() (natspkg.Client, error) {
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=chains,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=roundtables,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
@@ -95,6 +102,17 @@ func (r *MissionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	if mission.DeletionTimestamp != nil {
 		if controllerutil.ContainsFinalizer(mission, missionFinalizer) {
 			log.Info("Cleaning up mission resources", "mission", mission.Name)
+			// Streams are not owned by any object, so a mission deleted
+			// before CleaningUp would otherwise leak them. Best effort: a
+			// NATS outage must not block deletion.
+			if err := r.deleteNATSStreams(ctx, mission); err != nil {
+				log.Error(err, "Failed to delete NATS streams")
+			}
+			// Nothing in an isolation namespace is owned by the mission,
+			// so garbage collection won't remove it.
+			if err := r.deleteIsolationNamespace(ctx, mission); err != nil {
+				return ctrl.Result{}, err
+			}
 			controllerutil.RemoveFinalizer(mission, missionFinalizer)
 			if err := r.Update(ctx, mission); err != nil {
 				return ctrl.Result{}, err
@@ -124,10 +142,19 @@ func (r *MissionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		if apierrors.IsConflict(err) {
 			return ctrl.Result{Requeue: true}, nil
 		}
-		r.Recorder.Eventf(mission, corev1.EventTypeNormal, "PhaseTransition", "Mission transitioned to %s", aiv1alpha1.MissionPhasePending)
+		r.recordPhaseTransition(mission)
 		return ctrl.Result{}, err
 	}
 
+	if res, handled := r.reconcilePhaseHistory(ctx, mission); handled {
+		return res, nil
+	}
+
+	// Honor TTL edits and extensions before checking expiry
+	if res, handled := r.reconcileExpiry(ctx, mission); handled {
+		return res, nil
+	}
+
 	// Check TTL expiration in any non-terminal phase
 	if res, handled, err := r.reconcileTTLExpiry(ctx, mission); handled {
 		return res, err
@@ -138,6 +165,12 @@ func (r *MissionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	if res, handled := r.reconcileNotification(ctx, mission); handled {
 		return res, nil
 	}
+	if res, handled := r.reconcileEscalation(ctx, mission); handled {
+		return res, nil
+	}
+	if res, handled := r.reconcileTimelineExport(ctx, mission); handled {
+		return res, nil
+	}
 
 	switch mission.Status.Phase {
 	case aiv1alpha1.MissionPhasePending:
@@ -145,13 +178,19 @@ func (r *MissionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	case aiv1alpha1.MissionPhaseProvisioning:
 		return r.reconcileProvisioning(ctx, mission)
 	case aiv1alpha1.MissionPhasePlanning:
-		return r.Planner.ReconcilePlanning(ctx, mission)
+		return r.reconcilePlanning(ctx, mission)
 	case aiv1alpha1.MissionPhaseAssembling:
 		return r.reconcileAssembling(ctx, mission)
 	case aiv1alpha1.MissionPhaseBriefing:
 		return r.reconcileBriefing(ctx, mission)
 	case aiv1alpha1.MissionPhaseActive:
 		return r.reconcileActive(ctx, mission)
+	case aiv1alpha1.MissionPhaseVoting:
+		return r.reconcileVoting(ctx, mission)
+	case aiv1alpha1.MissionPhaseDebating:
+		return r.reconcileDebating(ctx, mission)
+	case aiv1alpha1.MissionPhaseDebriefing:
+		return r.reconcileDebriefing(ctx, mission)
 	case aiv1alpha1.MissionPhaseSucceeded, aiv1alpha1.MissionPhaseFailed:
 		// Only transition to cleanup if not already cleaned up (prevents infinite loop)
 		if meta.IsStatusConditionTrue(mission.Status.Conditions, aiv1alpha1.ConditionCleanupComplete) {
@@ -183,6 +222,9 @@ func (r *MissionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		if apierrors.IsConflict(err) {
 			return ctrl.Result{Requeue: true}, nil
 		}
+		if err == nil {
+			r.recordPhaseTransition(mission)
+		}
 		return ctrl.Result{RequeueAfter: RequeueDefault}, err
 	case aiv1alpha1.MissionPhaseCleaningUp:
 		return r.reconcileCleaningUp(ctx, mission)
@@ -193,6 +235,9 @@ func (r *MissionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		if apierrors.IsConflict(err) {
 			return ctrl.Result{Requeue: true}, nil
 		}
+		if err == nil {
+			r.recordPhaseTransition(mission)
+		}
 		return ctrl.Result{RequeueAfter: RequeueDefault}, err
 	}
 
@@ -224,7 +269,7 @@ func (r *MissionReconciler) reconcileTTLExpiry(ctx context.Context, mission *aiv
 		return ctrl.Result{Requeue: true}, true, nil
 	}
 	r.Recorder.Event(mission, corev1.EventTypeWarning, "Timeout", "Mission exceeded TTL")
-	r.Recorder.Eventf(mission, corev1.EventTypeNormal, "PhaseTransition", "Mission transitioned to %s", aiv1alpha1.MissionPhaseCleaningUp)
+	r.recordPhaseTransition(mission)
 	return ctrl.Result{RequeueAfter: RequeueDefault}, true, err
 }
 
@@ -237,7 +282,9 @@ func (r *MissionReconciler) reconcileTTLExpiry(ctx context.Context, mission *aiv
 // condition gets its own status update, and the requeue (backoff on failure,
 // RequeueFast otherwise) resumes normal reconciliation.
 func (r *MissionReconciler) reconcileNotification(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool) {
-	if !meta.IsStatusConditionTrue(mission.Status.Conditions, aiv1alpha1.ConditionMissionComplete) ||
+	// A debriefing mission's outcome is recorded, but its report is not yet.
+	if mission.Status.Phase == aiv1alpha1.MissionPhaseDebriefing ||
+		!meta.IsStatusConditionTrue(mission.Status.Conditions, aiv1alpha1.ConditionMissionComplete) ||
 		!notificationPending(mission.Spec.Notify, mission.Status.Conditions) {
 		return ctrl.Result{}, false
 	}
@@ -276,6 +323,22 @@ func natsPrefix(mission *aiv1alpha1.Mission) string {
 	return fmt.Sprintf("mission-%s", mission.Name)
 }
 
+// recordPhaseTransition emits the event for a mission that has just moved
+// to its current phase.
+func (r *MissionReconciler) recordPhaseTransition(mission *aiv1alpha1.Mission) {
+	r.Recorder.Eventf(mission, corev1.EventTypeNormal, "PhaseTransition", "Mission transitioned to %s", mission.Status.Phase)
+}
+
+// failValidation fails a mission whose spec is invalid.
+func (r *MissionReconciler) failValidation(ctx context.Context, mission *aiv1alpha1.Mission, msg string) error {
+	if err := status.ForMission(mission).Failed(msg).Apply(ctx, r.Client); err != nil {
+		return err
+	}
+	r.Recorder.Event(mission, corev1.EventTypeWarning, "ValidationFailed", msg)
+	r.recordPhaseTransition(mission)
+	return nil
+}
+
 // reconcilePending validates the mission spec before provisioning.
 func (r *MissionReconciler) reconcilePending(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
@@ -284,10 +347,7 @@ func (r *MissionReconciler) reconcilePending(ctx context.Context, mission *aiv1a
 	templateNames := make(map[string]bool)
 	for _, template := range mission.Spec.KnightTemplates {
 		if templateNames[template.Name] {
-			mission.Status.Phase = aiv1alpha1.MissionPhaseFailed
-			mission.Status.Result = fmt.Sprintf("Duplicate knight template name: %s", template.Name)
-			mission.Status.ObservedGeneration = mission.Generation
-			return ctrl.Result{}, r.Status().Update(ctx, mission)
+			return ctrl.Result{}, r.failValidation(ctx, mission, fmt.Sprintf("Duplicate knight template name: %s", template.Name))
 		}
 		templateNames[template.Name] = true
 	}
@@ -296,9 +356,7 @@ func (r *MissionReconciler) reconcilePending(ctx context.Context, mission *aiv1a
 	knightNames := make(map[string]bool)
 	for _, knight := range mission.Spec.Knights {
 		if knightNames[knight.Name] {
-			return ctrl.Result{}, status.ForMission(mission).
-				Failed(fmt.Sprintf("Duplicate knight name: %s", knight.Name)).
-				Apply(ctx, r.Client)
+			return ctrl.Result{}, r.failValidation(ctx, mission, fmt.Sprintf("Duplicate knight name: %s", knight.Name))
 		}
 		knightNames[knight.Name] = true
 
@@ -306,9 +364,12 @@ func (r *MissionReconciler) reconcilePending(ctx context.Context, mission *aiv1a
 		// Note: RoundTable-level templates are validated later during assembling
 		// (they require a Get call we defer to avoid premature fetches).
 		if knight.TemplateRef != "" && !templateNames[knight.TemplateRef] && mission.Spec.RoundTableRef == "" {
-			return ctrl.Result{}, status.ForMission(mission).
-				Failed(fmt.Sprintf("Knight %s references unknown template: %s", knight.Name, knight.TemplateRef)).
-				Apply(ctx, r.Client)
+			return ctrl.Result{}, r.failValidation(ctx, mission, fmt.Sprintf("Knight %s references unknown template: %s", knight.Name, knight.TemplateRef))
+		}
+
+		// Chains in an isolation namespace can only reach knights there.
+		if missionIsolated(mission) && !knight.Ephemeral {
+			return ctrl.Result{}, r.failValidation(ctx, mission, fmt.Sprintf("Knight %s must be ephemeral: an isolated mission cannot recruit existing knights", knight.Name))
 		}
 
 		// Validate ephemeral knights have spec OR templateRef (not both, not neither)
@@ -316,13 +377,24 @@ func (r *MissionReconciler) reconcilePending(ctx context.Context, mission *aiv1a
 			hasSpec := knight.EphemeralSpec != nil
 			hasTemplate := knight.TemplateRef != ""
 			if hasSpec == hasTemplate { // XOR check
-				return ctrl.Result{}, status.ForMission(mission).
-					Failed(fmt.Sprintf("Ephemeral knight %s must have exactly one of ephemeralSpec or templateRef", knight.Name)).
-					Apply(ctx, r.Client)
+				return ctrl.Result{}, r.failValidation(ctx, mission, fmt.Sprintf("Ephemeral knight %s must have exactly one of ephemeralSpec or templateRef", knight.Name))
 			}
 		}
 	}
 
+	// Meta-missions get their knights from the planner, so only a fixed
+	// roster can be checked for the debrief's lead knight here.
+	if d := mission.Spec.Debrief; d != nil && !mission.Spec.MetaMission && !knightNames[d.LeadKnight] {
+		return ctrl.Result{}, r.failValidation(ctx, mission, fmt.Sprintf("Debrief lead knight %s is not a mission knight", d.LeadKnight))
+	}
+
+	if msg := validateConsensus(mission, knightNames); msg != "" {
+		return ctrl.Result{}, r.failValidation(ctx, mission, msg)
+	}
+	if msg := validateDebate(mission, knightNames); msg != "" {
+		return ctrl.Result{}, r.failValidation(ctx, mission, msg)
+	}
+
 	// Validate referenced chains exist
 	for _, chainRef := range mission.Spec.Chains {
 		chain := &aiv1alpha1.Chain{}
@@ -331,22 +403,31 @@ func (r *MissionReconciler) reconcilePending(ctx context.Context, mission *aiv1a
 			Namespace: mission.Namespace,
 		}, chain); err != nil {
 			if client.IgnoreNotFound(err) == nil {
-				return ctrl.Result{}, status.ForMission(mission).
-					Failed(fmt.Sprintf("Referenced chain not found: %s", chainRef.Name)).
-					Apply(ctx, r.Client)
+				return ctrl.Result{}, r.failValidation(ctx, mission, fmt.Sprintf("Referenced chain not found: %s", chainRef.Name))
 			}
 			return ctrl.Result{}, err
 		}
 	}
 
 	log.Info("Mission spec validation passed", "mission", mission.Name)
+
+	// A dry run records its plan and waits here until dryRun is cleared.
+	if mission.Spec.DryRun {
+		return r.reconcileDryRun(ctx, mission)
+	}
+
+	// Wait for a slot under the RoundTable's maxMissions.
+	if result, handled, err := r.admitMission(ctx, mission); handled {
+		return result, err
+	}
+
 	err := status.ForMission(mission).
 		Phase(aiv1alpha1.MissionPhaseProvisioning).
 		Apply(ctx, r.Client)
 	if apierrors.IsConflict(err) {
 		return ctrl.Result{Requeue: true}, nil
 	}
-	r.Recorder.Eventf(mission, corev1.EventTypeNormal, "PhaseTransition", "Mission transitioned to %s", aiv1alpha1.MissionPhaseProvisioning)
+	r.recordPhaseTransition(mission)
 	return ctrl.Result{RequeueAfter: RequeueFast}, err
 }
 
@@ -363,12 +444,30 @@ func nextPhaseAfterProvisioning(mission *aiv1alpha1.Mission) aiv1alpha1.MissionP
 func (r *MissionReconciler) reconcileProvisioning(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
+	// Creation is idempotent; the stream name is saved with the next
+	// status update below.
+	r.ensureMissionStream(ctx, mission)
+
+	// An audited mission must not run unarchived.
+	if err := r.ensureAuditStream(mission); err != nil {
+		log.Error(err, "Failed to create mission audit stream, retrying")
+		r.Recorder.Eventf(mission, corev1.EventTypeWarning, "AuditStreamFailed", "Failed to create audit stream: %v", err)
+		return ctrl.Result{RequeueAfter: RequeueDefault}, nil
+	}
+
+	// An isolated mission always runs on an ephemeral RoundTable in its
+	// own namespace, since its chains resolve their RoundTable there.
+	isolated := missionIsolated(mission)
+	if isolated {
+		if result, handled, err := r.ensureIsolationNamespace(ctx, mission); handled {
+			return result, err
+		}
+	}
+
 	// If roundTableRef is already set, skip provisioning (using existing RT)
-	if mission.Spec.RoundTableRef != "" {
+	if mission.Spec.RoundTableRef != "" && !isolated {
 		log.Info("Using existing RoundTable", "roundTable", mission.Spec.RoundTableRef)
-		mission.Status.Phase = nextPhaseAfterProvisioning(mission)
-		mission.Status.ObservedGeneration = mission.Generation
-		return ctrl.Result{RequeueAfter: RequeueFast}, r.Status().Update(ctx, mission)
+		return r.finishProvisioning(ctx, mission)
 	}
 
 	// If no ephemeral knights, skip ephemeral RT creation (v1 compatibility)
@@ -379,11 +478,9 @@ func (r *MissionReconciler) reconcileProvisioning(ctx context.Context, mission *
 			break
 		}
 	}
-	if !hasEphemeral {
+	if !hasEphemeral && !isolated {
 		log.Info("No ephemeral knights, skipping ephemeral RoundTable creation")
-		mission.Status.Phase = nextPhaseAfterProvisioning(mission)
-		mission.Status.ObservedGeneration = mission.Generation
-		return ctrl.Result{RequeueAfter: RequeueFast}, r.Status().Update(ctx, mission)
+		return r.finishProvisioning(ctx, mission)
 	}
 
 	// Generate resource names
@@ -407,7 +504,7 @@ func (r *MissionReconciler) reconcileProvisioning(ctx context.Context, mission *
 
 	// 3. Check if ephemeral RoundTable already exists
 	rt := &aiv1alpha1.RoundTable{}
-	rtKey := types.NamespacedName{Name: roundTableName, Namespace: mission.Namespace}
+	rtKey := types.NamespacedName{Name: roundTableName, Namespace: workNamespace(mission)}
 	err := r.Get(ctx, rtKey, rt)
 
 	if err != nil {
@@ -451,37 +548,84 @@ func (r *MissionReconciler) reconcileProvisioning(ctx context.Context, mission *
 		"tasksStream", tasksStream,
 		"resultsStream", resultsStream)
 
-	// Transition to Assembling phase
+	return r.finishProvisioning(ctx, mission)
+}
+
+// finishProvisioning moves a provisioned mission on to Planning or
+// Assembling.
+func (r *MissionReconciler) finishProvisioning(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
 	mission.Status.Phase = nextPhaseAfterProvisioning(mission)
 	mission.Status.ObservedGeneration = mission.Generation
 	if err := r.Status().Update(ctx, mission); err != nil {
 		if apierrors.IsConflict(err) {
 			return ctrl.Result{Requeue: true}, nil
 		}
-		return ctrl.Result{}, fmt.Errorf("failed to transition to Assembling: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to transition to %s: %w", mission.Status.Phase, err)
+	}
+	r.recordPhaseTransition(mission)
+	return ctrl.Result{RequeueAfter: RequeueFast}, nil
+}
+
+// reconcilePlanning runs the planner for meta-missions, which skips
+// straight to Assembling for any other mission.
+func (r *MissionReconciler) reconcilePlanning(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
+	oldPhase := mission.Status.Phase
+
+	if result, handled, err := r.reviewPlan(ctx, mission); handled {
+		return result, err
+	}
+
+	result, err := r.Planner.ReconcilePlanning(ctx, mission)
+	if err != nil {
+		return result, err
 	}
 
-	return ctrl.Result{Requeue: true}, nil
+	if mission.Status.Phase != oldPhase {
+		if mission.Status.Phase == aiv1alpha1.MissionPhaseFailed {
+			r.Recorder.Event(mission, corev1.EventTypeWarning, "PlanningFailed", mission.Status.Result)
+		}
+		r.recordPhaseTransition(mission)
+	}
+	return result, nil
 }
 
 // reconcileAssembling creates ephemeral knights and validates all knight references for readiness.
 func (r *MissionReconciler) reconcileAssembling(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
 	oldPhase := mission.Status.Phase
+	var oldReadyMsg string
+	if cond := meta.FindStatusCondition(mission.Status.Conditions, "KnightsReady"); cond != nil {
+		oldReadyMsg = cond.Message
+	}
+
+	if result, handled, err := r.validateRoundTableRef(ctx, mission); handled {
+		return result, err
+	}
 
 	// Delegate to KnightAssembler
 	result, err := r.Assembler.ReconcileAssembling(ctx, mission)
 	if err != nil {
 		return result, err
 	}
+	updateMissionProgress(mission)
 
 	// Update status after assembly
 	if err := r.Status().Update(ctx, mission); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to update mission status: %w", err)
 	}
 
+	// A recruited knight that is missing leaves assembly waiting; one that
+	// never becomes ready fails it at the assembly timeout.
+	if cond := meta.FindStatusCondition(mission.Status.Conditions, "KnightsReady"); cond != nil &&
+		cond.Status == metav1.ConditionFalse && cond.Reason == "KnightNotFound" && cond.Message != oldReadyMsg {
+		r.Recorder.Event(mission, corev1.EventTypeWarning, "KnightNotFound", cond.Message)
+	}
+	if mission.Status.Phase == aiv1alpha1.MissionPhaseFailed && oldPhase != aiv1alpha1.MissionPhaseFailed {
+		r.Recorder.Event(mission, corev1.EventTypeWarning, "KnightsNotReady", mission.Status.Result)
+	}
+
 	// Emit events for phase transitions and assembly completion
 	if mission.Status.Phase != oldPhase {
-		r.Recorder.Eventf(mission, corev1.EventTypeNormal, "PhaseTransition", "Mission transitioned to %s", mission.Status.Phase)
+		r.recordPhaseTransition(mission)
 	}
 	if mission.Status.Phase == aiv1alpha1.MissionPhaseBriefing {
 		knightCount := len(mission.Status.KnightStatuses)
@@ -491,14 +635,22 @@ func (r *MissionReconciler) reconcileAssembling(ctx context.Context, mission *ai
 	return result, nil
 }
 
-// reconcileBriefing publishes the mission briefing to NATS and transitions to Active.
+// reconcileBriefing publishes the mission briefing to NATS, waits for
+// knights to acknowledge it when spec.briefingAckTimeout is set, and
+// transitions to Active.
 func (r *MissionReconciler) reconcileBriefing(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
 	// Publish briefing to NATS
-	if mission.Spec.Briefing != "" {
+	published := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionBriefingPublished)
+	switch {
+	case published != nil && published.Status == metav1.ConditionTrue && published.ObservedGeneration == mission.Generation:
+		// A mission waiting on acknowledgments has already briefed its
+		// knights; don't brief them again.
+	case mission.Spec.Briefing != "":
 		if err := r.publishBriefing(ctx, mission); err != nil {
 			log.Error(err, "Failed to publish briefing, will retry")
+			r.Recorder.Eventf(mission, corev1.EventTypeWarning, "BriefingFailed", "Failed to publish briefing: %v", err)
 			meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
 				Type:               aiv1alpha1.ConditionBriefingPublished,
 				Status:             metav1.ConditionFalse,
@@ -521,7 +673,7 @@ func (r *MissionReconciler) reconcileBriefing(ctx context.Context, mission *aiv1
 			Message:            "Mission briefing published to all knights",
 			ObservedGeneration: mission.Generation,
 		})
-	} else {
+	default:
 		meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
 			Type:               aiv1alpha1.ConditionBriefingPublished,
 			Status:             metav1.ConditionTrue,
@@ -531,6 +683,12 @@ func (r *MissionReconciler) reconcileBriefing(ctx context.Context, mission *aiv1
 		})
 	}
 
+	if briefingAckEnabled(mission) {
+		if result, handled, err := r.awaitBriefingAcks(ctx, mission); handled {
+			return result, err
+		}
+	}
+
 	// Bug #3 Fix: Trigger mission-generated chains to Running phase.
 	// Generated chains remain in Idle after the planner creates them.
 	// The chain controller only triggers chains via cron schedule, so mission-generated
@@ -546,7 +704,7 @@ func (r *MissionReconciler) reconcileBriefing(ctx context.Context, mission *aiv1
 	if apierrors.IsConflict(err) {
 		return ctrl.Result{Requeue: true}, nil
 	}
-	r.Recorder.Eventf(mission, corev1.EventTypeNormal, "PhaseTransition", "Mission transitioned to %s", aiv1alpha1.MissionPhaseActive)
+	r.recordPhaseTransition(mission)
 	return ctrl.Result{RequeueAfter: RequeueFast}, err
 }
 
@@ -557,52 +715,67 @@ func (r *MissionReconciler) reconcileActive(ctx context.Context, mission *aiv1al
 	// Check timeout
 	if mission.Status.StartedAt != nil {
 		elapsed := time.Since(mission.Status.StartedAt.Time)
-		if elapsed > time.Duration(mission.Spec.Timeout)*time.Second {
+		if timeout := missionTimeout(mission); elapsed > timeout {
 			log.Info("Mission timed out", "mission", mission.Name, "elapsed", elapsed)
+			msg := fmt.Sprintf("Mission timed out after %ds", int64(timeout/time.Second))
 			err := status.ForMission(mission).
-				Failed(fmt.Sprintf("Mission timed out after %ds", mission.Spec.Timeout)).
-				Condition(aiv1alpha1.ConditionMissionComplete, aiv1alpha1.ReasonMissionTimeout,
-					fmt.Sprintf("Mission timed out after %ds", mission.Spec.Timeout),
-					metav1.ConditionTrue).
+				Failed(msg).
+				Condition(aiv1alpha1.ConditionMissionComplete, aiv1alpha1.ReasonMissionTimeout, msg, metav1.ConditionTrue).
 				Apply(ctx, r.Client)
 			if apierrors.IsConflict(err) {
 				return ctrl.Result{Requeue: true}, nil
 			}
+			if err == nil {
+				r.Recorder.Event(mission, corev1.EventTypeWarning, "Timeout", msg)
+				r.recordPhaseTransition(mission)
+			}
 			return ctrl.Result{}, err
 		}
 	}
 
-	// Check cost budget
-	if mission.Spec.CostBudgetUSD != "" && mission.Spec.CostBudgetUSD != "0" {
-		totalCost, err := r.aggregateMissionCost(ctx, mission)
+	// A mission paused for a higher-priority one waits for a free slot
+	if res, handled, err := r.resumePreemptedMission(ctx, mission); handled {
+		return res, err
+	}
+
+	// Total the mission's cost and enforce its budget
+	totalCost, err := r.aggregateMissionUsage(ctx, mission)
+	if err != nil {
+		log.Error(err, "Failed to aggregate mission cost")
+	} else {
+		mission.Status.TotalCost = formatCostUSD(totalCost)
+		rt, err := r.missionRoundTable(ctx, mission)
 		if err != nil {
-			log.Error(err, "Failed to aggregate mission cost")
-		} else {
-			mission.Status.TotalCost = fmt.Sprintf("%.4f", totalCost)
-
-			// Parse budget
-			var budget float64
-			if _, err := fmt.Sscanf(mission.Spec.CostBudgetUSD, "%f", &budget); err == nil {
-				if totalCost > budget {
-					log.Info("Mission cost budget exceeded", "totalCost", totalCost, "budget", budget)
-
-					// Suspend all mission-owned chains to prevent further cost
-					if err := r.suspendMissionChains(ctx, mission); err != nil {
-						log.Error(err, "Failed to suspend mission chains")
-					}
-
-					err := status.ForMission(mission).
-						Failed(fmt.Sprintf("Cost budget exceeded: $%.2f > $%.2f", totalCost, budget)).
-						Condition(aiv1alpha1.ConditionMissionComplete, aiv1alpha1.ReasonOverBudget,
-							fmt.Sprintf("Cost $%.2f exceeded budget $%.2f", totalCost, budget),
-							metav1.ConditionTrue).
-						Apply(ctx, r.Client)
-					if apierrors.IsConflict(err) {
-						return ctrl.Result{Requeue: true}, nil
-					}
-					return ctrl.Result{}, err
-				}
+			log.Error(err, "Failed to get mission RoundTable for its default budget")
+		}
+		if budget, over := missionBudgetExceeded(mission, rt, totalCost); over {
+			log.Info("Mission cost budget exceeded", "totalCost", totalCost, "budget", budget)
+			r.Recorder.Eventf(mission, corev1.EventTypeWarning, "BudgetExceeded",
+				"Mission cost $%.4f exceeded budget $%.4f", totalCost, budget)
+
+			// Suspend all mission-owned chains to prevent further cost
+			if err := r.suspendMissionChains(ctx, mission); err != nil {
+				log.Error(err, "Failed to suspend mission chains")
 			}
+
+			msg := fmt.Sprintf("Cost $%.2f exceeded budget $%.2f", totalCost, budget)
+			err := status.ForMission(mission).
+				Failed(fmt.Sprintf("Cost budget exceeded: $%.2f > $%.2f", totalCost, budget)).
+				Condition(aiv1alpha1.ConditionMissionWithinBudget, aiv1alpha1.ReasonOverBudget, msg, metav1.ConditionFalse).
+				Condition(aiv1alpha1.ConditionMissionComplete, aiv1alpha1.ReasonOverBudget, msg, metav1.ConditionTrue).
+				Apply(ctx, r.Client)
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, nil
+			}
+			return ctrl.Result{}, err
+		} else if budget > 0 {
+			meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+				Type:               aiv1alpha1.ConditionMissionWithinBudget,
+				Status:             metav1.ConditionTrue,
+				Reason:             aiv1alpha1.ReasonMissionWithinBudget,
+				Message:            fmt.Sprintf("Cost $%.2f of $%.2f budget", totalCost, budget),
+				ObservedGeneration: mission.Generation,
+			})
 		}
 	}
 
@@ -631,22 +804,27 @@ func (r *MissionReconciler) reconcileActive(ctx context.Context, mission *aiv1al
 			}
 
 			if hasNonTerminalChains {
-				// Requeue and wait for all chains to finish
+				// The chain watch requeues once they finish
 				log.Info("Chains still running, waiting for completion before transitioning mission")
-				return ctrl.Result{RequeueAfter: RequeueDefault}, nil
+				return ctrl.Result{RequeueAfter: activeRequeue(mission)}, nil
 			}
 		}
 
 		if anyChainFailed {
-			mission.Status.Phase = aiv1alpha1.MissionPhaseFailed
+			mission.Status.Phase = missionCompletionPhase(mission, aiv1alpha1.MissionPhaseFailed)
 			now := metav1.Now()
 			mission.Status.CompletedAt = &now
-			mission.Status.Result = "One or more mission chains failed"
+			reason, msg := aiv1alpha1.ReasonMissionChainFailed, "One or more mission chains failed"
+			if name := failedSetupChain(mission); name != "" {
+				reason = aiv1alpha1.ReasonMissionSetupFailed
+				msg = fmt.Sprintf("Setup chain %s failed; Active chains were not started", name)
+			}
+			mission.Status.Result = msg
 			meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
 				Type:               aiv1alpha1.ConditionMissionComplete,
 				Status:             metav1.ConditionTrue,
-				Reason:             aiv1alpha1.ReasonMissionChainFailed,
-				Message:            "One or more mission chains failed",
+				Reason:             reason,
+				Message:            msg,
 				ObservedGeneration: mission.Generation,
 			})
 			mission.Status.ObservedGeneration = mission.Generation
@@ -654,11 +832,19 @@ func (r *MissionReconciler) reconcileActive(ctx context.Context, mission *aiv1al
 			if apierrors.IsConflict(err) {
 				return ctrl.Result{Requeue: true}, nil
 			}
+			if err == nil {
+				r.Recorder.Event(mission, corev1.EventTypeWarning, "ChainFailed", msg)
+				r.recordPhaseTransition(mission)
+			}
 			return ctrl.Result{}, err
 		}
 
 		if allChainsComplete {
-			mission.Status.Phase = aiv1alpha1.MissionPhaseSucceeded
+			// A vote or debate decides the outcome of a deliberating mission.
+			if deliberationPhase(mission) != "" {
+				return r.startDeliberation(ctx, mission)
+			}
+			mission.Status.Phase = missionCompletionPhase(mission, aiv1alpha1.MissionPhaseSucceeded)
 			now := metav1.Now()
 			mission.Status.CompletedAt = &now
 			mission.Status.Result = "All mission chains completed successfully"
@@ -674,8 +860,14 @@ func (r *MissionReconciler) reconcileActive(ctx context.Context, mission *aiv1al
 			if apierrors.IsConflict(err) {
 				return ctrl.Result{Requeue: true}, nil
 			}
+			if err == nil {
+				r.recordPhaseTransition(mission)
+			}
 			return ctrl.Result{}, err
 		}
+	} else if deliberationPhase(mission) != "" {
+		// With no chains, the vote or debate is the mission's work.
+		return r.startDeliberation(ctx, mission)
 	} else {
 		// No chains — stay Active until TTL expires or external completion.
 		// Knights may still receive ad-hoc tasks via NATS during the mission window.
@@ -683,37 +875,62 @@ func (r *MissionReconciler) reconcileActive(ctx context.Context, mission *aiv1al
 			"mission", mission.Name)
 	}
 
-	// Update knight statuses
+	// Update knight statuses and handle knights that stopped being Ready
 	r.updateKnightStatuses(ctx, mission)
+	r.checkKnightHealth(ctx, mission)
 	mission.Status.ObservedGeneration = mission.Generation
 	if statusErr := r.Status().Update(ctx, mission); statusErr != nil {
 		log.Error(statusErr, "Failed to update status with knight statuses")
 	}
-	return ctrl.Result{RequeueAfter: RequeueDefault}, nil
+	return ctrl.Result{RequeueAfter: activeRequeue(mission)}, nil
 }
 
 // reconcileMissionChains creates and monitors Chain CRs for the mission.
-// Returns (allComplete, anyFailed, error).
+// Setup chains run first; Active chains start only once every Setup chain
+// has succeeded, and never start if one fails. Teardown runs during
+// cleanup. Returns (allComplete, anyFailed, error).
 func (r *MissionReconciler) reconcileMissionChains(ctx context.Context, mission *aiv1alpha1.Mission) (bool, bool, error) {
-	log := logf.FromContext(ctx)
+	setupComplete, setupFailed, err := r.reconcileMissionChainPhase(ctx, mission, "Setup")
+	if err != nil || setupFailed || !setupComplete {
+		return false, setupFailed, err
+	}
+	return r.reconcileMissionChainPhase(ctx, mission, "Active")
+}
 
-	// Determine which phase chains to run based on mission state
-	// Setup chains run first, then Active, Teardown runs during cleanup
-	activePhases := []string{"Setup", "Active"}
+// missionChainPhase returns the mission phase a chain runs in, Active when
+// unset.
+func missionChainPhase(chainRef aiv1alpha1.MissionChainRef) string {
+	if chainRef.Phase == "" {
+		return "Active"
+	}
+	return chainRef.Phase
+}
+
+// failedSetupChain returns the name of a Setup chain that failed, or "".
+func failedSetupChain(mission *aiv1alpha1.Mission) string {
+	for _, chainRef := range mission.Spec.Chains {
+		if missionChainPhase(chainRef) != "Setup" {
+			continue
+		}
+		for _, cs := range mission.Status.ChainStatuses {
+			if cs.Name == chainRef.Name && cs.Phase == aiv1alpha1.ChainPhaseFailed {
+				return chainRef.Name
+			}
+		}
+	}
+	return ""
+}
+
+// reconcileMissionChainPhase creates, triggers and monitors the mission's
+// chains for one mission phase. Returns (allComplete, anyFailed, error).
+func (r *MissionReconciler) reconcileMissionChainPhase(ctx context.Context, mission *aiv1alpha1.Mission, phase string) (bool, bool, error) {
+	log := logf.FromContext(ctx)
 
 	allComplete := true
 	anyFailed := false
 
 	for _, chainRef := range mission.Spec.Chains {
-		// Only process chains for current active phases
-		phaseMatch := false
-		for _, p := range activePhases {
-			if chainRef.Phase == p || (chainRef.Phase == "" && p == "Active") { // default is Active
-				phaseMatch = true
-				break
-			}
-		}
-		if !phaseMatch {
+		if missionChainPhase(chainRef) != phase {
 			continue
 		}
 
@@ -729,7 +946,7 @@ func (r *MissionReconciler) reconcileMissionChains(ctx context.Context, mission
 		chain := &aiv1alpha1.Chain{}
 		err := r.Get(ctx, types.NamespacedName{
 			Name:      missionChainName,
-			Namespace: mission.Namespace,
+			Namespace: workNamespace(mission),
 		}, chain)
 		if err != nil {
 			if client.IgnoreNotFound(err) == nil {
@@ -746,7 +963,7 @@ func (r *MissionReconciler) reconcileMissionChains(ctx context.Context, mission
 			// Re-fetch to avoid conflict errors (chain controller may have reconciled)
 			if err := r.Get(ctx, types.NamespacedName{
 				Name:      missionChainName,
-				Namespace: mission.Namespace,
+				Namespace: workNamespace(mission),
 			}, chain); err != nil {
 				log.Error(err, "Failed to re-fetch chain for trigger", "chain", missionChainName)
 				allComplete = false
@@ -777,7 +994,19 @@ func (r *MissionReconciler) reconcileMissionChains(ctx context.Context, mission
 		case aiv1alpha1.ChainPhaseSucceeded:
 			// OK
 		case aiv1alpha1.ChainPhaseFailed:
-			anyFailed = true
+			retrying, err := r.retryMissionChain(ctx, mission, chainRef.Name, chain)
+			if err != nil {
+				if apierrors.IsConflict(err) {
+					allComplete = false
+					continue
+				}
+				return false, false, err
+			}
+			if retrying {
+				allComplete = false
+			} else {
+				anyFailed = true
+			}
 		default:
 			allComplete = false
 		}
@@ -801,25 +1030,35 @@ func (r *MissionReconciler) reconcileCleaningUp(ctx context.Context, mission *ai
 		if chainRef.Phase != "Teardown" {
 			continue
 		}
+		// Teardown runs on the mission's own copy, like Setup and Active
+		// chains, so missions sharing a teardown chain don't clobber each
+		// other's runs.
+		if err := r.ensureMissionChain(ctx, mission, chainRef); err != nil {
+			log.Info("Teardown chain not created, skipping", "chain", chainRef.Name, "error", err.Error())
+			continue
+		}
+		missionChainName := fmt.Sprintf("mission-%s-%s", mission.Name, chainRef.Name)
 		chain := &aiv1alpha1.Chain{}
 		if err := r.Get(ctx, types.NamespacedName{
-			Name:      chainRef.Name,
-			Namespace: mission.Namespace,
+			Name:      missionChainName,
+			Namespace: workNamespace(mission),
 		}, chain); err != nil {
-			log.Info("Teardown chain not found, skipping", "chain", chainRef.Name)
+			log.Info("Teardown chain not found, skipping", "chain", missionChainName)
 			continue
 		}
 		// If teardown chain hasn't run yet, trigger it
-		if chain.Status.Phase == aiv1alpha1.ChainPhaseIdle {
+		if chain.Status.Phase == aiv1alpha1.ChainPhaseIdle || chain.Status.Phase == "" {
 			now := metav1.Now()
 			chain.Status.Phase = aiv1alpha1.ChainPhaseRunning
 			chain.Status.StartedAt = &now
 			if err := r.Status().Update(ctx, chain); err != nil {
-				log.Error(err, "Failed to trigger teardown chain", "chain", chainRef.Name)
+				log.Error(err, "Failed to trigger teardown chain", "chain", missionChainName)
 			}
+			r.updateChainStatus(mission, chainRef.Name, missionChainName, aiv1alpha1.ChainPhaseRunning)
 			// Requeue to wait for teardown
 			return ctrl.Result{RequeueAfter: RequeueDefault}, nil
 		}
+		r.updateChainStatus(mission, chainRef.Name, missionChainName, chain.Status.Phase)
 		// If teardown chain is still running, wait
 		if chain.Status.Phase == aiv1alpha1.ChainPhaseRunning {
 			return ctrl.Result{RequeueAfter: RequeueDefault}, nil
@@ -844,6 +1083,7 @@ func (r *MissionReconciler) reconcileCleaningUp(ctx context.Context, mission *ai
 		log.Info("Deleting ephemeral Knight CRs")
 		if err := r.deleteEphemeralKnights(ctx, mission); err != nil {
 			log.Error(err, "Failed to delete ephemeral knights, retrying")
+			r.Recorder.Eventf(mission, corev1.EventTypeWarning, "CleanupFailed", "Failed to delete ephemeral knights: %v", err)
 			return ctrl.Result{RequeueAfter: RequeueDefault}, nil
 		}
 
@@ -855,12 +1095,14 @@ func (r *MissionReconciler) reconcileCleaningUp(ctx context.Context, mission *ai
 		}
 
 		// Step 3: Delete NATS streams
-		if mission.Status.NATSTasksStream != "" || mission.Status.NATSResultsStream != "" {
+		if mission.Status.NATSTasksStream != "" || mission.Status.NATSResultsStream != "" || mission.Status.NATSMissionStream != "" {
 			log.Info("Deleting NATS streams",
 				"tasksStream", mission.Status.NATSTasksStream,
-				"resultsStream", mission.Status.NATSResultsStream)
+				"resultsStream", mission.Status.NATSResultsStream,
+				"missionStream", mission.Status.NATSMissionStream)
 			if err := r.deleteNATSStreams(ctx, mission); err != nil {
 				log.Error(err, "Failed to delete NATS streams, retrying with backoff")
+				r.Recorder.Eventf(mission, corev1.EventTypeWarning, "CleanupFailed", "Failed to delete NATS streams: %v", err)
 				return ctrl.Result{RequeueAfter: RequeueModerate}, nil
 			}
 		}
@@ -870,9 +1112,17 @@ func (r *MissionReconciler) reconcileCleaningUp(ctx context.Context, mission *ai
 			log.Info("Deleting ephemeral RoundTable", "name", mission.Status.RoundTableName)
 			if err := r.deleteEphemeralRoundTable(ctx, mission); err != nil {
 				log.Error(err, "Failed to delete RoundTable, retrying")
+				r.Recorder.Eventf(mission, corev1.EventTypeWarning, "CleanupFailed", "Failed to delete ephemeral RoundTable: %v", err)
 				return ctrl.Result{RequeueAfter: RequeueDefault}, nil
 			}
 		}
+
+		// Step 5: Delete the isolation namespace with everything left in it
+		if err := r.deleteIsolationNamespace(ctx, mission); err != nil {
+			log.Error(err, "Failed to delete isolation namespace, retrying")
+			r.Recorder.Eventf(mission, corev1.EventTypeWarning, "CleanupFailed", "Failed to delete isolation namespace: %v", err)
+			return ctrl.Result{RequeueAfter: RequeueDefault}, nil
+		}
 	}
 
 	// Mark cleanup as done
@@ -894,11 +1144,14 @@ func (r *MissionReconciler) transitionToTerminalPhase(ctx context.Context, missi
 	log := logf.FromContext(ctx)
 
 	// Transition to terminal phase based on original outcome
+	oldPhase := mission.Status.Phase
 	mission.Status.Phase = terminalOutcome(mission)
 
 	mission.Status.ObservedGeneration = mission.Generation
 	if err := r.Status().Update(ctx, mission); err != nil {
 		log.Error(err, "Failed to update status during terminal phase transition")
+	} else if mission.Status.Phase != oldPhase {
+		r.recordPhaseTransition(mission)
 	}
 
 	// Self-delete if cleanupPolicy=Delete and TTL expired
@@ -925,20 +1178,16 @@ func (r *MissionReconciler) transitionToTerminalPhase(ctx context.Context, missi
 
 // updateKnightStatuses refreshes knight readiness from current Knight CRs.
 func (r *MissionReconciler) updateKnightStatuses(ctx context.Context, mission *aiv1alpha1.Mission) {
-	for i, mk := range mission.Spec.Knights {
-		if mk.Ephemeral {
-			continue
-		}
+	for i := range mission.Status.KnightStatuses {
+		ks := &mission.Status.KnightStatuses[i]
 		knight := &aiv1alpha1.Knight{}
-		if err := r.Get(ctx, types.NamespacedName{
-			Name:      mk.Name,
-			Namespace: mission.Namespace,
-		}, knight); err != nil {
-			mission.Status.KnightStatuses[i].Ready = false
+		if err := r.Get(ctx, knightStatusKey(mission, ks), knight); err != nil {
+			ks.Ready = false
 			continue
 		}
-		mission.Status.KnightStatuses[i].Ready = knight.Status.Ready
+		ks.Ready = knight.Status.Ready
 	}
+	updateMissionProgress(mission)
 }
 
 // publishBriefing delivers the mission briefing to each named knight's task subject.
@@ -951,25 +1200,13 @@ func (r *MissionReconciler) updateKnightStatuses(ctx context.Context, mission *a
 func (r *MissionReconciler) publishBriefing(ctx context.Context, mission *aiv1alpha1.Mission) error {
 	log := logf.FromContext(ctx)
 
-	client, err := r.natsClient()
+	// Knights whose own subjects can't be parsed are briefed under the
+	// RoundTable's prefix, on its NATS server.
+	client, fallbackPrefix, err := r.knightNATS(ctx, mission)
 	if err != nil {
 		return err
 	}
 
-	// Fallback subject prefix for knights whose own subjects can't be parsed:
-	// prefer the referenced RoundTable's prefix (covered by its tasks stream)
-	// over the mission-scoped prefix, which only exists for ephemeral tables.
-	fallbackPrefix := natsPrefix(mission)
-	if mission.Spec.RoundTableRef != "" {
-		rt := &aiv1alpha1.RoundTable{}
-		if err := r.Get(ctx, types.NamespacedName{
-			Name:      mission.Spec.RoundTableRef,
-			Namespace: mission.Namespace,
-		}, rt); err == nil && rt.Spec.NATS.SubjectPrefix != "" {
-			fallbackPrefix = rt.Spec.NATS.SubjectPrefix
-		}
-	}
-
 	attempted := 0
 	published := 0
 	for _, mk := range mission.Spec.Knights {
@@ -994,21 +1231,21 @@ func (r *MissionReconciler) publishBriefing(ctx context.Context, mission *aiv1al
 			ChainName: fmt.Sprintf("mission-%s", mission.Name),
 			StepName:  "briefing",
 			Task:      fmt.Sprintf("[Mission: %s]\nObjective: %s\n\n%s", mission.Name, mission.Spec.Objective, mission.Spec.Briefing),
+			Priority:  mission.Spec.Priority,
+		}
+		if briefingAckEnabled(mission) {
+			taskPayload.AckSubject = briefingAckSubject(mission, mk.Name)
 		}
+		taskPayload.ChatSubject = mission.Status.ChatSubject
 
 		// Derive subject prefix from the knight's NATS config
-		briefingPrefix := fallbackPrefix
-		if len(knight.Spec.NATS.Subjects) > 0 {
-			parts := strings.SplitN(knight.Spec.NATS.Subjects[0], ".tasks.", 2)
-			if len(parts) == 2 {
-				briefingPrefix = parts[0]
-			}
-		}
+		briefingPrefix := knightSubjectPrefix(knight, fallbackPrefix)
 		taskSubject := natspkg.TaskSubject(briefingPrefix, knight.Spec.Domain, mk.Name)
-		if err := client.PublishJSON(taskSubject, taskPayload); err != nil {
+		if err := natspkg.PublishTask(client, taskSubject, taskPayload); err != nil {
 			log.Error(err, "Failed to publish briefing to knight", "knight", mk.Name, "subject", taskSubject)
 			continue
 		}
+		r.auditMission(ctx, mission, natspkg.AuditKindTask, taskPayload.TaskID, taskPayload)
 		published++
 	}
 
@@ -1086,7 +1323,7 @@ func (r *MissionReconciler) storeResultsToKV(ctx context.Context, mission *aiv1a
 		chain := &aiv1alpha1.Chain{}
 		if err := r.Get(ctx, types.NamespacedName{
 			Name:      cs.ChainCRName,
-			Namespace: mission.Namespace,
+			Namespace: workNamespace(mission),
 		}, chain); err != nil {
 			log.Info("Failed to fetch chain for results", "chain", cs.ChainCRName, "error", err.Error())
 			continue
@@ -1120,6 +1357,11 @@ func (r *MissionReconciler) storeResultsToKV(ctx context.Context, mission *aiv1a
 		})
 	}
 
+	// Collaboration channel
+	if chat := archiveMissionChat(ctx, client, mission); len(chat) > 0 {
+		results["chat"] = chat
+	}
+
 	// Cost breakdown
 	if len(mission.Status.CostBreakdown) > 0 {
 		results["costs"] = map[string]interface{}{
@@ -1156,7 +1398,7 @@ func (r *MissionReconciler) suspendMissionChains(ctx context.Context, mission *a
 		chain := &aiv1alpha1.Chain{}
 		if err := r.Get(ctx, types.NamespacedName{
 			Name:      cs.ChainCRName,
-			Namespace: mission.Namespace,
+			Namespace: workNamespace(mission),
 		}, chain); err != nil {
 			if client.IgnoreNotFound(err) == nil {
 				continue // Chain not found, skip
@@ -1179,54 +1421,6 @@ func (r *MissionReconciler) suspendMissionChains(ctx context.Context, mission *a
 	return nil
 }
 
-// aggregateMissionCost calculates the total cost across all mission knights and updates costBreakdown.
-func (r *MissionReconciler) aggregateMissionCost(ctx context.Context, mission *aiv1alpha1.Mission) (float64, error) {
-	var totalCost float64
-	costBreakdown := make([]aiv1alpha1.MissionKnightCost, 0, len(mission.Spec.Knights))
-
-	for _, mk := range mission.Spec.Knights {
-		knightName := mk.Name
-		knight := &aiv1alpha1.Knight{}
-		if err := r.Get(ctx, types.NamespacedName{
-			Name:      knightName,
-			Namespace: mission.Namespace,
-		}, knight); err != nil {
-			if client.IgnoreNotFound(err) == nil {
-				// Knight not found, add to breakdown with zero cost
-				costBreakdown = append(costBreakdown, aiv1alpha1.MissionKnightCost{
-					Name:      knightName,
-					CostUSD:   "0.0000",
-					Ephemeral: mk.Ephemeral,
-				})
-				continue
-			}
-			return 0, err
-		}
-
-		// Parse knight's total cost
-		knightCost := "0.0000"
-		if knight.Status.TotalCost != "" {
-			var cost float64
-			if _, err := fmt.Sscanf(knight.Status.TotalCost, "%f", &cost); err == nil {
-				totalCost += cost
-				knightCost = knight.Status.TotalCost
-			}
-		}
-
-		// Add to breakdown
-		costBreakdown = append(costBreakdown, aiv1alpha1.MissionKnightCost{
-			Name:      knightName,
-			CostUSD:   knightCost,
-			Ephemeral: mk.Ephemeral,
-		})
-	}
-
-	// Update mission status with breakdown
-	mission.Status.CostBreakdown = costBreakdown
-
-	return totalCost, nil
-}
-
 // ensureMissionChain creates a mission-scoped chain copy if it doesn't already exist.
 func (r *MissionReconciler) ensureMissionChain(ctx context.Context, mission *aiv1alpha1.Mission, chainRef aiv1alpha1.MissionChainRef) error {
 	log := logf.FromContext(ctx)
@@ -1243,7 +1437,7 @@ func (r *MissionReconciler) ensureMissionChain(ctx context.Context, mission *aiv
 	existingChain := &aiv1alpha1.Chain{}
 	err := r.Get(ctx, types.NamespacedName{
 		Name:      missionChainName,
-		Namespace: mission.Namespace,
+		Namespace: workNamespace(mission),
 	}, existingChain)
 	if err == nil {
 		// Chain already exists
@@ -1262,42 +1456,24 @@ func (r *MissionReconciler) ensureMissionChain(ctx context.Context, mission *aiv
 		return fmt.Errorf("source chain %q not found: %w", chainRef.Name, err)
 	}
 
-	// Get RoundTable reference for this mission
-	rtRef := mission.Spec.RoundTableRef
-	if rtRef == "" {
-		rtRef = "default" // fallback to default if not specified
-	}
-
 	// Create the mission-scoped chain
+	labels := missionLabels(mission)
+	labels["ai.roundtable.io/chain-phase"] = chainRef.Phase
 	missionChain := &aiv1alpha1.Chain{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      missionChainName,
-			Namespace: mission.Namespace,
-			Labels: map[string]string{
-				"ai.roundtable.io/mission":     mission.Name,
-				"ai.roundtable.io/chain-phase": chainRef.Phase,
-			},
+			Namespace: workNamespace(mission),
+			Labels:    labels,
 		},
-		Spec: aiv1alpha1.ChainSpec{
-			Description:   fmt.Sprintf("Mission %s: %s", mission.Name, sourceChain.Spec.Description),
-			Steps:         sourceChain.Spec.Steps,
-			Timeout:       sourceChain.Spec.Timeout,
-			RoundTableRef: rtRef,
-			OutputKnight:  sourceChain.Spec.OutputKnight,
-			RetryPolicy:   sourceChain.Spec.RetryPolicy,
-		},
-	}
-
-	// Override input if specified
-	if chainRef.InputOverride != "" {
-		missionChain.Spec.Input = chainRef.InputOverride
-	} else {
-		missionChain.Spec.Input = sourceChain.Spec.Input
+		Spec: missionChainSpec(mission, chainRef, sourceChain),
 	}
 
-	// Set owner reference for garbage collection
-	if err := controllerutil.SetControllerReference(mission, missionChain, r.Scheme); err != nil {
-		return fmt.Errorf("failed to set owner reference: %w", err)
+	// Set owner reference for garbage collection. A copy in an isolation
+	// namespace can't have one; the namespace's deletion removes it.
+	if !missionIsolated(mission) {
+		if err := controllerutil.SetControllerReference(mission, missionChain, r.Scheme); err != nil {
+			return fmt.Errorf("failed to set owner reference: %w", err)
+		}
 	}
 
 	// Create the chain CR
@@ -1309,6 +1485,42 @@ func (r *MissionReconciler) ensureMissionChain(ctx context.Context, mission *aiv
 	return nil
 }
 
+// missionChainSpec builds the spec of a mission's copy of sourceChain. The
+// copy keeps the source's steps and policies, but runs on the mission's
+// RoundTable when the mission triggers it: schedules and event triggers are
+// dropped, so the shared chain's own runs never start the copy. The chain
+// ref's inputOverride replaces the source's input.
+func missionChainSpec(mission *aiv1alpha1.Mission, chainRef aiv1alpha1.MissionChainRef, sourceChain *aiv1alpha1.Chain) aiv1alpha1.ChainSpec {
+	spec := *sourceChain.Spec.DeepCopy()
+	spec.Description = fmt.Sprintf("Mission %s: %s", mission.Name, sourceChain.Spec.Description)
+	spec.MissionRef = mission.Name
+	spec.RoundTableRef = mission.Spec.RoundTableRef
+	if missionIsolated(mission) {
+		// The copy resolves its RoundTable in the isolation namespace.
+		spec.RoundTableRef = mission.Status.RoundTableName
+	}
+	if spec.RoundTableRef == "" {
+		spec.RoundTableRef = "default" // fallback to default if not specified
+	}
+	spec.Schedule = ""
+	spec.TimeZone = ""
+	spec.StartingDeadlineSeconds = nil
+	spec.Triggers = nil
+	spec.Suspended = false
+	// The mission's priority is a floor for every task the copy dispatches.
+	spec.Priority = max(spec.Priority, mission.Spec.Priority)
+	for i := range spec.Steps {
+		if p := spec.Steps[i].Priority; p != nil && *p < mission.Spec.Priority {
+			spec.Steps[i].Priority = ptr.To(mission.Spec.Priority)
+		}
+	}
+	if chainRef.InputOverride != "" {
+		spec.Input = chainRef.InputOverride
+		spec.InputFrom = nil
+	}
+	return spec
+}
+
 // triggerGeneratedChains transitions all mission-generated chains from Idle to Running.
 // This is necessary because the chain controller only triggers chains via cron schedule,
 // and mission-generated chains have no schedule.
@@ -1318,7 +1530,7 @@ func (r *MissionReconciler) triggerGeneratedChains(ctx context.Context, mission
 	// Iterate all chains owned by this mission
 	chainList := &aiv1alpha1.ChainList{}
 	if err := r.List(ctx, chainList,
-		client.InNamespace(mission.Namespace),
+		client.InNamespace(workNamespace(mission)),
 		client.MatchingLabels{aiv1alpha1.LabelMission: mission.Name},
 	); err != nil {
 		return fmt.Errorf("failed to list mission chains: %w", err)
@@ -1352,6 +1564,7 @@ func (r *MissionReconciler) updateChainStatus(mission *aiv1alpha1.Mission, chain
 		if mission.Status.ChainStatuses[i].Name == chainRefName {
 			mission.Status.ChainStatuses[i].ChainCRName = chainCRName
 			mission.Status.ChainStatuses[i].Phase = phase
+			updateMissionProgress(mission)
 			return
 		}
 	}
@@ -1362,6 +1575,7 @@ func (r *MissionReconciler) updateChainStatus(mission *aiv1alpha1.Mission, chain
 		ChainCRName: chainCRName,
 		Phase:       phase,
 	})
+	updateMissionProgress(mission)
 }
 
 // terminalOutcome returns the mission's terminal phase. The phase itself is
@@ -1425,7 +1639,7 @@ func (r *MissionReconciler) deleteEphemeralKnights(ctx context.Context, mission
 		knight := &aiv1alpha1.Knight{}
 		if err := r.Get(ctx, types.NamespacedName{
 			Name:      knightName,
-			Namespace: mission.Namespace,
+			Namespace: workNamespace(mission),
 		}, knight); err != nil {
 			if client.IgnoreNotFound(err) == nil {
 				continue // Already deleted
@@ -1471,27 +1685,23 @@ func (r *MissionReconciler) deleteNATSConsumers(ctx context.Context, mission *ai
 	return nil
 }
 
-// deleteNATSStreams deletes the mission's task and result streams.
+// deleteNATSStreams deletes the mission's task, result and mission-scoped
+// streams.
 func (r *MissionReconciler) deleteNATSStreams(ctx context.Context, mission *aiv1alpha1.Mission) error {
 	client, err := r.natsClient()
 	if err != nil {
 		return nil // Gracefully skip if no NATS client
 	}
 
-	// Delete tasks stream
-	if mission.Status.NATSTasksStream != "" {
-		if err := client.DeleteStream(mission.Status.NATSTasksStream); err != nil {
-			return fmt.Errorf("failed to delete tasks stream: %w", err)
-		}
-	}
-
-	// Delete results stream
-	if mission.Status.NATSResultsStream != "" {
-		if err := client.DeleteStream(mission.Status.NATSResultsStream); err != nil {
-			return fmt.Errorf("failed to delete results stream: %w", err)
+	for _, name := range []string{
+		mission.Status.NATSTasksStream,
+		mission.Status.NATSResultsStream,
+		mission.Status.NATSMissionStream,
+	} {
+		if err := deleteStream(client, name); err != nil {
+			return err
 		}
 	}
-
 	return nil
 }
 
@@ -1504,7 +1714,7 @@ func (r *MissionReconciler) deleteEphemeralRoundTable(ctx context.Context, missi
 	rt := &aiv1alpha1.RoundTable{}
 	if err := r.Get(ctx, types.NamespacedName{
 		Name:      mission.Status.RoundTableName,
-		Namespace: mission.Namespace,
+		Namespace: workNamespace(mission),
 	}, rt); err != nil {
 		if client.IgnoreNotFound(err) == nil {
 			return nil // Already deleted
@@ -1517,9 +1727,13 @@ func (r *MissionReconciler) deleteEphemeralRoundTable(ctx context.Context, missi
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *MissionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Re-reconcile a mission when one of its chains progresses, so chain
+	// completion is seen at once instead of on the next requeue.
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&aiv1alpha1.Mission{}).
-		Owns(&aiv1alpha1.Chain{}).
+		Watches(&aiv1alpha1.Chain{},
+			handler.EnqueueRequestsFromMapFunc(missionForChain),
+			builder.WithPredicates(missionChainProgressed())).
 		Owns(&aiv1alpha1.Knight{}).
 		Owns(&aiv1alpha1.RoundTable{}).
 		Named("mission").
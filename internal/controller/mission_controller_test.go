@@ -171,6 +171,36 @@ var _ = Describe("Mission Controller", func() {
 		}
 	}
 
+	// recordMissionCost stands in for a mission chain run whose tasks
+	// reported cost on knightName.
+	costChainNN := types.NamespacedName{Name: fmt.Sprintf("mission-%s-cost", missionName), Namespace: namespace}
+	recordMissionCost := func(cost string) {
+		chain := &aiv1alpha1.Chain{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      costChainNN.Name,
+				Namespace: namespace,
+				Labels:    map[string]string{aiv1alpha1.LabelMission: missionName},
+			},
+			Spec: aiv1alpha1.ChainSpec{
+				Steps:         []aiv1alpha1.ChainStep{{Name: "work", KnightRef: knightName, Task: "work"}},
+				RoundTableRef: "default",
+				MissionRef:    missionName,
+			},
+		}
+		Expect(k8sClient.Create(ctx, chain)).To(Succeed())
+		chain.Status.StepStatuses = []aiv1alpha1.ChainStepStatus{
+			{Name: "work", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Knight: knightName, CostUSD: cost},
+		}
+		Expect(k8sClient.Status().Update(ctx, chain)).To(Succeed())
+	}
+
+	deleteCostChain := func() {
+		chain := &aiv1alpha1.Chain{}
+		if err := k8sClient.Get(ctx, costChainNN, chain); err == nil {
+			_ = k8sClient.Delete(ctx, chain)
+		}
+	}
+
 	// driveToPhase reconciles until the mission reaches targetPhase or maxIter is exceeded.
 	// Optional beforeReconcile callback runs before each reconcile (e.g., to make knights ready).
 	driveToPhase := func(r *MissionReconciler, targetPhase aiv1alpha1.MissionPhase, maxIter int, beforeReconcile ...func(aiv1alpha1.MissionPhase)) {
@@ -558,6 +588,7 @@ var _ = Describe("Mission Controller", func() {
 
 		AfterEach(func() {
 			deleteMission()
+			deleteCostChain()
 			deleteKnight()
 		})
 
@@ -577,11 +608,8 @@ var _ = Describe("Mission Controller", func() {
 			// Drive to Active
 			driveToPhase(r, aiv1alpha1.MissionPhaseActive, 10, readyOnProvisioning(), readyOnAssembling())
 
-			// Set knight cost under budget
-			knight := &aiv1alpha1.Knight{}
-			Expect(k8sClient.Get(ctx, knightNN, knight)).To(Succeed())
-			knight.Status.TotalCost = "5.25"
-			Expect(k8sClient.Status().Update(ctx, knight)).To(Succeed())
+			// Record mission task cost under budget
+			recordMissionCost("5.25")
 
 			// Reconcile — should stay Active (no chains to complete) with cost tracked
 			_, _ = r.Reconcile(ctx, reconcile.Request{NamespacedName: missionNN})
@@ -590,6 +618,10 @@ var _ = Describe("Mission Controller", func() {
 			Expect(k8sClient.Get(ctx, missionNN, mission)).To(Succeed())
 			Expect(mission.Status.Phase).To(Equal(aiv1alpha1.MissionPhaseActive))
 			Expect(mission.Status.TotalCost).To(Equal("5.2500"))
+			Expect(mission.Status.CostBreakdown).To(ContainElement(aiv1alpha1.MissionKnightCost{Name: knightName, CostUSD: "5.2500"}))
+			condition := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionWithinBudget)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
 		})
 
 		It("should fail when over budget", func() {
@@ -608,11 +640,8 @@ var _ = Describe("Mission Controller", func() {
 			// Drive to Active (no chains, so it will try to succeed immediately)
 			driveToPhase(r, aiv1alpha1.MissionPhaseActive, 10, readyOnProvisioning(), readyOnAssembling())
 
-			// Set knight cost over budget
-			knight := &aiv1alpha1.Knight{}
-			Expect(k8sClient.Get(ctx, knightNN, knight)).To(Succeed())
-			knight.Status.TotalCost = "15.75"
-			Expect(k8sClient.Status().Update(ctx, knight)).To(Succeed())
+			// Record mission task cost over budget
+			recordMissionCost("15.75")
 
 			// Reconcile should fail due to budget
 			Eventually(func() aiv1alpha1.MissionPhase {
@@ -629,6 +658,7 @@ var _ = Describe("Mission Controller", func() {
 			condition := meta.FindStatusCondition(mission.Status.Conditions, "Complete")
 			Expect(condition).NotTo(BeNil())
 			Expect(condition.Reason).To(Equal("OverBudget"))
+			Expect(meta.IsStatusConditionFalse(mission.Status.Conditions, aiv1alpha1.ConditionMissionWithinBudget)).To(BeTrue())
 		})
 	})
 
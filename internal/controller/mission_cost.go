@@ -0,0 +1,113 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// missionKnightName returns the name of the Knight CR serving a mission
+// knight: ephemeral knights are created as <mission>-<name>.
+func missionKnightName(mission *aiv1alpha1.Mission, mk aiv1alpha1.MissionKnight) string {
+	if mk.Ephemeral {
+		return fmt.Sprintf("%s-%s", mission.Name, mk.Name)
+	}
+	return mk.Name
+}
+
+// aggregateMissionUsage totals the cost of the mission's chain runs, as the
+// chain controller recorded it from each task result's cost, and refreshes
+// the per-knight costBreakdown and each knight status's tasksCompleted (the
+// steps whose result the knight returned successfully). Only the mission's
+// own tasks count, so a shared knight's work for other chains is neither
+// billed nor credited to the mission.
+func (r *MissionReconciler) aggregateMissionUsage(ctx context.Context, mission *aiv1alpha1.Mission) (float64, error) {
+	chains := &aiv1alpha1.ChainList{}
+	if err := r.List(ctx, chains,
+		client.InNamespace(workNamespace(mission)),
+		client.MatchingLabels{aiv1alpha1.LabelMission: mission.Name},
+	); err != nil {
+		return 0, fmt.Errorf("failed to list mission chains: %w", err)
+	}
+
+	var totalCost float64
+	knightCosts := make(map[string]float64)
+	knightTasks := make(map[string]int64)
+	for _, chain := range chains.Items {
+		for _, ss := range chain.Status.StepStatuses {
+			cost := parseCostUSD(ss.CostUSD)
+			totalCost += cost
+			if ss.Knight != "" && cost > 0 {
+				knightCosts[ss.Knight] += cost
+			}
+			if ss.Knight != "" && ss.Phase == aiv1alpha1.ChainStepPhaseSucceeded {
+				knightTasks[ss.Knight]++
+			}
+		}
+	}
+	// Runs cleared by a chain retry still count toward the mission total.
+	for _, cs := range mission.Status.ChainStatuses {
+		totalCost += parseCostUSD(cs.RetriedCostUSD)
+	}
+	for i := range mission.Status.KnightStatuses {
+		ks := &mission.Status.KnightStatuses[i]
+		ks.TasksCompleted = knightTasks[missionKnightName(mission, aiv1alpha1.MissionKnight{Name: ks.Name, Ephemeral: ks.Ephemeral})]
+	}
+
+	costBreakdown := make([]aiv1alpha1.MissionKnightCost, 0, len(mission.Spec.Knights))
+	for _, mk := range mission.Spec.Knights {
+		name := missionKnightName(mission, mk)
+		costBreakdown = append(costBreakdown, aiv1alpha1.MissionKnightCost{
+			Name:      mk.Name,
+			CostUSD:   formatCostUSD(knightCosts[name]),
+			Ephemeral: mk.Ephemeral,
+		})
+		delete(knightCosts, name)
+	}
+	// Knights the mission's chains used without listing them.
+	others := make([]string, 0, len(knightCosts))
+	for name := range knightCosts {
+		others = append(others, name)
+	}
+	sort.Strings(others)
+	for _, name := range others {
+		costBreakdown = append(costBreakdown, aiv1alpha1.MissionKnightCost{
+			Name:    name,
+			CostUSD: formatCostUSD(knightCosts[name]),
+		})
+	}
+	mission.Status.CostBreakdown = costBreakdown
+
+	return totalCost, nil
+}
+
+// missionBudgetExceeded reports whether totalCost has passed the mission's
+// cost budget (see missionCostBudget), returning the budget (0 when the
+// mission has none).
+func missionBudgetExceeded(mission *aiv1alpha1.Mission, rt *aiv1alpha1.RoundTable, totalCost float64) (float64, bool) {
+	budget := parseCostUSD(missionCostBudget(mission, rt))
+	if budget <= 0 {
+		return 0, false
+	}
+	return budget, totalCost > budget
+}
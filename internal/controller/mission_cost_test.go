@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestAggregateMissionUsage(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	missionChain := func(name, mission string, steps ...aiv1alpha1.ChainStepStatus) *aiv1alpha1.Chain {
+		return &aiv1alpha1.Chain{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{aiv1alpha1.LabelMission: mission}},
+			Status:     aiv1alpha1.ChainStatus{StepStatuses: steps},
+		}
+	}
+	r := &MissionReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		missionChain("mission-quest-recon", "quest",
+			aiv1alpha1.ChainStepStatus{Name: "scan", Knight: "galahad", CostUSD: "0.2500", Phase: aiv1alpha1.ChainStepPhaseSucceeded},
+			aiv1alpha1.ChainStepStatus{Name: "probe", Knight: "quest-scout", CostUSD: "0.5000", Phase: aiv1alpha1.ChainStepPhaseSucceeded},
+			aiv1alpha1.ChainStepStatus{Name: "exploit", Knight: "quest-scout", Phase: aiv1alpha1.ChainStepPhaseFailed},
+		),
+		missionChain("mission-quest-report", "quest",
+			aiv1alpha1.ChainStepStatus{Name: "write", Knight: "percival", CostUSD: "1.0000", Phase: aiv1alpha1.ChainStepPhaseSucceeded},
+			aiv1alpha1.ChainStepStatus{Name: "review", Knight: "galahad", Phase: aiv1alpha1.ChainStepPhaseSucceeded},
+		),
+		missionChain("mission-other-recon", "other",
+			aiv1alpha1.ChainStepStatus{Name: "scan", Knight: "galahad", CostUSD: "9.0000", Phase: aiv1alpha1.ChainStepPhaseSucceeded},
+		),
+	).Build()}
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "quest", Namespace: "default"},
+		Spec: aiv1alpha1.MissionSpec{
+			CostBudgetUSD: "1.50",
+			Knights: []aiv1alpha1.MissionKnight{
+				{Name: "galahad"},
+				{Name: "scout", Ephemeral: true},
+				{Name: "lancelot"},
+			},
+		},
+	}
+	r.initKnightStatuses(mission)
+
+	total, err := r.aggregateMissionUsage(context.Background(), mission)
+	if err != nil {
+		t.Fatalf("aggregateMissionUsage() error = %v", err)
+	}
+	if total != 1.75 {
+		t.Errorf("total = %v, want 1.75 from the mission's own chains", total)
+	}
+	want := []aiv1alpha1.MissionKnightCost{
+		{Name: "galahad", CostUSD: "0.2500"},
+		{Name: "scout", CostUSD: "0.5000", Ephemeral: true},
+		{Name: "lancelot", CostUSD: "0.0000"},
+		{Name: "percival", CostUSD: "1.0000"},
+	}
+	if len(mission.Status.CostBreakdown) != len(want) {
+		t.Fatalf("costBreakdown = %+v, want %+v", mission.Status.CostBreakdown, want)
+	}
+	for i := range want {
+		if mission.Status.CostBreakdown[i] != want[i] {
+			t.Errorf("costBreakdown[%d] = %+v, want %+v", i, mission.Status.CostBreakdown[i], want[i])
+		}
+	}
+
+	for i, want := range []int64{2, 1, 0} {
+		if got := mission.Status.KnightStatuses[i].TasksCompleted; got != want {
+			t.Errorf("%s tasksCompleted = %d, want %d", mission.Status.KnightStatuses[i].Name, got, want)
+		}
+	}
+
+	if budget, over := missionBudgetExceeded(mission, nil, total); !over || budget != 1.5 {
+		t.Errorf("missionBudgetExceeded() = %v, %t, want 1.5, true", budget, over)
+	}
+	mission.Spec.CostBudgetUSD = "0"
+	if _, over := missionBudgetExceeded(mission, nil, total); over {
+		t.Error("missionBudgetExceeded() = true without a budget")
+	}
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+const (
+	// missionArgumentLimit caps each debate argument kept in status.
+	missionArgumentLimit = 2 << 10
+
+	// defaultDebateRounds and defaultDebateRoundTimeout apply when
+	// spec.debate leaves them unset.
+	defaultDebateRounds       = 3
+	defaultDebateRoundTimeout = 300 * time.Second
+)
+
+// debateRounds returns how many rounds the debate runs.
+func debateRounds(debate *aiv1alpha1.MissionDebate) int32 {
+	if debate.Rounds > 0 {
+		return debate.Rounds
+	}
+	return defaultDebateRounds
+}
+
+// debateRoundTimeout returns how long each round may take.
+func debateRoundTimeout(debate *aiv1alpha1.MissionDebate) time.Duration {
+	if debate.RoundTimeout > 0 {
+		return time.Duration(debate.RoundTimeout) * time.Second
+	}
+	return defaultDebateRoundTimeout
+}
+
+// validateDebate checks the debate can be held by the mission's knights.
+// Meta-missions get their knights from the planner, so their roster is not
+// checked here.
+func validateDebate(mission *aiv1alpha1.Mission, knightNames map[string]bool) string {
+	d := mission.Spec.Debate
+	if d == nil {
+		return ""
+	}
+	if mission.Spec.Consensus != nil {
+		return "A mission cannot have both consensus and debate"
+	}
+	seen := make(map[string]bool, len(d.Debaters))
+	for _, debater := range d.Debaters {
+		if seen[debater.Knight] {
+			return fmt.Sprintf("Knight %s debates more than one position", debater.Knight)
+		}
+		seen[debater.Knight] = true
+		if !mission.Spec.MetaMission && !knightNames[debater.Knight] {
+			return fmt.Sprintf("Debater %s is not a mission knight", debater.Knight)
+		}
+	}
+	if seen[d.Moderator] {
+		return fmt.Sprintf("Debate moderator %s cannot also debate", d.Moderator)
+	}
+	if !mission.Spec.MetaMission && !knightNames[d.Moderator] {
+		return fmt.Sprintf("Debate moderator %s is not a mission knight", d.Moderator)
+	}
+	return ""
+}
+
+// debateTaskID names a debater's task for a round.
+func debateTaskID(mission *aiv1alpha1.Mission, round int32, knight string) string {
+	return fmt.Sprintf("mission-%s-debate-r%d-%s-gen%d", mission.Name, round, knight, mission.Generation)
+}
+
+// debateTranscript renders the arguments so far, one section per argument.
+func debateTranscript(mission *aiv1alpha1.Mission) string {
+	positions := make(map[string]string, len(mission.Spec.Debate.Debaters))
+	for _, d := range mission.Spec.Debate.Debaters {
+		positions[d.Knight] = d.Position
+	}
+	var sections []string
+	for _, a := range mission.Status.Debate.Arguments {
+		body := strings.TrimRight(a.Argument, "\n")
+		if a.Error != "" {
+			body = fmt.Sprintf("(no argument: %s)", a.Error)
+		}
+		sections = append(sections, fmt.Sprintf("### Round %d: %s (%s)\n\n%s", a.Round, a.Knight, positions[a.Knight], body))
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+// debateTask asks a debater for its argument in the current round.
+func debateTask(mission *aiv1alpha1.Mission, debater aiv1alpha1.MissionDebater) string {
+	d, ds := mission.Spec.Debate, mission.Status.Debate
+	var b strings.Builder
+	b.WriteString(missionHeader(mission))
+	fmt.Fprintf(&b, "\n\nYou are debating the motion below. Argue %s.\n\nMotion: %s\n\nRound %d of %d.",
+		debater.Position, d.Motion, ds.Round, debateRounds(d))
+	if ds.Round > 1 {
+		b.WriteString(" Rebut the other side's arguments below and strengthen your own.")
+		b.WriteString("\n\n## Arguments so far\n\n")
+		b.WriteString(debateTranscript(mission))
+	}
+	b.WriteString("\n\nReply with your argument only.")
+	return b.String()
+}
+
+// conclusionTask asks the moderator to weigh the debate.
+func conclusionTask(mission *aiv1alpha1.Mission) string {
+	var b strings.Builder
+	b.WriteString(missionHeader(mission))
+	fmt.Fprintf(&b, "\n\nYou moderated a debate on the motion: %s\n\n", mission.Spec.Debate.Motion)
+	b.WriteString("Weigh the arguments below and write the conclusion: which position is better supported, " +
+		"the strongest points on each side, and what remains uncertain. Reply with the conclusion only.")
+	b.WriteString("\n\n## Arguments\n\n")
+	b.WriteString(debateTranscript(mission))
+	return b.String()
+}
+
+// publishArgument posts an argument to the mission's debate subject.
+// Observers are best-effort: a failure is logged, never returned.
+func (r *MissionReconciler) publishArgument(ctx context.Context, mission *aiv1alpha1.Mission, arg aiv1alpha1.MissionArgument) {
+	if arg.Error != "" {
+		return
+	}
+	client, err := r.natsClient()
+	if err != nil {
+		return
+	}
+	var position string
+	for _, d := range mission.Spec.Debate.Debaters {
+		if d.Knight == arg.Knight {
+			position = d.Position
+		}
+	}
+	payload := natspkg.DebateArgument{Round: arg.Round, Knight: arg.Knight, Position: position, Argument: arg.Argument}
+	if err := client.PublishJSON(mission.Status.Debate.Subject, payload); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to publish debate argument", "knight", arg.Knight, "round", arg.Round)
+	}
+}
+
+// reconcileDebating runs the Debating phase: each round every debater is
+// sent the motion, its position and the arguments so far; once all have
+// answered or the round times out the next round starts. After the last
+// round the moderator's conclusion becomes status.result.
+func (r *MissionReconciler) reconcileDebating(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	spec := mission.Spec.Debate
+	if spec == nil {
+		return r.finishDeliberation(ctx, mission, aiv1alpha1.MissionPhaseSucceeded, aiv1alpha1.ReasonMissionSucceeded)
+	}
+	nc, prefix, err := r.knightNATS(ctx, mission)
+	if err != nil {
+		log.Error(err, "Cannot hold the mission debate without NATS")
+		return ctrl.Result{RequeueAfter: RequeueSlow}, nil
+	}
+	if mission.Status.Debate == nil {
+		mission.Status.Debate = &aiv1alpha1.MissionDebateStatus{Subject: natspkg.DebateSubject(natsPrefix(mission))}
+	}
+	ds := mission.Status.Debate
+	timeout := debateRoundTimeout(spec)
+
+	if ds.ConclusionTaskID != "" {
+		moderator, _ := missionKnightByName(mission, spec.Moderator)
+		result, err := r.pollMissionTaskResult(ctx, nc, prefix, mission, moderator, ds.ConclusionTaskID)
+		if err != nil {
+			log.Error(err, "Failed to read debate conclusion")
+		}
+		switch {
+		case result != nil && result.GetError() == "":
+			mission.Status.Result = truncateOutput(result.GetOutput(), missionReportLimit)
+			r.Recorder.Eventf(mission, corev1.EventTypeNormal, "DebateConcluded", "Moderator %s concluded the debate", spec.Moderator)
+			return r.finishDeliberation(ctx, mission, aiv1alpha1.MissionPhaseSucceeded, aiv1alpha1.ReasonMissionSucceeded)
+		case result != nil:
+			return r.debateInconclusive(ctx, mission, fmt.Sprintf("moderator failed the conclusion: %s", result.GetError()))
+		case time.Since(ds.RoundStartedAt.Time) >= timeout:
+			return r.debateInconclusive(ctx, mission, "moderator did not conclude in time")
+		}
+		return ctrl.Result{RequeueAfter: RequeueDefault}, nil
+	}
+
+	if ds.Round > 0 {
+		argued := make(map[string]bool, len(spec.Debaters))
+		for _, a := range ds.Arguments {
+			if a.Round == ds.Round {
+				argued[a.Knight] = true
+			}
+		}
+		for _, debater := range spec.Debaters {
+			if argued[debater.Knight] {
+				continue
+			}
+			mk, _ := missionKnightByName(mission, debater.Knight)
+			result, err := r.pollMissionTaskResult(ctx, nc, prefix, mission, mk, debateTaskID(mission, ds.Round, debater.Knight))
+			if err != nil {
+				log.Error(err, "Failed to read debate argument", "knight", debater.Knight)
+				continue
+			}
+			if result == nil {
+				continue
+			}
+			arg := aiv1alpha1.MissionArgument{
+				Round:    ds.Round,
+				Knight:   debater.Knight,
+				Argument: truncateOutput(result.GetOutput(), missionArgumentLimit),
+				Error:    result.GetError(),
+			}
+			ds.Arguments = append(ds.Arguments, arg)
+			argued[debater.Knight] = true
+			r.publishArgument(ctx, mission, arg)
+		}
+		if len(argued) < len(spec.Debaters) {
+			if time.Since(ds.RoundStartedAt.Time) < timeout {
+				return r.updateDebrief(ctx, mission, RequeueDefault)
+			}
+			for _, debater := range spec.Debaters {
+				if !argued[debater.Knight] {
+					ds.Arguments = append(ds.Arguments, aiv1alpha1.MissionArgument{
+						Round: ds.Round, Knight: debater.Knight, Error: "no argument in time",
+					})
+				}
+			}
+		}
+	}
+
+	now := metav1.Now()
+	ds.RoundStartedAt = &now
+	if ds.Round >= debateRounds(spec) {
+		moderator, ok := missionKnightByName(mission, spec.Moderator)
+		taskID := fmt.Sprintf("mission-%s-debate-conclusion-gen%d", mission.Name, mission.Generation)
+		if !ok {
+			return r.debateInconclusive(ctx, mission, fmt.Sprintf("moderator %s is not a mission knight", spec.Moderator))
+		}
+		if err := r.dispatchMissionTask(ctx, nc, prefix, mission, moderator, taskID, "conclusion", conclusionTask(mission)); err != nil {
+			log.Error(err, "Failed to dispatch debate conclusion task", "knight", spec.Moderator)
+			return r.debateInconclusive(ctx, mission, fmt.Sprintf("conclusion task not delivered: %v", err))
+		}
+		ds.ConclusionTaskID = taskID
+		return r.updateDebrief(ctx, mission, RequeueDefault)
+	}
+
+	ds.Round++
+	for _, debater := range spec.Debaters {
+		var err error
+		if mk, ok := missionKnightByName(mission, debater.Knight); ok {
+			err = r.dispatchMissionTask(ctx, nc, prefix, mission, mk, debateTaskID(mission, ds.Round, debater.Knight), "debate", debateTask(mission, debater))
+		} else {
+			err = fmt.Errorf("not a mission knight")
+		}
+		if err != nil {
+			log.Error(err, "Failed to dispatch debate task", "knight", debater.Knight, "round", ds.Round)
+			ds.Arguments = append(ds.Arguments, aiv1alpha1.MissionArgument{
+				Round: ds.Round, Knight: debater.Knight, Error: fmt.Sprintf("debate task not delivered: %v", err),
+			})
+		}
+	}
+	r.Recorder.Eventf(mission, corev1.EventTypeNormal, "DebateRound",
+		"Started round %d of %d with %d debaters", ds.Round, debateRounds(spec), len(spec.Debaters))
+	return r.updateDebrief(ctx, mission, RequeueFast)
+}
+
+// debateInconclusive fails a debate the moderator did not conclude, keeping
+// the transcript as the result.
+func (r *MissionReconciler) debateInconclusive(ctx context.Context, mission *aiv1alpha1.Mission, reason string) (ctrl.Result, error) {
+	r.Recorder.Eventf(mission, corev1.EventTypeWarning, "DebateInconclusive", "Debate has no conclusion: %s", reason)
+	mission.Status.Result = truncateOutput(fmt.Sprintf("Debate inconclusive: %s\n\n%s", reason, debateTranscript(mission)), missionReportLimit)
+	return r.finishDeliberation(ctx, mission, aiv1alpha1.MissionPhaseFailed, aiv1alpha1.ReasonMissionInconclusive)
+}
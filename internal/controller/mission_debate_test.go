@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestReconcileDebating(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	knight := func(name string) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: aiv1alpha1.KnightSpec{Domain: "strategy", NATS: aiv1alpha1.KnightNATS{
+				Subjects:      []string{"fleet-a.tasks.strategy." + name},
+				ResultsStream: "fleet_a_results",
+			}},
+		}
+	}
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "council", Namespace: "default"},
+		Spec: aiv1alpha1.MissionSpec{
+			Objective: "Decide the migration",
+			Knights:   []aiv1alpha1.MissionKnight{{Name: "galahad"}, {Name: "mordred"}, {Name: "arthur"}},
+			Debate: &aiv1alpha1.MissionDebate{
+				Motion: "Migrate the fleet to NATS 3 this quarter",
+				Debaters: []aiv1alpha1.MissionDebater{
+					{Knight: "galahad", Position: "for the motion"},
+					{Knight: "mordred", Position: "against the motion"},
+				},
+				Moderator: "arthur",
+				Rounds:    2,
+			},
+		},
+		Status: aiv1alpha1.MissionStatus{Phase: aiv1alpha1.MissionPhaseDebating},
+	}
+	nc := newFakeNATSClient()
+	nc.messages = map[string]*nats.Msg{}
+	recorder := record.NewFakeRecorder(20)
+	r := &MissionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(mission, knight("galahad"), knight("mordred"), knight("arthur")).
+			WithStatusSubresource(&aiv1alpha1.Mission{}).Build(),
+		Recorder: recorder,
+		NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+	}
+	ctx := context.Background()
+	reply := func(taskID, output string) {
+		data, _ := json.Marshal(natspkg.TaskResult{TaskID: taskID, Output: output})
+		nc.messages[natspkg.ResultSubject("fleet-a", taskID)] = &nats.Msg{Data: data}
+	}
+	task := func(knight string) natspkg.TaskPayload {
+		var payload natspkg.TaskPayload
+		if err := json.Unmarshal(nc.published["fleet-a.tasks.strategy."+knight], &payload); err != nil {
+			t.Fatalf("task for %s: %v", knight, err)
+		}
+		return payload
+	}
+	reconcile := func() {
+		t.Helper()
+		if _, err := r.reconcileDebating(ctx, mission); err != nil {
+			t.Fatalf("reconcileDebating() error = %v", err)
+		}
+	}
+
+	reconcile()
+	if got := task("mordred"); got.TaskID != debateTaskID(mission, 1, "mordred") || !strings.Contains(got.Task, "Argue against the motion") {
+		t.Fatalf("round 1 task = %+v, want mordred arguing against", got)
+	}
+
+	reply(debateTaskID(mission, 1, "galahad"), "The new release halves our latency.")
+	reply(debateTaskID(mission, 1, "mordred"), "Our clients are not ready.")
+	reconcile() // collects round 1 and starts round 2
+	if mission.Status.Debate.Round != 2 {
+		t.Fatalf("round = %d, want 2 once both debaters argued", mission.Status.Debate.Round)
+	}
+	if got := task("galahad").Task; !strings.Contains(got, "Rebut") || !strings.Contains(got, "Our clients are not ready.") {
+		t.Errorf("round 2 task does not carry the other side's argument:\n%s", got)
+	}
+	var published natspkg.DebateArgument
+	if err := json.Unmarshal(nc.published["mission-council.debate"], &published); err != nil || published.Round != 1 {
+		t.Errorf("published argument = %+v, %v, want round 1 on the debate subject", published, err)
+	}
+
+	reply(debateTaskID(mission, 2, "galahad"), "Client upgrades are scripted.")
+	reply(debateTaskID(mission, 2, "mordred"), "Scripts were never tested.")
+	reconcile() // collects round 2 and asks the moderator
+	conclusion := task("arthur")
+	if conclusion.TaskID != mission.Status.Debate.ConclusionTaskID || !strings.Contains(conclusion.Task, "### Round 2: mordred (against the motion)") {
+		t.Fatalf("conclusion task = %+v, want the full transcript", conclusion)
+	}
+
+	reply(conclusion.TaskID, "Migrate next quarter, after testing the client scripts.")
+	reconcile()
+	if mission.Status.Phase != aiv1alpha1.MissionPhaseSucceeded || !strings.HasPrefix(mission.Status.Result, "Migrate next quarter") {
+		t.Errorf("phase = %s, result = %q, want Succeeded with the conclusion", mission.Status.Phase, mission.Status.Result)
+	}
+	if !meta.IsStatusConditionTrue(mission.Status.Conditions, aiv1alpha1.ConditionMissionComplete) {
+		t.Error("Complete condition not set")
+	}
+	if events := strings.Join(drainEvents(recorder), "\n"); !strings.Contains(events, "DebateConcluded") {
+		t.Errorf("events = %v, want DebateConcluded", events)
+	}
+}
+
+func TestValidateDebate(t *testing.T) {
+	knights := map[string]bool{"galahad": true, "mordred": true, "arthur": true}
+	debate := func(moderator string, debaters ...string) *aiv1alpha1.Mission {
+		d := &aiv1alpha1.MissionDebate{Motion: "m", Moderator: moderator}
+		for _, k := range debaters {
+			d.Debaters = append(d.Debaters, aiv1alpha1.MissionDebater{Knight: k, Position: "p"})
+		}
+		return &aiv1alpha1.Mission{Spec: aiv1alpha1.MissionSpec{Debate: d}}
+	}
+	tests := []struct {
+		name    string
+		mission *aiv1alpha1.Mission
+		want    string
+	}{
+		{"valid", debate("arthur", "galahad", "mordred"), ""},
+		{"moderator debates", debate("galahad", "galahad", "mordred"), "cannot also debate"},
+		{"unknown debater", debate("arthur", "galahad", "lancelot"), "not a mission knight"},
+		{"duplicate debater", debate("arthur", "galahad", "galahad"), "more than one position"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := validateDebate(tt.mission, knights)
+			if (tt.want == "") != (got == "") || !strings.Contains(got, tt.want) {
+				t.Errorf("validateDebate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
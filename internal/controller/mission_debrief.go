@@ -0,0 +1,395 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+const (
+	// missionSummaryLimit caps each knight summary kept in status.
+	missionSummaryLimit = 4 << 10
+
+	// missionReportLimit caps the report kept as status.result.
+	missionReportLimit = 32 << 10
+
+	// defaultDebriefTimeout applies when spec.debrief.timeout is unset.
+	defaultDebriefTimeout = 600 * time.Second
+)
+
+// missionCompletionPhase returns the phase a mission enters when its chains
+// finish with outcome: Debriefing first if it has a debrief.
+func missionCompletionPhase(mission *aiv1alpha1.Mission, outcome aiv1alpha1.MissionPhase) aiv1alpha1.MissionPhase {
+	if mission.Spec.Debrief != nil {
+		return aiv1alpha1.MissionPhaseDebriefing
+	}
+	return outcome
+}
+
+// deliberationPhase returns the phase a mission enters once its chains
+// succeed to decide its outcome, or "" if the chains decide it.
+func deliberationPhase(mission *aiv1alpha1.Mission) aiv1alpha1.MissionPhase {
+	switch {
+	case mission.Spec.Consensus != nil:
+		return aiv1alpha1.MissionPhaseVoting
+	case mission.Spec.Debate != nil:
+		return aiv1alpha1.MissionPhaseDebating
+	}
+	return ""
+}
+
+// startDeliberation moves a mission whose chains succeeded into its
+// deliberation phase.
+func (r *MissionReconciler) startDeliberation(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
+	mission.Status.Phase = deliberationPhase(mission)
+	mission.Status.ObservedGeneration = mission.Generation
+	err := r.Status().Update(ctx, mission)
+	if apierrors.IsConflict(err) {
+		return ctrl.Result{Requeue: true}, nil
+	}
+	if err == nil {
+		r.recordPhaseTransition(mission)
+	}
+	return ctrl.Result{RequeueAfter: RequeueFast}, err
+}
+
+// finishDeliberation records the outcome a vote or debate decided and
+// moves the mission on to Debriefing or its terminal phase. The first line
+// of status.result is the Complete condition's message.
+func (r *MissionReconciler) finishDeliberation(ctx context.Context, mission *aiv1alpha1.Mission, outcome aiv1alpha1.MissionPhase, reason string) (ctrl.Result, error) {
+	now := metav1.Now()
+	mission.Status.CompletedAt = &now
+	message := strings.SplitN(mission.Status.Result, "\n", 2)[0]
+	if message == "" {
+		message = fmt.Sprintf("Mission %s", outcome)
+	}
+	meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionMissionComplete,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: mission.Generation,
+	})
+	mission.Status.Phase = missionCompletionPhase(mission, outcome)
+	r.recordPhaseTransition(mission)
+	return r.updateDebrief(ctx, mission, RequeueFast)
+}
+
+// debriefTimeout returns how long the whole debrief may take.
+func debriefTimeout(debrief *aiv1alpha1.MissionDebrief) time.Duration {
+	if debrief.Timeout > 0 {
+		return time.Duration(debrief.Timeout) * time.Second
+	}
+	return defaultDebriefTimeout
+}
+
+// debriefTaskID names a mission knight's debrief task. The generation keeps
+// a retried dispatch idempotent, like the briefing's.
+func debriefTaskID(mission *aiv1alpha1.Mission, knight string) string {
+	return fmt.Sprintf("mission-%s-debrief-%s-gen%d", mission.Name, knight, mission.Generation)
+}
+
+// missionHeader opens every task the mission controller sends a knight.
+func missionHeader(mission *aiv1alpha1.Mission) string {
+	return fmt.Sprintf("[Mission: %s]\nObjective: %s\nOutcome: %s", mission.Name, mission.Spec.Objective, terminalOutcome(mission))
+}
+
+// debriefTask asks a knight for its final summary.
+func debriefTask(mission *aiv1alpha1.Mission) string {
+	return missionHeader(mission) + "\n\nThe mission has ended. Write a final summary of your work on it: " +
+		"what you did, what you found, and anything left unresolved. Reply with the summary only."
+}
+
+// missionSummaries joins the knights' summaries into one Markdown document,
+// one section per mission knight; knights that did not answer say so.
+func missionSummaries(mission *aiv1alpha1.Mission) string {
+	byKnight := make(map[string]aiv1alpha1.MissionKnightSummary)
+	if mission.Status.Debrief != nil {
+		for _, s := range mission.Status.Debrief.Summaries {
+			byKnight[s.Knight] = s
+		}
+	}
+	sections := make([]string, 0, len(mission.Spec.Knights))
+	for _, mk := range mission.Spec.Knights {
+		body := "(no summary received)"
+		if s, ok := byKnight[mk.Name]; ok {
+			body = strings.TrimRight(s.Summary, "\n")
+			if s.Error != "" {
+				body = fmt.Sprintf("(failed: %s)", s.Error)
+			}
+		}
+		sections = append(sections, fmt.Sprintf("### %s\n\n%s", mk.Name, body))
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+// reportTask asks the lead knight to compose the mission report.
+func reportTask(mission *aiv1alpha1.Mission) string {
+	var b strings.Builder
+	b.WriteString(missionHeader(mission))
+	b.WriteString("\n\nCompose the mission report from the chain outcomes and knight summaries below.")
+	if mission.Spec.Debrief.Instructions != "" {
+		b.WriteString("\n\n" + mission.Spec.Debrief.Instructions)
+	}
+	if mission.Spec.Debrief.VaultPath != "" {
+		fmt.Fprintf(&b, "\n\nAlso save the report as a Markdown note at %s/%s.",
+			vaultMountPath, strings.TrimPrefix(mission.Spec.Debrief.VaultPath, "/"))
+	}
+	b.WriteString(" Reply with the report.")
+	if len(mission.Status.ChainStatuses) > 0 {
+		b.WriteString("\n\n## Chains\n")
+		for _, cs := range mission.Status.ChainStatuses {
+			fmt.Fprintf(&b, "\n- %s: %s", cs.Name, cs.Phase)
+		}
+	}
+	if cs := mission.Status.Consensus; cs != nil && cs.Tally != "" {
+		decision := cs.Decision
+		if decision == "" {
+			decision = "none reached"
+		}
+		fmt.Fprintf(&b, "\n\n## Consensus\n\nDecision: %s (%s)", decision, cs.Tally)
+	}
+	b.WriteString("\n\n## Knight summaries\n\n")
+	b.WriteString(missionSummaries(mission))
+	return b.String()
+}
+
+// knightSubjectPrefix derives the subject prefix a knight takes tasks (and
+// returns results) under from its first subject, else fallback.
+func knightSubjectPrefix(knight *aiv1alpha1.Knight, fallback string) string {
+	if len(knight.Spec.NATS.Subjects) > 0 {
+		parts := strings.SplitN(knight.Spec.NATS.Subjects[0], ".tasks.", 2)
+		if len(parts) == 2 {
+			return parts[0]
+		}
+	}
+	return fallback
+}
+
+// missionKnight fetches the Knight CR serving a mission knight.
+func (r *MissionReconciler) missionKnight(ctx context.Context, mission *aiv1alpha1.Mission, mk aiv1alpha1.MissionKnight) (*aiv1alpha1.Knight, error) {
+	knight := &aiv1alpha1.Knight{}
+	if err := r.Get(ctx, types.NamespacedName{Name: missionKnightName(mission, mk), Namespace: workNamespace(mission)}, knight); err != nil {
+		return nil, err
+	}
+	return knight, nil
+}
+
+// dispatchMissionTask publishes a mission task to a knight, under prefix
+// when the knight's own subjects don't name one.
+func (r *MissionReconciler) dispatchMissionTask(ctx context.Context, nc natspkg.Client, prefix string, mission *aiv1alpha1.Mission, mk aiv1alpha1.MissionKnight, taskID, stepName, task string) error {
+	knight, err := r.missionKnight(ctx, mission, mk)
+	if err != nil {
+		return err
+	}
+	prefix = knightSubjectPrefix(knight, prefix)
+	payload := natspkg.TaskPayload{
+		TaskID:    taskID,
+		ChainName: fmt.Sprintf("mission-%s", mission.Name),
+		StepName:  stepName,
+		Task:      task,
+		Priority:  mission.Spec.Priority,
+	}
+	if err := natspkg.PublishTask(nc, natspkg.TaskSubject(prefix, knight.Spec.Domain, knight.Name), payload); err != nil {
+		return err
+	}
+	archiveAudit(ctx, nc, mission, natspkg.AuditKindTask, taskID, payload)
+	return nil
+}
+
+// pollMissionTaskResult returns a mission task's result from the knight's
+// results stream, or nil if it has not arrived.
+func (r *MissionReconciler) pollMissionTaskResult(ctx context.Context, nc natspkg.Client, prefix string, mission *aiv1alpha1.Mission, mk aiv1alpha1.MissionKnight, taskID string) (*natspkg.TaskResult, error) {
+	knight, err := r.missionKnight(ctx, mission, mk)
+	if err != nil {
+		return nil, err
+	}
+	stream := knight.Spec.NATS.ResultsStream
+	consumer := "mission-" + taskID
+	msg, err := nc.PollMessage(natspkg.ResultSubject(knightSubjectPrefix(knight, prefix), taskID), 2*time.Second,
+		natspkg.WithDurable(consumer),
+		natspkg.WithAckExplicit(),
+		natspkg.WithBindStream(stream),
+		natspkg.WithDeliverAll(),
+		natspkg.WithFallbackAutoDetect(),
+	)
+	if err != nil || msg == nil {
+		return nil, nil
+	}
+	if err := msg.Ack(); err != nil {
+		logf.FromContext(ctx).V(1).Info("Failed to ack mission task result", "taskID", taskID, "error", err.Error())
+	}
+	_ = nc.DeleteConsumer(stream, consumer)
+
+	var result natspkg.TaskResult
+	if err := json.Unmarshal(msg.Data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse result of task %s: %w", taskID, err)
+	}
+	archiveAudit(ctx, nc, mission, natspkg.AuditKindResult, taskID, result)
+	return &result, nil
+}
+
+// reconcileDebriefing runs the Debriefing phase: it asks every mission
+// knight for a final summary, waits up to half the debrief timeout for the
+// answers, then asks the lead knight to compose them into the mission
+// report, which becomes status.result.
+func (r *MissionReconciler) reconcileDebriefing(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	spec := mission.Spec.Debrief
+	if spec == nil {
+		return r.finishDebrief(ctx, mission, mission.Status.Result)
+	}
+	nc, prefix, err := r.knightNATS(ctx, mission)
+	if err != nil {
+		log.Error(err, "Cannot debrief mission without NATS")
+		r.Recorder.Eventf(mission, corev1.EventTypeWarning, "DebriefFailed", "Mission debrief skipped: %v", err)
+		return r.finishDebrief(ctx, mission, mission.Status.Result)
+	}
+
+	d := mission.Status.Debrief
+	if d == nil || d.StartedAt == nil {
+		now := metav1.Now()
+		d = &aiv1alpha1.MissionDebriefStatus{StartedAt: &now}
+		task := debriefTask(mission)
+		for _, mk := range mission.Spec.Knights {
+			if err := r.dispatchMissionTask(ctx, nc, prefix, mission, mk, debriefTaskID(mission, mk.Name), "debrief", task); err != nil {
+				log.Error(err, "Failed to dispatch debrief task", "knight", mk.Name)
+				d.Summaries = append(d.Summaries, aiv1alpha1.MissionKnightSummary{
+					Knight: mk.Name,
+					Error:  fmt.Sprintf("debrief task not delivered: %v", err),
+				})
+			}
+		}
+		mission.Status.Debrief = d
+		r.Recorder.Eventf(mission, corev1.EventTypeNormal, "DebriefStarted",
+			"Asked %d knights for their mission summaries", len(mission.Spec.Knights))
+		return r.updateDebrief(ctx, mission, RequeueFast)
+	}
+
+	timeout := debriefTimeout(spec)
+	elapsed := time.Since(d.StartedAt.Time)
+
+	if d.ReportTaskID == "" {
+		answered := make(map[string]bool, len(d.Summaries))
+		for _, s := range d.Summaries {
+			answered[s.Knight] = true
+		}
+		for _, mk := range mission.Spec.Knights {
+			if answered[mk.Name] {
+				continue
+			}
+			result, err := r.pollMissionTaskResult(ctx, nc, prefix, mission, mk, debriefTaskID(mission, mk.Name))
+			if err != nil {
+				log.Error(err, "Failed to read debrief result", "knight", mk.Name)
+				continue
+			}
+			if result == nil {
+				continue
+			}
+			d.Summaries = append(d.Summaries, aiv1alpha1.MissionKnightSummary{
+				Knight:  mk.Name,
+				Summary: truncateOutput(result.GetOutput(), missionSummaryLimit),
+				Error:   result.GetError(),
+			})
+		}
+		if len(d.Summaries) < len(mission.Spec.Knights) && elapsed < timeout/2 {
+			return r.updateDebrief(ctx, mission, RequeueDefault)
+		}
+
+		lead, ok := missionKnightByName(mission, spec.LeadKnight)
+		if !ok {
+			r.Recorder.Eventf(mission, corev1.EventTypeWarning, "DebriefFailed",
+				"Lead knight %q is not a mission knight; using the knight summaries as the report", spec.LeadKnight)
+			return r.finishDebrief(ctx, mission, missionSummaries(mission))
+		}
+		taskID := debriefTaskID(mission, "report")
+		if err := r.dispatchMissionTask(ctx, nc, prefix, mission, lead, taskID, "report", reportTask(mission)); err != nil {
+			log.Error(err, "Failed to dispatch report task", "knight", lead.Name)
+			r.Recorder.Eventf(mission, corev1.EventTypeWarning, "DebriefFailed",
+				"Report task not delivered to %s; using the knight summaries as the report", lead.Name)
+			return r.finishDebrief(ctx, mission, missionSummaries(mission))
+		}
+		d.ReportTaskID = taskID
+		return r.updateDebrief(ctx, mission, RequeueDefault)
+	}
+
+	lead, _ := missionKnightByName(mission, spec.LeadKnight)
+	result, err := r.pollMissionTaskResult(ctx, nc, prefix, mission, lead, d.ReportTaskID)
+	if err != nil {
+		log.Error(err, "Failed to read report result")
+	}
+	switch {
+	case result != nil && result.GetError() == "":
+		return r.finishDebrief(ctx, mission, result.GetOutput())
+	case result != nil:
+		r.Recorder.Eventf(mission, corev1.EventTypeWarning, "DebriefFailed",
+			"Lead knight failed the report: %s; using the knight summaries as the report", result.GetError())
+		return r.finishDebrief(ctx, mission, missionSummaries(mission))
+	case elapsed >= timeout:
+		r.Recorder.Event(mission, corev1.EventTypeWarning, "DebriefFailed",
+			"Lead knight did not report in time; using the knight summaries as the report")
+		return r.finishDebrief(ctx, mission, missionSummaries(mission))
+	}
+	return ctrl.Result{RequeueAfter: RequeueDefault}, nil
+}
+
+// missionKnightByName finds a knight in spec.knights.
+func missionKnightByName(mission *aiv1alpha1.Mission, name string) (aiv1alpha1.MissionKnight, bool) {
+	for _, mk := range mission.Spec.Knights {
+		if mk.Name == name {
+			return mk, true
+		}
+	}
+	return aiv1alpha1.MissionKnight{}, false
+}
+
+// updateDebrief saves the debrief progress.
+func (r *MissionReconciler) updateDebrief(ctx context.Context, mission *aiv1alpha1.Mission, requeue time.Duration) (ctrl.Result, error) {
+	mission.Status.ObservedGeneration = mission.Generation
+	if err := r.Status().Update(ctx, mission); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: requeue}, nil
+}
+
+// finishDebrief records the report as the mission result and moves the
+// mission to its terminal outcome, from where cleanup proceeds as usual.
+func (r *MissionReconciler) finishDebrief(ctx context.Context, mission *aiv1alpha1.Mission, report string) (ctrl.Result, error) {
+	mission.Status.Result = truncateOutput(report, missionReportLimit)
+	mission.Status.Phase = terminalOutcome(mission)
+	r.recordPhaseTransition(mission)
+	return r.updateDebrief(ctx, mission, RequeueFast)
+}
@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestReconcileDebriefing(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	knight := func(name string) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: aiv1alpha1.KnightSpec{Domain: "security", NATS: aiv1alpha1.KnightNATS{
+				Subjects:      []string{"fleet-a.tasks.security." + name},
+				ResultsStream: "fleet_a_results",
+			}},
+		}
+	}
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default"},
+		Spec: aiv1alpha1.MissionSpec{
+			Objective: "Map the perimeter",
+			Knights:   []aiv1alpha1.MissionKnight{{Name: "galahad"}, {Name: "percival"}},
+			Debrief:   &aiv1alpha1.MissionDebrief{LeadKnight: "galahad", VaultPath: "Briefings/recon.md", Timeout: 600},
+		},
+		Status: aiv1alpha1.MissionStatus{
+			Phase:  aiv1alpha1.MissionPhaseDebriefing,
+			Result: "All mission chains completed successfully",
+			Conditions: []metav1.Condition{{
+				Type: aiv1alpha1.ConditionMissionComplete, Status: metav1.ConditionTrue,
+				Reason: aiv1alpha1.ReasonMissionSucceeded, LastTransitionTime: metav1.Now(),
+			}},
+		},
+	}
+	nc := newFakeNATSClient()
+	nc.messages = map[string]*nats.Msg{}
+	r := &MissionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(mission, knight("galahad"), knight("percival")).
+			WithStatusSubresource(&aiv1alpha1.Mission{}).Build(),
+		Recorder: record.NewFakeRecorder(20),
+		NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+	}
+	ctx := context.Background()
+	reply := func(taskID, output string) {
+		data, _ := json.Marshal(natspkg.TaskResult{TaskID: taskID, Output: output})
+		nc.messages[natspkg.ResultSubject("fleet-a", taskID)] = &nats.Msg{Data: data}
+	}
+
+	if _, err := r.reconcileDebriefing(ctx, mission); err != nil {
+		t.Fatalf("reconcileDebriefing() error = %v", err)
+	}
+	for _, name := range []string{"galahad", "percival"} {
+		data, ok := nc.published["fleet-a.tasks.security."+name]
+		if !ok || !strings.Contains(string(data), "final summary") {
+			t.Fatalf("no debrief task published to %s: %s", name, data)
+		}
+	}
+
+	reply(debriefTaskID(mission, "galahad"), "Scanned 40 hosts; two exposed SSH ports.")
+	if _, err := r.reconcileDebriefing(ctx, mission); err != nil {
+		t.Fatalf("reconcileDebriefing() error = %v", err)
+	}
+	if got := mission.Status.Debrief; len(got.Summaries) != 1 || got.ReportTaskID != "" {
+		t.Fatalf("debrief = %+v, want one summary and no report task while percival may still answer", got)
+	}
+
+	// Half the timeout passes without percival answering.
+	past := metav1.NewTime(time.Now().Add(-301 * time.Second))
+	mission.Status.Debrief.StartedAt = &past
+	if _, err := r.reconcileDebriefing(ctx, mission); err != nil {
+		t.Fatalf("reconcileDebriefing() error = %v", err)
+	}
+	var payload natspkg.TaskPayload
+	if err := json.Unmarshal(nc.published["fleet-a.tasks.security.galahad"], &payload); err != nil {
+		t.Fatalf("report task: %v", err)
+	}
+	if payload.TaskID != mission.Status.Debrief.ReportTaskID || payload.StepName != "report" {
+		t.Fatalf("report task = %+v, want the recorded report task", payload)
+	}
+	for _, want := range []string{"two exposed SSH ports", "### percival\n\n(no summary received)", "/vault/Briefings/recon.md", "Outcome: Succeeded"} {
+		if !strings.Contains(payload.Task, want) {
+			t.Errorf("report task missing %q:\n%s", want, payload.Task)
+		}
+	}
+
+	reply(payload.TaskID, "# Recon report\n\nPerimeter mapped.")
+	if _, err := r.reconcileDebriefing(ctx, mission); err != nil {
+		t.Fatalf("reconcileDebriefing() error = %v", err)
+	}
+	if mission.Status.Phase != aiv1alpha1.MissionPhaseSucceeded || mission.Status.Result != "# Recon report\n\nPerimeter mapped." {
+		t.Errorf("phase = %s, result = %q, want Succeeded with the report", mission.Status.Phase, mission.Status.Result)
+	}
+	if !meta.IsStatusConditionTrue(mission.Status.Conditions, aiv1alpha1.ConditionMissionComplete) {
+		t.Error("Complete condition lost")
+	}
+}
+
+func TestMissionCompletionPhase(t *testing.T) {
+	mission := &aiv1alpha1.Mission{}
+	if got := missionCompletionPhase(mission, aiv1alpha1.MissionPhaseFailed); got != aiv1alpha1.MissionPhaseFailed {
+		t.Errorf("missionCompletionPhase() = %s without a debrief, want Failed", got)
+	}
+	mission.Spec.Debrief = &aiv1alpha1.MissionDebrief{LeadKnight: "galahad"}
+	if got := missionCompletionPhase(mission, aiv1alpha1.MissionPhaseFailed); got != aiv1alpha1.MissionPhaseDebriefing {
+		t.Errorf("missionCompletionPhase() = %s with a debrief, want Debriefing", got)
+	}
+}
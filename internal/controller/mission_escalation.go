@@ -0,0 +1,243 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/notify"
+)
+
+const (
+	// defaultPagerDutyURL is the PagerDuty Events API v2 endpoint used when
+	// onFailure.page sets no url.
+	defaultPagerDutyURL = "https://events.pagerduty.com/v2/enqueue"
+
+	// defaultPageSeverity is the incident severity used when onFailure.page
+	// sets none.
+	defaultPageSeverity = "error"
+)
+
+// escalationPending reports whether a failed mission's spec.onFailure has not
+// run yet. Like the completion webhook it keys off the Complete condition,
+// since the phase passes through CleaningUp.
+func escalationPending(mission *aiv1alpha1.Mission) bool {
+	return mission.Spec.OnFailure != nil &&
+		mission.Status.Phase != aiv1alpha1.MissionPhaseDebriefing &&
+		meta.IsStatusConditionTrue(mission.Status.Conditions, aiv1alpha1.ConditionMissionComplete) &&
+		terminalOutcome(mission) == aiv1alpha1.MissionPhaseFailed &&
+		meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionEscalated) == nil
+}
+
+// reconcileEscalation runs spec.onFailure once for a failed mission and
+// records the outcome in the Escalated condition. Escalation never gates the
+// phase machine; the requeue resumes normal reconciliation.
+func (r *MissionReconciler) reconcileEscalation(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool) {
+	if !escalationPending(mission) {
+		return ctrl.Result{}, false
+	}
+
+	failures := r.escalate(ctx, mission)
+	cond := metav1.Condition{
+		Type:               aiv1alpha1.ConditionMissionEscalated,
+		Status:             metav1.ConditionTrue,
+		Reason:             aiv1alpha1.ReasonEscalated,
+		Message:            "Failure escalated",
+		ObservedGeneration: mission.Generation,
+	}
+	if len(failures) > 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = aiv1alpha1.ReasonEscalationFailed
+		cond.Message = strings.Join(failures, "; ")
+	}
+	meta.SetStatusCondition(&mission.Status.Conditions, cond)
+	if err := r.Status().Update(ctx, mission); err != nil {
+		// The next pass re-runs the escalation: the follow-up create is
+		// idempotent and pages dedupe on the mission UID.
+		logf.FromContext(ctx).Error(err, "Failed to update status after escalation")
+	}
+	return ctrl.Result{RequeueAfter: RequeueFast}, true
+}
+
+// escalate runs every onFailure action and returns a description of each
+// one that failed.
+func (r *MissionReconciler) escalate(ctx context.Context, mission *aiv1alpha1.Mission) []string {
+	log := logf.FromContext(ctx)
+	policy := mission.Spec.OnFailure
+	summary := missionFailureSummary(mission)
+
+	var failures []string
+	fail := func(action string, err error) {
+		log.Error(err, "Mission escalation failed", "action", action)
+		r.Recorder.Eventf(mission, corev1.EventTypeWarning, "EscalationFailed", "%s failed: %v", action, err)
+		failures = append(failures, fmt.Sprintf("%s: %v", action, err))
+	}
+
+	for i, n := range policy.Notifications {
+		if err := r.sendFailureNotification(ctx, mission, n, summary); err != nil {
+			fail(fmt.Sprintf("notification %d (%s)", i, n.Type), err)
+		}
+	}
+	if policy.FollowUp != nil {
+		name, err := r.createFollowUpMission(ctx, mission, policy.FollowUp)
+		if err != nil {
+			fail("follow-up mission", err)
+		} else {
+			mission.Status.FollowUpMission = name
+			r.Recorder.Eventf(mission, corev1.EventTypeNormal, "FollowUpMissionCreated",
+				"Created follow-up mission %s from template %s", name, policy.FollowUp.TemplateRef)
+		}
+	}
+	if policy.Page != nil {
+		if err := r.pageMissionFailure(ctx, mission, policy.Page, summary); err != nil {
+			fail("page", err)
+		} else {
+			r.Recorder.Event(mission, corev1.EventTypeNormal, "MissionPaged", "Triggered a PagerDuty incident")
+		}
+	}
+	return failures
+}
+
+// sendFailureNotification posts the failure summary to one channel. The URL
+// is read from its Secret and never logged.
+func (r *MissionReconciler) sendFailureNotification(ctx context.Context, mission *aiv1alpha1.Mission, n aiv1alpha1.MissionFailureNotification, summary string) error {
+	url, err := secretKeyValue(ctx, r.Client, mission.Namespace, &n.URLSecretRef)
+	if err != nil {
+		return err
+	}
+	url = strings.TrimSpace(url)
+	if r.Notify == nil || !r.Notify.URLAllowed(url) {
+		return fmt.Errorf("URL in secret %q does not match the operator's allowed URL prefixes", n.URLSecretRef.Name)
+	}
+	switch n.Type {
+	case aiv1alpha1.NotificationChannelSlack:
+		return r.Notify.PostSlack(ctx, url, summary)
+	case aiv1alpha1.NotificationChannelDiscord:
+		return r.Notify.PostDiscord(ctx, url, summary)
+	default:
+		payload := missionNotifyPayload(mission)
+		payload.Output, payload.Truncated = notify.Truncate(summary)
+		payload.OutputRef = nil
+		return r.Notify.Deliver(ctx, url, "", payload)
+	}
+}
+
+// createFollowUpMission creates "<mission>-followup" from the template
+// Mission's spec. The follow-up has no owner reference so it outlives the
+// failed mission's TTL, and it cannot create a follow-up of its own.
+func (r *MissionReconciler) createFollowUpMission(ctx context.Context, mission *aiv1alpha1.Mission, followUp *aiv1alpha1.MissionFollowUp) (string, error) {
+	template := &aiv1alpha1.Mission{}
+	if err := r.Get(ctx, types.NamespacedName{Name: followUp.TemplateRef, Namespace: mission.Namespace}, template); err != nil {
+		return "", fmt.Errorf("get template mission %q: %w", followUp.TemplateRef, err)
+	}
+
+	spec := template.Spec.DeepCopy()
+	spec.DryRun = false
+	if spec.OnFailure != nil {
+		spec.OnFailure.FollowUp = nil
+	}
+	briefing := fmt.Sprintf("Follow-up to failed mission %s.\nObjective: %s\nFailure: %s",
+		mission.Name, mission.Spec.Objective, missionFailureReason(mission))
+	if spec.Briefing != "" {
+		briefing += "\n\n" + spec.Briefing
+	}
+	spec.Briefing = briefing
+
+	name := mission.Name + "-followup"
+	diagnostic := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: mission.Namespace,
+			Labels:    map[string]string{aiv1alpha1.LabelFollowUpOf: mission.Name},
+		},
+		Spec: *spec,
+	}
+	if err := r.Create(ctx, diagnostic); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("create follow-up mission %q: %w", name, err)
+	}
+	return name, nil
+}
+
+// pageMissionFailure triggers a PagerDuty incident for the failed mission,
+// deduplicated on its UID. The routing key is never logged.
+func (r *MissionReconciler) pageMissionFailure(ctx context.Context, mission *aiv1alpha1.Mission, page *aiv1alpha1.MissionPage, summary string) error {
+	url := page.URL
+	if url == "" {
+		url = defaultPagerDutyURL
+	}
+	if r.Notify == nil || !r.Notify.URLAllowed(url) {
+		return fmt.Errorf("URL %q does not match the operator's allowed URL prefixes", url)
+	}
+	routingKey, err := secretKeyValue(ctx, r.Client, mission.Namespace, &page.RoutingKeySecretRef)
+	if err != nil {
+		return err
+	}
+	severity := page.Severity
+	if severity == "" {
+		severity = defaultPageSeverity
+	}
+	return r.Notify.PostPagerDuty(ctx, url, strings.TrimSpace(routingKey), notify.PagerDutyEvent{
+		DedupKey: string(mission.UID),
+		Summary:  summary,
+		Source:   mission.Namespace + "/" + mission.Name,
+		Severity: severity,
+	})
+}
+
+// missionFailureReason returns the recorded failure message.
+func missionFailureReason(mission *aiv1alpha1.Mission) string {
+	if cond := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionComplete); cond != nil && cond.Message != "" {
+		return cond.Message
+	}
+	return mission.Status.Result
+}
+
+// missionFailureSummary describes a failed mission for a chat message or
+// page: objective, failure reason, duration, and cost.
+func missionFailureSummary(mission *aiv1alpha1.Mission) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Mission %s/%s Failed\n", mission.Namespace, mission.Name)
+	fmt.Fprintf(&b, "Objective: %s\n", mission.Spec.Objective)
+
+	var details []string
+	if mission.Status.StartedAt != nil && mission.Status.CompletedAt != nil {
+		details = append(details, "Duration: "+mission.Status.CompletedAt.Sub(mission.Status.StartedAt.Time).Round(time.Second).String())
+	}
+	if mission.Status.TotalCost != "" {
+		details = append(details, "Cost: $"+mission.Status.TotalCost)
+	}
+	if len(details) > 0 {
+		b.WriteString(strings.Join(details, " | "))
+		b.WriteString("\n")
+	}
+	if reason := missionFailureReason(mission); reason != "" {
+		fmt.Fprintf(&b, "Reason: %s\n", reason)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
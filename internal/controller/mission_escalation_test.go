@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/notify"
+)
+
+func TestMissionFailureEscalation(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+
+	var mu sync.Mutex
+	bodies := map[string]map[string]any{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		bodies[r.URL.Path] = body
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default", UID: "uid-1"},
+		Spec: aiv1alpha1.MissionSpec{
+			Objective: "Map the perimeter",
+			OnFailure: &aiv1alpha1.MissionFailurePolicy{
+				Notifications: []aiv1alpha1.MissionFailureNotification{{
+					Type: aiv1alpha1.NotificationChannelSlack,
+					URLSecretRef: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "escalation"}, Key: "slack",
+					},
+				}},
+				FollowUp: &aiv1alpha1.MissionFollowUp{TemplateRef: "diagnose"},
+				Page: &aiv1alpha1.MissionPage{
+					URL: srv.URL + "/pagerduty",
+					RoutingKeySecretRef: corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "escalation"}, Key: "routing-key",
+					},
+				},
+			},
+		},
+		Status: aiv1alpha1.MissionStatus{
+			Phase: aiv1alpha1.MissionPhaseFailed,
+			Conditions: []metav1.Condition{{
+				Type:    aiv1alpha1.ConditionMissionComplete,
+				Status:  metav1.ConditionTrue,
+				Reason:  aiv1alpha1.ReasonMissionFailed,
+				Message: "chain scan failed",
+			}},
+		},
+	}
+	template := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "diagnose", Namespace: "default"},
+		Spec: aiv1alpha1.MissionSpec{
+			Objective: "Find out why the mission failed",
+			DryRun:    true,
+			Briefing:  "Check the knight logs.",
+			OnFailure: &aiv1alpha1.MissionFailurePolicy{FollowUp: &aiv1alpha1.MissionFollowUp{TemplateRef: "diagnose"}},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "escalation", Namespace: "default"},
+		Data: map[string][]byte{
+			"slack":       []byte(srv.URL + "/slack\n"),
+			"routing-key": []byte("rk"),
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mission, template, secret).
+		WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionReconciler{Client: c, Recorder: recorder, Notify: notify.NewNotifier([]string{srv.URL})}
+
+	if _, handled := r.reconcileEscalation(ctx, mission); !handled {
+		t.Fatal("reconcileEscalation() not handled, want the failure escalated")
+	}
+	cond := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionEscalated)
+	if cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Fatalf("Escalated condition = %+v, want True", cond)
+	}
+
+	mu.Lock()
+	slack, page := bodies["/slack"], bodies["/pagerduty"]
+	mu.Unlock()
+	if text, _ := slack["text"].(string); !strings.Contains(text, "Reason: chain scan failed") {
+		t.Errorf("slack message = %v, want the failure reason", slack)
+	}
+	if page["routing_key"] != "rk" || page["dedup_key"] != "uid-1" {
+		t.Errorf("page = %v, want the routing key and the mission UID", page)
+	}
+
+	followUp := &aiv1alpha1.Mission{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "recon-followup", Namespace: "default"}, followUp); err != nil {
+		t.Fatalf("get follow-up mission: %v", err)
+	}
+	if followUp.Spec.DryRun || followUp.Spec.OnFailure.FollowUp != nil {
+		t.Errorf("follow-up spec = %+v, want dryRun and followUp cleared", followUp.Spec)
+	}
+	if !strings.HasPrefix(followUp.Spec.Briefing, "Follow-up to failed mission recon.") ||
+		!strings.HasSuffix(followUp.Spec.Briefing, "Check the knight logs.") {
+		t.Errorf("follow-up briefing = %q", followUp.Spec.Briefing)
+	}
+	if followUp.Labels[aiv1alpha1.LabelFollowUpOf] != "recon" || mission.Status.FollowUpMission != "recon-followup" {
+		t.Errorf("follow-up labels = %v, status %q", followUp.Labels, mission.Status.FollowUpMission)
+	}
+	if events := drainEvents(recorder); len(events) != 2 {
+		t.Errorf("events = %v, want FollowUpMissionCreated and MissionPaged", events)
+	}
+
+	if _, handled := r.reconcileEscalation(ctx, mission); handled {
+		t.Error("second reconcileEscalation() handled, want the escalation run once")
+	}
+}
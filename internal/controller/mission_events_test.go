@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// drainEvents returns the events recorded so far.
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case e := <-recorder.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func TestMissionEvents(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	knight := &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{Name: "galahad", Namespace: "default"},
+		Spec: aiv1alpha1.KnightSpec{Domain: "security", NATS: aiv1alpha1.KnightNATS{
+			Subjects: []string{"fleet-a.tasks.security.galahad"},
+		}},
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		mission    *aiv1alpha1.Mission
+		reconcile  func(r *MissionReconciler, m *aiv1alpha1.Mission) error
+		wantEvents []string
+	}{
+		{
+			name: "invalid spec",
+			mission: &aiv1alpha1.Mission{
+				ObjectMeta: metav1.ObjectMeta{Name: "quest", Namespace: "default"},
+				Spec:       aiv1alpha1.MissionSpec{Chains: []aiv1alpha1.MissionChainRef{{Name: "missing"}}},
+				Status:     aiv1alpha1.MissionStatus{Phase: aiv1alpha1.MissionPhasePending},
+			},
+			reconcile: func(r *MissionReconciler, m *aiv1alpha1.Mission) error {
+				_, err := r.reconcilePending(ctx, m)
+				return err
+			},
+			wantEvents: []string{
+				"Warning ValidationFailed Referenced chain not found: missing",
+				"Normal PhaseTransition Mission transitioned to Failed",
+			},
+		},
+		{
+			name: "briefing publish failure",
+			mission: &aiv1alpha1.Mission{
+				ObjectMeta: metav1.ObjectMeta{Name: "quest", Namespace: "default"},
+				Spec: aiv1alpha1.MissionSpec{
+					Briefing: "Hold the bridge",
+					Knights:  []aiv1alpha1.MissionKnight{{Name: "galahad"}},
+				},
+				Status: aiv1alpha1.MissionStatus{Phase: aiv1alpha1.MissionPhaseBriefing},
+			},
+			reconcile: func(r *MissionReconciler, m *aiv1alpha1.Mission) error {
+				_, err := r.reconcileBriefing(ctx, m)
+				return err
+			},
+			wantEvents: []string{"Warning BriefingFailed Failed to publish briefing"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nc := newFakeNATSClient()
+			nc.failSubject = func(string) bool { return true }
+			recorder := record.NewFakeRecorder(20)
+			r := &MissionReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.mission, knight.DeepCopy()).
+					WithStatusSubresource(&aiv1alpha1.Mission{}).Build(),
+				Recorder: recorder,
+				NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+			}
+			if err := tt.reconcile(r, tt.mission); err != nil {
+				t.Fatalf("reconcile error = %v", err)
+			}
+			events := drainEvents(recorder)
+			for _, want := range tt.wantEvents {
+				found := false
+				for _, e := range events {
+					found = found || strings.HasPrefix(e, want)
+				}
+				if !found {
+					t.Errorf("events = %q, want one starting %q", events, want)
+				}
+			}
+		})
+	}
+}
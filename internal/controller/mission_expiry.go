@@ -0,0 +1,135 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// maxMissionExtension caps status.extensionSeconds at the longest TTL a
+// mission may be given.
+const maxMissionExtension = 7 * 24 * 60 * 60
+
+// missionTimeout returns how long the mission may take to finish: its
+// timeout plus any extension.
+func missionTimeout(mission *aiv1alpha1.Mission) time.Duration {
+	return time.Duration(mission.Spec.Timeout+mission.Status.ExtensionSeconds) * time.Second
+}
+
+// missionExpiry returns when the mission expires: its TTL plus any
+// extension after it started.
+func missionExpiry(mission *aiv1alpha1.Mission) time.Time {
+	return mission.Status.StartedAt.Add(time.Duration(mission.Spec.TTL+mission.Status.ExtensionSeconds) * time.Second)
+}
+
+// parseExtension reads the extend annotation: whole seconds or a Go
+// duration, which must be positive.
+func parseExtension(value string) (int32, error) {
+	value = strings.TrimSpace(value)
+	seconds, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		d, derr := time.ParseDuration(value)
+		if derr != nil {
+			return 0, fmt.Errorf("%q is neither seconds nor a duration", value)
+		}
+		seconds = int64(d / time.Second)
+	}
+	if seconds <= 0 || seconds > maxMissionExtension {
+		return 0, fmt.Errorf("extension must be between 1s and %ds", maxMissionExtension)
+	}
+	return int32(seconds), nil
+}
+
+// reconcileExpiry applies the extend annotation and keeps status.expiresAt
+// in step with spec.ttl, recording a change in the Extended condition.
+// Expiry is otherwise computed once, at start. Missions already expiring
+// or cleaning up are left alone.
+func (r *MissionReconciler) reconcileExpiry(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool) {
+	if mission.Status.StartedAt == nil || mission.Status.ExpiresAt == nil ||
+		mission.Status.Phase == aiv1alpha1.MissionPhaseCleaningUp || mission.Status.Phase == aiv1alpha1.MissionPhaseExpired {
+		return ctrl.Result{}, false
+	}
+
+	reason, requested := aiv1alpha1.ReasonTTLChanged, int32(0)
+	if value, ok := mission.Annotations[aiv1alpha1.AnnotationExtend]; ok {
+		seconds, err := parseExtension(value)
+		if err != nil {
+			r.Recorder.Eventf(mission, corev1.EventTypeWarning, "InvalidExtension", "Ignoring extend annotation: %v", err)
+			r.clearExtend(ctx, mission)
+			return ctrl.Result{}, false
+		}
+		requested = min(seconds, maxMissionExtension-mission.Status.ExtensionSeconds)
+		mission.Status.ExtensionSeconds += requested
+		reason = aiv1alpha1.ReasonExtendRequested
+	}
+
+	expiresAt := metav1.NewTime(missionExpiry(mission))
+	if expiresAt.Equal(mission.Status.ExpiresAt) {
+		r.clearExtend(ctx, mission)
+		return ctrl.Result{}, false
+	}
+	previous := mission.Status.ExpiresAt
+	mission.Status.ExpiresAt = &expiresAt
+	msg := fmt.Sprintf("Expires at %s (ttl %ds + %ds extension); timeout %s",
+		expiresAt.UTC().Format(time.RFC3339), mission.Spec.TTL, mission.Status.ExtensionSeconds, missionTimeout(mission))
+	meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionMissionExtended,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            msg,
+		ObservedGeneration: mission.Generation,
+	})
+	if err := r.Status().Update(ctx, mission); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to record mission expiry")
+		return ctrl.Result{RequeueAfter: RequeueFast}, true
+	}
+	if requested > 0 {
+		r.Recorder.Eventf(mission, corev1.EventTypeNormal, "MissionExtended", "Extended by %ds: %s", requested, msg)
+	} else {
+		r.Recorder.Eventf(mission, corev1.EventTypeNormal, "ExpiryUpdated", "spec.ttl changed (was expiring at %s): %s",
+			previous.UTC().Format(time.RFC3339), msg)
+	}
+	r.clearExtend(ctx, mission)
+	return ctrl.Result{RequeueAfter: RequeueFast}, true
+}
+
+// clearExtend removes the extend annotation. It runs after the status
+// update so a failed write cannot lose an extension; failures are only
+// logged.
+func (r *MissionReconciler) clearExtend(ctx context.Context, mission *aiv1alpha1.Mission) {
+	if _, ok := mission.Annotations[aiv1alpha1.AnnotationExtend]; !ok {
+		return
+	}
+	patch := client.MergeFrom(mission.DeepCopy())
+	delete(mission.Annotations, aiv1alpha1.AnnotationExtend)
+	if err := r.Patch(ctx, mission, patch); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to clear extend annotation")
+	}
+}
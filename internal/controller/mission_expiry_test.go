@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestParseExtension(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int32
+		wantErr bool
+	}{
+		{"600", 600, false},
+		{" 30m ", 1800, false},
+		{"1h30m", 5400, false},
+		{"0", 0, true},
+		{"-5m", 0, true},
+		{"8d", 0, true},
+		{"700000", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseExtension(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseExtension(%q) = %d, %v, want %d, error %t", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestReconcileExpiry(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	started := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	expires := metav1.NewTime(started.Add(2 * time.Hour))
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "recon", Namespace: "default",
+			Annotations: map[string]string{aiv1alpha1.AnnotationExtend: "30m"},
+		},
+		Spec: aiv1alpha1.MissionSpec{RoundTableRef: "fleet", TTL: 7200, Timeout: 3600},
+		Status: aiv1alpha1.MissionStatus{
+			Phase:     aiv1alpha1.MissionPhaseActive,
+			StartedAt: &started,
+			ExpiresAt: &expires,
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mission).
+		WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionReconciler{Client: c, Recorder: recorder}
+
+	if _, handled := r.reconcileExpiry(ctx, mission); !handled {
+		t.Fatal("reconcileExpiry() not handled, want the extension applied")
+	}
+	if want := started.Add(150 * time.Minute); !mission.Status.ExpiresAt.Time.Equal(want) || mission.Status.ExtensionSeconds != 1800 {
+		t.Errorf("expiresAt = %s, extension %ds, want %s and 1800s", mission.Status.ExpiresAt, mission.Status.ExtensionSeconds, want)
+	}
+	if got := missionTimeout(mission); got != 90*time.Minute {
+		t.Errorf("missionTimeout() = %s, want the extension added", got)
+	}
+	cond := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionExtended)
+	if cond == nil || cond.Reason != aiv1alpha1.ReasonExtendRequested {
+		t.Errorf("Extended condition = %+v, want ExtendRequested", cond)
+	}
+	stored := &aiv1alpha1.Mission{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "recon", Namespace: "default"}, stored); err != nil {
+		t.Fatalf("get mission: %v", err)
+	}
+	if _, ok := stored.Annotations[aiv1alpha1.AnnotationExtend]; ok {
+		t.Error("extend annotation not removed")
+	}
+
+	// A TTL edit moves the expiry again; nothing changes after that.
+	mission.Spec.TTL = 3600
+	if err := c.Update(ctx, mission); err != nil {
+		t.Fatalf("update mission: %v", err)
+	}
+	if _, handled := r.reconcileExpiry(ctx, mission); !handled {
+		t.Fatal("reconcileExpiry() not handled after a TTL edit")
+	}
+	if want := started.Add(90 * time.Minute); !mission.Status.ExpiresAt.Time.Equal(want) {
+		t.Errorf("expiresAt = %s, want %s", mission.Status.ExpiresAt, want)
+	}
+	if _, handled := r.reconcileExpiry(ctx, mission); handled {
+		t.Error("reconcileExpiry() handled with nothing to change")
+	}
+	events := drainEvents(recorder)
+	if len(events) != 2 || !strings.Contains(events[0], "MissionExtended") || !strings.Contains(events[1], "ExpiryUpdated") {
+		t.Errorf("events = %v, want MissionExtended then ExpiryUpdated", events)
+	}
+}
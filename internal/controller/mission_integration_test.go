@@ -328,15 +328,25 @@ var _ = Describe("Mission Integration Tests", func() {
 				time.Sleep(100 * time.Millisecond)
 			}
 
-			// Set knight cost BEFORE next reconcile (reconcileActive checks budget)
-			Eventually(func() error {
-				k := &aiv1alpha1.Knight{}
-				if err := k8sClient.Get(ctx, knightNN, k); err != nil {
-					return err
-				}
-				k.Status.TotalCost = "2.5000" // Exceeds budget of 1.00
-				return k8sClient.Status().Update(ctx, k)
-			}, timeout, interval).Should(Succeed())
+			// Record mission task cost BEFORE next reconcile (reconcileActive checks budget)
+			costChain := &aiv1alpha1.Chain{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mission-" + missionName + "-cost",
+					Namespace: namespace,
+					Labels:    map[string]string{aiv1alpha1.LabelMission: missionName},
+				},
+				Spec: aiv1alpha1.ChainSpec{
+					Steps:         []aiv1alpha1.ChainStep{{Name: "work", KnightRef: knightName, Task: "work"}},
+					RoundTableRef: "default",
+					MissionRef:    missionName,
+				},
+			}
+			Expect(k8sClient.Create(ctx, costChain)).To(Succeed())
+			DeferCleanup(func() { _ = k8sClient.Delete(ctx, costChain) })
+			costChain.Status.StepStatuses = []aiv1alpha1.ChainStepStatus{
+				{Name: "work", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Knight: knightName, CostUSD: "2.5000"}, // Exceeds budget of 1.00
+			}
+			Expect(k8sClient.Status().Update(ctx, costChain)).To(Succeed())
 
 			// Reconcile to detect budget exceeded
 			Eventually(func() aiv1alpha1.MissionPhase {
@@ -0,0 +1,178 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	missionpkg "github.com/dapperdivers/roundtable/internal/mission"
+)
+
+// missionIsolated reports whether the mission runs in its own namespace.
+func missionIsolated(mission *aiv1alpha1.Mission) bool {
+	return missionpkg.Isolated(mission)
+}
+
+// workNamespace returns the namespace holding the mission's ephemeral
+// knights, chain copies and ephemeral RoundTable.
+func workNamespace(mission *aiv1alpha1.Mission) string {
+	return missionpkg.WorkNamespace(mission)
+}
+
+// missionLabels returns the labels marking an object as the mission's.
+func missionLabels(mission *aiv1alpha1.Mission) map[string]string {
+	return missionpkg.MissionLabels(mission)
+}
+
+// isolationSecrets returns the secrets an isolated mission's knights
+// reference: the parent RoundTable's, then the mission's own.
+func (r *MissionReconciler) isolationSecrets(ctx context.Context, mission *aiv1alpha1.Mission) ([]string, error) {
+	var names []string
+	seen := map[string]bool{}
+	add := func(refs []corev1.LocalObjectReference) {
+		for _, ref := range refs {
+			if ref.Name != "" && !seen[ref.Name] {
+				seen[ref.Name] = true
+				names = append(names, ref.Name)
+			}
+		}
+	}
+	if mission.Spec.RoundTableRef != "" {
+		rt := &aiv1alpha1.RoundTable{}
+		if err := r.Get(ctx, types.NamespacedName{Name: mission.Spec.RoundTableRef, Namespace: mission.Namespace}, rt); err != nil {
+			return nil, fmt.Errorf("get RoundTable %q: %w", mission.Spec.RoundTableRef, err)
+		}
+		add(rt.Spec.Secrets)
+	}
+	add(mission.Spec.Secrets)
+	return names, nil
+}
+
+// ensureIsolationNamespace creates an isolated mission's namespace and
+// copies its secrets into it. It returns handled=true while provisioning
+// must wait: the namespace name is being recorded, an earlier namespace of
+// the same name is still terminating, or a secret can't be copied yet.
+func (r *MissionReconciler) ensureIsolationNamespace(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool, error) {
+	log := logf.FromContext(ctx)
+
+	if mission.Status.IsolationNamespace == "" {
+		mission.Status.IsolationNamespace = missionpkg.IsolationNamespaceName(mission)
+		if err := r.Status().Update(ctx, mission); err != nil {
+			if apierrors.IsConflict(err) {
+				return ctrl.Result{Requeue: true}, true, nil
+			}
+			return ctrl.Result{}, true, err
+		}
+		return ctrl.Result{RequeueAfter: RequeueFast}, true, nil
+	}
+	name := mission.Status.IsolationNamespace
+
+	ns := &corev1.Namespace{}
+	err := r.Get(ctx, types.NamespacedName{Name: name}, ns)
+	switch {
+	case apierrors.IsNotFound(err):
+		ns = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				aiv1alpha1.LabelMission:          mission.Name,
+				aiv1alpha1.LabelMissionNamespace: mission.Namespace,
+				aiv1alpha1.LabelEphemeral:        "true",
+			},
+		}}
+		if err := r.Create(ctx, ns); err != nil {
+			return ctrl.Result{}, true, fmt.Errorf("failed to create isolation namespace: %w", err)
+		}
+		log.Info("Created mission isolation namespace", "namespace", name)
+		r.Recorder.Eventf(mission, corev1.EventTypeNormal, "IsolationNamespaceCreated", "Created namespace %s", name)
+	case err != nil:
+		return ctrl.Result{}, true, err
+	case !ownsIsolationNamespace(mission, ns):
+		return ctrl.Result{}, true, r.failValidation(ctx, mission,
+			fmt.Sprintf("Isolation namespace %s exists and does not belong to this mission", name))
+	case ns.DeletionTimestamp != nil:
+		log.Info("Waiting for terminating isolation namespace", "namespace", name)
+		return ctrl.Result{RequeueAfter: RequeueDefault}, true, nil
+	}
+
+	secrets, err := r.isolationSecrets(ctx, mission)
+	if err != nil {
+		return ctrl.Result{}, true, err
+	}
+	for _, secretName := range secrets {
+		if err := r.copySecret(ctx, mission, secretName, name); err != nil {
+			log.Error(err, "Failed to copy secret into isolation namespace", "secret", secretName)
+			r.Recorder.Eventf(mission, corev1.EventTypeWarning, "SecretCopyFailed",
+				"Failed to copy secret %s into namespace %s: %v", secretName, name, err)
+			return ctrl.Result{RequeueAfter: RequeueModerate}, true, nil
+		}
+	}
+	return ctrl.Result{}, false, nil
+}
+
+// ownsIsolationNamespace reports whether ns was created for the mission.
+func ownsIsolationNamespace(mission *aiv1alpha1.Mission, ns *corev1.Namespace) bool {
+	return ns.Labels[aiv1alpha1.LabelMission] == mission.Name &&
+		ns.Labels[aiv1alpha1.LabelMissionNamespace] == mission.Namespace
+}
+
+// copySecret copies a secret from the mission's namespace into its
+// isolation namespace, refreshing an earlier copy.
+func (r *MissionReconciler) copySecret(ctx context.Context, mission *aiv1alpha1.Mission, name, namespace string) error {
+	source := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: mission.Namespace}, source); err != nil {
+		return err
+	}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Labels = missionLabels(mission)
+		secret.Type = source.Type
+		secret.Data = source.Data
+		return nil
+	})
+	return err
+}
+
+// deleteIsolationNamespace deletes an isolated mission's namespace, and
+// with it everything the mission ran there.
+func (r *MissionReconciler) deleteIsolationNamespace(ctx context.Context, mission *aiv1alpha1.Mission) error {
+	if mission.Status.IsolationNamespace == "" {
+		return nil
+	}
+	ns := &corev1.Namespace{}
+	if err := r.Get(ctx, types.NamespacedName{Name: mission.Status.IsolationNamespace}, ns); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !ownsIsolationNamespace(mission, ns) || ns.DeletionTimestamp != nil {
+		return nil
+	}
+	if err := r.Delete(ctx, ns); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	r.Recorder.Eventf(mission, corev1.EventTypeNormal, "IsolationNamespaceDeleted", "Deleted namespace %s", ns.Name)
+	return nil
+}
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestIsolationNamespaceLifecycle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default", UID: "0123456789abcdef"},
+		Spec: aiv1alpha1.MissionSpec{
+			RoundTableRef: "fleet",
+			Isolation:     aiv1alpha1.MissionIsolationNamespace,
+			Secrets:       []corev1.LocalObjectReference{{Name: "target-creds"}},
+		},
+		Status: aiv1alpha1.MissionStatus{Phase: aiv1alpha1.MissionPhaseProvisioning},
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec:       aiv1alpha1.RoundTableSpec{Secrets: []corev1.LocalObjectReference{{Name: "model-keys"}}},
+	}
+	secret := func(name string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Data:       map[string][]byte{"key": []byte(name)},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mission, rt, secret("target-creds"), secret("model-keys")).
+		WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionReconciler{Client: c, Recorder: recorder}
+
+	if _, handled, err := r.ensureIsolationNamespace(ctx, mission); err != nil || !handled {
+		t.Fatalf("ensureIsolationNamespace() = %t, %v, want the name recorded first", handled, err)
+	}
+	if want := "mission-recon-01234567"; mission.Status.IsolationNamespace != want || workNamespace(mission) != want {
+		t.Fatalf("isolation namespace = %q, work namespace %q, want %q", mission.Status.IsolationNamespace, workNamespace(mission), want)
+	}
+	if _, handled, err := r.ensureIsolationNamespace(ctx, mission); err != nil || handled {
+		t.Fatalf("ensureIsolationNamespace() = %t, %v, want provisioning to continue", handled, err)
+	}
+
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mission.Status.IsolationNamespace}, ns); err != nil {
+		t.Fatalf("get isolation namespace: %v", err)
+	}
+	if !ownsIsolationNamespace(mission, ns) {
+		t.Errorf("namespace labels = %v, want the mission's", ns.Labels)
+	}
+	for _, name := range []string{"model-keys", "target-creds"} {
+		copied := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: ns.Name}, copied); err != nil {
+			t.Fatalf("get copied secret %s: %v", name, err)
+		}
+		if string(copied.Data["key"]) != name || copied.Labels[aiv1alpha1.LabelMissionNamespace] != "default" {
+			t.Errorf("secret %s = %v, labels %v, want a labelled copy", name, copied.Data, copied.Labels)
+		}
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "IsolationNamespaceCreated") {
+		t.Errorf("events = %v, want IsolationNamespaceCreated", events)
+	}
+
+	if err := r.deleteIsolationNamespace(ctx, mission); err != nil {
+		t.Fatalf("deleteIsolationNamespace() error = %v", err)
+	}
+	if err := c.Get(ctx, types.NamespacedName{Name: ns.Name}, ns); !apierrors.IsNotFound(err) {
+		t.Errorf("get isolation namespace after delete = %v, want not found", err)
+	}
+}
+
+func TestIsolatedMissionChain(t *testing.T) {
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default"},
+		Spec:       aiv1alpha1.MissionSpec{RoundTableRef: "fleet", Isolation: aiv1alpha1.MissionIsolationNamespace},
+		Status:     aiv1alpha1.MissionStatus{RoundTableName: "mission-recon-01234567", IsolationNamespace: "mission-recon-01234567"},
+	}
+	spec := missionChainSpec(mission, aiv1alpha1.MissionChainRef{Name: "scan"}, &aiv1alpha1.Chain{})
+	if spec.RoundTableRef != "mission-recon-01234567" {
+		t.Errorf("roundTableRef = %q, want the ephemeral RoundTable", spec.RoundTableRef)
+	}
+
+	chain := &aiv1alpha1.Chain{ObjectMeta: metav1.ObjectMeta{
+		Name:      "mission-recon-scan",
+		Namespace: workNamespace(mission),
+		Labels:    missionLabels(mission),
+	}}
+	reqs := missionForChain(context.Background(), chain)
+	if len(reqs) != 1 || reqs[0].Namespace != "default" || reqs[0].Name != "recon" {
+		t.Errorf("missionForChain() = %v, want default/recon", reqs)
+	}
+}
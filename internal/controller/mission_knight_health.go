@@ -0,0 +1,264 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	missionpkg "github.com/dapperdivers/roundtable/internal/mission"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// knightStatusKey returns the Knight CR behind a mission knight status.
+func knightStatusKey(mission *aiv1alpha1.Mission, ks *aiv1alpha1.MissionKnightStatus) types.NamespacedName {
+	if ks.Ephemeral {
+		return types.NamespacedName{Name: fmt.Sprintf("%s-%s", mission.Name, ks.Name), Namespace: workNamespace(mission)}
+	}
+	return types.NamespacedName{Name: ks.Name, Namespace: mission.Namespace}
+}
+
+// checkKnightHealth records when each knight stopped being Ready, reports
+// not-Ready knights in the KnightDegraded condition, and applies
+// spec.knightHealth to those past the grace period. Knights already
+// replaced no longer count.
+func (r *MissionReconciler) checkKnightHealth(ctx context.Context, mission *aiv1alpha1.Mission) {
+	now := metav1.Now()
+	var degraded []*aiv1alpha1.MissionKnightStatus
+	for i := range mission.Status.KnightStatuses {
+		ks := &mission.Status.KnightStatuses[i]
+		if ks.Ready {
+			ks.NotReadySince = nil
+			continue
+		}
+		if ks.ReplacedBy != "" {
+			continue
+		}
+		if ks.NotReadySince == nil {
+			ks.NotReadySince = &now
+		}
+		degraded = append(degraded, ks)
+	}
+
+	if len(degraded) == 0 {
+		if meta.IsStatusConditionTrue(mission.Status.Conditions, aiv1alpha1.ConditionMissionKnightDegraded) {
+			r.Recorder.Event(mission, corev1.EventTypeNormal, "KnightsRecovered", "No mission knight is degraded")
+		}
+		if meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionKnightDegraded) != nil {
+			meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+				Type:               aiv1alpha1.ConditionMissionKnightDegraded,
+				Status:             metav1.ConditionFalse,
+				Reason:             aiv1alpha1.ReasonKnightsHealthy,
+				Message:            "No mission knight is degraded",
+				ObservedGeneration: mission.Generation,
+			})
+		}
+		return
+	}
+
+	names := make([]string, 0, len(degraded))
+	for _, ks := range degraded {
+		names = append(names, ks.Name)
+	}
+	msg := "Knights not ready: " + strings.Join(names, ", ")
+	if meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionMissionKnightDegraded,
+		Status:             metav1.ConditionTrue,
+		Reason:             aiv1alpha1.ReasonKnightNotReady,
+		Message:            msg,
+		ObservedGeneration: mission.Generation,
+	}) {
+		r.Recorder.Event(mission, corev1.EventTypeWarning, "KnightDegraded", msg)
+	}
+
+	policy := mission.Spec.KnightHealth
+	if policy == nil || policy.Action == "" || policy.Action == aiv1alpha1.KnightHealthWait {
+		return
+	}
+	grace := time.Duration(policy.GracePeriodSeconds) * time.Second
+	for _, ks := range degraded {
+		if now.Sub(ks.NotReadySince.Time) < grace {
+			continue
+		}
+		if err := r.handleDegradedKnight(ctx, mission, ks, policy.Action); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to handle degraded knight", "knight", ks.Name, "action", policy.Action)
+			r.Recorder.Eventf(mission, corev1.EventTypeWarning, "KnightReplacementFailed",
+				"Could not %s knight %s: %v", strings.ToLower(string(policy.Action)), ks.Name, err)
+		}
+	}
+}
+
+// handleDegradedKnight moves a degraded knight's pending chain steps to a
+// Ready knight in its domain (Reroute) or to an ephemeral replacement
+// (Replace), and records the knight they moved to.
+func (r *MissionReconciler) handleDegradedKnight(ctx context.Context, mission *aiv1alpha1.Mission, ks *aiv1alpha1.MissionKnightStatus, action aiv1alpha1.KnightHealthAction) error {
+	key := knightStatusKey(mission, ks)
+	knight := &aiv1alpha1.Knight{}
+	if err := r.Get(ctx, key, knight); err != nil {
+		return fmt.Errorf("get knight %s: %w", key.Name, err)
+	}
+
+	var target, replacedBy string
+	switch action {
+	case aiv1alpha1.KnightHealthReroute:
+		substitute, err := r.substituteKnight(ctx, mission, knight)
+		if err != nil {
+			return err
+		}
+		if substitute == "" {
+			return fmt.Errorf("no Ready knight in domain %s", knight.Spec.Domain)
+		}
+		target, replacedBy = substitute, substitute
+	case aiv1alpha1.KnightHealthReplace:
+		name, err := r.createReplacementKnight(ctx, mission, ks, knight)
+		if err != nil {
+			return err
+		}
+		target, replacedBy = fmt.Sprintf("%s-%s", mission.Name, name), name
+	default:
+		return nil
+	}
+
+	moved, err := r.rerouteKnightSteps(ctx, mission, knight.Name, target)
+	if err != nil {
+		return err
+	}
+	ks.ReplacedBy = replacedBy
+	r.Recorder.Eventf(mission, corev1.EventTypeNormal, "KnightReplaced",
+		"Moved %d pending steps from knight %s to %s", moved, ks.Name, replacedBy)
+	return nil
+}
+
+// substituteKnight picks a Ready, unsuspended knight in the degraded
+// knight's domain, preferring the mission's own knights. It returns "" when
+// there is none.
+func (r *MissionReconciler) substituteKnight(ctx context.Context, mission *aiv1alpha1.Mission, degraded *aiv1alpha1.Knight) (string, error) {
+	knights := &aiv1alpha1.KnightList{}
+	if err := r.List(ctx, knights, client.InNamespace(workNamespace(mission))); err != nil {
+		return "", fmt.Errorf("list knights: %w", err)
+	}
+	own := make(map[string]bool, len(mission.Status.KnightStatuses))
+	for i := range mission.Status.KnightStatuses {
+		ks := &mission.Status.KnightStatuses[i]
+		if ks.Ready {
+			own[knightStatusKey(mission, ks).Name] = true
+		}
+	}
+
+	var candidates []string
+	for _, k := range knights.Items {
+		if k.Name == degraded.Name || k.Spec.Domain != degraded.Spec.Domain || k.Spec.Suspended || !k.Status.Ready {
+			continue
+		}
+		if own[k.Name] {
+			return k.Name, nil
+		}
+		candidates = append(candidates, k.Name)
+	}
+	if len(candidates) == 0 {
+		return "", nil
+	}
+	slices.Sort(candidates)
+	return candidates[0], nil
+}
+
+// createReplacementKnight creates an ephemeral copy of a degraded knight
+// subscribed to its own task subject, tracked as "<knight>-replacement" in
+// the mission's knight statuses so cleanup deletes it with the others.
+func (r *MissionReconciler) createReplacementKnight(ctx context.Context, mission *aiv1alpha1.Mission, ks *aiv1alpha1.MissionKnightStatus, degraded *aiv1alpha1.Knight) (string, error) {
+	name := ks.Name + "-replacement"
+	crName := fmt.Sprintf("%s-%s", mission.Name, name)
+
+	spec := degraded.Spec.DeepCopy()
+	spec.Suspended = false
+	prefix := knightSubjectPrefix(degraded, natsPrefix(mission))
+	spec.NATS.Subjects = []string{natspkg.TaskSubject(prefix, spec.Domain, crName)}
+	spec.NATS.ConsumerName = fmt.Sprintf("msn-%s-%s", mission.Name, name)
+
+	labels := missionLabels(mission)
+	labels[aiv1alpha1.LabelEphemeral] = "true"
+	labels[aiv1alpha1.LabelRole] = "replacement"
+	replacement := &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            crName,
+			Namespace:       workNamespace(mission),
+			Labels:          labels,
+			OwnerReferences: missionpkg.OwnerReferences(mission),
+		},
+		Spec: *spec,
+	}
+	if err := r.Create(ctx, replacement); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("create replacement knight %s: %w", crName, err)
+	}
+
+	if !slices.ContainsFunc(mission.Status.KnightStatuses, func(s aiv1alpha1.MissionKnightStatus) bool { return s.Name == name }) {
+		mission.Status.KnightStatuses = append(mission.Status.KnightStatuses, aiv1alpha1.MissionKnightStatus{
+			Name:      name,
+			Ephemeral: true,
+		})
+	}
+	return name, nil
+}
+
+// rerouteKnightSteps points the not-yet-started steps of the mission's
+// chains at knight to instead of from, returning how many moved.
+func (r *MissionReconciler) rerouteKnightSteps(ctx context.Context, mission *aiv1alpha1.Mission, from, to string) (int, error) {
+	chains := &aiv1alpha1.ChainList{}
+	if err := r.List(ctx, chains, client.InNamespace(workNamespace(mission)), client.MatchingLabels(missionLabels(mission))); err != nil {
+		return 0, fmt.Errorf("list mission chains: %w", err)
+	}
+
+	moved := 0
+	for i := range chains.Items {
+		chain := &chains.Items[i]
+		started := make(map[string]bool, len(chain.Status.StepStatuses))
+		for _, ss := range chain.Status.StepStatuses {
+			if ss.Phase != "" && ss.Phase != aiv1alpha1.ChainStepPhasePending {
+				started[ss.Name] = true
+			}
+		}
+		n := 0
+		for _, steps := range [][]aiv1alpha1.ChainStep{chain.Spec.Steps, chain.Spec.Finally} {
+			for j := range steps {
+				if steps[j].KnightRef == from && !started[steps[j].Name] {
+					steps[j].KnightRef = to
+					n++
+				}
+			}
+		}
+		if n == 0 {
+			continue
+		}
+		if err := r.Update(ctx, chain); err != nil {
+			return moved, fmt.Errorf("update chain %s: %w", chain.Name, err)
+		}
+		moved += n
+	}
+	return moved, nil
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestMissionKnightHealthReroute(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default", Generation: 1},
+		Spec: aiv1alpha1.MissionSpec{
+			RoundTableRef: "fleet",
+			Knights:       []aiv1alpha1.MissionKnight{{Name: "galahad"}},
+			KnightHealth:  &aiv1alpha1.MissionKnightHealth{Action: aiv1alpha1.KnightHealthReroute},
+		},
+		Status: aiv1alpha1.MissionStatus{
+			Phase:          aiv1alpha1.MissionPhaseActive,
+			KnightStatuses: []aiv1alpha1.MissionKnightStatus{{Name: "galahad", Ready: true}},
+		},
+	}
+	knight := func(name string, ready bool) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       aiv1alpha1.KnightSpec{Domain: "security"},
+			Status:     aiv1alpha1.KnightStatus{Ready: ready},
+		}
+	}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon-sweep", Namespace: "default", Labels: missionLabels(mission)},
+		Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{
+			{Name: "scan", KnightRef: "galahad", Task: "scan"},
+			{Name: "report", KnightRef: "galahad", Task: "report", DependsOn: []string{"scan"}},
+		}},
+		Status: aiv1alpha1.ChainStatus{StepStatuses: []aiv1alpha1.ChainStepStatus{
+			{Name: "scan", Phase: aiv1alpha1.ChainStepPhaseRunning},
+			{Name: "report", Phase: aiv1alpha1.ChainStepPhasePending},
+		}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mission, knight("galahad", false), knight("percival", true), knight("bors", true), chain).
+		WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionReconciler{Client: c, Recorder: recorder}
+
+	r.updateKnightStatuses(ctx, mission)
+	r.checkKnightHealth(ctx, mission)
+
+	cond := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionKnightDegraded)
+	if cond == nil || cond.Status != metav1.ConditionTrue || !strings.Contains(cond.Message, "galahad") {
+		t.Errorf("KnightDegraded condition = %+v, want True naming galahad", cond)
+	}
+	ks := mission.Status.KnightStatuses[0]
+	if ks.NotReadySince == nil || ks.ReplacedBy != "bors" {
+		t.Errorf("knight status = %+v, want not ready and replaced by bors", ks)
+	}
+	got := &aiv1alpha1.Chain{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "recon-sweep", Namespace: "default"}, got); err != nil {
+		t.Fatalf("get chain: %v", err)
+	}
+	if got.Spec.Steps[0].KnightRef != "galahad" || got.Spec.Steps[1].KnightRef != "bors" {
+		t.Errorf("step knights = %s, %s, want the running step kept and the pending one rerouted",
+			got.Spec.Steps[0].KnightRef, got.Spec.Steps[1].KnightRef)
+	}
+	events := strings.Join(drainEvents(recorder), "\n")
+	if !strings.Contains(events, "KnightDegraded") || !strings.Contains(events, "KnightReplaced") {
+		t.Errorf("events = %v, want KnightDegraded and KnightReplaced", events)
+	}
+
+	// The replaced knight no longer degrades the mission.
+	r.checkKnightHealth(ctx, mission)
+	cond = meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionKnightDegraded)
+	if cond == nil || cond.Status != metav1.ConditionFalse {
+		t.Errorf("KnightDegraded condition = %+v, want False once rerouted", cond)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "KnightsRecovered") {
+		t.Errorf("events = %v, want KnightsRecovered", events)
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// defaultTaskCostUSD estimates a task on a model the RoundTable's
+// modelTaskCostUSD does not price.
+const defaultTaskCostUSD = 0.05
+
+// taskCostUSD returns the estimated cost of one task on model.
+func taskCostUSD(rt *aiv1alpha1.RoundTable, model string) float64 {
+	if rt != nil && rt.Spec.Policies != nil {
+		if cost, ok := rt.Spec.Policies.ModelTaskCostUSD[model]; ok {
+			return parseCostUSD(cost)
+		}
+	}
+	return defaultTaskCostUSD
+}
+
+// reconcileDryRun records what a dry-run mission would do in status.plan.
+// The mission stays Pending; the plan is made once per generation.
+func (r *MissionReconciler) reconcileDryRun(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
+	if mission.Status.Plan != nil && mission.Status.Plan.ObservedGeneration == mission.Generation {
+		return ctrl.Result{}, nil
+	}
+
+	plan, err := r.planMission(ctx, mission)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	mission.Status.Plan = plan
+
+	cond := metav1.Condition{
+		Type:               aiv1alpha1.ConditionMissionPlanned,
+		Status:             metav1.ConditionTrue,
+		Reason:             aiv1alpha1.ReasonPlanReady,
+		Message:            fmt.Sprintf("Dry run: %d knights, %d chains, estimated $%s", len(plan.Knights), len(plan.Chains), plan.EstimatedCostUSD),
+		ObservedGeneration: mission.Generation,
+	}
+	if len(plan.Warnings) > 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = aiv1alpha1.ReasonPlanHasWarnings
+		cond.Message = fmt.Sprintf("%s; %d warnings to review", cond.Message, len(plan.Warnings))
+	}
+	meta.SetStatusCondition(&mission.Status.Conditions, cond)
+	mission.Status.ObservedGeneration = mission.Generation
+	if err := r.Status().Update(ctx, mission); err != nil {
+		if apierrors.IsConflict(err) {
+			return ctrl.Result{Requeue: true}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	r.Recorder.Event(mission, corev1.EventTypeNormal, "MissionPlanned", cond.Message)
+	return ctrl.Result{}, nil
+}
+
+// planMission resolves the mission's knights and models, checks its chains
+// as the mission would run them, and estimates their cost at one task per
+// knight step. Problems are reported as plan warnings, not errors, so a
+// reviewer sees all of them at once.
+func (r *MissionReconciler) planMission(ctx context.Context, mission *aiv1alpha1.Mission) (*aiv1alpha1.MissionPlan, error) {
+	plan := &aiv1alpha1.MissionPlan{ObservedGeneration: mission.Generation}
+	warn := func(format string, args ...interface{}) {
+		plan.Warnings = append(plan.Warnings, fmt.Sprintf(format, args...))
+	}
+
+	var rt *aiv1alpha1.RoundTable
+	if mission.Spec.RoundTableRef != "" {
+		rt = &aiv1alpha1.RoundTable{}
+		if err := r.Get(ctx, types.NamespacedName{Name: mission.Spec.RoundTableRef, Namespace: mission.Namespace}, rt); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+			warn("RoundTable %s not found", mission.Spec.RoundTableRef)
+			rt = nil
+		}
+	}
+	defaultModel := ""
+	if rt != nil && rt.Spec.Defaults != nil {
+		defaultModel = rt.Spec.Defaults.Model
+	}
+
+	if mission.Spec.MetaMission {
+		warn("Meta-mission: the planner chooses knights and chains when the mission runs; they are not estimated")
+	}
+
+	// Chain steps name knights by their Knight CR name.
+	models := map[string]string{}
+	for _, mk := range mission.Spec.Knights {
+		model, err := r.planKnightModel(ctx, mission, mk, rt)
+		if err != nil {
+			warn("Knight %s: %v", mk.Name, err)
+		}
+		if model == "" {
+			model = defaultModel
+		}
+		plan.Knights = append(plan.Knights, aiv1alpha1.MissionPlanKnight{Name: mk.Name, Ephemeral: mk.Ephemeral, Model: model})
+		models[missionKnightName(mission, mk)] = model
+	}
+
+	var total float64
+	for _, chainRef := range mission.Spec.Chains {
+		pc := aiv1alpha1.MissionPlanChain{Name: chainRef.Name, Phase: missionChainPhase(chainRef)}
+		cost, tasks := r.planChain(ctx, mission, chainRef, models, defaultModel, rt, warn)
+		pc.Tasks = tasks
+		pc.EstimatedCostUSD = formatCostUSD(cost)
+		plan.Chains = append(plan.Chains, pc)
+		total += cost
+	}
+	plan.EstimatedCostUSD = formatCostUSD(total)
+
+	if budgetUSD := missionCostBudget(mission, rt); parseCostUSD(budgetUSD) > 0 && total > parseCostUSD(budgetUSD) {
+		warn("Estimated cost $%s exceeds the mission budget of $%s", plan.EstimatedCostUSD, budgetUSD)
+	}
+	return plan, nil
+}
+
+// planKnightModel returns the model a mission knight would run, from its
+// ephemeral spec or template, or from the existing knight it recruits.
+func (r *MissionReconciler) planKnightModel(ctx context.Context, mission *aiv1alpha1.Mission, mk aiv1alpha1.MissionKnight, rt *aiv1alpha1.RoundTable) (string, error) {
+	if !mk.Ephemeral {
+		knight := &aiv1alpha1.Knight{}
+		if err := r.Get(ctx, types.NamespacedName{Name: mk.Name, Namespace: mission.Namespace}, knight); err != nil {
+			if apierrors.IsNotFound(err) {
+				return "", fmt.Errorf("recruited knight not found")
+			}
+			return "", err
+		}
+		return knight.Spec.Model, nil
+	}
+	if mk.SpecOverrides != nil && mk.SpecOverrides.Model != "" {
+		return mk.SpecOverrides.Model, nil
+	}
+	if mk.EphemeralSpec != nil {
+		return mk.EphemeralSpec.Model, nil
+	}
+	for _, tmpl := range mission.Spec.KnightTemplates {
+		if tmpl.Name == mk.TemplateRef {
+			return tmpl.Spec.Model, nil
+		}
+	}
+	if rt != nil {
+		if spec, ok := rt.Spec.KnightTemplates[mk.TemplateRef]; ok {
+			return spec.Model, nil
+		}
+	}
+	return "", fmt.Errorf("template %s not found", mk.TemplateRef)
+}
+
+// planChain checks the mission's copy of a chain and estimates its cost.
+// Steps run by knights count as one task each; approval, input and http
+// steps are free, and onFailure handlers are left out since they only run
+// when the chain fails.
+func (r *MissionReconciler) planChain(ctx context.Context, mission *aiv1alpha1.Mission, chainRef aiv1alpha1.MissionChainRef,
+	models map[string]string, defaultModel string, rt *aiv1alpha1.RoundTable, warn func(string, ...interface{})) (float64, int32) {
+	source := &aiv1alpha1.Chain{}
+	if err := r.Get(ctx, types.NamespacedName{Name: chainRef.Name, Namespace: mission.Namespace}, source); err != nil {
+		warn("Chain %s: %v", chainRef.Name, err)
+		return 0, 0
+	}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: source.Name, Namespace: source.Namespace},
+		Spec:       missionChainSpec(mission, chainRef, source),
+	}
+	if err := (&ChainReconciler{Client: r.Client}).expandChainTemplate(ctx, chain); err != nil {
+		warn("Chain %s: %v", chainRef.Name, err)
+		return 0, 0
+	}
+	if err := ValidateChainSpec(chain); err != nil {
+		warn("Chain %s: %v", chainRef.Name, err)
+	}
+
+	var cost float64
+	var tasks int32
+	for _, step := range append(append([]aiv1alpha1.ChainStep{}, chain.Spec.Steps...), chain.Spec.Finally...) {
+		if (step.Type != "" && step.Type != aiv1alpha1.ChainStepTypeTask) || isHTTPStep(&step) {
+			continue
+		}
+		model := defaultModel
+		if step.KnightRef != "" {
+			m, ok := models[step.KnightRef]
+			if !ok {
+				knight := &aiv1alpha1.Knight{}
+				if err := r.Get(ctx, types.NamespacedName{Name: step.KnightRef, Namespace: mission.Namespace}, knight); err != nil {
+					warn("Chain %s step %s: knight %s not found", chainRef.Name, step.Name, step.KnightRef)
+				}
+				m = knight.Spec.Model
+			}
+			if m != "" {
+				model = m
+			}
+		}
+		if step.Model != "" {
+			model = step.Model
+		}
+		cost += taskCostUSD(rt, model)
+		tasks++
+	}
+	return cost, tasks
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	missionpkg "github.com/dapperdivers/roundtable/internal/mission"
+	"github.com/dapperdivers/roundtable/internal/status"
+)
+
+// reviewPlan holds a planned meta-mission with spec.planner.requireApproval
+// until a human decides on its generated plan through the plan approval
+// annotation. It returns handled=false once the plan is approved (or needs
+// no review) so the planner moves the mission on to Assembling.
+func (r *MissionReconciler) reviewPlan(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool, error) {
+	if !meta.IsStatusConditionTrue(mission.Status.Conditions, "PlanApplied") || !missionpkg.PlanApprovalPending(mission) {
+		return ctrl.Result{}, false, nil
+	}
+
+	// Decisions are only honored once the plan is waiting, so one left
+	// over from an earlier run can't approve a plan nobody reviewed.
+	if meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionPlanApproved) == nil {
+		pr := mission.Status.PlanningResult
+		msg := fmt.Sprintf("Generated plan is waiting for review (annotate %s=%s|%s)",
+			aiv1alpha1.AnnotationPlanApproval, aiv1alpha1.ApprovalApprove, aiv1alpha1.ApprovalReject)
+		if pr != nil {
+			msg = fmt.Sprintf("Generated plan (%d chains, %d knights) is waiting for review (annotate %s=%s|%s)",
+				pr.ChainsGenerated, pr.KnightsGenerated,
+				aiv1alpha1.AnnotationPlanApproval, aiv1alpha1.ApprovalApprove, aiv1alpha1.ApprovalReject)
+		}
+		meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionPlanApproved,
+			Status:             metav1.ConditionUnknown,
+			Reason:             aiv1alpha1.ReasonPlanAwaitingApproval,
+			Message:            msg,
+			ObservedGeneration: mission.Generation,
+		})
+		if err := r.Status().Update(ctx, mission); err != nil {
+			return ctrl.Result{}, true, err
+		}
+		r.Recorder.Event(mission, corev1.EventTypeNormal, "PlanApprovalRequested", msg)
+		r.clearPlanApproval(ctx, mission)
+		return ctrl.Result{}, true, nil
+	}
+
+	switch decision := strings.ToLower(strings.TrimSpace(mission.Annotations[aiv1alpha1.AnnotationPlanApproval])); decision {
+	case "":
+		// The annotation update requeues the mission.
+		return ctrl.Result{}, true, nil
+	case aiv1alpha1.ApprovalApprove:
+		meta.SetStatusCondition(&mission.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionPlanApproved,
+			Status:             metav1.ConditionTrue,
+			Reason:             aiv1alpha1.ReasonPlanApproved,
+			Message:            "Generated plan approved",
+			ObservedGeneration: mission.Generation,
+		})
+		if err := r.Status().Update(ctx, mission); err != nil {
+			return ctrl.Result{}, true, err
+		}
+		r.Recorder.Event(mission, corev1.EventTypeNormal, "PlanApproved", "Generated plan approved")
+		r.clearPlanApproval(ctx, mission)
+		return ctrl.Result{}, false, nil
+	case aiv1alpha1.ApprovalReject:
+		err := status.ForMission(mission).
+			Failed("Generated plan rejected by reviewer").
+			Condition(aiv1alpha1.ConditionPlanApproved, aiv1alpha1.ReasonPlanRejected, "Generated plan rejected", metav1.ConditionFalse).
+			Apply(ctx, r.Client)
+		if err != nil {
+			return ctrl.Result{}, true, err
+		}
+		r.Recorder.Event(mission, corev1.EventTypeWarning, "PlanRejected", "Generated plan rejected")
+		r.recordPhaseTransition(mission)
+		r.clearPlanApproval(ctx, mission)
+		return ctrl.Result{}, true, nil
+	default:
+		r.Recorder.Eventf(mission, corev1.EventTypeWarning, "InvalidApproval",
+			"Ignoring plan approval value %q (expected %s or %s)",
+			decision, aiv1alpha1.ApprovalApprove, aiv1alpha1.ApprovalReject)
+		r.clearPlanApproval(ctx, mission)
+		return ctrl.Result{}, true, nil
+	}
+}
+
+// clearPlanApproval removes the plan approval annotation. It runs after the
+// status update so a failed write cannot lose a decision; failures are only
+// logged.
+func (r *MissionReconciler) clearPlanApproval(ctx context.Context, mission *aiv1alpha1.Mission) {
+	if _, ok := mission.Annotations[aiv1alpha1.AnnotationPlanApproval]; !ok {
+		return
+	}
+	patch := client.MergeFrom(mission.DeepCopy())
+	delete(mission.Annotations, aiv1alpha1.AnnotationPlanApproval)
+	if err := r.Patch(ctx, mission, patch); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to clear plan approval annotation")
+	}
+}
@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestReviewPlan(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	planned := func(name string) *aiv1alpha1.Mission {
+		return &aiv1alpha1.Mission{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: aiv1alpha1.MissionSpec{
+				MetaMission: true,
+				Planner:     &aiv1alpha1.MissionPlanner{KnightRef: "merlin", RequireApproval: true},
+			},
+			Status: aiv1alpha1.MissionStatus{
+				Phase:          aiv1alpha1.MissionPhasePlanning,
+				PlanningResult: &aiv1alpha1.PlanningResult{ChainsGenerated: 2, KnightsGenerated: 1},
+				Conditions: []metav1.Condition{{
+					Type: "PlanApplied", Status: metav1.ConditionTrue, Reason: "PlanningComplete",
+				}},
+			},
+		}
+	}
+	approved, rejected := planned("approved"), planned("rejected")
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(approved, rejected).
+		WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionReconciler{Client: c, Recorder: recorder}
+
+	decide := func(mission *aiv1alpha1.Mission, decision string) {
+		t.Helper()
+		if err := c.Get(ctx, types.NamespacedName{Name: mission.Name, Namespace: "default"}, mission); err != nil {
+			t.Fatalf("get mission: %v", err)
+		}
+		mission.Annotations = map[string]string{aiv1alpha1.AnnotationPlanApproval: decision}
+		if err := c.Update(ctx, mission); err != nil {
+			t.Fatalf("annotate mission: %v", err)
+		}
+	}
+
+	if _, handled, err := r.reviewPlan(ctx, approved); err != nil || !handled {
+		t.Fatalf("reviewPlan() = %t, %v, want the plan held for review", handled, err)
+	}
+	cond := meta.FindStatusCondition(approved.Status.Conditions, aiv1alpha1.ConditionPlanApproved)
+	if cond == nil || cond.Status != metav1.ConditionUnknown || !strings.Contains(cond.Message, "2 chains, 1 knights") {
+		t.Fatalf("PlanApproved condition = %+v, want Unknown awaiting review", cond)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "PlanApprovalRequested") {
+		t.Errorf("events = %v, want PlanApprovalRequested", events)
+	}
+
+	decide(approved, "Approve")
+	if _, handled, err := r.reviewPlan(ctx, approved); err != nil || handled {
+		t.Fatalf("reviewPlan() = %t, %v, want the planner to continue", handled, err)
+	}
+	if !meta.IsStatusConditionTrue(approved.Status.Conditions, aiv1alpha1.ConditionPlanApproved) {
+		t.Error("PlanApproved condition not True after approval")
+	}
+	if _, ok := approved.Annotations[aiv1alpha1.AnnotationPlanApproval]; ok {
+		t.Error("plan approval annotation not cleared")
+	}
+
+	if _, _, err := r.reviewPlan(ctx, rejected); err != nil {
+		t.Fatalf("reviewPlan() error = %v", err)
+	}
+	decide(rejected, "reject")
+	if _, handled, err := r.reviewPlan(ctx, rejected); err != nil || !handled {
+		t.Fatalf("reviewPlan() = %t, %v, want the mission failed", handled, err)
+	}
+	if rejected.Status.Phase != aiv1alpha1.MissionPhaseFailed {
+		t.Errorf("phase = %s, want Failed", rejected.Status.Phase)
+	}
+}
@@ -0,0 +1,98 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestMissionDryRun(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default", Generation: 1},
+		Spec: aiv1alpha1.MissionSpec{
+			RoundTableRef: "fleet",
+			DryRun:        true,
+			CostBudgetUSD: "0.10",
+			Knights: []aiv1alpha1.MissionKnight{
+				{Name: "scout", Ephemeral: true, EphemeralSpec: &aiv1alpha1.KnightSpec{Model: "opus"}},
+				{Name: "galahad"},
+			},
+			Chains: []aiv1alpha1.MissionChainRef{{Name: "sweep", Phase: "Active"}},
+		},
+		Status: aiv1alpha1.MissionStatus{Phase: aiv1alpha1.MissionPhasePending},
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{Policies: &aiv1alpha1.RoundTablePolicies{
+			ModelTaskCostUSD: map[string]string{"opus": "0.20", "haiku": "0.01"},
+		}},
+	}
+	galahad := &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{Name: "galahad", Namespace: "default"},
+		Spec:       aiv1alpha1.KnightSpec{Model: "haiku"},
+	}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "sweep", Namespace: "default"},
+		Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{
+			{Name: "scan", KnightRef: "recon-scout", Task: "scan"},
+			{Name: "triage", KnightRef: "galahad", Task: "triage", DependsOn: []string{"scan"}},
+			{Name: "signoff", Type: aiv1alpha1.ChainStepTypeApproval, DependsOn: []string{"triage"}},
+			{Name: "report", KnightRef: "ghost", Task: "report", DependsOn: []string{"signoff"}},
+		}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mission, rt, galahad, chain).
+		WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionReconciler{Client: c, Recorder: recorder}
+
+	if _, err := r.reconcilePending(ctx, mission); err != nil {
+		t.Fatalf("reconcilePending() error = %v", err)
+	}
+	if mission.Status.Phase != aiv1alpha1.MissionPhasePending {
+		t.Errorf("phase = %s, want a dry run to stay Pending", mission.Status.Phase)
+	}
+	plan := mission.Status.Plan
+	if plan == nil {
+		t.Fatal("status.plan not set")
+	}
+	if len(plan.Knights) != 2 || plan.Knights[0].Model != "opus" || plan.Knights[1].Model != "haiku" {
+		t.Errorf("plan knights = %+v, want scout on opus and galahad on haiku", plan.Knights)
+	}
+	// scan on opus, triage on haiku, report on an unknown knight at the
+	// default price; the approval step is free.
+	if len(plan.Chains) != 1 || plan.Chains[0].Tasks != 3 || plan.EstimatedCostUSD != "0.2600" {
+		t.Errorf("plan chains = %+v, estimate %s, want 3 tasks for $0.2600", plan.Chains, plan.EstimatedCostUSD)
+	}
+	warnings := strings.Join(plan.Warnings, "\n")
+	if !strings.Contains(warnings, "knight ghost not found") || !strings.Contains(warnings, "exceeds the mission budget") {
+		t.Errorf("plan warnings = %v, want the unknown knight and the budget", plan.Warnings)
+	}
+	cond := meta.FindStatusCondition(mission.Status.Conditions, aiv1alpha1.ConditionMissionPlanned)
+	if cond == nil || cond.Reason != aiv1alpha1.ReasonPlanHasWarnings {
+		t.Errorf("Planned condition = %+v, want PlanHasWarnings", cond)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "MissionPlanned") {
+		t.Errorf("events = %v, want MissionPlanned", events)
+	}
+
+	if _, err := r.reconcilePending(ctx, mission); err != nil {
+		t.Fatalf("second reconcilePending() error = %v", err)
+	}
+	if events := drainEvents(recorder); len(events) != 0 {
+		t.Errorf("events = %v, want the plan made once per generation", events)
+	}
+}
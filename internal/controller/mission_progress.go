@@ -0,0 +1,45 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// updateMissionProgress recomputes the mission's progress summary from its
+// chain and knight statuses. Chains count once they succeed or fail;
+// chains not yet started count toward the total from the spec.
+func updateMissionProgress(mission *aiv1alpha1.Mission) {
+	var chainsDone int
+	for _, cs := range mission.Status.ChainStatuses {
+		if cs.Phase == aiv1alpha1.ChainPhaseSucceeded || cs.Phase == aiv1alpha1.ChainPhaseFailed {
+			chainsDone++
+		}
+	}
+	chainsTotal := max(len(mission.Spec.Chains), len(mission.Status.ChainStatuses))
+
+	var knightsReady int
+	for _, ks := range mission.Status.KnightStatuses {
+		if ks.Ready {
+			knightsReady++
+		}
+	}
+	mission.Status.Progress = fmt.Sprintf("%d/%d chains, %d/%d knights ready",
+		chainsDone, chainsTotal, knightsReady, len(mission.Status.KnightStatuses))
+}
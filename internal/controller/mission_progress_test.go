@@ -0,0 +1,30 @@
+package controller
+
+import (
+	"testing"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestUpdateMissionProgress(t *testing.T) {
+	mission := &aiv1alpha1.Mission{
+		Spec: aiv1alpha1.MissionSpec{Chains: []aiv1alpha1.MissionChainRef{
+			{Name: "setup", Phase: "Setup"}, {Name: "scan"}, {Name: "report"},
+		}},
+		Status: aiv1alpha1.MissionStatus{
+			ChainStatuses: []aiv1alpha1.MissionChainStatus{
+				{Name: "setup", Phase: aiv1alpha1.ChainPhaseSucceeded},
+				{Name: "scan", Phase: aiv1alpha1.ChainPhaseRunning},
+			},
+			KnightStatuses: []aiv1alpha1.MissionKnightStatus{
+				{Name: "galahad", Ready: true},
+				{Name: "percival"},
+			},
+		},
+	}
+
+	updateMissionProgress(mission)
+	if want := "1/3 chains, 1/2 knights ready"; mission.Status.Progress != want {
+		t.Errorf("progress = %q, want %q", mission.Status.Progress, want)
+	}
+}
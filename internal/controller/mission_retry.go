@@ -0,0 +1,105 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// missionChainStatus returns the mission's status entry for a chain
+// reference, or nil.
+func missionChainStatus(mission *aiv1alpha1.Mission, chainRefName string) *aiv1alpha1.MissionChainStatus {
+	for i := range mission.Status.ChainStatuses {
+		if mission.Status.ChainStatuses[i].Name == chainRefName {
+			return &mission.Status.ChainStatuses[i]
+		}
+	}
+	return nil
+}
+
+// chainRetryBackoff returns the delay before a chain's next re-run:
+// backoffSeconds, doubled for each earlier retry, up to maxBackoffSeconds
+// (a day when unset).
+func chainRetryBackoff(policy *aiv1alpha1.MissionChainRetryPolicy, retries int32) time.Duration {
+	delay := time.Duration(policy.BackoffSeconds) * time.Second
+	maxDelay := time.Duration(policy.MaxBackoffSeconds) * time.Second
+	if maxDelay <= 0 {
+		maxDelay = maxRetryBackoff
+	}
+	for i := int32(0); i < retries; i++ {
+		// Stop at the cap before the doubling can overflow.
+		if delay > maxDelay/2 {
+			delay = maxDelay
+			break
+		}
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// retryMissionChain re-runs a failed mission chain when the mission's
+// chainRetryPolicy allows another attempt. It reports whether the chain is
+// being retried, now or once its backoff has passed; false means the
+// failure is final.
+func (r *MissionReconciler) retryMissionChain(ctx context.Context, mission *aiv1alpha1.Mission, chainRefName string, chain *aiv1alpha1.Chain) (bool, error) {
+	policy := mission.Spec.ChainRetryPolicy
+	cs := missionChainStatus(mission, chainRefName)
+	if policy == nil || cs == nil || cs.Retries >= policy.MaxRetries {
+		return false, nil
+	}
+
+	// A chain failed without a completion time is retried straight away.
+	if failedAt := chain.Status.CompletedAt; failedAt != nil &&
+		time.Since(failedAt.Time) < chainRetryBackoff(policy, cs.Retries) {
+		return true, nil
+	}
+
+	var failedCost float64
+	for _, ss := range chain.Status.StepStatuses {
+		failedCost += parseCostUSD(ss.CostUSD)
+	}
+
+	// Clearing the step statuses starts a fresh run, as a manual trigger
+	// does.
+	now := metav1.Now()
+	chain.Status.Phase = aiv1alpha1.ChainPhaseRunning
+	chain.Status.StepStatuses = nil
+	chain.Status.StartedAt = &now
+	chain.Status.CompletedAt = nil
+	chain.Status.TimedOutAt = nil
+	if err := r.Status().Update(ctx, chain); err != nil {
+		return false, fmt.Errorf("failed to retry chain %s: %w", chain.Name, err)
+	}
+
+	cs.Retries++
+	cs.Phase = aiv1alpha1.ChainPhaseRunning
+	if failedCost > 0 {
+		cs.RetriedCostUSD = formatCostUSD(parseCostUSD(cs.RetriedCostUSD) + failedCost)
+	}
+	logf.FromContext(ctx).Info("Retrying failed mission chain", "chain", chain.Name, "retry", cs.Retries)
+	r.Recorder.Eventf(mission, corev1.EventTypeWarning, "ChainRetried",
+		"Chain %s failed, retrying (%d of %d)", chain.Name, cs.Retries, policy.MaxRetries)
+	return true, nil
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestRetryMissionChain(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	failedAt := metav1.NewTime(time.Now().Add(-45 * time.Second))
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "mission-quest-scan", Namespace: "default"},
+		Spec:       aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{{Name: "run", KnightRef: "galahad", Task: "scan"}}},
+		Status: aiv1alpha1.ChainStatus{
+			Phase:        aiv1alpha1.ChainPhaseFailed,
+			CompletedAt:  &failedAt,
+			StepStatuses: []aiv1alpha1.ChainStepStatus{{Name: "run", Phase: aiv1alpha1.ChainStepPhaseFailed, CostUSD: "0.25"}},
+		},
+	}
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "quest", Namespace: "default"},
+		Spec: aiv1alpha1.MissionSpec{
+			ChainRetryPolicy: &aiv1alpha1.MissionChainRetryPolicy{MaxRetries: 2, BackoffSeconds: 30},
+		},
+		Status: aiv1alpha1.MissionStatus{ChainStatuses: []aiv1alpha1.MissionChainStatus{
+			{Name: "scan", ChainCRName: "mission-quest-scan", Phase: aiv1alpha1.ChainPhaseFailed},
+		}},
+	}
+	r := &MissionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(chain).
+			WithStatusSubresource(&aiv1alpha1.Chain{}).Build(),
+		Recorder: record.NewFakeRecorder(10),
+	}
+	ctx := context.Background()
+
+	retrying, err := r.retryMissionChain(ctx, mission, "scan", chain)
+	if err != nil || !retrying {
+		t.Fatalf("retryMissionChain() = %t, %v, want a retry", retrying, err)
+	}
+	got := &aiv1alpha1.Chain{}
+	if err := r.Get(ctx, types.NamespacedName{Name: chain.Name, Namespace: "default"}, got); err != nil {
+		t.Fatalf("get chain: %v", err)
+	}
+	if got.Status.Phase != aiv1alpha1.ChainPhaseRunning || len(got.Status.StepStatuses) != 0 {
+		t.Errorf("chain = %s with %d step statuses, want a fresh run", got.Status.Phase, len(got.Status.StepStatuses))
+	}
+	cs := mission.Status.ChainStatuses[0]
+	if cs.Retries != 1 || cs.RetriedCostUSD != "0.2500" {
+		t.Errorf("chain status = %+v, want one retry costing 0.2500", cs)
+	}
+
+	// The second retry waits 60s after the failure.
+	got.Status.Phase = aiv1alpha1.ChainPhaseFailed
+	got.Status.CompletedAt = &failedAt
+	if retrying, _ := r.retryMissionChain(ctx, mission, "scan", got); !retrying || got.Status.Phase != aiv1alpha1.ChainPhaseFailed {
+		t.Errorf("retryMissionChain() during backoff = %t, chain %s, want to wait", retrying, got.Status.Phase)
+	}
+
+	mission.Status.ChainStatuses[0].Retries = 2
+	if retrying, _ := r.retryMissionChain(ctx, mission, "scan", got); retrying {
+		t.Error("retryMissionChain() retried past maxRetries")
+	}
+}
+
+func TestChainRetryBackoff(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  aiv1alpha1.MissionChainRetryPolicy
+		retries int32
+		want    time.Duration
+	}{
+		{name: "first retry", policy: aiv1alpha1.MissionChainRetryPolicy{BackoffSeconds: 30}, want: 30 * time.Second},
+		{name: "doubles per retry", policy: aiv1alpha1.MissionChainRetryPolicy{BackoffSeconds: 30}, retries: 3, want: 240 * time.Second},
+		{name: "capped by maxBackoffSeconds", policy: aiv1alpha1.MissionChainRetryPolicy{BackoffSeconds: 30, MaxBackoffSeconds: 100}, retries: 3, want: 100 * time.Second},
+		{name: "no cap stops at a day", policy: aiv1alpha1.MissionChainRetryPolicy{BackoffSeconds: 30}, retries: 80, want: 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := chainRetryBackoff(&tt.policy, tt.retries); got != tt.want {
+				t.Errorf("chainRetryBackoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/status"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// missionRoundTable returns the RoundTable the mission runs under: its
+// ephemeral RoundTable once provisioned, otherwise spec.roundTableRef. It
+// returns nil when the mission has neither.
+func (r *MissionReconciler) missionRoundTable(ctx context.Context, mission *aiv1alpha1.Mission) (*aiv1alpha1.RoundTable, error) {
+	key := types.NamespacedName{Name: mission.Status.RoundTableName, Namespace: workNamespace(mission)}
+	if key.Name == "" {
+		key = types.NamespacedName{Name: mission.Spec.RoundTableRef, Namespace: mission.Namespace}
+	}
+	if key.Name == "" {
+		return nil, nil
+	}
+	rt := &aiv1alpha1.RoundTable{}
+	if err := r.Get(ctx, key, rt); err != nil {
+		return nil, err
+	}
+	return rt, nil
+}
+
+// missionSubjectPrefix returns the subject prefix for tasks sent to the
+// mission's knights: spec.natsPrefix when set, otherwise the RoundTable's,
+// whose tasks stream covers it, otherwise the mission-scoped prefix.
+func missionSubjectPrefix(mission *aiv1alpha1.Mission, rt *aiv1alpha1.RoundTable) string {
+	if mission.Spec.NATSPrefix == "" && rt != nil && rt.Spec.NATS.SubjectPrefix != "" {
+		return rt.Spec.NATS.SubjectPrefix
+	}
+	return natsPrefix(mission)
+}
+
+// missionCostBudget returns the mission's cost budget: spec.costBudgetUSD
+// when set, otherwise the RoundTable's defaults.missionCostBudgetUSD.
+func missionCostBudget(mission *aiv1alpha1.Mission, rt *aiv1alpha1.RoundTable) string {
+	if mission.Spec.CostBudgetUSD != "" || rt == nil || rt.Spec.Defaults == nil {
+		return mission.Spec.CostBudgetUSD
+	}
+	return rt.Spec.Defaults.MissionCostBudgetUSD
+}
+
+// knightNATS returns the client and subject prefix for tasks sent to the
+// mission's knights, from the server and prefix of the RoundTable they
+// serve. A RoundTable that can't be read falls back to the shared client
+// and the mission-scoped prefix.
+func (r *MissionReconciler) knightNATS(ctx context.Context, mission *aiv1alpha1.Mission) (natspkg.Client, string, error) {
+	if r.NATS == nil {
+		return nil, "", fmt.Errorf("NATS provider not configured")
+	}
+	rt, err := r.missionRoundTable(ctx, mission)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to get mission RoundTable, using the shared NATS client")
+		rt = nil
+	}
+	var conn natspkg.Connection
+	if rt != nil {
+		if conn, err = fleetConnection(ctx, r.Client, rt); err != nil {
+			return nil, "", err
+		}
+	}
+	client, err := r.NATS.ClientForConnection(conn)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, missionSubjectPrefix(mission, rt), nil
+}
+
+// validateRoundTableRef fails an assembling mission whose spec.roundTableRef
+// names a RoundTable that doesn't exist. It returns handled=true when the
+// mission was failed or must be retried.
+func (r *MissionReconciler) validateRoundTableRef(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool, error) {
+	if mission.Spec.RoundTableRef == "" {
+		return ctrl.Result{}, false, nil
+	}
+	rt := &aiv1alpha1.RoundTable{}
+	err := r.Get(ctx, types.NamespacedName{Name: mission.Spec.RoundTableRef, Namespace: mission.Namespace}, rt)
+	if err == nil {
+		return ctrl.Result{}, false, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, true, fmt.Errorf("failed to get RoundTable %s: %w", mission.Spec.RoundTableRef, err)
+	}
+
+	msg := fmt.Sprintf("RoundTable %s not found", mission.Spec.RoundTableRef)
+	if err := status.ForMission(mission).Failed(msg).Apply(ctx, r.Client); err != nil {
+		return ctrl.Result{}, true, err
+	}
+	r.Recorder.Event(mission, corev1.EventTypeWarning, "RoundTableNotFound", msg)
+	r.recordPhaseTransition(mission)
+	return ctrl.Result{}, true, nil
+}
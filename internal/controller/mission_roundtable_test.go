@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestMissionInheritsRoundTable(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default"},
+		Spec:       aiv1alpha1.MissionSpec{RoundTableRef: "fleet"},
+		Status:     aiv1alpha1.MissionStatus{Phase: aiv1alpha1.MissionPhaseAssembling},
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{
+			NATS:     aiv1alpha1.RoundTableNATS{SubjectPrefix: "fleet-b"},
+			Defaults: &aiv1alpha1.RoundTableDefaults{MissionCostBudgetUSD: "2.50"},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mission, rt).
+		WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionReconciler{Client: c, Recorder: recorder}
+
+	got, err := r.missionRoundTable(ctx, mission)
+	if err != nil || got == nil || got.Name != "fleet" {
+		t.Fatalf("missionRoundTable() = %v, %v, want fleet", got, err)
+	}
+	if prefix := missionSubjectPrefix(mission, got); prefix != "fleet-b" {
+		t.Errorf("subject prefix = %q, want the RoundTable's", prefix)
+	}
+	if budget, over := missionBudgetExceeded(mission, got, 3); !over || budget != 2.5 {
+		t.Errorf("missionBudgetExceeded() = %v, %t, want the RoundTable's default 2.5", budget, over)
+	}
+
+	mission.Spec.NATSPrefix = "custom"
+	mission.Spec.CostBudgetUSD = "5"
+	if prefix := missionSubjectPrefix(mission, got); prefix != "custom" {
+		t.Errorf("subject prefix = %q, want spec.natsPrefix", prefix)
+	}
+	if _, over := missionBudgetExceeded(mission, got, 3); over {
+		t.Error("missionBudgetExceeded() = true, want spec.costBudgetUSD to win")
+	}
+
+	if _, handled, err := r.validateRoundTableRef(ctx, mission); err != nil || handled {
+		t.Fatalf("validateRoundTableRef() = %t, %v, want assembly to continue", handled, err)
+	}
+	mission.Spec.RoundTableRef = "ghost"
+	if _, handled, err := r.validateRoundTableRef(ctx, mission); err != nil || !handled {
+		t.Fatalf("validateRoundTableRef() = %t, %v, want the mission failed", handled, err)
+	}
+	if mission.Status.Phase != aiv1alpha1.MissionPhaseFailed {
+		t.Errorf("phase = %s, want Failed", mission.Status.Phase)
+	}
+	if events := drainEvents(recorder); len(events) == 0 || !strings.Contains(events[0], "RoundTableNotFound") {
+		t.Errorf("events = %v, want RoundTableNotFound", events)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	corev1 "k8s.io/api/core/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// missionStreamName returns the name of the stream capturing the mission's
+// own subjects. Stream names may not contain dots, so hyphens and dots in
+// the mission name become underscores. The UID suffix keeps same-named
+// missions in other namespaces, or a recreated mission, from sharing (and
+// deleting) one stream.
+func missionStreamName(mission *aiv1alpha1.Mission) string {
+	uid8 := string(mission.UID)[:8]
+	return fmt.Sprintf("mission_%s_%s", strings.NewReplacer("-", "_", ".", "_").Replace(mission.Name), uid8)
+}
+
+// ensureMissionStream creates the stream capturing <natsPrefix>.> so
+// anything published under the mission's prefix is retained, and records
+// it in status along with the mission's chat subject, which the stream
+// retains. Messages expire with the mission's TTL.
+//
+// Failure is not fatal: a fleet stream may already cover the prefix, in
+// which case the mission's subjects are bound there instead. A warning
+// event records the failure and provisioning continues.
+func (r *MissionReconciler) ensureMissionStream(ctx context.Context, mission *aiv1alpha1.Mission) {
+	if mission.Status.NATSMissionStream != "" {
+		return
+	}
+	client, err := r.natsClient()
+	if err != nil {
+		return // Gracefully skip if no NATS client
+	}
+
+	cfg := natspkg.StreamConfig{
+		Name:      missionStreamName(mission),
+		Subjects:  []string{natsPrefix(mission) + ".>"},
+		Retention: natspkg.RetentionLimits,
+		MaxAge:    time.Duration(mission.Spec.TTL) * time.Second,
+		Storage:   natspkg.StorageFile,
+	}
+	if err := client.CreateStream(cfg); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to create mission stream", "stream", cfg.Name, "subjects", cfg.Subjects)
+		r.Recorder.Eventf(mission, corev1.EventTypeWarning, "MissionStreamFailed",
+			"Failed to create stream %s for %s: %v", cfg.Name, cfg.Subjects[0], err)
+		return
+	}
+	mission.Status.NATSMissionStream = cfg.Name
+	mission.Status.ChatSubject = natspkg.ChatSubject(natsPrefix(mission))
+}
+
+// deleteStream deletes a stream, treating one that is already gone as
+// deleted.
+func deleteStream(client natspkg.Client, name string) error {
+	if name == "" {
+		return nil
+	}
+	if err := client.DeleteStream(name); err != nil && !errors.Is(err, nats.ErrStreamNotFound) {
+		return fmt.Errorf("failed to delete stream %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestMissionStreamLifecycle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "red-team", Namespace: "default", UID: "4f2a9c1e-77d0-4b1a-9a3e-1c2d3e4f5a6b", Finalizers: []string{missionFinalizer}},
+		Spec:       aiv1alpha1.MissionSpec{Objective: "Probe the perimeter", TTL: 3600, RoundTableRef: "fleet-a"},
+		Status:     aiv1alpha1.MissionStatus{Phase: aiv1alpha1.MissionPhaseProvisioning},
+	}
+	nc := newFakeNATSClient()
+	r := &MissionReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(mission).
+			WithStatusSubresource(&aiv1alpha1.Mission{}).Build(),
+		Recorder: record.NewFakeRecorder(10),
+		NATS:     natspkg.NewProviderWithClient(nc, logr.Discard()),
+	}
+	ctx := context.Background()
+
+	if _, err := r.reconcileProvisioning(ctx, mission); err != nil {
+		t.Fatalf("reconcileProvisioning() error = %v", err)
+	}
+	got := &aiv1alpha1.Mission{}
+	if err := r.Get(ctx, types.NamespacedName{Name: "red-team", Namespace: "default"}, got); err != nil {
+		t.Fatalf("get mission: %v", err)
+	}
+	if got.Status.NATSMissionStream != "mission_red_team_4f2a9c1e" {
+		t.Fatalf("natsMissionStream = %q, want mission_red_team_4f2a9c1e", got.Status.NATSMissionStream)
+	}
+	cfg, ok := nc.streams["mission_red_team_4f2a9c1e"]
+	if !ok || len(cfg.Subjects) != 1 || cfg.Subjects[0] != "mission-red-team.>" || cfg.MaxAge != time.Hour {
+		t.Fatalf("mission stream = %+v, want mission-red-team.> kept for the TTL", cfg)
+	}
+
+	if err := r.Delete(ctx, got); err != nil {
+		t.Fatalf("delete mission: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "red-team", Namespace: "default"}}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if len(nc.streams) != 0 {
+		t.Errorf("streams after deletion = %v, want the mission stream removed", nc.streams)
+	}
+
+	// A same-named mission elsewhere, or a recreated one, gets its own stream.
+	twin := got.DeepCopy()
+	twin.Namespace, twin.UID = "team-red", "9b8c7d6e-0000-4000-8000-000000000000"
+	if name := missionStreamName(twin); name == got.Status.NATSMissionStream {
+		t.Errorf("missionStreamName() for a same-named mission = %s, want a distinct stream", name)
+	}
+
+	// A stream that is already gone does not block cleanup.
+	if err := r.deleteNATSStreams(ctx, got); err != nil {
+		t.Errorf("deleteNATSStreams() for a deleted stream error = %v", err)
+	}
+}
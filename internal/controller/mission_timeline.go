@@ -0,0 +1,282 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+const (
+	// missionPhaseHistoryLimit caps status.phaseHistory; the oldest
+	// transitions are dropped first.
+	missionPhaseHistoryLimit = 32
+
+	// missionTimelineKey is the ConfigMap key holding an exported timeline.
+	missionTimelineKey = "timeline.json"
+)
+
+// Timeline entry types.
+const (
+	timelinePhaseChanged     = "PhaseChanged"
+	timelineConditionChanged = "ConditionChanged"
+	timelineChainStarted     = "ChainStarted"
+	timelineChainCompleted   = "ChainCompleted"
+	timelineStepDispatched   = "StepDispatched"
+	timelineStepCompleted    = "StepCompleted"
+)
+
+// missionTimeline is the exported timeline document.
+type missionTimeline struct {
+	Mission       string                         `json:"mission"`
+	Namespace     string                         `json:"namespace"`
+	Phase         aiv1alpha1.MissionPhase        `json:"phase"`
+	Result        string                         `json:"result,omitempty"`
+	StartedAt     *time.Time                     `json:"startedAt,omitempty"`
+	CompletedAt   *time.Time                     `json:"completedAt,omitempty"`
+	TotalCostUSD  string                         `json:"totalCostUSD,omitempty"`
+	CostBreakdown []aiv1alpha1.MissionKnightCost `json:"costBreakdown,omitempty"`
+	Entries       []timelineEntry                `json:"entries"`
+}
+
+// timelineEntry is one event in a mission timeline.
+type timelineEntry struct {
+	Time      time.Time `json:"time"`
+	Type      string    `json:"type"`
+	Phase     string    `json:"phase,omitempty"`
+	Condition string    `json:"condition,omitempty"`
+	Status    string    `json:"status,omitempty"`
+	Chain     string    `json:"chain,omitempty"`
+	Step      string    `json:"step,omitempty"`
+	Knight    string    `json:"knight,omitempty"`
+	TaskID    string    `json:"taskId,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	CostUSD   string    `json:"costUSD,omitempty"`
+}
+
+// reconcilePhaseHistory appends the mission's current phase to
+// status.phaseHistory when it differs from the last recorded one. Phase
+// changes are made all over the controller, so they are observed here on
+// the reconcile after, instead of at each change.
+func (r *MissionReconciler) reconcilePhaseHistory(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool) {
+	if mission.Spec.Timeline == nil {
+		return ctrl.Result{}, false
+	}
+	history := mission.Status.PhaseHistory
+	if len(history) > 0 && history[len(history)-1].Phase == mission.Status.Phase {
+		return ctrl.Result{}, false
+	}
+
+	at := metav1.Now()
+	if len(history) == 0 && mission.Status.Phase == aiv1alpha1.MissionPhasePending && mission.Status.StartedAt != nil {
+		at = *mission.Status.StartedAt
+	}
+	history = append(history, aiv1alpha1.MissionPhaseTransition{Phase: mission.Status.Phase, Time: at})
+	if len(history) > missionPhaseHistoryLimit {
+		history = history[len(history)-missionPhaseHistoryLimit:]
+	}
+	mission.Status.PhaseHistory = history
+	if err := r.Status().Update(ctx, mission); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to record mission phase history")
+	}
+	return ctrl.Result{RequeueAfter: RequeueFast}, true
+}
+
+// reconcileTimelineExport exports the timeline once the mission's outcome
+// is recorded, before cleanup deletes its chains. A failed export is
+// reported and retried on later reconciles, but never holds up the phase
+// machine.
+func (r *MissionReconciler) reconcileTimelineExport(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, bool) {
+	if mission.Spec.Timeline == nil || mission.Status.TimelineRef != "" ||
+		mission.Status.Phase == aiv1alpha1.MissionPhaseDebriefing ||
+		!meta.IsStatusConditionTrue(mission.Status.Conditions, aiv1alpha1.ConditionMissionComplete) {
+		return ctrl.Result{}, false
+	}
+
+	ref, err := r.exportTimeline(ctx, mission)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to export mission timeline")
+		r.Recorder.Eventf(mission, corev1.EventTypeWarning, "TimelineExportFailed", "Failed to export timeline: %v", err)
+		return ctrl.Result{}, false
+	}
+	mission.Status.TimelineRef = ref
+	if err := r.Status().Update(ctx, mission); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to record timeline reference")
+		return ctrl.Result{RequeueAfter: RequeueFast}, true
+	}
+	r.Recorder.Eventf(mission, corev1.EventTypeNormal, "TimelineExported", "Exported timeline to %s", ref)
+	return ctrl.Result{RequeueAfter: RequeueFast}, true
+}
+
+// exportTimeline writes the mission's timeline to its target and returns
+// where it went.
+func (r *MissionReconciler) exportTimeline(ctx context.Context, mission *aiv1alpha1.Mission) (string, error) {
+	timeline, err := r.buildTimeline(ctx, mission)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(timeline, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal timeline: %w", err)
+	}
+
+	if mission.Spec.Timeline.Target == aiv1alpha1.MissionTimelineObjectStore {
+		if r.Artifacts == nil {
+			return "", fmt.Errorf("no artifact object store configured")
+		}
+		return r.Artifacts.Put(fmt.Sprintf("missions/%s/%s/%s", mission.Namespace, mission.Name, missionTimelineKey), data)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mission.Name + "-timeline",
+			Namespace: mission.Namespace,
+			Labels:    map[string]string{aiv1alpha1.LabelMission: mission.Name},
+		},
+		Data: map[string]string{missionTimelineKey: string(data)},
+	}
+	if err := r.Create(ctx, cm); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return "", fmt.Errorf("create timeline ConfigMap: %w", err)
+		}
+		// Left by an earlier mission of the same name; replace its timeline.
+		// Any other ConfigMap of that name is someone else's.
+		existing := &corev1.ConfigMap{}
+		if err := r.Get(ctx, types.NamespacedName{Name: cm.Name, Namespace: cm.Namespace}, existing); err != nil {
+			return "", fmt.Errorf("get timeline ConfigMap: %w", err)
+		}
+		if existing.Labels[aiv1alpha1.LabelMission] != mission.Name {
+			return "", fmt.Errorf("ConfigMap %s/%s exists and was not exported by Mission %s", cm.Namespace, cm.Name, mission.Name)
+		}
+		existing.Labels = cm.Labels
+		existing.Data = cm.Data
+		if err := r.Update(ctx, existing); err != nil {
+			return "", fmt.Errorf("update timeline ConfigMap: %w", err)
+		}
+	}
+	return cm.Name, nil
+}
+
+// buildTimeline assembles the timeline from the mission's phase history and
+// conditions and its chains' run and step statuses, ordered by time.
+func (r *MissionReconciler) buildTimeline(ctx context.Context, mission *aiv1alpha1.Mission) (*missionTimeline, error) {
+	timeline := &missionTimeline{
+		Mission:       mission.Name,
+		Namespace:     mission.Namespace,
+		Phase:         terminalOutcome(mission),
+		Result:        mission.Status.Result,
+		TotalCostUSD:  mission.Status.TotalCost,
+		CostBreakdown: mission.Status.CostBreakdown,
+	}
+	if mission.Status.StartedAt != nil {
+		timeline.StartedAt = &mission.Status.StartedAt.Time
+	}
+	if mission.Status.CompletedAt != nil {
+		timeline.CompletedAt = &mission.Status.CompletedAt.Time
+	}
+
+	var entries []timelineEntry
+	for _, t := range mission.Status.PhaseHistory {
+		entries = append(entries, timelineEntry{Time: t.Time.Time, Type: timelinePhaseChanged, Phase: string(t.Phase)})
+	}
+	for _, c := range mission.Status.Conditions {
+		entries = append(entries, timelineEntry{
+			Time:      c.LastTransitionTime.Time,
+			Type:      timelineConditionChanged,
+			Condition: c.Type,
+			Status:    string(c.Status),
+			Reason:    c.Reason,
+			Message:   c.Message,
+		})
+	}
+
+	for _, cs := range mission.Status.ChainStatuses {
+		if cs.ChainCRName == "" {
+			continue
+		}
+		chain := &aiv1alpha1.Chain{}
+		if err := r.Get(ctx, types.NamespacedName{Name: cs.ChainCRName, Namespace: workNamespace(mission)}, chain); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("get chain %s: %w", cs.ChainCRName, err)
+		}
+		entries = append(entries, chainTimelineEntries(cs.Name, chain)...)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].Time.Before(entries[j].Time) })
+	timeline.Entries = entries
+	return timeline, nil
+}
+
+// chainTimelineEntries returns the timeline entries for a mission chain's
+// latest run and its steps.
+func chainTimelineEntries(name string, chain *aiv1alpha1.Chain) []timelineEntry {
+	var entries []timelineEntry
+	if chain.Status.StartedAt != nil {
+		entries = append(entries, timelineEntry{Time: chain.Status.StartedAt.Time, Type: timelineChainStarted, Chain: name})
+	}
+	for _, ss := range chain.Status.StepStatuses {
+		if ss.StartedAt != nil {
+			entries = append(entries, timelineEntry{
+				Time:   ss.StartedAt.Time,
+				Type:   timelineStepDispatched,
+				Chain:  name,
+				Step:   ss.Name,
+				Knight: ss.Knight,
+				TaskID: ss.TaskID,
+			})
+		}
+		if ss.CompletedAt != nil {
+			entries = append(entries, timelineEntry{
+				Time:    ss.CompletedAt.Time,
+				Type:    timelineStepCompleted,
+				Phase:   string(ss.Phase),
+				Chain:   name,
+				Step:    ss.Name,
+				Knight:  ss.Knight,
+				TaskID:  ss.TaskID,
+				Message: ss.Error,
+				CostUSD: ss.CostUSD,
+			})
+		}
+	}
+	if chain.Status.CompletedAt != nil {
+		entries = append(entries, timelineEntry{
+			Time:    chain.Status.CompletedAt.Time,
+			Type:    timelineChainCompleted,
+			Phase:   string(chain.Status.Phase),
+			Chain:   name,
+			CostUSD: chain.Status.CostUSD,
+		})
+	}
+	return entries
+}
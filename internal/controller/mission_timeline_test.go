@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestMissionTimelineExport(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	start := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	at := func(sec int) *metav1.Time {
+		t := metav1.NewTime(start.Add(time.Duration(sec) * time.Second))
+		return &t
+	}
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default"},
+		Spec: aiv1alpha1.MissionSpec{
+			RoundTableRef: "fleet",
+			Timeline:      &aiv1alpha1.MissionTimeline{Target: aiv1alpha1.MissionTimelineConfigMap},
+		},
+		Status: aiv1alpha1.MissionStatus{
+			Phase:         aiv1alpha1.MissionPhasePending,
+			StartedAt:     at(0),
+			TotalCost:     "0.0300",
+			ChainStatuses: []aiv1alpha1.MissionChainStatus{{Name: "sweep", ChainCRName: "recon-sweep"}},
+		},
+	}
+	chain := &aiv1alpha1.Chain{
+		ObjectMeta: metav1.ObjectMeta{Name: "recon-sweep", Namespace: "default"},
+		Status: aiv1alpha1.ChainStatus{
+			Phase:       aiv1alpha1.ChainPhaseSucceeded,
+			StartedAt:   at(10),
+			CompletedAt: at(40),
+			CostUSD:     "0.0300",
+			StepStatuses: []aiv1alpha1.ChainStepStatus{{
+				Name: "scan", Phase: aiv1alpha1.ChainStepPhaseSucceeded, Knight: "galahad",
+				StartedAt: at(11), CompletedAt: at(39), CostUSD: "0.0300",
+			}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(mission, chain).
+		WithStatusSubresource(&aiv1alpha1.Mission{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionReconciler{Client: c, Recorder: recorder}
+
+	if _, handled := r.reconcilePhaseHistory(ctx, mission); !handled {
+		t.Fatal("reconcilePhaseHistory() not handled, want Pending recorded")
+	}
+	if _, handled := r.reconcilePhaseHistory(ctx, mission); handled {
+		t.Error("reconcilePhaseHistory() handled twice for the same phase")
+	}
+	if _, handled := r.reconcileTimelineExport(ctx, mission); handled {
+		t.Error("reconcileTimelineExport() handled before the outcome is recorded")
+	}
+
+	mission.Status.Phase = aiv1alpha1.MissionPhaseSucceeded
+	mission.Status.CompletedAt = at(45)
+	mission.Status.Conditions = []metav1.Condition{{
+		Type: aiv1alpha1.ConditionMissionComplete, Status: metav1.ConditionTrue,
+		Reason: aiv1alpha1.ReasonMissionSucceeded, LastTransitionTime: *at(45),
+	}}
+	if _, handled := r.reconcileTimelineExport(ctx, mission); !handled {
+		t.Fatal("reconcileTimelineExport() not handled for a finished mission")
+	}
+	if mission.Status.TimelineRef != "recon-timeline" {
+		t.Fatalf("timelineRef = %q, want recon-timeline", mission.Status.TimelineRef)
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "recon-timeline", Namespace: "default"}, cm); err != nil {
+		t.Fatalf("get timeline ConfigMap: %v", err)
+	}
+	if len(cm.OwnerReferences) != 0 {
+		t.Errorf("owner references = %v, want the timeline to outlive the mission", cm.OwnerReferences)
+	}
+	var timeline missionTimeline
+	if err := json.Unmarshal([]byte(cm.Data[missionTimelineKey]), &timeline); err != nil {
+		t.Fatalf("unmarshal timeline: %v", err)
+	}
+	var kinds []string
+	for _, e := range timeline.Entries {
+		kinds = append(kinds, e.Type)
+	}
+	want := "PhaseChanged,ChainStarted,StepDispatched,StepCompleted,ChainCompleted,ConditionChanged"
+	if got := strings.Join(kinds, ","); got != want {
+		t.Errorf("entry types = %s, want %s", got, want)
+	}
+	if timeline.Phase != aiv1alpha1.MissionPhaseSucceeded || timeline.TotalCostUSD != "0.0300" {
+		t.Errorf("timeline = %s for $%s, want Succeeded for $0.0300", timeline.Phase, timeline.TotalCostUSD)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "TimelineExported") {
+		t.Errorf("events = %v, want TimelineExported", events)
+	}
+
+	// A later mission of the same name replaces its own timeline.
+	if ref, err := r.exportTimeline(ctx, mission); err != nil || ref != "recon-timeline" {
+		t.Errorf("exportTimeline() over an earlier timeline = %q, %v", ref, err)
+	}
+
+	// A ConfigMap of the same name the mission didn't export is left alone.
+	foreign := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "audit-timeline", Namespace: "default"},
+		Data:       map[string]string{"config": "keep"},
+	}
+	if err := c.Create(ctx, foreign); err != nil {
+		t.Fatalf("create ConfigMap: %v", err)
+	}
+	audit := mission.DeepCopy()
+	audit.Name = "audit"
+	audit.Status.TimelineRef = ""
+	if _, handled := r.reconcileTimelineExport(ctx, audit); handled {
+		t.Error("reconcileTimelineExport() handled over another ConfigMap")
+	}
+	if err := c.Get(ctx, types.NamespacedName{Name: "audit-timeline", Namespace: "default"}, foreign); err != nil {
+		t.Fatalf("get ConfigMap: %v", err)
+	}
+	if foreign.Data["config"] != "keep" || foreign.Data[missionTimelineKey] != "" {
+		t.Errorf("ConfigMap data = %v, want it untouched", foreign.Data)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "was not exported by Mission audit") {
+		t.Errorf("events = %v, want TimelineExportFailed", events)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// missionActiveResync is how long an Active mission waits for a chain event
+// before it is reconciled anyway, to refresh knight readiness.
+const missionActiveResync = RequeueVerySlow
+
+// chainMissionNamespace returns the namespace of the mission a chain
+// belongs to. A chain in a mission's isolation namespace records it in a
+// label; any other chain shares its mission's namespace.
+func chainMissionNamespace(chain client.Object) string {
+	if ns := chain.GetLabels()[aiv1alpha1.LabelMissionNamespace]; ns != "" {
+		return ns
+	}
+	return chain.GetNamespace()
+}
+
+// missionForChain maps a Chain to the mission it belongs to: the mission
+// label set on mission and planner-generated chains, then spec.missionRef,
+// then a Mission controller reference.
+func missionForChain(_ context.Context, obj client.Object) []reconcile.Request {
+	name := obj.GetLabels()[aiv1alpha1.LabelMission]
+	if chain, ok := obj.(*aiv1alpha1.Chain); ok && name == "" {
+		name = chain.Spec.MissionRef
+	}
+	if owner := metav1.GetControllerOf(obj); name == "" && owner != nil &&
+		owner.Kind == "Mission" && owner.APIVersion == aiv1alpha1.GroupVersion.String() {
+		name = owner.Name
+	}
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: chainMissionNamespace(obj), Name: name}}}
+}
+
+// missionChainProgressed passes Chain creates and deletes, and updates that
+// change the run's phase or a step's phase or cost — what mission chain
+// tracking and budget enforcement read.
+func missionChainProgressed() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldChain, ok := e.ObjectOld.(*aiv1alpha1.Chain)
+			if !ok {
+				return false
+			}
+			newChain, ok := e.ObjectNew.(*aiv1alpha1.Chain)
+			if !ok {
+				return false
+			}
+			if oldChain.Status.Phase != newChain.Status.Phase ||
+				len(oldChain.Status.StepStatuses) != len(newChain.Status.StepStatuses) {
+				return true
+			}
+			for i, ss := range newChain.Status.StepStatuses {
+				old := oldChain.Status.StepStatuses[i]
+				if old.Phase != ss.Phase || old.CostUSD != ss.CostUSD {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
+
+// activeRequeue returns when an Active mission must be reconciled without a
+// chain event: at its timeout or TTL, while a failed chain waits out its
+// retry backoff, and otherwise after missionActiveResync.
+func activeRequeue(mission *aiv1alpha1.Mission) time.Duration {
+	requeue := missionActiveResync
+	deadlines := []time.Time{}
+	if mission.Status.StartedAt != nil && mission.Spec.Timeout > 0 {
+		deadlines = append(deadlines, mission.Status.StartedAt.Add(missionTimeout(mission)))
+	}
+	if mission.Status.ExpiresAt != nil {
+		deadlines = append(deadlines, mission.Status.ExpiresAt.Time)
+	}
+	for _, deadline := range deadlines {
+		if left := time.Until(deadline) + time.Second; left < requeue {
+			requeue = max(left, RequeueFast)
+		}
+	}
+	for _, cs := range mission.Status.ChainStatuses {
+		if cs.Phase == aiv1alpha1.ChainPhaseFailed && requeue > RequeueDefault {
+			requeue = RequeueDefault
+		}
+	}
+	return requeue
+}
@@ -0,0 +1,97 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestMissionForChain(t *testing.T) {
+	tests := []struct {
+		name  string
+		chain *aiv1alpha1.Chain
+		want  string
+	}{
+		{name: "label", chain: &aiv1alpha1.Chain{ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{aiv1alpha1.LabelMission: "quest"},
+		}}, want: "quest"},
+		{name: "missionRef", chain: &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{MissionRef: "quest"}}, want: "quest"},
+		{name: "owner", chain: &aiv1alpha1.Chain{ObjectMeta: metav1.ObjectMeta{
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: aiv1alpha1.GroupVersion.String(), Kind: "Mission", Name: "quest", Controller: ptr.To(true),
+			}},
+		}}, want: "quest"},
+		{name: "not a mission chain", chain: &aiv1alpha1.Chain{ObjectMeta: metav1.ObjectMeta{
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: aiv1alpha1.GroupVersion.String(), Kind: "RoundTable", Name: "fleet", Controller: ptr.To(true),
+			}},
+		}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.chain.Namespace = "default"
+			requests := missionForChain(context.Background(), tt.chain)
+			if tt.want == "" {
+				if len(requests) != 0 {
+					t.Errorf("missionForChain() = %v, want none", requests)
+				}
+				return
+			}
+			if len(requests) != 1 || requests[0].Name != tt.want || requests[0].Namespace != "default" {
+				t.Errorf("missionForChain() = %v, want default/%s", requests, tt.want)
+			}
+		})
+	}
+}
+
+func TestMissionChainProgressed(t *testing.T) {
+	running := aiv1alpha1.ChainStatus{Phase: aiv1alpha1.ChainPhaseRunning, StepStatuses: []aiv1alpha1.ChainStepStatus{
+		{Name: "scan", Phase: aiv1alpha1.ChainStepPhaseRunning},
+	}}
+	tests := []struct {
+		name   string
+		update func(*aiv1alpha1.ChainStatus)
+		want   bool
+	}{
+		{name: "no change", update: func(*aiv1alpha1.ChainStatus) {}},
+		{name: "chain phase", update: func(s *aiv1alpha1.ChainStatus) { s.Phase = aiv1alpha1.ChainPhaseSucceeded }, want: true},
+		{name: "step phase", update: func(s *aiv1alpha1.ChainStatus) { s.StepStatuses[0].Phase = aiv1alpha1.ChainStepPhaseSucceeded }, want: true},
+		{name: "step cost", update: func(s *aiv1alpha1.ChainStatus) { s.StepStatuses[0].CostUSD = "0.0100" }, want: true},
+		{name: "step output", update: func(s *aiv1alpha1.ChainStatus) { s.StepStatuses[0].Output = "partial" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldChain := &aiv1alpha1.Chain{Status: *running.DeepCopy()}
+			newChain := oldChain.DeepCopy()
+			tt.update(&newChain.Status)
+			if got := missionChainProgressed().Update(event.UpdateEvent{ObjectOld: oldChain, ObjectNew: newChain}); got != tt.want {
+				t.Errorf("Update() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestActiveRequeue(t *testing.T) {
+	started := metav1.NewTime(time.Now().Add(-100 * time.Second))
+	mission := &aiv1alpha1.Mission{Spec: aiv1alpha1.MissionSpec{Timeout: 3600}, Status: aiv1alpha1.MissionStatus{StartedAt: &started}}
+	if got := activeRequeue(mission); got != missionActiveResync {
+		t.Errorf("activeRequeue() = %s, want the resync interval", got)
+	}
+
+	mission.Spec.Timeout = 120
+	if got := activeRequeue(mission); got > 25*time.Second || got < 15*time.Second {
+		t.Errorf("activeRequeue() = %s, want the time left before the timeout", got)
+	}
+
+	mission.Spec.Timeout = 3600
+	mission.Status.ChainStatuses = []aiv1alpha1.MissionChainStatus{{Name: "recon", Phase: aiv1alpha1.ChainPhaseFailed}}
+	if got := activeRequeue(mission); got != RequeueDefault {
+		t.Errorf("activeRequeue() = %s, want %s while a chain awaits retry", got, RequeueDefault)
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// MissionRequestReconciler expands MissionRequests into Missions.
+type MissionRequestReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// errRequestRejected is a MissionRequest the operator will not expand: the
+// reason and message go on its Accepted condition.
+type errRequestRejected struct {
+	reason  string
+	message string
+}
+
+func (e *errRequestRejected) Error() string { return e.message }
+
+// +kubebuilder:rbac:groups=ai.roundtable.io,resources=missionrequests,verbs=get;list;watch
+// +kubebuilder:rbac:groups=ai.roundtable.io,resources=missionrequests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ai.roundtable.io,resources=missions,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+func (r *MissionRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	request := &aiv1alpha1.MissionRequest{}
+	if err := r.Get(ctx, req.NamespacedName, request); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if request.Status.MissionName == "" {
+		if cond := meta.FindStatusCondition(request.Status.Conditions, aiv1alpha1.ConditionMissionRequestAccepted); cond != nil &&
+			cond.Status == metav1.ConditionFalse && cond.ObservedGeneration == request.Generation {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, r.expandRequest(ctx, request)
+	}
+
+	// Mirror the created mission's phase.
+	mission := &aiv1alpha1.Mission{}
+	if err := r.Get(ctx, types.NamespacedName{Name: request.Status.MissionName, Namespace: request.Namespace}, mission); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if request.Status.MissionPhase == mission.Status.Phase {
+		return ctrl.Result{}, nil
+	}
+	request.Status.MissionPhase = mission.Status.Phase
+	return ctrl.Result{}, r.Status().Update(ctx, request)
+}
+
+// expandRequest creates the request's Mission, or records why it can't.
+func (r *MissionRequestReconciler) expandRequest(ctx context.Context, request *aiv1alpha1.MissionRequest) error {
+	mission, err := r.requestedMission(ctx, request)
+	if err == nil {
+		err = r.Create(ctx, mission)
+		if apierrors.IsAlreadyExists(err) {
+			err = r.adoptExisting(ctx, request, mission)
+		}
+	}
+
+	var rejected *errRequestRejected
+	switch {
+	case err == nil:
+		request.Status.MissionName = mission.Name
+		request.Status.MissionPhase = mission.Status.Phase
+		meta.SetStatusCondition(&request.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionMissionRequestAccepted,
+			Status:             metav1.ConditionTrue,
+			Reason:             aiv1alpha1.ReasonMissionCreated,
+			Message:            fmt.Sprintf("Created mission %s from template %s", mission.Name, request.Spec.TemplateRef),
+			ObservedGeneration: request.Generation,
+		})
+		r.Recorder.Eventf(request, corev1.EventTypeNormal, "MissionCreated",
+			"Created mission %s from template %s", mission.Name, request.Spec.TemplateRef)
+	case errors.As(err, &rejected):
+		meta.SetStatusCondition(&request.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionMissionRequestAccepted,
+			Status:             metav1.ConditionFalse,
+			Reason:             rejected.reason,
+			Message:            rejected.message,
+			ObservedGeneration: request.Generation,
+		})
+		r.Recorder.Event(request, corev1.EventTypeWarning, "MissionRequestRejected", rejected.message)
+	default:
+		return err
+	}
+	return r.Status().Update(ctx, request)
+}
+
+// adoptExisting accepts a Mission left by an earlier reconcile whose status
+// update was lost; a Mission of the same name the request doesn't own
+// rejects it.
+func (r *MissionRequestReconciler) adoptExisting(ctx context.Context, request *aiv1alpha1.MissionRequest, mission *aiv1alpha1.Mission) error {
+	if err := r.Get(ctx, client.ObjectKeyFromObject(mission), mission); err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(mission, request) {
+		return &errRequestRejected{
+			reason:  aiv1alpha1.ReasonMissionExists,
+			message: fmt.Sprintf("mission %s already exists and was not created by this request", mission.Name),
+		}
+	}
+	return nil
+}
+
+// requestedMission builds the request's Mission from its template: the
+// template's spec with dryRun cleared and the objective and briefing
+// rendered with the request's parameters. The Mission has the request's
+// name and is owned by it.
+func (r *MissionRequestReconciler) requestedMission(ctx context.Context, request *aiv1alpha1.MissionRequest) (*aiv1alpha1.Mission, error) {
+	template := &aiv1alpha1.Mission{}
+	if err := r.Get(ctx, types.NamespacedName{Name: request.Spec.TemplateRef, Namespace: request.Namespace}, template); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, &errRequestRejected{
+				reason:  aiv1alpha1.ReasonInvalidTemplateRef,
+				message: fmt.Sprintf("template mission %q not found", request.Spec.TemplateRef),
+			}
+		}
+		return nil, err
+	}
+	if template.Labels[aiv1alpha1.LabelRequestTemplate] != "true" {
+		return nil, &errRequestRejected{
+			reason: aiv1alpha1.ReasonTemplateNotAllowed,
+			message: fmt.Sprintf("mission %q is not labelled %s=true",
+				template.Name, aiv1alpha1.LabelRequestTemplate),
+		}
+	}
+
+	values, err := resolveParameters(template.Spec.Parameters, request.Spec.Parameters)
+	if err != nil {
+		return nil, &errRequestRejected{reason: aiv1alpha1.ReasonInvalidParameters, message: err.Error()}
+	}
+	params := make(map[string]interface{}, len(values))
+	for _, p := range template.Spec.Parameters {
+		params[p.Name], _ = parseParamValue(p, values[p.Name])
+	}
+
+	spec := template.Spec.DeepCopy()
+	spec.DryRun = false
+	spec.Parameters = nil
+	if spec.Objective, err = renderRequestText("objective", template.Spec.Objective, params); err != nil {
+		return nil, &errRequestRejected{reason: aiv1alpha1.ReasonInvalidTemplate, message: err.Error()}
+	}
+	if spec.Briefing, err = renderRequestText("briefing", template.Spec.Briefing, params); err != nil {
+		return nil, &errRequestRejected{reason: aiv1alpha1.ReasonInvalidTemplate, message: err.Error()}
+	}
+
+	return &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            request.Name,
+			Namespace:       request.Namespace,
+			Labels:          map[string]string{aiv1alpha1.LabelMissionRequest: request.Name},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(request, aiv1alpha1.GroupVersion.WithKind("MissionRequest"))},
+		},
+		Spec: *spec,
+	}, nil
+}
+
+// renderRequestText renders a template field with the request's parameters
+// as .Params.
+func renderRequestText(field, text string, params map[string]interface{}) (string, error) {
+	tmpl, err := template.New(field).Funcs(validationTemplateFuncs()).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("template %s: %w", field, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, map[string]interface{}{"Params": params}); err != nil {
+		return "", fmt.Errorf("template %s: %w", field, err)
+	}
+	return buf.String(), nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *MissionRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&aiv1alpha1.MissionRequest{}).
+		Owns(&aiv1alpha1.Mission{}).
+		Named("missionrequest").
+		Complete(r)
+}
@@ -0,0 +1,118 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestMissionRequestExpansion(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	depth := "2"
+	template := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "recon-template",
+			Namespace: "default",
+			Labels:    map[string]string{aiv1alpha1.LabelRequestTemplate: "true"},
+		},
+		Spec: aiv1alpha1.MissionSpec{
+			Objective:     "Recon {{ .Params.target }}",
+			Briefing:      "Depth {{ .Params.depth }}",
+			RoundTableRef: "fleet",
+			DryRun:        true,
+			Parameters: []aiv1alpha1.ChainParameter{
+				{Name: "target", Required: true},
+				{Name: "depth", Type: aiv1alpha1.ChainParameterTypeNumber, Default: &depth},
+			},
+		},
+	}
+	plain := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "admin-only", Namespace: "default"},
+		Spec:       aiv1alpha1.MissionSpec{Objective: "anything", DryRun: true},
+	}
+	request := func(name, templateRef string, params map[string]string) *aiv1alpha1.MissionRequest {
+		return &aiv1alpha1.MissionRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID(name)},
+			Spec:       aiv1alpha1.MissionRequestSpec{TemplateRef: templateRef, Parameters: params},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(template, plain,
+			request("scan-example", "recon-template", map[string]string{"target": "example.com"}),
+			request("sneaky", "admin-only", nil),
+			request("typo", "recon-template", map[string]string{"target": "x", "dpeth": "3"})).
+		WithStatusSubresource(&aiv1alpha1.Mission{}, &aiv1alpha1.MissionRequest{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &MissionRequestReconciler{Client: c, Recorder: recorder}
+	reconcile := func(name string) *aiv1alpha1.MissionRequest {
+		t.Helper()
+		key := types.NamespacedName{Name: name, Namespace: "default"}
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile(%s) error = %v", name, err)
+		}
+		got := &aiv1alpha1.MissionRequest{}
+		if err := c.Get(ctx, key, got); err != nil {
+			t.Fatalf("get request %s: %v", name, err)
+		}
+		return got
+	}
+
+	got := reconcile("scan-example")
+	if got.Status.MissionName != "scan-example" {
+		t.Fatalf("missionName = %q, want scan-example", got.Status.MissionName)
+	}
+	mission := &aiv1alpha1.Mission{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "scan-example", Namespace: "default"}, mission); err != nil {
+		t.Fatalf("get mission: %v", err)
+	}
+	if mission.Spec.Objective != "Recon example.com" || mission.Spec.Briefing != "Depth 2" {
+		t.Errorf("objective = %q, briefing %q, want the rendered template", mission.Spec.Objective, mission.Spec.Briefing)
+	}
+	if mission.Spec.DryRun || mission.Spec.Parameters != nil || mission.Spec.RoundTableRef != "fleet" {
+		t.Errorf("spec = %+v, want the template's spec with dryRun and parameters cleared", mission.Spec)
+	}
+	if !metav1.IsControlledBy(mission, got) || mission.Labels[aiv1alpha1.LabelMissionRequest] != "scan-example" {
+		t.Errorf("mission owners = %v, labels %v, want the request's", mission.OwnerReferences, mission.Labels)
+	}
+
+	mission.Status.Phase = aiv1alpha1.MissionPhaseActive
+	if err := c.Status().Update(ctx, mission); err != nil {
+		t.Fatalf("update mission status: %v", err)
+	}
+	if got = reconcile("scan-example"); got.Status.MissionPhase != aiv1alpha1.MissionPhaseActive {
+		t.Errorf("missionPhase = %q, want Active", got.Status.MissionPhase)
+	}
+
+	for name, reason := range map[string]string{
+		"sneaky": aiv1alpha1.ReasonTemplateNotAllowed,
+		"typo":   aiv1alpha1.ReasonInvalidParameters,
+	} {
+		got := reconcile(name)
+		cond := meta.FindStatusCondition(got.Status.Conditions, aiv1alpha1.ConditionMissionRequestAccepted)
+		if got.Status.MissionName != "" || cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != reason {
+			t.Errorf("%s: missionName %q, Accepted %+v, want rejected with %s", name, got.Status.MissionName, cond, reason)
+		}
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, &aiv1alpha1.Mission{}); err == nil {
+			t.Errorf("%s: mission created, want none", name)
+		}
+	}
+
+	events := drainEvents(recorder)
+	if len(events) != 3 || !strings.Contains(events[0], "MissionCreated") || !strings.Contains(events[1], "MissionRequestRejected") {
+		t.Errorf("events = %v, want MissionCreated and two rejections", events)
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// fleetConnection resolves a RoundTable's NATS connection: its server and
+// the credentials and TLS settings read from Secrets in its namespace.
+func fleetConnection(ctx context.Context, c client.Client, rt *aiv1alpha1.RoundTable) (natspkg.Connection, error) {
+	conn := natspkg.Connection{URL: rt.Spec.NATS.URL}
+	secret := func(ref *corev1.SecretKeySelector) (string, error) {
+		return secretKeyValue(ctx, c, rt.Namespace, ref)
+	}
+
+	var err error
+	if auth := rt.Spec.NATS.Auth; auth != nil {
+		switch {
+		case auth.CredsSecretRef != nil:
+			var creds string
+			creds, err = secret(auth.CredsSecretRef)
+			conn.Auth.Creds = []byte(creds)
+		case auth.NKeySeedSecretRef != nil:
+			conn.Auth.NKeySeed, err = secret(auth.NKeySeedSecretRef)
+		case auth.TokenSecretRef != nil:
+			conn.Auth.Token, err = secret(auth.TokenSecretRef)
+		case auth.User != nil:
+			conn.Auth.User = auth.User.Username
+			conn.Auth.Password, err = secret(&auth.User.PasswordSecretRef)
+		}
+		if err != nil {
+			return conn, fmt.Errorf("NATS auth: %w", err)
+		}
+	}
+
+	if spec := rt.Spec.NATS.TLS; spec != nil {
+		conn.TLS = &natspkg.TLSConfig{InsecureSkipVerify: spec.InsecureSkipVerify}
+		if spec.CASecretRef != nil {
+			ca, err := secret(spec.CASecretRef)
+			if err != nil {
+				return conn, fmt.Errorf("NATS TLS: %w", err)
+			}
+			conn.TLS.CA = []byte(ca)
+		}
+		if spec.ClientCertSecretName != "" {
+			pair := &corev1.Secret{}
+			if err := c.Get(ctx, types.NamespacedName{Name: spec.ClientCertSecretName, Namespace: rt.Namespace}, pair); err != nil {
+				return conn, fmt.Errorf("NATS TLS: read secret %q: %w", spec.ClientCertSecretName, err)
+			}
+			conn.TLS.Cert = pair.Data[corev1.TLSCertKey]
+			conn.TLS.Key = pair.Data[corev1.TLSPrivateKeyKey]
+		}
+	}
+	return conn, nil
+}
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestFleetConnection(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	secret := func(name string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}, Data: data}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		secret("nats-users", map[string][]byte{"operator": []byte("grail")}),
+		secret("nats-ca", map[string][]byte{"ca.crt": []byte("ca-pem")}),
+		secret("nats-client", map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")}),
+	).Build()
+	ref := func(name, key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: key}
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{
+			URL:  "tls://nats.fleet:4222",
+			Auth: &aiv1alpha1.NATSAuth{User: &aiv1alpha1.NATSUserAuth{Username: "operator", PasswordSecretRef: *ref("nats-users", "operator")}},
+			TLS:  &aiv1alpha1.NATSTLS{CASecretRef: ref("nats-ca", "ca.crt"), ClientCertSecretName: "nats-client"},
+		}},
+	}
+
+	conn, err := fleetConnection(context.Background(), c, rt)
+	if err != nil {
+		t.Fatalf("fleetConnection() error = %v", err)
+	}
+	if conn.URL != "tls://nats.fleet:4222" || conn.Auth.User != "operator" || conn.Auth.Password != "grail" {
+		t.Errorf("connection = %s as %s, want the fleet server and user", conn.URL, conn.Auth.User)
+	}
+	if conn.TLS == nil || string(conn.TLS.CA) != "ca-pem" || string(conn.TLS.Cert) != "cert" || string(conn.TLS.Key) != "key" {
+		t.Errorf("TLS = %+v, want the CA and client certificate", conn.TLS)
+	}
+
+	rt.Spec.NATS.Auth = &aiv1alpha1.NATSAuth{TokenSecretRef: ref("nats-users", "missing")}
+	if _, err := fleetConnection(context.Background(), c, rt); err == nil {
+		t.Error("fleetConnection() with a missing secret key succeeded, want an error")
+	}
+}
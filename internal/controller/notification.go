@@ -155,16 +155,21 @@ func webhookToken(ctx context.Context, c client.Client, namespace string, webhoo
 // chain-outputs NATS KV bucket referenced by outputRef.
 func chainNotifyPayload(chain *aiv1alpha1.Chain) notify.Payload {
 	steps := make([]notify.StepSummary, 0, len(chain.Status.StepStatuses))
-	knightByStep := make(map[string]string, len(chain.Spec.Steps))
-	for _, s := range chain.Spec.Steps {
+	allSteps := allChainSteps(chain)
+	knightByStep := make(map[string]string, len(allSteps))
+	for _, s := range allSteps {
 		knightByStep[s.Name] = s.KnightRef
 	}
 
 	var output, outputStep string
 	for _, ss := range chain.Status.StepStatuses {
+		knight := ss.Knight
+		if knight == "" {
+			knight = knightByStep[ss.Name]
+		}
 		steps = append(steps, notify.StepSummary{
 			Name:   ss.Name,
-			Knight: knightByStep[ss.Name],
+			Knight: knight,
 			Phase:  string(ss.Phase),
 		})
 		if ss.Phase == aiv1alpha1.ChainStepPhaseSucceeded && ss.Output != "" {
@@ -189,6 +194,11 @@ func chainNotifyPayload(chain *aiv1alpha1.Chain) notify.Payload {
 		idempotencyKey = string(chain.UID) + "/" + chain.Status.RunID + "/" + string(chain.Status.Phase)
 	}
 
+	var webhookContext map[string]string
+	if chain.Spec.Notify != nil && chain.Spec.Notify.Webhook != nil {
+		webhookContext = chain.Spec.Notify.Webhook.Context
+	}
+
 	return notify.Payload{
 		Schema:         notify.SchemaV1,
 		Kind:           "Chain",
@@ -203,7 +213,7 @@ func chainNotifyPayload(chain *aiv1alpha1.Chain) notify.Payload {
 		Output:         output,
 		Truncated:      truncated,
 		OutputRef:      outputRef,
-		Context:        chain.Spec.Notify.Webhook.Context,
+		Context:        webhookContext,
 		IdempotencyKey: idempotencyKey,
 	}
 }
@@ -224,6 +234,11 @@ func missionNotifyPayload(mission *aiv1alpha1.Mission) notify.Payload {
 		}}
 	}
 
+	var webhookContext map[string]string
+	if mission.Spec.Notify != nil && mission.Spec.Notify.Webhook != nil {
+		webhookContext = mission.Spec.Notify.Webhook.Context
+	}
+
 	phase := string(terminalOutcome(mission))
 	return notify.Payload{
 		Schema:         notify.SchemaV1,
@@ -238,7 +253,7 @@ func missionNotifyPayload(mission *aiv1alpha1.Mission) notify.Payload {
 		Output:         output,
 		Truncated:      truncated,
 		OutputRef:      outputRef,
-		Context:        mission.Spec.Notify.Webhook.Context,
+		Context:        webhookContext,
 		IdempotencyKey: string(mission.UID) + "/" + phase,
 	}
 }
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// domainTaskSubjects returns the subject filter of a domain's tasks.
+// Format: {prefix}.tasks.{domain}.>
+func domainTaskSubjects(prefix, domain string) string {
+	return fmt.Sprintf("%s.tasks.%s.>", prefix, domain)
+}
+
+// reconcileAutoProvision adds and removes provisioned knights for each
+// autoProvision domain by its backlog, and records it in
+// status.autoProvision. A domain whose backlog can't be read keeps its
+// previous status.
+func (r *RoundTableReconciler) reconcileAutoProvision(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight, now time.Time) error {
+	ap := rt.Spec.AutoProvision
+	scaleUpAfter, err := optionalDuration(ap.ScaleUpAfter)
+	if err != nil {
+		return fmt.Errorf("invalid autoProvision scaleUpAfter: %w", err)
+	}
+	idleAfter, err := optionalDuration(ap.IdleAfter)
+	if err != nil {
+		return fmt.Errorf("invalid autoProvision idleAfter: %w", err)
+	}
+	nc, err := r.natsClient(ctx, rt)
+	if err != nil {
+		return err
+	}
+	provisioned := &aiv1alpha1.KnightList{}
+	if err := r.List(ctx, provisioned, client.InNamespace(rt.Namespace), client.MatchingLabels{
+		aiv1alpha1.LabelRoundTable:      rt.Name,
+		aiv1alpha1.LabelAutoProvisioned: "true",
+	}); err != nil {
+		return fmt.Errorf("failed to list provisioned knights: %w", err)
+	}
+
+	previous := map[string]aiv1alpha1.RoundTableAutoProvisionStatus{}
+	for _, s := range rt.Status.AutoProvision {
+		previous[s.Domain] = s
+	}
+	status := make([]aiv1alpha1.RoundTableAutoProvisionStatus, 0, len(ap.Domains))
+	for _, d := range ap.Domains {
+		backlog, err := nc.SubjectMessages(domainTasksStream(rt, d.Domain), domainTaskSubjects(rt.Spec.NATS.SubjectPrefix, d.Domain))
+		if err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to read domain backlog", "domain", d.Domain)
+			if prev, ok := previous[d.Domain]; ok {
+				status = append(status, prev)
+			}
+			continue
+		}
+		s, err := r.provisionDomain(ctx, rt, d, knights, provisioned.Items, previous[d.Domain], int64(backlog), scaleUpAfter, idleAfter, now)
+		if err != nil {
+			return err
+		}
+		status = append(status, s)
+	}
+	rt.Status.AutoProvision = status
+	return nil
+}
+
+// provisionDomain applies one domain's backlog: it adds a knight when the
+// domain has backlog and no ready knight, or the backlog has been over the
+// threshold for scaleUpAfter, and removes the newest provisioned knight once
+// the backlog has been empty for idleAfter. At most one knight is added or
+// removed a reconcile, and none is added while a provisioned one starts.
+func (r *RoundTableReconciler) provisionDomain(
+	ctx context.Context,
+	rt *aiv1alpha1.RoundTable,
+	d aiv1alpha1.AutoProvisionDomain,
+	knights, provisioned []aiv1alpha1.Knight,
+	prev aiv1alpha1.RoundTableAutoProvisionStatus,
+	backlog int64,
+	scaleUpAfter, idleAfter time.Duration,
+	now time.Time,
+) (aiv1alpha1.RoundTableAutoProvisionStatus, error) {
+	s := aiv1alpha1.RoundTableAutoProvisionStatus{Domain: d.Domain, Backlog: backlog}
+	if backlog >= rt.Spec.AutoProvision.BacklogThreshold {
+		s.BacklogSince = prev.BacklogSince
+		if s.BacklogSince == nil {
+			s.BacklogSince = &metav1.Time{Time: now}
+		}
+	}
+	if backlog == 0 {
+		s.IdleSince = prev.IdleSince
+		if s.IdleSince == nil {
+			s.IdleSince = &metav1.Time{Time: now}
+		}
+	}
+
+	var ready, starting bool
+	for _, k := range knights {
+		if k.Spec.Domain == d.Domain && k.Status.Ready {
+			ready = true
+		}
+	}
+	var mine []*aiv1alpha1.Knight
+	for i := range provisioned {
+		k := &provisioned[i]
+		if k.Spec.Domain != d.Domain || k.DeletionTimestamp != nil {
+			continue
+		}
+		mine = append(mine, k)
+		if k.Status.Ready {
+			ready = true
+		} else {
+			starting = true
+		}
+	}
+	s.Knights = int32(len(mine))
+
+	scaleUp := backlog > 0 && !ready ||
+		s.BacklogSince != nil && now.Sub(s.BacklogSince.Time) >= scaleUpAfter
+	switch {
+	case scaleUp && !starting && s.Knights < d.MaxKnights:
+		if reason, message := capacityReached(rt, knights, d.Domain); reason != "" {
+			logf.FromContext(ctx).V(1).Info("Not provisioning knight", "domain", d.Domain, "reason", message)
+			break
+		}
+		knight, err := r.createProvisionedKnight(ctx, rt, d)
+		if err != nil {
+			return s, err
+		}
+		r.Recorder.Eventf(rt, corev1.EventTypeNormal, "KnightProvisioned",
+			"Created knight %s for a backlog of %d %s tasks", knight.Name, backlog, d.Domain)
+		s.Knights++
+		if s.BacklogSince != nil {
+			s.BacklogSince = &metav1.Time{Time: now}
+		}
+	case s.IdleSince != nil && now.Sub(s.IdleSince.Time) >= idleAfter && len(mine) > 0:
+		newest := mine[0]
+		for _, k := range mine[1:] {
+			if newest.CreationTimestamp.Before(&k.CreationTimestamp) {
+				newest = k
+			}
+		}
+		if err := r.Delete(ctx, newest); client.IgnoreNotFound(err) != nil {
+			return s, fmt.Errorf("failed to delete provisioned knight %s: %w", newest.Name, err)
+		}
+		r.Recorder.Eventf(rt, corev1.EventTypeNormal, "KnightDeprovisioned",
+			"Deleted knight %s, domain %s is idle", newest.Name, d.Domain)
+		s.Knights--
+		s.IdleSince = &metav1.Time{Time: now}
+	}
+	return s, nil
+}
+
+// createProvisionedKnight creates a knight for a domain from its
+// knightTemplates entry, subscribed to the domain's tasks. It carries the
+// knightSelector's labels so the table discovers it, and is owned by the
+// table.
+func (r *RoundTableReconciler) createProvisionedKnight(ctx context.Context, rt *aiv1alpha1.RoundTable, d aiv1alpha1.AutoProvisionDomain) (*aiv1alpha1.Knight, error) {
+	template, ok := rt.Spec.KnightTemplates[d.TemplateRef]
+	if !ok {
+		return nil, fmt.Errorf("autoProvision domain %s: knight template %q not found", d.Domain, d.TemplateRef)
+	}
+
+	randBytes := make([]byte, 3)
+	if _, err := rand.Read(randBytes); err != nil {
+		return nil, fmt.Errorf("failed to generate random suffix: %w", err)
+	}
+	spec := template.DeepCopy()
+	spec.Domain = d.Domain
+	spec.Suspended = false
+	if spec.NATS.URL == "" {
+		spec.NATS.URL = rt.Spec.NATS.URL
+	}
+	if spec.NATS.Stream == "" {
+		spec.NATS.Stream = rt.Spec.NATS.TasksStream
+	}
+	if spec.NATS.ResultsStream == "" {
+		spec.NATS.ResultsStream = rt.Spec.NATS.ResultsStream
+	}
+	if len(spec.NATS.Subjects) == 0 {
+		spec.NATS.Subjects = []string{domainTaskSubjects(rt.Spec.NATS.SubjectPrefix, d.Domain)}
+	}
+
+	labels := map[string]string{}
+	if rt.Spec.KnightSelector != nil {
+		for k, v := range rt.Spec.KnightSelector.MatchLabels {
+			labels[k] = v
+		}
+	}
+	labels[aiv1alpha1.LabelRoundTable] = rt.Name
+	labels[aiv1alpha1.LabelAutoProvisioned] = "true"
+	knight := &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-%s", rt.Name, d.Domain, hex.EncodeToString(randBytes)),
+			Namespace: rt.Namespace,
+			Labels:    labels,
+		},
+		Spec: *spec,
+	}
+	if err := controllerutil.SetControllerReference(rt, knight, r.Scheme); err != nil {
+		return nil, fmt.Errorf("failed to set owner reference: %w", err)
+	}
+	if err := r.Create(ctx, knight); err != nil {
+		return nil, fmt.Errorf("failed to create knight for domain %s: %w", d.Domain, err)
+	}
+	return knight, nil
+}
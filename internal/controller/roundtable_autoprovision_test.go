@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestReconcileAutoProvision(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default", UID: "rt-uid"},
+		Spec: aiv1alpha1.RoundTableSpec{
+			NATS: aiv1alpha1.RoundTableNATS{SubjectPrefix: "fleet-a", TasksStream: "fleet_a_tasks", ResultsStream: "fleet_a_results"},
+			KnightTemplates: map[string]aiv1alpha1.KnightSpec{
+				"analyst": {Model: "claude-sonnet-4-20250514"},
+			},
+			AutoProvision: &aiv1alpha1.RoundTableAutoProvision{
+				Domains:          []aiv1alpha1.AutoProvisionDomain{{Domain: "research", TemplateRef: "analyst", MaxKnights: 2}},
+				BacklogThreshold: 10,
+				ScaleUpAfter:     "2m",
+				IdleAfter:        "15m",
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt).
+		WithStatusSubresource(&aiv1alpha1.Knight{}).Build()
+	nc := newFakeNATSClient()
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{Client: c, Scheme: scheme, Recorder: recorder, NATS: natspkg.NewProviderWithClient(nc, logr.Discard())}
+	start := time.Now()
+	run := func(backlog uint64, at time.Duration) []aiv1alpha1.Knight {
+		t.Helper()
+		nc.backlogs = map[string]uint64{"fleet-a.tasks.research.>": backlog}
+		knights, err := tableKnights(ctx, c, rt)
+		if err != nil {
+			t.Fatalf("tableKnights() error = %v", err)
+		}
+		if err := r.reconcileAutoProvision(ctx, rt, knights, start.Add(at)); err != nil {
+			t.Fatalf("reconcileAutoProvision() error = %v", err)
+		}
+		if knights, err = tableKnights(ctx, c, rt); err != nil {
+			t.Fatalf("tableKnights() error = %v", err)
+		}
+		return knights
+	}
+
+	// Backlog with no ready knight provisions one, and only one while it starts.
+	knights := run(3, 0)
+	if len(knights) != 1 {
+		t.Fatalf("knights = %d, want 1 provisioned", len(knights))
+	}
+	k := knights[0]
+	if k.Spec.Domain != "research" || k.Spec.Model != "claude-sonnet-4-20250514" || k.Spec.NATS.Subjects[0] != "fleet-a.tasks.research.>" ||
+		k.Labels[aiv1alpha1.LabelAutoProvisioned] != "true" || !metav1.IsControlledBy(&k, rt) {
+		t.Errorf("knight = %+v, want the analyst template for research owned by the table", k)
+	}
+	if knights = run(3, time.Minute); len(knights) != 1 {
+		t.Errorf("knights = %d, want no second knight while the first starts", len(knights))
+	}
+
+	k.Status.Ready = true
+	if err := c.Status().Update(ctx, &k); err != nil {
+		t.Fatalf("update knight status: %v", err)
+	}
+	// A sustained backlog adds another knight.
+	if knights = run(12, 2*time.Minute); len(knights) != 1 || rt.Status.AutoProvision[0].BacklogSince == nil {
+		t.Fatalf("knights = %d, status %+v, want the backlog timed before scaling", len(knights), rt.Status.AutoProvision)
+	}
+	if knights = run(12, 5*time.Minute); len(knights) != 2 || rt.Status.AutoProvision[0].Knights != 2 {
+		t.Errorf("knights = %d, status %+v, want 2 after a sustained backlog", len(knights), rt.Status.AutoProvision)
+	}
+
+	// An idle domain sheds a knight once idleAfter passes.
+	if knights = run(0, 6*time.Minute); len(knights) != 2 {
+		t.Errorf("knights = %d, want none removed as soon as the backlog empties", len(knights))
+	}
+	if knights = run(0, 22*time.Minute); len(knights) != 1 || rt.Status.AutoProvision[0].Knights != 1 {
+		t.Errorf("knights = %d, status %+v, want one removed once idle", len(knights), rt.Status.AutoProvision)
+	}
+
+	events := drainEvents(recorder)
+	if len(events) != 3 || !strings.Contains(events[0], "KnightProvisioned") || !strings.Contains(events[2], "KnightDeprovisioned") {
+		t.Errorf("events = %v, want two provisions and a removal", events)
+	}
+}
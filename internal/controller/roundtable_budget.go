@@ -0,0 +1,122 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// reconcileCostReset resets the cost counter when a policies.costResetSchedule
+// time has passed since the last reset (or since the table was created): the
+// knights' cumulative cost becomes the baseline totalCost is counted from.
+// The table is reconciled every minute, so a reset is at most that late.
+func (r *RoundTableReconciler) reconcileCostReset(rt *aiv1alpha1.RoundTable, cumulative float64, now time.Time) error {
+	next, err := nextCostReset(rt)
+	if err != nil || next.IsZero() {
+		return err
+	}
+	if !next.After(now) {
+		r.Recorder.Eventf(rt, corev1.EventTypeNormal, "CostReset",
+			"Cost counter reset at $%.4f", costSinceReset(rt, cumulative))
+		rt.Status.CostBaselineUSD = fmt.Sprintf("%.4f", cumulative)
+		rt.Status.LastCostReset = &metav1.Time{Time: now}
+	}
+	return nil
+}
+
+// nextCostReset returns the first policies.costResetSchedule time after
+// the last reset (or the table's creation), or the zero time without a
+// schedule.
+func nextCostReset(rt *aiv1alpha1.RoundTable) (time.Time, error) {
+	if rt.Spec.Policies == nil || rt.Spec.Policies.CostResetSchedule == "" {
+		return time.Time{}, nil
+	}
+	sched, err := cron.ParseStandard(rt.Spec.Policies.CostResetSchedule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid costResetSchedule %q: %w", rt.Spec.Policies.CostResetSchedule, err)
+	}
+	last := rt.CreationTimestamp.Time
+	if rt.Status.LastCostReset != nil {
+		last = rt.Status.LastCostReset.Time
+	}
+	return sched.Next(last), nil
+}
+
+// costSinceReset returns the cost counted against the budget: the knights'
+// cumulative cost less the baseline taken at the last reset. Deleted
+// knights take their cost with them, so it never goes below zero.
+func costSinceReset(rt *aiv1alpha1.RoundTable, cumulative float64) float64 {
+	baseline, err := strconv.ParseFloat(rt.Status.CostBaselineUSD, 64)
+	if err != nil {
+		return cumulative
+	}
+	if baseline > cumulative {
+		return 0
+	}
+	return cumulative - baseline
+}
+
+// enforceBudget suspends the table's running knights while it is over its
+// cost budget, marking them with the budget-suspended annotation, and
+// resumes the marked knights once it is back under. Knights suspended by
+// hand are left alone either way.
+func (r *RoundTableReconciler) enforceBudget(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight, overBudget bool) error {
+	changed := 0
+	for i := range knights {
+		knight := &knights[i]
+		_, marked := knight.Annotations[aiv1alpha1.AnnotationBudgetSuspended]
+		patch := client.MergeFrom(knight.DeepCopy())
+		switch {
+		case overBudget && !knight.Spec.Suspended:
+			if knight.Annotations == nil {
+				knight.Annotations = map[string]string{}
+			}
+			knight.Annotations[aiv1alpha1.AnnotationBudgetSuspended] = "true"
+			knight.Spec.Suspended = true
+		case !overBudget && marked:
+			delete(knight.Annotations, aiv1alpha1.AnnotationBudgetSuspended)
+			knight.Spec.Suspended = false
+		default:
+			continue
+		}
+		if err := r.Patch(ctx, knight, patch); err != nil {
+			return fmt.Errorf("failed to update knight %s: %w", knight.Name, err)
+		}
+		changed++
+	}
+	if changed == 0 {
+		return nil
+	}
+	if overBudget {
+		r.Recorder.Eventf(rt, corev1.EventTypeWarning, "BudgetExceeded",
+			"Cost %s exceeds budget %s, suspended %d knights", rt.Status.TotalCost, rt.Spec.Policies.CostBudgetUSD, changed)
+	} else {
+		r.Recorder.Eventf(rt, corev1.EventTypeNormal, "BudgetRestored",
+			"Cost %s is within budget, resumed %d knights", rt.Status.TotalCost, changed)
+	}
+	return nil
+}
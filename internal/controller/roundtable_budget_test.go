@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestRoundTableBudgetEnforcement(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "fleet",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-40 * 24 * time.Hour)),
+		},
+		Spec: aiv1alpha1.RoundTableSpec{Policies: &aiv1alpha1.RoundTablePolicies{CostBudgetUSD: "1.00"}},
+	}
+	knight := func(name, cost string, suspended bool) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       aiv1alpha1.KnightSpec{Suspended: suspended},
+			Status:     aiv1alpha1.KnightStatus{TotalCost: cost},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(rt, knight("galahad", "0.80", false), knight("percival", "0.50", false), knight("tristan", "0", true)).
+		WithStatusSubresource(&aiv1alpha1.RoundTable{}, &aiv1alpha1.Knight{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{Client: c, Recorder: recorder}
+	reconcile := func() {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "fleet", Namespace: "default"}}); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+	}
+	knightState := func(name string) (bool, bool) {
+		t.Helper()
+		k := &aiv1alpha1.Knight{}
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, k); err != nil {
+			t.Fatalf("get knight %s: %v", name, err)
+		}
+		_, marked := k.Annotations[aiv1alpha1.AnnotationBudgetSuspended]
+		return k.Spec.Suspended, marked
+	}
+
+	reconcile()
+	if err := c.Get(ctx, types.NamespacedName{Name: "fleet", Namespace: "default"}, rt); err != nil {
+		t.Fatalf("get roundtable: %v", err)
+	}
+	if rt.Status.Phase != aiv1alpha1.RoundTablePhaseOverBudget {
+		t.Fatalf("phase = %s, want OverBudget", rt.Status.Phase)
+	}
+	for _, name := range []string{"galahad", "percival"} {
+		if suspended, marked := knightState(name); !suspended || !marked {
+			t.Errorf("%s suspended = %t, marked %t, want suspended for the budget", name, suspended, marked)
+		}
+	}
+	if suspended, marked := knightState("tristan"); !suspended || marked {
+		t.Errorf("tristan suspended = %t, marked %t, want left suspended by hand", suspended, marked)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "suspended 2 knights") {
+		t.Errorf("events = %v, want BudgetExceeded for 2 knights", events)
+	}
+
+	reconcile()
+	if events := drainEvents(recorder); len(events) != 0 {
+		t.Errorf("events = %v, want none while the knights stay suspended", events)
+	}
+
+	// A monthly reset has passed since the table was created.
+	if err := c.Get(ctx, types.NamespacedName{Name: "fleet", Namespace: "default"}, rt); err != nil {
+		t.Fatalf("get roundtable: %v", err)
+	}
+	rt.Spec.Policies.CostResetSchedule = "@monthly"
+	if err := c.Update(ctx, rt); err != nil {
+		t.Fatalf("update roundtable: %v", err)
+	}
+	reconcile()
+	if err := c.Get(ctx, types.NamespacedName{Name: "fleet", Namespace: "default"}, rt); err != nil {
+		t.Fatalf("get roundtable: %v", err)
+	}
+	if rt.Status.LastCostReset == nil || rt.Status.CostBaselineUSD != "1.3000" || rt.Status.TotalCost != "0.0000" {
+		t.Errorf("lastCostReset = %v, baseline %s, totalCost %s, want a reset at $1.30", rt.Status.LastCostReset, rt.Status.CostBaselineUSD, rt.Status.TotalCost)
+	}
+	for _, name := range []string{"galahad", "percival"} {
+		if suspended, marked := knightState(name); suspended || marked {
+			t.Errorf("%s suspended = %t, marked %t, want resumed", name, suspended, marked)
+		}
+	}
+	if suspended, _ := knightState("tristan"); !suspended {
+		t.Error("tristan resumed, want knights suspended by hand left alone")
+	}
+	events := drainEvents(recorder)
+	if len(events) != 2 || !strings.Contains(events[0], "CostReset") || !strings.Contains(events[1], "resumed 2 knights") {
+		t.Errorf("events = %v, want CostReset and BudgetRestored", events)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// roundTableSelects reports whether knight belongs to rt, by the rules of
+// discoverKnights, given rt's namespaces from tableNamespaces.
+func roundTableSelects(rt *aiv1alpha1.RoundTable, namespaces []string, knight *aiv1alpha1.Knight) (bool, error) {
+	if !slices.Contains(namespaces, knight.Namespace) {
+		return false, nil
+	}
+	if rt.Spec.Ephemeral {
+		return knight.Labels[aiv1alpha1.LabelRoundTable] == rt.Name, nil
+	}
+	if knight.Labels[aiv1alpha1.LabelEphemeral] == "true" {
+		return false, nil
+	}
+	if listsKnight(rt, knight) {
+		return true, nil
+	}
+	if rt.Spec.KnightSelector == nil {
+		return len(rt.Spec.Knights) == 0, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(rt.Spec.KnightSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid knightSelector: %w", err)
+	}
+	return selector.Matches(labels.Set(knight.Labels)), nil
+}
+
+// knightLimits reports whether rt has a knight limit configured.
+func knightLimits(rt *aiv1alpha1.RoundTable) bool {
+	p := rt.Spec.Policies
+	return p != nil && (p.MaxKnights > 0 || len(p.MaxKnightsPerDomain) > 0)
+}
+
+// capacityReached returns why one more knight in domain would exceed rt's
+// limits given its current knights, or "" if it fits. An empty domain
+// checks only maxKnights.
+func capacityReached(rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight, domain string) (reason, message string) {
+	p := rt.Spec.Policies
+	if p == nil {
+		return "", ""
+	}
+	if p.MaxKnights > 0 && int32(len(knights)) >= p.MaxKnights {
+		return aiv1alpha1.ReasonMaxKnightsReached,
+			fmt.Sprintf("RoundTable %s has %d of %d knights", rt.Name, len(knights), p.MaxKnights)
+	}
+	quota, ok := p.MaxKnightsPerDomain[domain]
+	if domain == "" || !ok {
+		return "", ""
+	}
+	var inDomain int32
+	for i := range knights {
+		if knights[i].Spec.Domain == domain {
+			inDomain++
+		}
+	}
+	if inDomain >= quota {
+		return aiv1alpha1.ReasonDomainQuotaReached,
+			fmt.Sprintf("RoundTable %s has %d of %d knights in domain %s", rt.Name, inDomain, quota, domain)
+	}
+	return "", ""
+}
+
+// setCapacityCondition records whether rt is at its maxKnights or any
+// domain's quota. Tables without limits have no AtCapacity condition.
+func setCapacityCondition(rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight) {
+	if !knightLimits(rt) {
+		meta.RemoveStatusCondition(&rt.Status.Conditions, aiv1alpha1.ConditionRoundTableAtCapacity)
+		return
+	}
+	reason, message := capacityReached(rt, knights, "")
+	if reason == "" {
+		domains := make([]string, 0, len(rt.Spec.Policies.MaxKnightsPerDomain))
+		for domain := range rt.Spec.Policies.MaxKnightsPerDomain {
+			domains = append(domains, domain)
+		}
+		sort.Strings(domains)
+		for _, domain := range domains {
+			if reason, message = capacityReached(rt, knights, domain); reason != "" {
+				break
+			}
+		}
+	}
+	cond := metav1.Condition{
+		Type:               aiv1alpha1.ConditionRoundTableAtCapacity,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: rt.Generation,
+	}
+	if reason == "" {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = aiv1alpha1.ReasonWithinCapacity
+		cond.Message = fmt.Sprintf("RoundTable %s has %d knights", rt.Name, len(knights))
+	}
+	meta.SetStatusCondition(&rt.Status.Conditions, cond)
+}
+
+// ValidateKnightCapacity rejects a new knight that would take a RoundTable
+// it belongs to past policies.maxKnights or its domain's
+// policies.maxKnightsPerDomain quota. It is used by the Knight webhook.
+func ValidateKnightCapacity(ctx context.Context, c client.Reader, knight *aiv1alpha1.Knight) error {
+	tables := &aiv1alpha1.RoundTableList{}
+	// A table in another namespace can select the knight's.
+	if err := c.List(ctx, tables); err != nil {
+		return fmt.Errorf("failed to list roundtables: %w", err)
+	}
+	for i := range tables.Items {
+		rt := &tables.Items[i]
+		if !knightLimits(rt) {
+			continue
+		}
+		namespaces, err := tableNamespaces(ctx, c, rt)
+		if err != nil {
+			continue
+		}
+		selected, err := roundTableSelects(rt, namespaces, knight)
+		if err != nil || !selected {
+			continue
+		}
+		knights, err := tableKnights(ctx, c, rt)
+		if err != nil {
+			return err
+		}
+		others := knights[:0]
+		for _, k := range knights {
+			if k.Name != knight.Name {
+				others = append(others, k)
+			}
+		}
+		if reason, message := capacityReached(rt, others, knight.Spec.Domain); reason != "" {
+			return errors.New(message)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestSetCapacityCondition(t *testing.T) {
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{Policies: &aiv1alpha1.RoundTablePolicies{
+			MaxKnights:          5,
+			MaxKnightsPerDomain: map[string]int32{"research": 2},
+		}},
+	}
+	knights := []aiv1alpha1.Knight{
+		{Spec: aiv1alpha1.KnightSpec{Domain: "research"}},
+		{Spec: aiv1alpha1.KnightSpec{Domain: "security"}},
+	}
+
+	setCapacityCondition(rt, knights)
+	cond := meta.FindStatusCondition(rt.Status.Conditions, aiv1alpha1.ConditionRoundTableAtCapacity)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != aiv1alpha1.ReasonWithinCapacity {
+		t.Errorf("AtCapacity = %+v, want WithinCapacity", cond)
+	}
+
+	knights = append(knights, aiv1alpha1.Knight{Spec: aiv1alpha1.KnightSpec{Domain: "research"}})
+	setCapacityCondition(rt, knights)
+	cond = meta.FindStatusCondition(rt.Status.Conditions, aiv1alpha1.ConditionRoundTableAtCapacity)
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != aiv1alpha1.ReasonDomainQuotaReached {
+		t.Errorf("AtCapacity = %+v, want DomainQuotaReached", cond)
+	}
+
+	rt.Spec.Policies = nil
+	setCapacityCondition(rt, knights)
+	if cond := meta.FindStatusCondition(rt.Status.Conditions, aiv1alpha1.ConditionRoundTableAtCapacity); cond != nil {
+		t.Errorf("AtCapacity = %+v, want none without limits", cond)
+	}
+}
+
+func TestTableKnightsAcrossNamespaces(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	fleet := map[string]string{"fleet": "a"}
+	namespace := func(name string, labels map[string]string, tables string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Labels: labels, Annotations: map[string]string{aiv1alpha1.AnnotationRoundTables: tables},
+		}}
+	}
+	knight := func(name, ns string) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, Labels: fleet},
+			Spec:       aiv1alpha1.KnightSpec{Domain: "security"},
+		}
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet-a", Namespace: "ops"},
+		Spec: aiv1alpha1.RoundTableSpec{
+			KnightSelector:    &metav1.LabelSelector{MatchLabels: fleet},
+			Namespaces:        []string{"team-red", "team-green"},
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: fleet},
+			Policies:          &aiv1alpha1.RoundTablePolicies{MaxKnights: 4},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt,
+		namespace("ops", nil, ""), namespace("team-red", nil, "ops/fleet-a"), namespace("team-blue", fleet, "ops/other, ops/fleet-a"),
+		namespace("other", nil, "ops/fleet-a"), namespace("team-green", nil, ""), namespace("team-gold", fleet, "ops/other"),
+		knight("galahad", "ops"), knight("gawain", "team-red"), knight("tristan", "team-blue"), knight("kay", "other"),
+		knight("percival", "team-green"), knight("bedivere", "team-gold")).Build()
+
+	knights, err := tableKnights(ctx, c, rt)
+	if err != nil {
+		t.Fatalf("tableKnights() error = %v", err)
+	}
+	var got []string
+	for _, k := range knights {
+		got = append(got, k.Namespace+"/"+k.Name)
+	}
+	// Namespaces that don't name the table are skipped, listed or selected.
+	if want := []string{"ops/galahad", "team-blue/tristan", "team-red/gawain"}; !slices.Equal(got, want) {
+		t.Errorf("knights = %v, want %v", got, want)
+	}
+
+	if err := ValidateKnightCapacity(ctx, c, knight("bors", "team-blue")); err != nil {
+		t.Errorf("ValidateKnightCapacity() error = %v, want the fourth knight allowed", err)
+	}
+	if err := c.Create(ctx, knight("bors", "team-blue")); err != nil {
+		t.Fatalf("create knight: %v", err)
+	}
+	if err := ValidateKnightCapacity(ctx, c, knight("lancelot", "team-red")); err == nil {
+		t.Error("ValidateKnightCapacity() in a selected namespace succeeded, want maxKnights reached")
+	}
+	if err := ValidateKnightCapacity(ctx, c, knight("lancelot", "other")); err != nil {
+		t.Errorf("ValidateKnightCapacity() in an unselected namespace error = %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// roundTableFinalizer holds a RoundTable that manages NATS resources until
+// they are cleaned up.
+const roundTableFinalizer = "ai.roundtable.io/roundtable-finalizer"
+
+// managesNATS reports whether the table creates NATS resources that must
+// be cleaned up when it is deleted.
+func managesNATS(rt *aiv1alpha1.RoundTable) bool {
+	return rt.Spec.NATS.CreateStreams || len(rt.Spec.NATS.KVBuckets) > 0
+}
+
+// cleanupNATS deletes the table's streams (with createStreams) and KV
+// buckets, or with nats.retainStreams only the operator's dead-letter
+// consumer. Resources already gone are skipped.
+func (r *RoundTableReconciler) cleanupNATS(ctx context.Context, rt *aiv1alpha1.RoundTable) error {
+	nc, err := r.natsClient(ctx, rt)
+	if err != nil {
+		return err
+	}
+	spec := rt.Spec.NATS
+
+	if spec.RetainStreams {
+		if !spec.CreateStreams || spec.DeadLetter == nil {
+			return nil
+		}
+		advisories := natspkg.AdvisoryStreamName(spec.TasksStream)
+		if err := nc.DeleteConsumer(advisories, deadLetterConsumer); err != nil &&
+			!errors.Is(err, nats.ErrConsumerNotFound) && !errors.Is(err, nats.ErrStreamNotFound) {
+			return fmt.Errorf("failed to delete consumer %s: %w", deadLetterConsumer, err)
+		}
+		return nil
+	}
+
+	if spec.CreateStreams {
+		streams := []string{spec.TasksStream, spec.ResultsStream}
+		if spec.Topology == aiv1alpha1.NATSTopologyPerDomain {
+			for _, domain := range tableDomains(rt) {
+				streams = append(streams, domainTasksStream(rt, domain))
+			}
+		}
+		if spec.DeadLetter != nil {
+			streams = append(streams,
+				natspkg.DeadLetterStreamName(spec.TasksStream), natspkg.AdvisoryStreamName(spec.TasksStream))
+		}
+		for _, name := range streams {
+			if err := deleteStream(nc, name); err != nil {
+				return err
+			}
+		}
+	}
+	for _, b := range spec.KVBuckets {
+		bucket := natspkg.KVBucketName(spec.SubjectPrefix, b.Name)
+		if err := nc.DeleteKeyValue(bucket); err != nil && !errors.Is(err, nats.ErrBucketNotFound) {
+			return err
+		}
+	}
+	return nil
+}
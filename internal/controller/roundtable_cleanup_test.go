@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestRoundTableNATSCleanup(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "fleet", Namespace: "default"}
+
+	for _, retain := range []bool{false, true} {
+		rt := &aiv1alpha1.RoundTable{
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+			Spec: aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{
+				SubjectPrefix: "fleet-a",
+				TasksStream:   "fleet_a_tasks",
+				ResultsStream: "fleet_a_results",
+				CreateStreams: true,
+				DeadLetter:    &aiv1alpha1.RoundTableDeadLetter{MaxAge: "168h"},
+				KVBuckets:     []aiv1alpha1.RoundTableKVBucket{{Name: "memory", History: 1}},
+				RetainStreams: retain,
+			}},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt).
+			WithStatusSubresource(&aiv1alpha1.RoundTable{}).Build()
+		nc := newFakeNATSClient()
+		r := &RoundTableReconciler{Client: c, Recorder: record.NewFakeRecorder(10), NATS: natspkg.NewProviderWithClient(nc, logr.Discard())}
+
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		if err := c.Get(ctx, key, rt); err != nil {
+			t.Fatalf("get roundtable: %v", err)
+		}
+		if len(rt.Finalizers) != 1 || rt.Finalizers[0] != roundTableFinalizer {
+			t.Fatalf("finalizers = %v, want %s", rt.Finalizers, roundTableFinalizer)
+		}
+		if len(nc.streams) != 4 || len(nc.buckets) != 1 {
+			t.Fatalf("streams = %v, buckets %v, want the fleet's streams and bucket", nc.streams, nc.buckets)
+		}
+
+		if err := c.Delete(ctx, rt); err != nil {
+			t.Fatalf("delete roundtable: %v", err)
+		}
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile() on deletion error = %v", err)
+		}
+		if err := c.Get(ctx, key, &aiv1alpha1.RoundTable{}); err == nil {
+			t.Errorf("retain %t: roundtable still present, want the finalizer removed", retain)
+		}
+		if retain && (len(nc.streams) != 4 || len(nc.buckets) != 1) {
+			t.Errorf("streams = %v, buckets %v, want them retained", nc.streams, nc.buckets)
+		}
+		if !retain && (len(nc.streams) != 0 || len(nc.buckets) != 0) {
+			t.Errorf("streams = %v, buckets %v, want them deleted", nc.streams, nc.buckets)
+		}
+	}
+}
@@ -23,19 +23,29 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/notify"
 	rtmetrics "github.com/dapperdivers/roundtable/pkg/metrics"
 	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
 )
@@ -46,15 +56,28 @@ type RoundTableReconciler struct {
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
 
-	NATS *natspkg.Provider
+	NATS   *natspkg.Provider
+	Notify *notify.Notifier
+
+	// heraldMu guards heraldProgress, which the herald fills between
+	// reconciles; heraldEvents requeues a table it routed tasks for.
+	heraldMu       sync.Mutex
+	heraldProgress map[types.NamespacedName]*heraldProgress
+	heraldEvents   chan event.GenericEvent
 }
 
-// natsClient returns the shared NATS client, or an error if the provider is not configured.
-func (r *RoundTableReconciler) natsClient() (natspkg.Client, error) {
+// natsClient returns the NATS client for the RoundTable's server (the
+// shared client when it sets no URL, credentials or TLS), or an error if
+// the provider is not configured.
+func (r *RoundTableReconciler) natsClient(ctx context.Context, rt *aiv1alpha1.RoundTable) (natspkg.Client, error) {
 	if r.NATS == nil {
 		return nil, fmt.Errorf("NATS provider not configured")
 	}
-	return r.NATS.Client()
+	conn, err := fleetConnection(ctx, r.Client, rt)
+	if err != nil {
+		return nil, err
+	}
+	return r.NATS.ClientForConnection(conn)
 }
 
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=roundtables,verbs=get;list;watch;create;update;patch;delete
@@ -62,6 +85,8 @@ func (r *RoundTableReconciler) natsClient() (natspkg.Client, error) {
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=roundtables/finalizers,verbs=update
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=knights,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ai.roundtable.io,resources=missions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 
 func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -70,14 +95,46 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	rt := &aiv1alpha1.RoundTable{}
 	if err := r.Get(ctx, req.NamespacedName, rt); err != nil {
 		if client.IgnoreNotFound(err) == nil {
+			rtmetrics.DeleteTable(req.Namespace, req.Name)
 			return ctrl.Result{}, nil
 		}
 		return ctrl.Result{}, err
 	}
 
+	// Handle deletion
+	if rt.DeletionTimestamp != nil {
+		rtmetrics.DeleteTable(rt.Namespace, rt.Name)
+		if controllerutil.ContainsFinalizer(rt, roundTableFinalizer) {
+			// Best effort: a NATS outage must not block deletion.
+			if err := r.cleanupNATS(ctx, rt); err != nil {
+				log.Error(err, "Failed to clean up NATS resources")
+				r.Recorder.Eventf(rt, corev1.EventTypeWarning, "NATSCleanupFailed", "NATS cleanup: %v", err)
+			}
+			controllerutil.RemoveFinalizer(rt, roundTableFinalizer)
+			if err := r.Update(ctx, rt); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Add finalizer
+	if managesNATS(rt) && !controllerutil.ContainsFinalizer(rt, roundTableFinalizer) {
+		controllerutil.AddFinalizer(rt, roundTableFinalizer)
+		if err := r.Update(ctx, rt); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Handle suspended state
 	if rt.Spec.Suspended {
+		if knights, err := r.discoverKnights(ctx, rt); err != nil {
+			log.Error(err, "Failed to discover knights")
+		} else if err := r.suspendKnights(ctx, rt, knights); err != nil {
+			log.Error(err, "Failed to suspend knights")
+		}
 		rt.Status.Phase = aiv1alpha1.RoundTablePhaseSuspended
+		recordHistory(rt, time.Now())
 		meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
 			Type:               aiv1alpha1.ConditionRoundTableAvailable,
 			Status:             metav1.ConditionFalse,
@@ -86,6 +143,7 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			ObservedGeneration: rt.Generation,
 		})
 		rt.Status.ObservedGeneration = rt.Generation
+		recordTableMetrics(rt)
 		if err := r.Status().Update(ctx, rt); err != nil {
 			return ctrl.Result{}, err
 		}
@@ -99,17 +157,34 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{RequeueAfter: RequeueSlow}, err
 	}
 
+	if len(rt.Spec.Knights) > 0 {
+		r.reportMissingMembers(rt, knights)
+		if err := r.applyMemberOverrides(ctx, rt, knights); err != nil {
+			log.Error(err, "Failed to apply knight overrides")
+		}
+	} else {
+		rt.Status.MissingKnights = nil
+		meta.RemoveStatusCondition(&rt.Status.Conditions, aiv1alpha1.ConditionRoundTableMembersPresent)
+	}
+
 	// 2. Health Aggregation
 	var readyCount int32
 	knightSummaries := make([]aiv1alpha1.RoundTableKnightSummary, 0, len(knights))
 	var totalTasksCompleted int64
 	var totalCost float64
 
+	backlogs := r.tableBacklogs(ctx, rt, knights)
 	for _, k := range knights {
 		summary := aiv1alpha1.RoundTableKnightSummary{
-			Name:  k.Name,
-			Phase: k.Status.Phase,
-			Ready: k.Status.Ready,
+			Name:       k.Name,
+			Namespace:  k.Namespace,
+			Phase:      k.Status.Phase,
+			Ready:      k.Status.Ready,
+			Domain:     k.Spec.Domain,
+			Model:      k.Spec.Model,
+			Skills:     k.Spec.Skills,
+			QueueDepth: int64(backlogs[knightKey(&k)]),
+			LastTaskAt: k.Status.LastTaskAt,
 		}
 		knightSummaries = append(knightSummaries, summary)
 		if k.Status.Ready {
@@ -123,12 +198,37 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
+	// Knights in other clusters count towards the table's health.
 	total := int32(len(knights))
+	if rt.Spec.Federation != nil {
+		external, err := r.federatedKnights(ctx, rt, time.Now())
+		if err != nil {
+			log.Error(err, "Failed to read federated knights")
+			r.Recorder.Eventf(rt, corev1.EventTypeWarning, "FederationFailed", "Federation: %v", err)
+			external = unreachableExternalKnights(rt)
+		}
+		for _, s := range external {
+			if s.Ready {
+				readyCount++
+			}
+		}
+		knightSummaries = append(knightSummaries, external...)
+		total += int32(len(external))
+	}
+
+	// Cost Reset
+	lastReset := rt.Status.LastCostReset
+	if err := r.reconcileCostReset(rt, totalCost, time.Now()); err != nil {
+		log.Error(err, "Failed to reset cost counter")
+	}
+	totalCost = costSinceReset(rt, totalCost)
+
 	rt.Status.KnightsTotal = total
 	rt.Status.KnightsReady = readyCount
 	rt.Status.Knights = knightSummaries
 	rt.Status.TotalTasksCompleted = totalTasksCompleted
 	rt.Status.TotalCost = fmt.Sprintf("%.4f", totalCost)
+	rollupDomains(rt, knights, backlogs, time.Now(), rt.Status.LastCostReset != lastReset)
 
 	// 3. NATS Stream Management
 	if rt.Spec.NATS.CreateStreams {
@@ -141,6 +241,14 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 				Message:            err.Error(),
 				ObservedGeneration: rt.Generation,
 			})
+		} else if drift := streamDriftMessage(rt); drift != "" {
+			meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
+				Type:               aiv1alpha1.ConditionNATSReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             aiv1alpha1.ReasonStreamDrift,
+				Message:            drift,
+				ObservedGeneration: rt.Generation,
+			})
 		} else {
 			meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
 				Type:               aiv1alpha1.ConditionNATSReady,
@@ -151,6 +259,37 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			})
 		}
 	}
+	if rt.Spec.NATS.CreateStreams && rt.Spec.NATS.DeadLetter != nil {
+		if err := r.reconcileDeadLetters(ctx, rt); err != nil {
+			log.Error(err, "Failed to process dead-lettered tasks")
+		}
+	} else {
+		rt.Status.DeadLetter = nil
+	}
+	if rt.Spec.Herald != nil {
+		if err := r.reconcileHerald(ctx, rt); err != nil {
+			log.Error(err, "Failed to route tasks")
+			r.Recorder.Eventf(rt, corev1.EventTypeWarning, "HeraldFailed", "Herald: %v", err)
+		}
+	} else {
+		rt.Status.Herald = nil
+	}
+	if len(rt.Spec.NATS.KVBuckets) > 0 {
+		if err := r.ensureKVBuckets(ctx, rt); err != nil {
+			log.Error(err, "Failed to ensure NATS KV buckets")
+			r.Recorder.Eventf(rt, corev1.EventTypeWarning, "KVBucketFailed", "KV buckets: %v", err)
+		}
+	} else {
+		rt.Status.KVBuckets = nil
+	}
+
+	// Secret distribution; a mission injects its ephemeral knights' secrets
+	if !rt.Spec.Ephemeral {
+		if err := r.distributeSecrets(ctx, rt, knights); err != nil {
+			log.Error(err, "Failed to distribute secrets")
+			r.Recorder.Eventf(rt, corev1.EventTypeWarning, "SecretDistributionFailed", "Secrets: %v", err)
+		}
+	}
 
 	// 4. Warm Pool Reconciliation
 	if rt.Spec.WarmPool != nil && rt.Spec.WarmPool.Size > 0 {
@@ -159,9 +298,42 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
+	natsHealthy := r.probeNATS(ctx, rt, knights)
+
 	// 5. Cost Budget Check
 	phase := r.computePhase(rt, readyCount, total, totalCost)
+	if phase == aiv1alpha1.RoundTablePhaseReady && !natsHealthy {
+		// Ready knights are no use while the message bus is broken.
+		phase = aiv1alpha1.RoundTablePhaseDegraded
+	}
 	rt.Status.Phase = phase
+	recordHistory(rt, time.Now())
+	if err := r.resumeKnights(ctx, rt, knights, phase == aiv1alpha1.RoundTablePhaseOverBudget); err != nil {
+		log.Error(err, "Failed to resume knights")
+	}
+	if err := r.enforceBudget(ctx, rt, knights, phase == aiv1alpha1.RoundTablePhaseOverBudget); err != nil {
+		log.Error(err, "Failed to enforce cost budget")
+	}
+	r.forecastCost(ctx, rt, totalCost, time.Now())
+
+	if rt.Spec.ModelRollout != nil {
+		if err := r.reconcileModelRollout(ctx, rt, knights, time.Now()); err != nil {
+			log.Error(err, "Failed to roll out model")
+			r.Recorder.Eventf(rt, corev1.EventTypeWarning, "ModelRolloutFailed", "Model rollout: %v", err)
+		}
+	} else {
+		rt.Status.ModelRollout = nil
+	}
+
+	// Demand-based provisioning; an over-budget table adds no knights.
+	if rt.Spec.AutoProvision != nil && phase != aiv1alpha1.RoundTablePhaseOverBudget {
+		if err := r.reconcileAutoProvision(ctx, rt, knights, time.Now()); err != nil {
+			log.Error(err, "Failed to auto-provision knights")
+			r.Recorder.Eventf(rt, corev1.EventTypeWarning, "AutoProvisionFailed", "Auto-provisioning: %v", err)
+		}
+	} else if rt.Spec.AutoProvision == nil {
+		rt.Status.AutoProvision = nil
+	}
 
 	// 6. Active Missions count
 	activeMissions, err := r.countActiveMissions(ctx, rt)
@@ -181,6 +353,16 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			ObservedGeneration: rt.Generation,
 		})
 	case aiv1alpha1.RoundTablePhaseDegraded:
+		if readyCount == total {
+			meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
+				Type:               aiv1alpha1.ConditionRoundTableAvailable,
+				Status:             metav1.ConditionFalse,
+				Reason:             aiv1alpha1.ReasonNATSUnhealthy,
+				Message:            fmt.Sprintf("All %d knights are ready but NATS is unhealthy", total),
+				ObservedGeneration: rt.Generation,
+			})
+			break
+		}
 		meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
 			Type:               aiv1alpha1.ConditionRoundTableAvailable,
 			Status:             metav1.ConditionFalse,
@@ -196,7 +378,6 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			Message:            fmt.Sprintf("Cost %.4f exceeds budget %s", totalCost, rt.Spec.Policies.CostBudgetUSD),
 			ObservedGeneration: rt.Generation,
 		})
-		r.Recorder.Event(rt, corev1.EventTypeWarning, "BudgetExceeded", "Cost budget exceeded, suspending knights")
 	default:
 		meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
 			Type:               aiv1alpha1.ConditionRoundTableAvailable,
@@ -207,6 +388,8 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		})
 	}
 
+	setCapacityCondition(rt, knights)
+
 	rt.Status.ObservedGeneration = rt.Generation
 
 	// Update Prometheus metrics
@@ -221,6 +404,7 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		rtmetrics.WarmPoolSize.WithLabelValues("provisioning", rt.Name).Set(float64(rt.Status.WarmPool.Provisioning))
 		rtmetrics.WarmPoolSize.WithLabelValues("claimed", rt.Name).Set(float64(rt.Status.WarmPool.Claimed))
 	}
+	recordTableMetrics(rt)
 
 	if err := r.Status().Update(ctx, rt); err != nil {
 		return ctrl.Result{}, err
@@ -229,15 +413,39 @@ func (r *RoundTableReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	return ctrl.Result{RequeueAfter: RequeueVerySlow}, nil
 }
 
-// discoverKnights lists Knight CRs matching the RoundTable's knightSelector.
+// recordTableMetrics exports rt's status as its per-table metrics. Domains
+// that no longer have knights drop out of the backlog metric.
+func recordTableMetrics(rt *aiv1alpha1.RoundTable) {
+	rtmetrics.TableKnightsReady.WithLabelValues(rt.Namespace, rt.Name).Set(float64(rt.Status.KnightsReady))
+	rtmetrics.TableKnights.WithLabelValues(rt.Namespace, rt.Name).Set(float64(rt.Status.KnightsTotal))
+	rtmetrics.TableActiveMissions.WithLabelValues(rt.Namespace, rt.Name).Set(float64(rt.Status.ActiveMissions))
+	rtmetrics.TableTasksCompleted.WithLabelValues(rt.Namespace, rt.Name).Set(float64(rt.Status.TotalTasksCompleted))
+	if cost, err := strconv.ParseFloat(rt.Status.TotalCost, 64); err == nil {
+		rtmetrics.TableCostUSD.WithLabelValues(rt.Namespace, rt.Name).Set(cost)
+	}
+	backlogs := make(map[string]int64, len(rt.Status.Domains))
+	for _, d := range rt.Status.Domains {
+		backlogs[d.Domain] = d.Backlog
+	}
+	rtmetrics.SetTableBacklogs(rt.Namespace, rt.Name, backlogs)
+}
+
+// discoverKnights lists Knight CRs matching the RoundTable's knightSelector
+// in the table's namespaces.
 // For ephemeral RoundTables, it returns only knights with the matching round-table label.
 // For non-ephemeral RoundTables, it excludes all ephemeral knights.
 func (r *RoundTableReconciler) discoverKnights(ctx context.Context, rt *aiv1alpha1.RoundTable) ([]aiv1alpha1.Knight, error) {
-	knightList := &aiv1alpha1.KnightList{}
-	listOpts := []client.ListOption{
-		client.InNamespace(rt.Namespace),
+	return tableKnights(ctx, r.Client, rt)
+}
+
+// tableKnights lists the knights belonging to rt, as discoverKnights.
+func tableKnights(ctx context.Context, c client.Reader, rt *aiv1alpha1.RoundTable) ([]aiv1alpha1.Knight, error) {
+	namespaces, err := tableNamespaces(ctx, c, rt)
+	if err != nil {
+		return nil, err
 	}
 
+	var listOpts []client.ListOption
 	if rt.Spec.Ephemeral {
 		// Ephemeral RoundTable: only manage knights that belong to this specific table
 		listOpts = append(listOpts, client.MatchingLabels{
@@ -255,15 +463,30 @@ func (r *RoundTableReconciler) discoverKnights(ctx context.Context, rt *aiv1alph
 		}
 	}
 
-	if err := r.List(ctx, knightList, listOpts...); err != nil {
-		return nil, fmt.Errorf("failed to list knights: %w", err)
+	// With spec.knights and no selector, only the listed knights belong.
+	listedOnly := !rt.Spec.Ephemeral && len(rt.Spec.Knights) > 0 && rt.Spec.KnightSelector == nil
+
+	var knights []aiv1alpha1.Knight
+	for _, ns := range namespaces {
+		knightList := &aiv1alpha1.KnightList{}
+		if !listedOnly {
+			if err := c.List(ctx, knightList, append(listOpts, client.InNamespace(ns))...); err != nil {
+				return nil, fmt.Errorf("failed to list knights in %s: %w", ns, err)
+			}
+		}
+		if !rt.Spec.Ephemeral && len(rt.Spec.Knights) > 0 {
+			if err := addListedKnights(ctx, c, rt, ns, knightList); err != nil {
+				return nil, err
+			}
+		}
+		knights = append(knights, knightList.Items...)
 	}
 
 	// For non-ephemeral RoundTables, filter out any ephemeral knights
 	// (in case knightSelector didn't exclude them)
 	if !rt.Spec.Ephemeral {
-		filtered := make([]aiv1alpha1.Knight, 0, len(knightList.Items))
-		for _, knight := range knightList.Items {
+		filtered := make([]aiv1alpha1.Knight, 0, len(knights))
+		for _, knight := range knights {
 			if knight.Labels[aiv1alpha1.LabelEphemeral] != "true" {
 				filtered = append(filtered, knight)
 			}
@@ -271,7 +494,67 @@ func (r *RoundTableReconciler) discoverKnights(ctx context.Context, rt *aiv1alph
 		return filtered, nil
 	}
 
-	return knightList.Items, nil
+	return knights, nil
+}
+
+// tableNamespaces returns the namespaces rt discovers knights in: its own
+// first, then spec.namespaces and those matching spec.namespaceSelector in
+// name order. A namespace other than rt's own joins only if it opts in with
+// namespaceAdmitsTable, so a table can't take over another team's knights.
+func tableNamespaces(ctx context.Context, c client.Reader, rt *aiv1alpha1.RoundTable) ([]string, error) {
+	if rt.Spec.Ephemeral {
+		return []string{rt.Namespace}, nil
+	}
+	seen := map[string]bool{rt.Namespace: true}
+	var others []string
+	add := func(ns *corev1.Namespace) {
+		if !seen[ns.Name] {
+			seen[ns.Name] = true
+			if namespaceAdmitsTable(ns, rt) {
+				others = append(others, ns.Name)
+			}
+		}
+	}
+	for _, name := range rt.Spec.Namespaces {
+		if seen[name] {
+			continue
+		}
+		ns := &corev1.Namespace{}
+		if err := c.Get(ctx, types.NamespacedName{Name: name}, ns); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get namespace %s: %w", name, err)
+		}
+		add(ns)
+	}
+	if rt.Spec.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(rt.Spec.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespaceSelector: %w", err)
+		}
+		nsList := &corev1.NamespaceList{}
+		if err := c.List(ctx, nsList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+		for i := range nsList.Items {
+			add(&nsList.Items[i])
+		}
+	}
+	sort.Strings(others)
+	return append([]string{rt.Namespace}, others...), nil
+}
+
+// namespaceAdmitsTable reports whether ns lists rt in its
+// AnnotationRoundTables annotation.
+func namespaceAdmitsTable(ns *corev1.Namespace, rt *aiv1alpha1.RoundTable) bool {
+	want := rt.Namespace + "/" + rt.Name
+	for _, table := range strings.Split(ns.Annotations[aiv1alpha1.AnnotationRoundTables], ",") {
+		if strings.TrimSpace(table) == want {
+			return true
+		}
+	}
+	return false
 }
 
 // computePhase determines the RoundTable phase based on knight health and cost.
@@ -295,7 +578,8 @@ func (r *RoundTableReconciler) computePhase(rt *aiv1alpha1.RoundTable, readyCoun
 	return aiv1alpha1.RoundTablePhaseDegraded
 }
 
-// countActiveMissions counts missions referencing this RoundTable that are in active phases.
+// countActiveMissions counts missions referencing this RoundTable that hold
+// one of its maxMissions slots.
 func (r *RoundTableReconciler) countActiveMissions(ctx context.Context, rt *aiv1alpha1.RoundTable) (int32, error) {
 	missionList := &aiv1alpha1.MissionList{}
 	if err := r.List(ctx, missionList, client.InNamespace(rt.Namespace)); err != nil {
@@ -304,60 +588,13 @@ func (r *RoundTableReconciler) countActiveMissions(ctx context.Context, rt *aiv1
 
 	var count int32
 	for _, m := range missionList.Items {
-		if m.Spec.RoundTableRef == rt.Name {
-			switch m.Status.Phase {
-			case aiv1alpha1.MissionPhaseAssembling, aiv1alpha1.MissionPhaseBriefing, aiv1alpha1.MissionPhaseActive:
-				count++
-			}
+		if m.Spec.RoundTableRef == rt.Name && missionHoldsSlot(&m) {
+			count++
 		}
 	}
 	return count, nil
 }
 
-// ensureStreams creates or verifies JetStream streams for this RoundTable.
-func (r *RoundTableReconciler) ensureStreams(ctx context.Context, rt *aiv1alpha1.RoundTable) error {
-	// Get shared NATS client
-	client, err := r.natsClient()
-	if err != nil {
-		return fmt.Errorf("failed to connect to NATS: %w", err)
-	}
-
-	// Map retention policy string to enum
-	retention := natspkg.RetentionWorkQueue
-	switch rt.Spec.NATS.StreamRetention {
-	case "Limits":
-		retention = natspkg.RetentionLimits
-	case "Interest":
-		retention = natspkg.RetentionInterest
-	}
-
-	// Tasks stream
-	tasksSubject := natspkg.StreamSubject(rt.Spec.NATS.SubjectPrefix, "tasks")
-	tasksStreamConfig := natspkg.StreamConfig{
-		Name:      rt.Spec.NATS.TasksStream,
-		Subjects:  []string{tasksSubject},
-		Retention: retention,
-		Storage:   natspkg.StorageFile,
-	}
-	if err := client.CreateStream(tasksStreamConfig); err != nil {
-		return fmt.Errorf("tasks stream: %w", err)
-	}
-
-	// Results stream
-	resultsSubject := natspkg.StreamSubject(rt.Spec.NATS.SubjectPrefix, "results")
-	resultsStreamConfig := natspkg.StreamConfig{
-		Name:      rt.Spec.NATS.ResultsStream,
-		Subjects:  []string{resultsSubject},
-		Retention: retention,
-		Storage:   natspkg.StorageFile,
-	}
-	if err := client.CreateStream(resultsStreamConfig); err != nil {
-		return fmt.Errorf("results stream: %w", err)
-	}
-
-	return nil
-}
-
 // reconcileWarmPool ensures the warm pool has the desired number of pre-warmed knights.
 // It creates new warm knights when the pool is below capacity and recycles idle ones.
 func (r *RoundTableReconciler) reconcileWarmPool(ctx context.Context, rt *aiv1alpha1.RoundTable) error {
@@ -593,8 +830,25 @@ func (r *RoundTableReconciler) createWarmKnight(ctx context.Context, rt *aiv1alp
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *RoundTableReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The herald routes tasks on its own loop, stopped with the manager.
+	r.heraldEvents = make(chan event.GenericEvent, 1)
+	if err := mgr.Add(manager.RunnableFunc(r.runHerald)); err != nil {
+		return err
+	}
+
+	// Re-reconcile tables when one of their knights appears, leaves, or
+	// changes readiness, when a mission referencing them starts or
+	// finishes, and when the herald routes their tasks. Costs and NATS
+	// backlogs are still polled.
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&aiv1alpha1.RoundTable{}).
+		Watches(&aiv1alpha1.Knight{},
+			handler.EnqueueRequestsFromMapFunc(r.tablesForKnight),
+			builder.WithPredicates(knightAvailabilityChanged())).
+		Watches(&aiv1alpha1.Mission{},
+			handler.EnqueueRequestsFromMapFunc(tableForMission),
+			builder.WithPredicates(missionSlotChanged())).
+		WatchesRawSource(source.Channel(r.heraldEvents, &handler.EnqueueRequestForObject{})).
 		Named("roundtable").
 		Complete(r)
 }
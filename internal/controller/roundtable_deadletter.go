@@ -0,0 +1,235 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+const (
+	// deadLetterConsumer is the durable consumer on a RoundTable's
+	// advisory stream.
+	deadLetterConsumer = "roundtable-dead-letter"
+
+	// deadLetterBatch bounds the advisories handled per reconcile.
+	deadLetterBatch = 20
+
+	// deadLetterFetchTimeout bounds the wait for the next advisory.
+	deadLetterFetchTimeout = 500 * time.Millisecond
+)
+
+// deadLetterStreamConfigs returns the dead-letter stream, which keeps the
+// copied tasks, and the stream capturing the tasks stream's MAX_DELIVERIES
+// advisories, and those of its domain streams under the PerDomain
+// topology, until the controller handles them.
+func deadLetterStreamConfigs(rt *aiv1alpha1.RoundTable) ([]natspkg.StreamConfig, error) {
+	maxAge, err := optionalDuration(rt.Spec.NATS.DeadLetter.MaxAge)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deadLetter maxAge: %w", err)
+	}
+	tasks := rt.Spec.NATS.TasksStream
+	advisories := []string{natspkg.MaxDeliveriesAdvisorySubject(tasks)}
+	if rt.Spec.NATS.Topology == aiv1alpha1.NATSTopologyPerDomain {
+		for _, domain := range tableDomains(rt) {
+			advisories = append(advisories, natspkg.MaxDeliveriesAdvisorySubject(domainTasksStream(rt, domain)))
+		}
+	}
+	return []natspkg.StreamConfig{{
+		Name:      natspkg.DeadLetterStreamName(tasks),
+		Subjects:  []string{natspkg.StreamSubject(rt.Spec.NATS.SubjectPrefix, "dlq")},
+		Retention: natspkg.RetentionLimits,
+		Storage:   natspkg.StorageFile,
+		MaxAge:    maxAge,
+	}, {
+		Name:      natspkg.AdvisoryStreamName(tasks),
+		Subjects:  advisories,
+		Retention: natspkg.RetentionWorkQueue,
+		Storage:   natspkg.StorageFile,
+	}}, nil
+}
+
+// reconcileDeadLetters copies the tasks the tasks stream's consumers gave
+// up on into the dead-letter stream, re-drives them when the redrive
+// annotation is set, and records the stream's depth in status.deadLetter.
+func (r *RoundTableReconciler) reconcileDeadLetters(ctx context.Context, rt *aiv1alpha1.RoundTable) error {
+	nc, err := r.natsClient(ctx, rt)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	dlq := natspkg.DeadLetterStreamName(rt.Spec.NATS.TasksStream)
+	status := rt.Status.DeadLetter
+	if status == nil || status.Stream != dlq {
+		status = &aiv1alpha1.RoundTableDeadLetterStatus{Stream: dlq}
+		rt.Status.DeadLetter = status
+	}
+
+	moved, err := r.collectDeadLetters(ctx, rt, nc)
+	if moved > 0 {
+		now := metav1.Now()
+		status.LastDeadLetterAt = &now
+		r.Recorder.Eventf(rt, corev1.EventTypeWarning, "TasksDeadLettered",
+			"%d tasks exhausted their deliveries and were copied to %s", moved, dlq)
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, ok := rt.Annotations[aiv1alpha1.AnnotationRedrive]; ok {
+		redriven, err := redriveDeadLetters(nc, dlq)
+		if err != nil {
+			return err
+		}
+		now := metav1.Now()
+		status.LastRedriveAt = &now
+		r.Recorder.Eventf(rt, corev1.EventTypeNormal, "DeadLettersRedriven",
+			"Republished %d dead-lettered tasks from %s", redriven, dlq)
+		// Patch a copy: the response would overwrite the status computed
+		// so far.
+		cleared := rt.DeepCopy()
+		delete(cleared.Annotations, aiv1alpha1.AnnotationRedrive)
+		if err := r.Patch(ctx, cleared, client.MergeFrom(rt)); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to clear redrive annotation")
+		} else {
+			rt.Annotations = cleared.Annotations
+			rt.ResourceVersion = cleared.ResourceVersion
+		}
+	}
+
+	info, err := nc.StreamInfo(dlq)
+	if err != nil {
+		return err
+	}
+	status.Messages = int64(info.State.Msgs)
+	return nil
+}
+
+// collectDeadLetters handles up to deadLetterBatch MAX_DELIVERIES
+// advisories, copying each poisoned task to the dead-letter stream. It
+// returns the number of tasks copied.
+func (r *RoundTableReconciler) collectDeadLetters(ctx context.Context, rt *aiv1alpha1.RoundTable, nc natspkg.Client) (int, error) {
+	log := logf.FromContext(ctx)
+	advisories := natspkg.AdvisoryStreamName(rt.Spec.NATS.TasksStream)
+	if err := nc.EnsureConsumer(advisories, deadLetterConsumer, natspkg.ConsumerConfig{AckPolicy: natspkg.AckExplicit}); err != nil {
+		return 0, err
+	}
+
+	moved := 0
+	for range deadLetterBatch {
+		msg, err := nc.FetchMessage(advisories, deadLetterConsumer, deadLetterFetchTimeout)
+		if err != nil {
+			return moved, err
+		}
+		if msg == nil {
+			break
+		}
+		copied, err := copyDeadLetter(nc, rt.Spec.NATS.SubjectPrefix, msg.Data)
+		if err != nil {
+			_ = msg.Nak()
+			return moved, err
+		}
+		if err := msg.Ack(); err != nil {
+			log.Error(err, "Failed to ack MAX_DELIVERIES advisory", "subject", msg.Subject)
+		}
+		if copied {
+			moved++
+		}
+	}
+	return moved, nil
+}
+
+// copyDeadLetter copies the task a MAX_DELIVERIES advisory names to the
+// dead-letter stream. It returns false, without error, for an advisory it
+// can't use or a task no longer in the stream.
+func copyDeadLetter(nc natspkg.Client, prefix string, data []byte) (bool, error) {
+	var advisory natspkg.MaxDeliveriesAdvisory
+	if err := json.Unmarshal(data, &advisory); err != nil || advisory.Stream == "" {
+		return false, nil
+	}
+	task, err := nc.GetMessage(advisory.Stream, advisory.StreamSeq)
+	if errors.Is(err, nats.ErrMsgNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	msg := nats.NewMsg(natspkg.DeadLetterSubject(prefix, task.Subject))
+	msg.Data = task.Data
+	for key, values := range task.Header {
+		msg.Header[key] = values
+	}
+	msg.Header.Set(natspkg.HeaderDeadLetterSubject, task.Subject)
+	msg.Header.Set(natspkg.HeaderDeadLetterConsumer, advisory.Consumer)
+	msg.Header.Set(natspkg.HeaderDeadLetterDeliveries, strconv.FormatUint(advisory.Deliveries, 10))
+	return true, nc.PublishMsg(msg)
+}
+
+// redriveDeadLetters republishes every task in the dead-letter stream to
+// its original subject and purges what it republished. The message ID is
+// dropped so the tasks stream doesn't discard the task as a duplicate.
+func redriveDeadLetters(nc natspkg.Client, dlq string) (int, error) {
+	info, err := nc.StreamInfo(dlq)
+	if err != nil {
+		return 0, err
+	}
+	if info.State.Msgs == 0 {
+		return 0, nil
+	}
+
+	redriven := 0
+	for seq := info.State.FirstSeq; seq <= info.State.LastSeq; seq++ {
+		stored, err := nc.GetMessage(dlq, seq)
+		if errors.Is(err, nats.ErrMsgNotFound) {
+			continue
+		}
+		if err != nil {
+			return redriven, err
+		}
+		subject := stored.Header.Get(natspkg.HeaderDeadLetterSubject)
+		if subject == "" {
+			continue
+		}
+		msg := nats.NewMsg(subject)
+		msg.Data = stored.Data
+		for key, values := range stored.Header {
+			msg.Header[key] = values
+		}
+		for _, key := range []string{nats.MsgIdHdr, natspkg.HeaderDeadLetterSubject,
+			natspkg.HeaderDeadLetterConsumer, natspkg.HeaderDeadLetterDeliveries} {
+			msg.Header.Del(key)
+		}
+		if err := nc.PublishMsg(msg); err != nil {
+			return redriven, err
+		}
+		redriven++
+	}
+	return redriven, nc.PurgeStream(dlq, info.State.LastSeq+1)
+}
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestRoundTableDeadLetters(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{
+			SubjectPrefix: "fleet-a",
+			TasksStream:   "fleet_a_tasks",
+			ResultsStream: "fleet_a_results",
+			CreateStreams: true,
+			DeadLetter:    &aiv1alpha1.RoundTableDeadLetter{MaxAge: "168h"},
+		}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt).Build()
+	nc := newFakeNATSClient()
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{Client: c, Recorder: recorder, NATS: natspkg.NewProviderWithClient(nc, logr.Discard())}
+
+	if err := r.ensureStreams(ctx, rt); err != nil {
+		t.Fatalf("ensureStreams() error = %v", err)
+	}
+	advisories := nc.streams["fleet_a_tasks_dlq_advisories"]
+	if len(nc.streams) != 4 || advisories.Subjects[0] != "$JS.EVENT.ADVISORY.CONSUMER.MAX_DELIVERIES.fleet_a_tasks.*" {
+		t.Fatalf("streams = %v, want the dead-letter and advisory streams", nc.streams)
+	}
+
+	nc.stored["fleet_a_tasks"] = []*nats.RawStreamMsg{{
+		Subject:  "fleet-a.tasks.security.galahad",
+		Sequence: 7,
+		Header:   nats.Header{nats.MsgIdHdr: []string{"t1"}},
+		Data:     []byte(`{"taskId":"t1"}`),
+	}}
+	nc.fetched = map[string][]*nats.Msg{deadLetterConsumer: {
+		{Data: []byte(`{"stream":"fleet_a_tasks","consumer":"knight-galahad","stream_seq":7,"deliveries":3}`)},
+		{Data: []byte(`{"stream":"fleet_a_tasks","consumer":"knight-galahad","stream_seq":9,"deliveries":3}`)},
+	}}
+	if err := r.reconcileDeadLetters(ctx, rt); err != nil {
+		t.Fatalf("reconcileDeadLetters() error = %v", err)
+	}
+	if len(nc.sent) != 1 {
+		t.Fatalf("sent = %d messages, want the one task still in the stream", len(nc.sent))
+	}
+	copied := nc.sent[0]
+	if copied.Subject != "fleet-a.dlq.tasks.security.galahad" ||
+		copied.Header.Get(natspkg.HeaderDeadLetterSubject) != "fleet-a.tasks.security.galahad" ||
+		copied.Header.Get(natspkg.HeaderDeadLetterDeliveries) != "3" {
+		t.Errorf("dead letter = %s %v, want the task with its original subject", copied.Subject, copied.Header)
+	}
+	if rt.Status.DeadLetter == nil || rt.Status.DeadLetter.LastDeadLetterAt == nil {
+		t.Errorf("deadLetter status = %+v, want lastDeadLetterAt", rt.Status.DeadLetter)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "1 tasks exhausted") {
+		t.Errorf("events = %v, want TasksDeadLettered", events)
+	}
+
+	// Re-drive what reached the dead-letter stream.
+	nc.stored["fleet_a_tasks_dlq"] = []*nats.RawStreamMsg{{Subject: copied.Subject, Sequence: 1, Header: copied.Header, Data: copied.Data}}
+	annotated := rt.DeepCopy()
+	annotated.Annotations = map[string]string{aiv1alpha1.AnnotationRedrive: "true"}
+	if err := c.Patch(ctx, annotated, client.MergeFrom(rt)); err != nil {
+		t.Fatalf("annotate roundtable: %v", err)
+	}
+	rt.Annotations, rt.ResourceVersion = annotated.Annotations, annotated.ResourceVersion
+	if err := r.reconcileDeadLetters(ctx, rt); err != nil {
+		t.Fatalf("reconcileDeadLetters() error = %v", err)
+	}
+	redriven := nc.sent[len(nc.sent)-1]
+	if redriven.Subject != "fleet-a.tasks.security.galahad" || redriven.Header.Get(nats.MsgIdHdr) != "" ||
+		redriven.Header.Get(natspkg.HeaderDeadLetterSubject) != "" {
+		t.Errorf("re-driven = %s %v, want the original subject without the message ID", redriven.Subject, redriven.Header)
+	}
+	if rt.Status.DeadLetter.Messages != 0 || rt.Status.DeadLetter.LastRedriveAt == nil || len(nc.stored["fleet_a_tasks_dlq"]) != 0 {
+		t.Errorf("deadLetter status = %+v, want the stream purged", rt.Status.DeadLetter)
+	}
+	got := &aiv1alpha1.RoundTable{}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(rt), got); err != nil {
+		t.Fatalf("get roundtable: %v", err)
+	}
+	if _, ok := got.Annotations[aiv1alpha1.AnnotationRedrive]; ok {
+		t.Error("redrive annotation still set, want it removed")
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "Republished 1 dead-lettered tasks") {
+		t.Errorf("events = %v, want DeadLettersRedriven", events)
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// domainSamplePeriod is how old a domain's counter sample must be before
+// its rates are recomputed. The table is reconciled every minute; rates
+// over a single minute would swing with each task.
+const domainSamplePeriod = 5 * time.Minute
+
+// tableBacklogs returns the knights' consumer backlogs, or none when NATS
+// is unreachable.
+func (r *RoundTableReconciler) tableBacklogs(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight) map[types.NamespacedName]uint64 {
+	nc, err := r.natsClient(ctx, rt)
+	if err != nil {
+		logf.FromContext(ctx).V(1).Info("Cannot inspect knight backlogs", "error", err.Error())
+		return nil
+	}
+	return consumerBacklogs(ctx, nc, knights)
+}
+
+// rollupDomains sets status.domains from the knights' statuses and their
+// consumers' backlogs. Rates come from the change
+// in each domain's counters since its previous sample; counters that went
+// down (a knight was deleted) restart the sample without a rate. Each
+// domain's costUSD counts from its baseline, retaken when reset reports the
+// table's cost counter was just reset.
+func rollupDomains(rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight, backlogs map[types.NamespacedName]uint64, now time.Time, reset bool) {
+	type counters struct {
+		tasks int64
+		cost  float64
+	}
+	domains := map[string]*aiv1alpha1.RoundTableDomainStatus{}
+	current := map[string]*counters{}
+	for _, k := range knights {
+		d, ok := domains[k.Spec.Domain]
+		if !ok {
+			d = &aiv1alpha1.RoundTableDomainStatus{Domain: k.Spec.Domain}
+			domains[k.Spec.Domain] = d
+			current[k.Spec.Domain] = &counters{}
+		}
+		d.KnightsTotal++
+		if k.Status.Ready {
+			d.KnightsReady++
+		}
+		d.Backlog += int64(backlogs[knightKey(&k)])
+		current[k.Spec.Domain].tasks += k.Status.TasksCompleted
+		if cost, err := strconv.ParseFloat(k.Status.TotalCost, 64); err == nil {
+			current[k.Spec.Domain].cost += cost
+		}
+	}
+
+	previous := map[string]aiv1alpha1.RoundTableDomainStatus{}
+	for _, d := range rt.Status.Domains {
+		previous[d.Domain] = d
+	}
+	status := make([]aiv1alpha1.RoundTableDomainStatus, 0, len(domains))
+	for name, d := range domains {
+		cur := current[name]
+		prev, ok := previous[name]
+		setDomainCost(d, prev, cur.cost, reset)
+		prevCost, err := strconv.ParseFloat(prev.TotalCost, 64)
+		switch {
+		case !ok || prev.SampledAt == nil || err != nil || cur.tasks < prev.TasksCompleted || cur.cost < prevCost:
+			// No usable sample: start one.
+		case now.Sub(prev.SampledAt.Time) < domainSamplePeriod:
+			d.TasksPerHour, d.CostPerHour = prev.TasksPerHour, prev.CostPerHour
+			d.TasksCompleted, d.TotalCost, d.SampledAt = prev.TasksCompleted, prev.TotalCost, prev.SampledAt
+			status = append(status, *d)
+			continue
+		default:
+			hours := now.Sub(prev.SampledAt.Time).Hours()
+			d.TasksPerHour = fmt.Sprintf("%.2f", float64(cur.tasks-prev.TasksCompleted)/hours)
+			d.CostPerHour = fmt.Sprintf("%.4f", (cur.cost-prevCost)/hours)
+		}
+		d.TasksCompleted = cur.tasks
+		d.TotalCost = fmt.Sprintf("%.4f", cur.cost)
+		d.SampledAt = &metav1.Time{Time: now}
+		status = append(status, *d)
+	}
+	sort.Slice(status, func(i, j int) bool { return status[i].Domain < status[j].Domain })
+	rt.Status.Domains = status
+}
+
+// setDomainCost sets d's cost since the table's last cost reset from the
+// domain's cumulative cost, carrying prev's baseline or, on a reset, taking
+// a new one. Deleted knights take their cost with them, so it never goes
+// below zero.
+func setDomainCost(d *aiv1alpha1.RoundTableDomainStatus, prev aiv1alpha1.RoundTableDomainStatus, cumulative float64, reset bool) {
+	d.CostBaselineUSD = prev.CostBaselineUSD
+	if reset {
+		d.CostBaselineUSD = fmt.Sprintf("%.4f", cumulative)
+	}
+	cost := cumulative
+	if baseline, err := strconv.ParseFloat(d.CostBaselineUSD, 64); err == nil {
+		cost = max(cumulative-baseline, 0)
+	}
+	d.CostUSD = fmt.Sprintf("%.4f", cost)
+}
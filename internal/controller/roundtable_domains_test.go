@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestRollupDomains(t *testing.T) {
+	nc := newFakeNATSClient()
+	nc.consumers = map[string]*nats.ConsumerInfo{
+		"knight-galahad":  {NumPending: 4, NumAckPending: 1},
+		"knight-percival": {NumPending: 2},
+	}
+	r := &RoundTableReconciler{NATS: natspkg.NewProviderWithClient(nc, logr.Discard())}
+	rt := &aiv1alpha1.RoundTable{ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"}}
+	knight := func(name, domain string, ready bool, tasks int64, cost string) aiv1alpha1.Knight {
+		return aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       aiv1alpha1.KnightSpec{Domain: domain},
+			Status:     aiv1alpha1.KnightStatus{Ready: ready, TasksCompleted: tasks, TotalCost: cost},
+		}
+	}
+	start := time.Now()
+
+	knights := []aiv1alpha1.Knight{
+		knight("galahad", "security", true, 10, "1.00"),
+		knight("percival", "security", false, 5, "0.50"),
+		knight("tristan", "research", true, 3, "0.20"),
+	}
+	backlogs := r.tableBacklogs(context.Background(), rt, knights)
+	rollupDomains(rt, knights, backlogs, start, false)
+	if len(rt.Status.Domains) != 2 || rt.Status.Domains[0].Domain != "research" {
+		t.Fatalf("domains = %+v, want research and security", rt.Status.Domains)
+	}
+	security := rt.Status.Domains[1]
+	if security.KnightsTotal != 2 || security.KnightsReady != 1 || security.Backlog != 7 || security.TasksCompleted != 15 {
+		t.Errorf("security = %+v, want 2 knights, 1 ready, backlog 7, 15 tasks", security)
+	}
+	if security.TasksPerHour != "" {
+		t.Errorf("tasksPerHour = %q, want none before a second sample", security.TasksPerHour)
+	}
+
+	// Within the sample period the rates and sample are kept.
+	knights[0].Status.TasksCompleted = 12
+	rollupDomains(rt, knights, backlogs, start.Add(time.Minute), false)
+	if security = rt.Status.Domains[1]; security.TasksCompleted != 15 || !security.SampledAt.Time.Equal(start) {
+		t.Errorf("security = %+v, want the first sample kept", security)
+	}
+
+	knights[0].Status.TasksCompleted = 20
+	knights[0].Status.TotalCost = "1.30"
+	rollupDomains(rt, knights, backlogs, start.Add(30*time.Minute), false)
+	security = rt.Status.Domains[1]
+	if security.TasksPerHour != "20.00" || security.CostPerHour != "0.6000" || security.TasksCompleted != 25 {
+		t.Errorf("security = %+v, want 20 tasks and $0.60 an hour", security)
+	}
+	if research := rt.Status.Domains[0]; research.TasksPerHour != "0.00" || research.Backlog != 0 {
+		t.Errorf("research = %+v, want an idle domain", research)
+	}
+	if security.CostUSD != "1.8000" {
+		t.Errorf("costUSD = %q, want the full $1.80 before a reset", security.CostUSD)
+	}
+
+	// A cost reset takes a baseline; the domain's cost counts from it.
+	rollupDomains(rt, knights, backlogs, start.Add(31*time.Minute), true)
+	if security = rt.Status.Domains[1]; security.CostBaselineUSD != "1.8000" || security.CostUSD != "0.0000" {
+		t.Errorf("security = %+v, want a $1.80 baseline", security)
+	}
+	knights[0].Status.TotalCost = "1.50"
+	rollupDomains(rt, knights, backlogs, start.Add(32*time.Minute), false)
+	if security = rt.Status.Domains[1]; security.CostUSD != "0.2000" {
+		t.Errorf("costUSD = %q, want $0.20 since the reset", security.CostUSD)
+	}
+}
+
+func TestKnightSummaries(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	lastTask := metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	rt := &aiv1alpha1.RoundTable{ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"}}
+	galahad := &aiv1alpha1.Knight{
+		ObjectMeta: metav1.ObjectMeta{Name: "galahad", Namespace: "default"},
+		Spec:       aiv1alpha1.KnightSpec{Domain: "security", Model: "claude-sonnet-4", Skills: []string{"nmap"}},
+		Status:     aiv1alpha1.KnightStatus{Ready: true, LastTaskAt: &lastTask},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt, galahad).
+		WithStatusSubresource(&aiv1alpha1.RoundTable{}, &aiv1alpha1.Knight{}).Build()
+	nc := newFakeNATSClient()
+	nc.consumers = map[string]*nats.ConsumerInfo{"knight-galahad": {NumPending: 3}}
+	r := &RoundTableReconciler{Client: c, Recorder: record.NewFakeRecorder(10), NATS: natspkg.NewProviderWithClient(nc, logr.Discard())}
+
+	key := types.NamespacedName{Name: "fleet", Namespace: "default"}
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if err := c.Get(ctx, key, rt); err != nil {
+		t.Fatalf("get roundtable: %v", err)
+	}
+	if len(rt.Status.Knights) != 1 {
+		t.Fatalf("knights = %+v, want galahad", rt.Status.Knights)
+	}
+	got := rt.Status.Knights[0]
+	if got.Domain != "security" || got.Model != "claude-sonnet-4" || len(got.Skills) != 1 ||
+		got.QueueDepth != 3 || got.LastTaskAt == nil || !got.LastTaskAt.Equal(&lastTask) {
+		t.Errorf("summary = %+v, want galahad's domain, model, skills, queue depth and last task", got)
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+const (
+	// defaultHeartbeatTimeout is federation.heartbeatTimeout's default.
+	defaultHeartbeatTimeout = 90 * time.Second
+
+	// defaultForgetAfter is federation.forgetAfter's default.
+	defaultForgetAfter = 24 * time.Hour
+)
+
+// federatedKnights reads the heartbeats in the table's federation bucket
+// and summarizes each knight in another cluster, ready while it reports
+// ready and its last heartbeat is within heartbeatTimeout. Knights joining
+// the table or dropping out of ready raise ExternalKnightJoined and
+// ExternalKnightLost events.
+func (r *RoundTableReconciler) federatedKnights(ctx context.Context, rt *aiv1alpha1.RoundTable, now time.Time) ([]aiv1alpha1.RoundTableKnightSummary, error) {
+	log := logf.FromContext(ctx)
+	timeout, err := optionalDuration(rt.Spec.Federation.HeartbeatTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("invalid federation heartbeatTimeout: %w", err)
+	}
+	if timeout == 0 {
+		timeout = defaultHeartbeatTimeout
+	}
+	forget, err := optionalDuration(rt.Spec.Federation.ForgetAfter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid federation forgetAfter: %w", err)
+	}
+	if forget == 0 {
+		forget = defaultForgetAfter
+	}
+
+	nc, err := r.natsClient(ctx, rt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	// Heartbeats expire with the bucket's TTL, dropping silent knights.
+	bucket := natspkg.FederationBucketName(rt.Spec.NATS.SubjectPrefix)
+	cfg := natspkg.KeyValueConfig{
+		Bucket:      bucket,
+		Description: fmt.Sprintf("RoundTable %s/%s federation heartbeats", rt.Namespace, rt.Name),
+		TTL:         forget,
+		Storage:     natspkg.StorageFile,
+	}
+	if rt.Spec.NATS.Stream != nil {
+		cfg.Replicas = int(rt.Spec.NATS.Stream.Replicas)
+	}
+	if err := nc.EnsureKeyValue(cfg); err != nil {
+		return nil, err
+	}
+	keys, err := nc.KVKeys(bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := make(map[string]aiv1alpha1.RoundTableKnightSummary)
+	for _, s := range rt.Status.Knights {
+		if s.External {
+			previous[s.Cluster+"/"+s.Name] = s
+		}
+	}
+	external := make([]aiv1alpha1.RoundTableKnightSummary, 0, len(keys))
+	for _, key := range keys {
+		data, err := nc.KVGet(bucket, key)
+		if err != nil {
+			// The heartbeat expired after the keys were listed.
+			log.V(1).Info("Failed to read federation heartbeat", "key", key, "error", err.Error())
+			continue
+		}
+		var hb natspkg.KnightHeartbeat
+		if err := json.Unmarshal(data, &hb); err != nil || hb.Knight == "" || hb.Cluster == "" {
+			log.V(1).Info("Ignoring malformed federation heartbeat", "key", key)
+			continue
+		}
+		age := now.Sub(hb.Timestamp)
+		if age > forget {
+			continue
+		}
+		seen := metav1.NewTime(hb.Timestamp)
+		summary := aiv1alpha1.RoundTableKnightSummary{
+			Name:          hb.Knight,
+			External:      true,
+			Cluster:       hb.Cluster,
+			Domain:        hb.Domain,
+			Model:         hb.Model,
+			Skills:        hb.Skills,
+			Ready:         hb.Ready && age <= timeout,
+			Phase:         aiv1alpha1.KnightPhaseDegraded,
+			LastHeartbeat: &seen,
+		}
+		if summary.Ready {
+			summary.Phase = aiv1alpha1.KnightPhaseReady
+		}
+
+		switch prev, known := previous[hb.Cluster+"/"+hb.Knight]; {
+		case !known:
+			r.Recorder.Eventf(rt, corev1.EventTypeNormal, "ExternalKnightJoined",
+				"Knight %s in cluster %s joined the table", hb.Knight, hb.Cluster)
+		case prev.Ready && !summary.Ready:
+			r.Recorder.Eventf(rt, corev1.EventTypeWarning, "ExternalKnightLost",
+				"Knight %s in cluster %s is not ready; last heartbeat %s ago", hb.Knight, hb.Cluster, age.Round(time.Second))
+		}
+		external = append(external, summary)
+	}
+	sort.Slice(external, func(i, j int) bool {
+		if external[i].Cluster != external[j].Cluster {
+			return external[i].Cluster < external[j].Cluster
+		}
+		return external[i].Name < external[j].Name
+	})
+	return external, nil
+}
+
+// unreachableExternalKnights returns the external members last recorded in
+// status, not ready: while the federation bucket can't be read they stay
+// listed but can't be counted on.
+func unreachableExternalKnights(rt *aiv1alpha1.RoundTable) []aiv1alpha1.RoundTableKnightSummary {
+	var external []aiv1alpha1.RoundTableKnightSummary
+	for _, s := range rt.Status.Knights {
+		if s.External {
+			s.Ready = false
+			s.Phase = aiv1alpha1.KnightPhaseDegraded
+			external = append(external, s)
+		}
+	}
+	return external
+}
@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestFederatedKnights(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{
+			NATS:       aiv1alpha1.RoundTableNATS{SubjectPrefix: "fleet-a"},
+			Federation: &aiv1alpha1.RoundTableFederation{},
+		},
+	}
+	heartbeat := func(knight, cluster string, ready bool, age time.Duration) []byte {
+		data, _ := json.Marshal(natspkg.KnightHeartbeat{
+			Knight: knight, Cluster: cluster, Domain: "security", Ready: ready, Timestamp: now.Add(-age),
+		})
+		return data
+	}
+	nc := newFakeNATSClient()
+	nc.values = map[string]map[string][]byte{"fleet-a-federation": {
+		"edge-1.galahad":  heartbeat("galahad", "edge-1", true, 30*time.Second),
+		"edge-1.gawain":   heartbeat("gawain", "edge-1", true, 5*time.Minute),
+		"edge-2.percival": heartbeat("percival", "edge-2", false, time.Second),
+		"edge-2.tristan":  heartbeat("tristan", "edge-2", true, 48*time.Hour),
+		"edge-2.garbage":  []byte("not json"),
+	}}
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{Recorder: recorder, NATS: natspkg.NewProviderWithClient(nc, logr.Discard())}
+
+	external, err := r.federatedKnights(context.Background(), rt, now)
+	if err != nil {
+		t.Fatalf("federatedKnights() error = %v", err)
+	}
+	var got []string
+	for _, s := range external {
+		if !s.External || s.LastHeartbeat == nil {
+			t.Errorf("%s = %+v, want an external member with its heartbeat", s.Name, s)
+		}
+		got = append(got, s.Cluster+"/"+s.Name+"="+string(s.Phase))
+	}
+	if want := "edge-1/galahad=Ready edge-1/gawain=Degraded edge-2/percival=Degraded"; strings.Join(got, " ") != want {
+		t.Errorf("external knights = %v, want %s", got, want)
+	}
+	if cfg := nc.buckets["fleet-a-federation"]; cfg.TTL != defaultForgetAfter {
+		t.Errorf("federation bucket TTL = %s, want %s", cfg.TTL, defaultForgetAfter)
+	}
+	if events := drainEvents(recorder); len(events) != 3 || !strings.Contains(events[0], "ExternalKnightJoined") {
+		t.Errorf("events = %v, want three ExternalKnightJoined", events)
+	}
+
+	// galahad goes silent.
+	rt.Status.Knights = external
+	nc.values["fleet-a-federation"]["edge-1.galahad"] = heartbeat("galahad", "edge-1", true, 2*time.Minute)
+	if _, err := r.federatedKnights(context.Background(), rt, now); err != nil {
+		t.Fatalf("federatedKnights() error = %v", err)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "Knight galahad in cluster edge-1 is not ready") {
+		t.Errorf("events = %v, want ExternalKnightLost for galahad", events)
+	}
+
+	for _, s := range unreachableExternalKnights(rt) {
+		if s.Ready {
+			t.Errorf("%s ready while the bucket is unreachable, want not ready", s.Name)
+		}
+	}
+}
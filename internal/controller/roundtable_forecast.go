@@ -0,0 +1,161 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/notify"
+)
+
+const (
+	// costSamplePeriod is how often status.costHistory is sampled, and how
+	// old its oldest sample must be before a burn rate is computed.
+	costSamplePeriod = 15 * time.Minute
+
+	// costHistoryLength keeps a day of costHistory samples.
+	costHistoryLength = 96
+)
+
+// forecastCost samples the table's cost since its last reset into
+// status.costHistory and computes the burn rate since the oldest sample.
+// With a cost reset schedule it projects the cost at the next reset and,
+// with a cost budget, sets the BudgetAtRisk condition from the forecast.
+// When the condition turns True a BudgetAtRisk event is recorded and the
+// policies' budgetAlerts are sent.
+func (r *RoundTableReconciler) forecastCost(ctx context.Context, rt *aiv1alpha1.RoundTable, cost float64, now time.Time) {
+	history := rt.Status.CostHistory
+	if n := len(history); n > 0 && parseCostUSD(history[n-1].CostUSD) > cost {
+		// The cost went down (a reset, or a knight was deleted): start over.
+		history = nil
+	}
+	if n := len(history); n == 0 || now.Sub(history[n-1].Time.Time) >= costSamplePeriod {
+		history = append(history, aiv1alpha1.RoundTableCostSample{Time: metav1.NewTime(now), CostUSD: formatCostUSD(cost)})
+		if len(history) > costHistoryLength {
+			history = history[len(history)-costHistoryLength:]
+		}
+	}
+	rt.Status.CostHistory = history
+	rt.Status.BurnRatePerHour = ""
+	rt.Status.ForecastCost = ""
+
+	elapsed := now.Sub(history[0].Time.Time)
+	if elapsed < costSamplePeriod {
+		meta.RemoveStatusCondition(&rt.Status.Conditions, aiv1alpha1.ConditionRoundTableBudgetAtRisk)
+		return
+	}
+	rate := max(cost-parseCostUSD(history[0].CostUSD), 0) / elapsed.Hours()
+	rt.Status.BurnRatePerHour = formatCostUSD(rate)
+
+	next, err := nextCostReset(rt)
+	if err != nil || next.IsZero() {
+		meta.RemoveStatusCondition(&rt.Status.Conditions, aiv1alpha1.ConditionRoundTableBudgetAtRisk)
+		return
+	}
+	forecast := cost + rate*max(next.Sub(now).Hours(), 0)
+	rt.Status.ForecastCost = formatCostUSD(forecast)
+
+	budget := parseCostUSD(rt.Spec.Policies.CostBudgetUSD)
+	if budget <= 0 {
+		meta.RemoveStatusCondition(&rt.Status.Conditions, aiv1alpha1.ConditionRoundTableBudgetAtRisk)
+		return
+	}
+	wasAtRisk := meta.IsStatusConditionTrue(rt.Status.Conditions, aiv1alpha1.ConditionRoundTableBudgetAtRisk)
+	if forecast <= budget {
+		meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
+			Type:               aiv1alpha1.ConditionRoundTableBudgetAtRisk,
+			Status:             metav1.ConditionFalse,
+			Reason:             aiv1alpha1.ReasonForecastWithinBudget,
+			Message:            fmt.Sprintf("Projected $%.2f of the $%.2f budget by the reset at %s", forecast, budget, next.UTC().Format(time.RFC3339)),
+			ObservedGeneration: rt.Generation,
+		})
+		return
+	}
+
+	msg := fmt.Sprintf("Burning $%.2f/h: projected $%.2f by the reset at %s, over the $%.2f budget",
+		rate, forecast, next.UTC().Format(time.RFC3339), budget)
+	if cost < budget && rate > 0 {
+		exhausted := now.Add(time.Duration((budget - cost) / rate * float64(time.Hour)))
+		msg += fmt.Sprintf("; exhausted around %s", exhausted.UTC().Format(time.RFC3339))
+	}
+	meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionRoundTableBudgetAtRisk,
+		Status:             metav1.ConditionTrue,
+		Reason:             aiv1alpha1.ReasonBurnRateExceedsBudget,
+		Message:            msg,
+		ObservedGeneration: rt.Generation,
+	})
+	if !wasAtRisk {
+		r.Recorder.Event(rt, corev1.EventTypeWarning, "BudgetAtRisk", msg)
+		r.sendBudgetAlerts(ctx, rt, msg)
+	}
+}
+
+// sendBudgetAlerts posts msg to every policies.budgetAlerts channel.
+// Delivery is best-effort: failures only produce warning Events.
+func (r *RoundTableReconciler) sendBudgetAlerts(ctx context.Context, rt *aiv1alpha1.RoundTable, msg string) {
+	summary := fmt.Sprintf("RoundTable %s/%s budget at risk\n%s", rt.Namespace, rt.Name, msg)
+	for i, n := range rt.Spec.Policies.BudgetAlerts {
+		if err := r.sendBudgetAlert(ctx, rt, n, summary); err != nil {
+			logf.FromContext(ctx).Error(err, "Budget alert failed", "alert", i, "type", n.Type)
+			r.Recorder.Eventf(rt, corev1.EventTypeWarning, "BudgetAlertFailed",
+				"Budget alert %d (%s) failed: %v", i, n.Type, err)
+		}
+	}
+}
+
+// sendBudgetAlert delivers the summary to one channel. The URL is read
+// from its Secret and never logged.
+func (r *RoundTableReconciler) sendBudgetAlert(ctx context.Context, rt *aiv1alpha1.RoundTable, n aiv1alpha1.BudgetAlertNotification, summary string) error {
+	url, err := secretKeyValue(ctx, r.Client, rt.Namespace, &n.URLSecretRef)
+	if err != nil {
+		return err
+	}
+	url = strings.TrimSpace(url)
+	if r.Notify == nil || !r.Notify.URLAllowed(url) {
+		return fmt.Errorf("URL in secret %q does not match the operator's allowed URL prefixes", n.URLSecretRef.Name)
+	}
+	switch n.Type {
+	case aiv1alpha1.NotificationChannelSlack:
+		return r.Notify.PostSlack(ctx, url, summary)
+	case aiv1alpha1.NotificationChannelDiscord:
+		return r.Notify.PostDiscord(ctx, url, summary)
+	default:
+		cond := meta.FindStatusCondition(rt.Status.Conditions, aiv1alpha1.ConditionRoundTableBudgetAtRisk)
+		output, truncated := notify.Truncate(summary)
+		return r.Notify.Deliver(ctx, url, "", notify.Payload{
+			Schema:         notify.SchemaV1,
+			Kind:           "RoundTable",
+			Name:           rt.Name,
+			Namespace:      rt.Namespace,
+			UID:            string(rt.UID),
+			Phase:          string(rt.Status.Phase),
+			Output:         output,
+			Truncated:      truncated,
+			IdempotencyKey: string(rt.UID) + "/" + aiv1alpha1.ConditionRoundTableBudgetAtRisk + "/" + cond.LastTransitionTime.UTC().Format(time.RFC3339),
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/notify"
+)
+
+func TestForecastCost(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+
+	var mu sync.Mutex
+	var alerts []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		text, _ := body["text"].(string)
+		mu.Lock()
+		alerts = append(alerts, text)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "alerts", Namespace: "default"},
+		Data:       map[string][]byte{"slack": []byte(srv.URL + "/slack")},
+	}
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build(),
+		Recorder: recorder,
+		Notify:   notify.NewNotifier([]string{srv.URL}),
+	}
+	// Created on the 1st; the cost resets monthly.
+	created := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default", CreationTimestamp: metav1.NewTime(created)},
+		Spec: aiv1alpha1.RoundTableSpec{Policies: &aiv1alpha1.RoundTablePolicies{
+			CostBudgetUSD:     "100.00",
+			CostResetSchedule: "@monthly",
+			BudgetAlerts: []aiv1alpha1.BudgetAlertNotification{{
+				Type: aiv1alpha1.NotificationChannelSlack,
+				URLSecretRef: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "alerts"}, Key: "slack",
+				},
+			}},
+		}},
+	}
+	ctx := context.Background()
+	start := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	r.forecastCost(ctx, rt, 10, start)
+	if len(rt.Status.CostHistory) != 1 || rt.Status.ForecastCost != "" {
+		t.Fatalf("costHistory = %v, forecast %q, want one sample and no forecast yet", rt.Status.CostHistory, rt.Status.ForecastCost)
+	}
+	if cond := meta.FindStatusCondition(rt.Status.Conditions, aiv1alpha1.ConditionRoundTableBudgetAtRisk); cond != nil {
+		t.Errorf("BudgetAtRisk = %+v, want none without a forecast", cond)
+	}
+
+	// $0.05 an hour: $36.40 by April 1st.
+	r.forecastCost(ctx, rt, 10.05, start.Add(time.Hour))
+	if rt.Status.BurnRatePerHour != "0.0500" || rt.Status.ForecastCost != "36.4000" {
+		t.Errorf("burnRate = %q, forecast %q, want $0.05/h and $36.40", rt.Status.BurnRatePerHour, rt.Status.ForecastCost)
+	}
+	cond := meta.FindStatusCondition(rt.Status.Conditions, aiv1alpha1.ConditionRoundTableBudgetAtRisk)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != aiv1alpha1.ReasonForecastWithinBudget {
+		t.Errorf("BudgetAtRisk = %+v, want ForecastWithinBudget", cond)
+	}
+
+	// $1 an hour runs out well before the reset.
+	r.forecastCost(ctx, rt, 12, start.Add(2*time.Hour))
+	cond = meta.FindStatusCondition(rt.Status.Conditions, aiv1alpha1.ConditionRoundTableBudgetAtRisk)
+	if cond == nil || cond.Status != metav1.ConditionTrue || !strings.Contains(cond.Message, "exhausted around") {
+		t.Fatalf("BudgetAtRisk = %+v, want BurnRateExceedsBudget", cond)
+	}
+	r.forecastCost(ctx, rt, 12.5, start.Add(2*time.Hour+time.Minute))
+	if len(rt.Status.CostHistory) != 3 {
+		t.Errorf("costHistory = %v, want no sample within the sample period", rt.Status.CostHistory)
+	}
+	mu.Lock()
+	if len(alerts) != 1 || !strings.Contains(alerts[0], "RoundTable default/fleet budget at risk") {
+		t.Errorf("alerts = %q, want one alert", alerts)
+	}
+	mu.Unlock()
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "BudgetAtRisk") {
+		t.Errorf("events = %v, want one BudgetAtRisk", events)
+	}
+
+	// A reset brings the cost down and restarts the history.
+	r.forecastCost(ctx, rt, 0, start.Add(3*time.Hour))
+	if len(rt.Status.CostHistory) != 1 || rt.Status.ForecastCost != "" {
+		t.Errorf("costHistory = %v, forecast %q, want a fresh history", rt.Status.CostHistory, rt.Status.ForecastCost)
+	}
+}
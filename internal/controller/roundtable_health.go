@@ -0,0 +1,133 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+const (
+	// defaultMaxRTT is nats.health.maxRTT's default.
+	defaultMaxRTT = 500 * time.Millisecond
+
+	// defaultMaxConsumerLag is nats.health.maxConsumerLag's default.
+	defaultMaxConsumerLag = 1000
+)
+
+// probeNATS checks the table's NATS server and its knights' consumers,
+// records the result in status.natsHealth and the NATSHealthy condition,
+// and reports whether NATS is healthy. Without a NATS provider there is
+// nothing to probe.
+func (r *RoundTableReconciler) probeNATS(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight) bool {
+	if r.NATS == nil {
+		return true
+	}
+	now := metav1.Now()
+	rt.Status.NATSHealth = &aiv1alpha1.RoundTableNATSHealthStatus{CheckedAt: &now}
+	reason, message := r.natsHealth(ctx, rt, knights, rt.Status.NATSHealth)
+
+	healthy := reason == aiv1alpha1.ReasonNATSResponsive
+	status := metav1.ConditionTrue
+	if !healthy {
+		status = metav1.ConditionFalse
+	}
+	prev := meta.FindStatusCondition(rt.Status.Conditions, aiv1alpha1.ConditionNATSHealthy)
+	switch {
+	case !healthy && (prev == nil || prev.Status == metav1.ConditionTrue):
+		r.Recorder.Event(rt, corev1.EventTypeWarning, "NATSUnhealthy", message)
+	case healthy && prev != nil && prev.Status == metav1.ConditionFalse:
+		r.Recorder.Event(rt, corev1.EventTypeNormal, "NATSRecovered", message)
+	}
+	meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
+		Type:               aiv1alpha1.ConditionNATSHealthy,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: rt.Generation,
+	})
+	return healthy
+}
+
+// natsHealth measures the round trip to the table's NATS server, checks
+// its tasks stream, and inspects every knight consumer the knights
+// recorded, filling in health. It returns the NATSHealthy reason and
+// message.
+func (r *RoundTableReconciler) natsHealth(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight, health *aiv1alpha1.RoundTableNATSHealthStatus) (string, string) {
+	log := logf.FromContext(ctx)
+	maxRTT, maxLag := defaultMaxRTT, int64(defaultMaxConsumerLag)
+	if h := rt.Spec.NATS.Health; h != nil {
+		if d, err := optionalDuration(h.MaxRTT); err != nil {
+			log.Error(err, "Invalid nats.health.maxRTT, using the default")
+		} else if d > 0 {
+			maxRTT = d
+		}
+		if h.MaxConsumerLag > 0 {
+			maxLag = h.MaxConsumerLag
+		}
+	}
+
+	nc, err := r.natsClient(ctx, rt)
+	if err != nil {
+		return aiv1alpha1.ReasonNATSUnreachable, fmt.Sprintf("Cannot connect to NATS: %v", err)
+	}
+	rtt, err := nc.RTT()
+	if err != nil {
+		return aiv1alpha1.ReasonNATSUnreachable, fmt.Sprintf("NATS did not answer a ping: %v", err)
+	}
+	health.RTT = rtt.Round(time.Microsecond).String()
+	if _, err := nc.StreamInfo(rt.Spec.NATS.TasksStream); err != nil {
+		return aiv1alpha1.ReasonNATSUnreachable, fmt.Sprintf("JetStream tasks stream unavailable: %v", err)
+	}
+
+	var consumerErr error
+	lagging := ""
+	for _, k := range knights {
+		if k.Status.NATSConsumer == "" {
+			continue
+		}
+		info, err := nc.ConsumerInfo(knightStream(&k), k.Status.NATSConsumer)
+		if err != nil {
+			health.ConsumerErrors++
+			if consumerErr == nil {
+				consumerErr = fmt.Errorf("knight %s: %w", k.Name, err)
+			}
+			continue
+		}
+		if lag := int64(info.NumPending); lag > health.MaxConsumerLag {
+			health.MaxConsumerLag, lagging = lag, k.Name
+		}
+	}
+
+	switch {
+	case rtt > maxRTT:
+		return aiv1alpha1.ReasonNATSSlow, fmt.Sprintf("NATS round trip %s exceeds %s", health.RTT, maxRTT)
+	case consumerErr != nil:
+		return aiv1alpha1.ReasonConsumerErrors, fmt.Sprintf("%d knight consumers can't be inspected: %v", health.ConsumerErrors, consumerErr)
+	case health.MaxConsumerLag > maxLag:
+		return aiv1alpha1.ReasonConsumersLagging, fmt.Sprintf("Knight %s has %d tasks pending, over %d", lagging, health.MaxConsumerLag, maxLag)
+	}
+	return aiv1alpha1.ReasonNATSResponsive, fmt.Sprintf("NATS round trip %s, largest consumer lag %d", health.RTT, health.MaxConsumerLag)
+}
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestProbeNATS(t *testing.T) {
+	ctx := context.Background()
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{
+			SubjectPrefix: "fleet-a",
+			TasksStream:   "fleet_a_tasks",
+			Health:        &aiv1alpha1.RoundTableNATSHealth{MaxConsumerLag: 10},
+		}},
+	}
+	knight := func(name, consumer string) aiv1alpha1.Knight {
+		return aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       aiv1alpha1.KnightSpec{NATS: aiv1alpha1.KnightNATS{Stream: "fleet_a_tasks"}},
+			Status:     aiv1alpha1.KnightStatus{NATSConsumer: consumer},
+		}
+	}
+	knights := []aiv1alpha1.Knight{knight("galahad", "knight-galahad"), knight("percival", "knight-percival"), knight("kay", "")}
+	nc := newFakeNATSClient()
+	nc.rtt = 2 * time.Millisecond
+	nc.consumers = map[string]*nats.ConsumerInfo{
+		"knight-galahad":  {NumPending: 4},
+		"knight-percival": {NumPending: 1},
+	}
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{Recorder: recorder, NATS: natspkg.NewProviderWithClient(nc, logr.Discard())}
+	probe := func(wantReason string) {
+		t.Helper()
+		healthy := r.probeNATS(ctx, rt, knights)
+		cond := meta.FindStatusCondition(rt.Status.Conditions, aiv1alpha1.ConditionNATSHealthy)
+		if cond == nil || cond.Reason != wantReason || healthy != (wantReason == aiv1alpha1.ReasonNATSResponsive) {
+			t.Fatalf("NATSHealthy = %+v, healthy %t, want %s", cond, healthy, wantReason)
+		}
+	}
+
+	probe(aiv1alpha1.ReasonNATSUnreachable)
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "tasks stream unavailable") {
+		t.Errorf("events = %v, want NATSUnhealthy", events)
+	}
+
+	if err := nc.CreateStream(natspkg.StreamConfig{Name: "fleet_a_tasks", Subjects: []string{"fleet-a.tasks.>"}}); err != nil {
+		t.Fatalf("CreateStream() error = %v", err)
+	}
+	probe(aiv1alpha1.ReasonNATSResponsive)
+	if health := rt.Status.NATSHealth; health.RTT != "2ms" || health.MaxConsumerLag != 4 || health.ConsumerErrors != 0 {
+		t.Errorf("natsHealth = %+v, want a 2ms round trip and lag 4", health)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "NATSRecovered") {
+		t.Errorf("events = %v, want NATSRecovered", events)
+	}
+
+	nc.consumers["knight-galahad"].NumPending = 40
+	probe(aiv1alpha1.ReasonConsumersLagging)
+	delete(nc.consumers, "knight-percival")
+	probe(aiv1alpha1.ReasonConsumerErrors)
+	nc.rtt = time.Second
+	probe(aiv1alpha1.ReasonNATSSlow)
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "Knight galahad has 40 tasks pending") {
+		t.Errorf("events = %v, want one NATSUnhealthy while it stays unhealthy", events)
+	}
+}
@@ -0,0 +1,297 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+const (
+	// heraldConsumer is the durable consumer on a RoundTable's tasks
+	// stream for {subjectPrefix}.tasks.any.
+	heraldConsumer = "roundtable-herald"
+
+	// heraldBatch bounds the tasks routed per table before the herald
+	// moves on to the next.
+	heraldBatch = 50
+
+	// heraldFetchTimeout bounds the wait for the next task.
+	heraldFetchTimeout = 500 * time.Millisecond
+
+	// heraldIdle is how long the herald waits when no table had a task.
+	heraldIdle = RequeueFast
+)
+
+// heraldProgress is what the herald has done for a table since its last
+// reconcile recorded it in status.herald.
+type heraldProgress struct {
+	routed       int64
+	unroutable   int
+	lastRoutedAt time.Time
+	err          error
+}
+
+// runHerald routes the tasks published to each herald table's
+// {subjectPrefix}.tasks.any until ctx is done. It runs beside the
+// reconciler, so a task waits for a knight's consumer rather than the
+// table's next reconcile.
+func (r *RoundTableReconciler) runHerald(ctx context.Context) error {
+	for {
+		if r.routeHeraldTables(ctx) == 0 {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(heraldIdle):
+			}
+		} else if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// routeHeraldTables makes one routing pass over the tables with a herald,
+// recording each table's progress and requeueing it. It returns the number
+// of tasks fetched.
+func (r *RoundTableReconciler) routeHeraldTables(ctx context.Context) int {
+	log := logf.FromContext(ctx)
+	tables := &aiv1alpha1.RoundTableList{}
+	if err := r.List(ctx, tables); err != nil {
+		log.Error(err, "Failed to list RoundTables for the herald")
+		return 0
+	}
+	fetched := 0
+	for i := range tables.Items {
+		rt := &tables.Items[i]
+		if rt.Spec.Herald == nil || rt.Spec.Suspended || rt.DeletionTimestamp != nil {
+			continue
+		}
+		routed, unroutable, err := r.routeHerald(ctx, rt)
+		fetched += routed + unroutable
+		if routed == 0 && unroutable == 0 && err == nil {
+			continue
+		}
+		key := client.ObjectKeyFromObject(rt)
+		r.heraldMu.Lock()
+		if r.heraldProgress == nil {
+			r.heraldProgress = map[types.NamespacedName]*heraldProgress{}
+		}
+		progress := r.heraldProgress[key]
+		if progress == nil {
+			progress = &heraldProgress{}
+			r.heraldProgress[key] = progress
+		}
+		if routed > 0 {
+			progress.routed += int64(routed)
+			progress.lastRoutedAt = time.Now()
+		}
+		progress.unroutable += unroutable
+		progress.err = err
+		r.heraldMu.Unlock()
+		// Dropping the requeue when one is already waiting loses nothing:
+		// the progress stays until a reconcile takes it.
+		select {
+		case r.heraldEvents <- event.GenericEvent{Object: rt}:
+		default:
+		}
+	}
+	return fetched
+}
+
+// routeHerald routes a batch of rt's waiting tasks to its knights.
+func (r *RoundTableReconciler) routeHerald(ctx context.Context, rt *aiv1alpha1.RoundTable) (int, int, error) {
+	retryAfter, err := heraldRetryAfter(rt)
+	if err != nil {
+		return 0, 0, err
+	}
+	knights, err := r.discoverKnights(ctx, rt)
+	if err != nil {
+		return 0, 0, err
+	}
+	nc, err := r.natsClient(ctx, rt)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	return routeTasks(ctx, nc, rt, knights, retryAfter)
+}
+
+// heraldRetryAfter returns how long an unroutable task waits before it is
+// redelivered.
+func heraldRetryAfter(rt *aiv1alpha1.RoundTable) (time.Duration, error) {
+	retryAfter, err := optionalDuration(rt.Spec.Herald.RetryAfter)
+	if err != nil {
+		return 0, fmt.Errorf("invalid herald retryAfter: %w", err)
+	}
+	if retryAfter == 0 {
+		retryAfter = 30 * time.Second
+	}
+	return retryAfter, nil
+}
+
+// reconcileHerald records in status.herald the tasks the herald routed
+// since the last reconcile and the tasks still waiting on
+// {subjectPrefix}.tasks.any.
+func (r *RoundTableReconciler) reconcileHerald(ctx context.Context, rt *aiv1alpha1.RoundTable) error {
+	retryAfter, err := heraldRetryAfter(rt)
+	if err != nil {
+		return err
+	}
+	if rt.Status.Herald == nil {
+		rt.Status.Herald = &aiv1alpha1.RoundTableHeraldStatus{}
+	}
+	status := rt.Status.Herald
+
+	r.heraldMu.Lock()
+	progress := r.heraldProgress[client.ObjectKeyFromObject(rt)]
+	delete(r.heraldProgress, client.ObjectKeyFromObject(rt))
+	r.heraldMu.Unlock()
+	if progress != nil {
+		if progress.routed > 0 {
+			status.Routed += progress.routed
+			status.LastRoutedAt = &metav1.Time{Time: progress.lastRoutedAt}
+		}
+		if progress.unroutable > 0 {
+			r.Recorder.Eventf(rt, corev1.EventTypeWarning, "TasksUnroutable",
+				"%d tasks matched no ready knight and will be retried in %s", progress.unroutable, retryAfter)
+		}
+		if progress.err != nil {
+			return progress.err
+		}
+	}
+
+	nc, err := r.natsClient(ctx, rt)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	info, err := nc.ConsumerInfo(rt.Spec.NATS.TasksStream, heraldConsumer)
+	if err != nil {
+		return err
+	}
+	status.Pending = int64(info.NumPending)
+	return nil
+}
+
+// routeTasks republishes up to heraldBatch tasks from
+// {subjectPrefix}.tasks.any to a knight's task subject. Tasks no knight
+// can take are redelivered after retryAfter. It returns the number of
+// tasks routed and left unroutable.
+func routeTasks(ctx context.Context, nc natspkg.Client, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight, retryAfter time.Duration) (int, int, error) {
+	log := logf.FromContext(ctx)
+	prefix, stream := rt.Spec.NATS.SubjectPrefix, rt.Spec.NATS.TasksStream
+	if err := nc.EnsureConsumer(stream, heraldConsumer, natspkg.ConsumerConfig{
+		FilterSubject: natspkg.AnyTaskSubject(prefix),
+		AckPolicy:     natspkg.AckExplicit,
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	var ready []aiv1alpha1.Knight
+	for _, k := range knights {
+		if k.Status.Ready && !k.Spec.Suspended {
+			ready = append(ready, k)
+		}
+	}
+	// Count what this pass routes, so a batch spreads across knights.
+	backlogs := consumerBacklogs(ctx, nc, ready)
+
+	routed, unroutable := 0, 0
+	for range heraldBatch {
+		msg, err := nc.FetchMessage(stream, heraldConsumer, heraldFetchTimeout)
+		if err != nil {
+			return routed, unroutable, err
+		}
+		if msg == nil {
+			break
+		}
+		knight := heraldKnight(ready, backlogs, msg.Header)
+		if knight == nil {
+			unroutable++
+			if err := msg.NakWithDelay(retryAfter); err != nil {
+				log.Error(err, "Failed to nak unroutable task", "subject", msg.Subject)
+			}
+			continue
+		}
+		if err := nc.PublishMsg(heraldMessage(prefix, knight, msg)); err != nil {
+			_ = msg.Nak()
+			return routed, unroutable, err
+		}
+		if err := msg.Ack(); err != nil {
+			log.Error(err, "Failed to ack routed task", "subject", msg.Subject)
+		}
+		if backlog, ok := backlogs[knightKey(knight)]; ok {
+			backlogs[knightKey(knight)] = backlog + 1
+		}
+		routed++
+	}
+	return routed, unroutable, nil
+}
+
+// heraldKnight picks the knight for a task: among the ready knights in the
+// task's Roundtable-Domain holding every skill in its Roundtable-Skills,
+// the one with the smallest backlog. It returns nil when none matches.
+func heraldKnight(ready []aiv1alpha1.Knight, backlogs map[types.NamespacedName]uint64, header nats.Header) *aiv1alpha1.Knight {
+	domain := header.Get(natspkg.HeaderRouteDomain)
+	var skills []string
+	for _, skill := range strings.Split(header.Get(natspkg.HeaderRouteSkills), ",") {
+		if skill = strings.TrimSpace(skill); skill != "" {
+			skills = append(skills, skill)
+		}
+	}
+
+	var matching []aiv1alpha1.Knight
+	for _, k := range ready {
+		if domain != "" && k.Spec.Domain != domain {
+			continue
+		}
+		if !slices.ContainsFunc(skills, func(s string) bool { return !slices.Contains(k.Spec.Skills, s) }) {
+			matching = append(matching, k)
+		}
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+	return &matching[leastLoaded(matching, backlogs)]
+}
+
+// heraldMessage copies a task to the knight's task subject. Its message ID
+// gets a suffix: the tasks stream would discard the original ID as a
+// duplicate, but still drops a second copy if the ack is lost.
+func heraldMessage(prefix string, knight *aiv1alpha1.Knight, task *nats.Msg) *nats.Msg {
+	msg := nats.NewMsg(natspkg.TaskSubject(prefix, knight.Spec.Domain, knight.Name))
+	msg.Data = task.Data
+	for key, values := range task.Header {
+		msg.Header[key] = values
+	}
+	if id := task.Header.Get(nats.MsgIdHdr); id != "" {
+		msg.Header.Set(nats.MsgIdHdr, id+".routed")
+	}
+	return msg
+}
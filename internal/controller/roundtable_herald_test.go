@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/nats-io/nats.go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestRoundTableHerald(t *testing.T) {
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{
+			NATS:   aiv1alpha1.RoundTableNATS{SubjectPrefix: "fleet-a", TasksStream: "fleet_a_tasks"},
+			Herald: &aiv1alpha1.RoundTableHerald{},
+		},
+	}
+	knight := func(name, domain string, ready bool, skills ...string) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: aiv1alpha1.KnightSpec{
+				Domain: domain,
+				Skills: skills,
+				NATS:   aiv1alpha1.KnightNATS{Stream: "fleet_a_tasks"},
+			},
+			Status: aiv1alpha1.KnightStatus{Ready: ready},
+		}
+	}
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt,
+		knight("galahad", "security", true, "nmap", "shodan"),
+		knight("gawain", "security", true, "nmap"),
+		knight("percival", "research", true),
+		knight("tristan", "research", false, "arxiv"),
+	).Build()
+	nc := newFakeNATSClient()
+	nc.consumers = map[string]*nats.ConsumerInfo{
+		natspkg.KnightConsumerName("galahad"):  {NumPending: 1},
+		natspkg.KnightConsumerName("gawain"):   {NumPending: 0},
+		natspkg.KnightConsumerName("percival"): {NumPending: 4},
+		heraldConsumer:                         {NumPending: 3},
+	}
+	task := func(id string, header map[string]string) *nats.Msg {
+		msg := nats.NewMsg(natspkg.AnyTaskSubject("fleet-a"))
+		msg.Header.Set(nats.MsgIdHdr, id)
+		for key, value := range header {
+			msg.Header.Set(key, value)
+		}
+		return msg
+	}
+	nc.fetched = map[string][]*nats.Msg{heraldConsumer: {
+		task("t1", map[string]string{natspkg.HeaderRouteDomain: "security"}),
+		task("t2", map[string]string{natspkg.HeaderRouteDomain: "security"}),
+		task("t3", map[string]string{natspkg.HeaderRouteSkills: "shodan, nmap"}),
+		task("t4", nil),
+		task("t5", map[string]string{natspkg.HeaderRouteSkills: "arxiv"}),
+	}}
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{
+		Client:       c,
+		Recorder:     recorder,
+		NATS:         natspkg.NewProviderWithClient(nc, logr.Discard()),
+		heraldEvents: make(chan event.GenericEvent, 1),
+	}
+
+	if fetched := r.routeHeraldTables(context.Background()); fetched != 5 {
+		t.Errorf("routeHeraldTables() = %d, want 5 tasks fetched", fetched)
+	}
+	select {
+	case e := <-r.heraldEvents:
+		if e.Object.GetName() != "fleet" {
+			t.Errorf("requeued %s, want fleet", e.Object.GetName())
+		}
+	default:
+		t.Error("routeHeraldTables() didn't requeue the table")
+	}
+	if err := r.reconcileHerald(context.Background(), rt); err != nil {
+		t.Fatalf("reconcileHerald() error = %v", err)
+	}
+	var got []string
+	for _, msg := range nc.sent {
+		got = append(got, msg.Header.Get(nats.MsgIdHdr)+"->"+msg.Subject)
+	}
+	want := []string{
+		"t1.routed->fleet-a.tasks.security.gawain",
+		"t2.routed->fleet-a.tasks.security.galahad",
+		"t3.routed->fleet-a.tasks.security.galahad",
+		"t4.routed->fleet-a.tasks.security.gawain",
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("routed = %v, want %v", got, want)
+	}
+	if status := rt.Status.Herald; status == nil || status.Routed != 4 || status.Pending != 3 || status.LastRoutedAt == nil {
+		t.Errorf("herald status = %+v, want 4 routed and 3 pending", status)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "1 tasks matched no ready knight") {
+		t.Errorf("events = %v, want TasksUnroutable", events)
+	}
+
+	// The next reconcile records only what the herald did since.
+	if err := r.reconcileHerald(context.Background(), rt); err != nil {
+		t.Fatalf("reconcileHerald() error = %v", err)
+	}
+	if status := rt.Status.Herald; status.Routed != 4 {
+		t.Errorf("herald routed = %d after a second reconcile, want 4", status.Routed)
+	}
+	if events := drainEvents(recorder); len(events) != 0 {
+		t.Errorf("events = %v, want none", events)
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+const (
+	// phaseHistoryLength is how many phases status.phaseHistory keeps.
+	phaseHistoryLength = 20
+
+	// spendSamplePeriod is how often status.spendHistory is sampled.
+	spendSamplePeriod = 24 * time.Hour
+
+	// spendHistoryLength keeps 30 days of spendHistory snapshots.
+	spendHistoryLength = 30
+)
+
+// recordHistory appends the table's phase to status.phaseHistory when it
+// changed, closing the previous entry, and snapshots its totalCost into
+// status.spendHistory once a day.
+func recordHistory(rt *aiv1alpha1.RoundTable, now time.Time) {
+	history := rt.Status.PhaseHistory
+	if n := len(history); n == 0 || history[n-1].Phase != rt.Status.Phase {
+		if n > 0 {
+			last := &history[n-1]
+			until := metav1.NewTime(now)
+			last.Until = &until
+			last.Duration = now.Sub(last.Since.Time).Round(time.Second).String()
+		}
+		history = append(history, aiv1alpha1.RoundTablePhaseTransition{
+			Phase:   rt.Status.Phase,
+			Since:   metav1.NewTime(now),
+			CostUSD: rt.Status.TotalCost,
+		})
+		if len(history) > phaseHistoryLength {
+			history = history[len(history)-phaseHistoryLength:]
+		}
+		rt.Status.PhaseHistory = history
+	}
+
+	spend := rt.Status.SpendHistory
+	if n := len(spend); n == 0 || now.Sub(spend[n-1].Time.Time) >= spendSamplePeriod {
+		spend = append(spend, aiv1alpha1.RoundTableCostSample{Time: metav1.NewTime(now), CostUSD: formatCostUSD(parseCostUSD(rt.Status.TotalCost))})
+		if len(spend) > spendHistoryLength {
+			spend = spend[len(spend)-spendHistoryLength:]
+		}
+		rt.Status.SpendHistory = spend
+	}
+}
@@ -0,0 +1,50 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestRecordHistory(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	rt := &aiv1alpha1.RoundTable{Status: aiv1alpha1.RoundTableStatus{Phase: aiv1alpha1.RoundTablePhaseReady, TotalCost: "1.5000"}}
+
+	recordHistory(rt, start)
+	recordHistory(rt, start.Add(time.Hour))
+	if len(rt.Status.PhaseHistory) != 1 || len(rt.Status.SpendHistory) != 1 {
+		t.Fatalf("phaseHistory = %+v, spendHistory %+v, want one entry each", rt.Status.PhaseHistory, rt.Status.SpendHistory)
+	}
+
+	rt.Status.Phase = aiv1alpha1.RoundTablePhaseDegraded
+	rt.Status.TotalCost = "2.2500"
+	recordHistory(rt, start.Add(2*time.Hour))
+	rt.Status.Phase = aiv1alpha1.RoundTablePhaseReady
+	recordHistory(rt, start.Add(2*time.Hour+45*time.Minute))
+
+	history := rt.Status.PhaseHistory
+	if len(history) != 3 {
+		t.Fatalf("phaseHistory = %+v, want Ready, Degraded, Ready", history)
+	}
+	degraded := history[1]
+	if degraded.Phase != aiv1alpha1.RoundTablePhaseDegraded || degraded.Duration != "45m0s" || degraded.CostUSD != "2.2500" {
+		t.Errorf("degraded entry = %+v, want 45m at $2.25", degraded)
+	}
+	if history[0].Duration != "2h0m0s" || history[2].Until != nil {
+		t.Errorf("phaseHistory = %+v, want the first closed and the current open", history)
+	}
+
+	recordHistory(rt, start.Add(25*time.Hour))
+	if spend := rt.Status.SpendHistory; len(spend) != 2 || spend[1].CostUSD != "2.2500" {
+		t.Errorf("spendHistory = %+v, want a second daily snapshot", spend)
+	}
+
+	for i := range 2 * phaseHistoryLength {
+		rt.Status.Phase = []aiv1alpha1.RoundTablePhase{aiv1alpha1.RoundTablePhaseDegraded, aiv1alpha1.RoundTablePhaseReady}[i%2]
+		recordHistory(rt, start.Add(time.Duration(26+i)*time.Hour))
+	}
+	if len(rt.Status.PhaseHistory) != phaseHistoryLength {
+		t.Errorf("phaseHistory has %d entries, want %d", len(rt.Status.PhaseHistory), phaseHistoryLength)
+	}
+}
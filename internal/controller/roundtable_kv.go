@@ -0,0 +1,79 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// kvBucketConfig returns the JetStream configuration of one of the
+// RoundTable's shared KV buckets.
+func kvBucketConfig(rt *aiv1alpha1.RoundTable, b aiv1alpha1.RoundTableKVBucket) (natspkg.KeyValueConfig, error) {
+	ttl, err := optionalDuration(b.TTL)
+	if err != nil {
+		return natspkg.KeyValueConfig{}, fmt.Errorf("kvBucket %s: invalid ttl: %w", b.Name, err)
+	}
+	cfg := natspkg.KeyValueConfig{
+		Bucket:       natspkg.KVBucketName(rt.Spec.NATS.SubjectPrefix, b.Name),
+		Description:  fmt.Sprintf("RoundTable %s/%s %s", rt.Namespace, rt.Name, b.Name),
+		TTL:          ttl,
+		MaxBytes:     b.MaxBytes,
+		MaxValueSize: b.MaxValueSize,
+		History:      uint8(b.History),
+		Storage:      natspkg.StorageFile,
+	}
+	if b.Storage != "" {
+		cfg.Storage = natspkg.StorageType(b.Storage)
+	}
+	// Buckets are replicated like the table's streams.
+	if rt.Spec.NATS.Stream != nil {
+		cfg.Replicas = int(rt.Spec.NATS.Stream.Replicas)
+	}
+	return cfg, nil
+}
+
+// ensureKVBuckets creates the RoundTable's KV buckets, or updates their
+// limits, and records them in status.kvBuckets.
+func (r *RoundTableReconciler) ensureKVBuckets(ctx context.Context, rt *aiv1alpha1.RoundTable) error {
+	nc, err := r.natsClient(ctx, rt)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	statuses := make([]aiv1alpha1.RoundTableKVBucketStatus, 0, len(rt.Spec.NATS.KVBuckets))
+	for _, b := range rt.Spec.NATS.KVBuckets {
+		cfg, err := kvBucketConfig(rt, b)
+		if err != nil {
+			return err
+		}
+		if err := nc.EnsureKeyValue(cfg); err != nil {
+			return err
+		}
+		status := aiv1alpha1.RoundTableKVBucketStatus{Name: b.Name, Bucket: cfg.Bucket}
+		if info, err := nc.StreamInfo(natspkg.KVStreamName(cfg.Bucket)); err == nil {
+			status.Values = int64(info.State.Msgs)
+			status.Bytes = int64(info.State.Bytes)
+		}
+		statuses = append(statuses, status)
+	}
+	rt.Status.KVBuckets = statuses
+	return nil
+}
@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestEnsureKVBuckets(t *testing.T) {
+	nc := newFakeNATSClient()
+	r := &RoundTableReconciler{Recorder: record.NewFakeRecorder(10), NATS: natspkg.NewProviderWithClient(nc, logr.Discard())}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "ai"},
+		Spec: aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{
+			SubjectPrefix: "fleet-a",
+			KVBuckets: []aiv1alpha1.RoundTableKVBucket{
+				{Name: "memory", History: 5, MaxBytes: 1 << 20},
+				{Name: "scratch", TTL: "1h", Storage: "Memory"},
+			},
+		}},
+	}
+
+	if err := r.ensureKVBuckets(context.Background(), rt); err != nil {
+		t.Fatalf("ensureKVBuckets() error = %v", err)
+	}
+	memory, scratch := nc.buckets["fleet-a-memory"], nc.buckets["fleet-a-scratch"]
+	if memory.History != 5 || memory.MaxBytes != 1<<20 || memory.Storage != natspkg.StorageFile {
+		t.Errorf("memory bucket = %+v, want history 5 and 1MiB on file", memory)
+	}
+	if scratch.TTL != time.Hour || scratch.Storage != natspkg.StorageMemory {
+		t.Errorf("scratch bucket = %+v, want a 1h TTL in memory", scratch)
+	}
+	if len(rt.Status.KVBuckets) != 2 || rt.Status.KVBuckets[1].Bucket != "fleet-a-scratch" {
+		t.Errorf("kvBuckets status = %+v, want both buckets", rt.Status.KVBuckets)
+	}
+
+	rt.Spec.NATS.KVBuckets[1].TTL = "soon"
+	if err := r.ensureKVBuckets(context.Background(), rt); err == nil {
+		t.Error("ensureKVBuckets() with an invalid ttl succeeded, want an error")
+	}
+}
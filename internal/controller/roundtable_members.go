@@ -0,0 +1,148 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// memberKey returns the namespace/name of a spec.knights entry.
+func memberKey(rt *aiv1alpha1.RoundTable, m aiv1alpha1.RoundTableMember) string {
+	ns := m.Namespace
+	if ns == "" {
+		ns = rt.Namespace
+	}
+	return ns + "/" + m.Name
+}
+
+// listsKnight reports whether rt's spec.knights lists knight.
+func listsKnight(rt *aiv1alpha1.RoundTable, knight *aiv1alpha1.Knight) bool {
+	key := knight.Namespace + "/" + knight.Name
+	return slices.ContainsFunc(rt.Spec.Knights, func(m aiv1alpha1.RoundTableMember) bool {
+		return memberKey(rt, m) == key
+	})
+}
+
+// addListedKnights adds the existing spec.knights entries in namespace ns
+// missing from knights, keeping them in name order.
+func addListedKnights(ctx context.Context, c client.Reader, rt *aiv1alpha1.RoundTable, ns string, knights *aiv1alpha1.KnightList) error {
+	added := false
+	for _, m := range rt.Spec.Knights {
+		if memberKey(rt, m) != ns+"/"+m.Name ||
+			slices.ContainsFunc(knights.Items, func(k aiv1alpha1.Knight) bool { return k.Name == m.Name }) {
+			continue
+		}
+		knight := &aiv1alpha1.Knight{}
+		if err := c.Get(ctx, types.NamespacedName{Name: m.Name, Namespace: ns}, knight); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get knight %s/%s: %w", ns, m.Name, err)
+		}
+		knights.Items = append(knights.Items, *knight)
+		added = true
+	}
+	if added {
+		sort.Slice(knights.Items, func(i, j int) bool { return knights.Items[i].Name < knights.Items[j].Name })
+	}
+	return nil
+}
+
+// reportMissingMembers records the spec.knights entries with no knight in
+// the table in status.missingKnights and the MembersPresent condition,
+// with a KnightsMissing event when the set changes.
+func (r *RoundTableReconciler) reportMissingMembers(rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight) {
+	present := make(map[string]bool, len(knights))
+	for _, k := range knights {
+		present[k.Namespace+"/"+k.Name] = true
+	}
+	var missing []string
+	for _, m := range rt.Spec.Knights {
+		if key := memberKey(rt, m); !present[key] {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) > 0 && !slices.Equal(missing, rt.Status.MissingKnights) {
+		r.Recorder.Eventf(rt, corev1.EventTypeWarning, "KnightsMissing",
+			"Listed knights not in the table: %s", strings.Join(missing, ", "))
+	}
+	rt.Status.MissingKnights = missing
+	cond := metav1.Condition{
+		Type:               aiv1alpha1.ConditionRoundTableMembersPresent,
+		Status:             metav1.ConditionTrue,
+		Reason:             aiv1alpha1.ReasonAllMembersPresent,
+		Message:            fmt.Sprintf("All %d listed knights are present", len(rt.Spec.Knights)),
+		ObservedGeneration: rt.Generation,
+	}
+	if len(missing) > 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = aiv1alpha1.ReasonMembersMissing
+		cond.Message = fmt.Sprintf("%d of %d listed knights are missing: %s",
+			len(missing), len(rt.Spec.Knights), strings.Join(missing, ", "))
+	}
+	meta.SetStatusCondition(&rt.Status.Conditions, cond)
+}
+
+// applyMemberOverrides sets each spec.knights entry's overrides on its
+// knight's spec. The model override waits while a modelRollout owns the
+// knights' models.
+func (r *RoundTableReconciler) applyMemberOverrides(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight) error {
+	overrides := make(map[string]aiv1alpha1.RoundTableMember, len(rt.Spec.Knights))
+	for _, m := range rt.Spec.Knights {
+		overrides[memberKey(rt, m)] = m
+	}
+	for i := range knights {
+		knight := &knights[i]
+		m, ok := overrides[knight.Namespace+"/"+knight.Name]
+		if !ok {
+			continue
+		}
+		patch := client.MergeFrom(knight.DeepCopy())
+		var changed []string
+		if m.Model != "" && rt.Spec.ModelRollout == nil && knight.Spec.Model != m.Model {
+			knight.Spec.Model = m.Model
+			changed = append(changed, "model "+m.Model)
+		}
+		if m.Concurrency > 0 && knight.Spec.Concurrency != m.Concurrency {
+			knight.Spec.Concurrency = m.Concurrency
+			changed = append(changed, fmt.Sprintf("concurrency %d", m.Concurrency))
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		if err := r.Patch(ctx, knight, patch); err != nil {
+			return fmt.Errorf("failed to update knight %s: %w", knight.Name, err)
+		}
+		r.Recorder.Eventf(rt, corev1.EventTypeNormal, "KnightOverridden",
+			"Set %s on knight %s", strings.Join(changed, ", "), knight.Name)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestRoundTableMembers(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	knight := func(name string, labels map[string]string) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Spec:       aiv1alpha1.KnightSpec{Domain: "security", Model: "claude-sonnet-4", Concurrency: 1},
+		}
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{Knights: []aiv1alpha1.RoundTableMember{
+			{Name: "galahad", Model: "claude-opus-4", Concurrency: 3},
+			{Name: "percival"},
+		}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(rt, knight("galahad", nil), knight("kay", nil), knight("tristan", map[string]string{"fleet": "a"})).
+		WithStatusSubresource(&aiv1alpha1.RoundTable{}, &aiv1alpha1.Knight{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{Client: c, Recorder: recorder}
+	reconcile := func() *aiv1alpha1.RoundTable {
+		t.Helper()
+		key := types.NamespacedName{Name: "fleet", Namespace: "default"}
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+		got := &aiv1alpha1.RoundTable{}
+		if err := c.Get(ctx, key, got); err != nil {
+			t.Fatalf("get roundtable: %v", err)
+		}
+		return got
+	}
+	members := func(rt *aiv1alpha1.RoundTable) []string {
+		var names []string
+		for _, k := range rt.Status.Knights {
+			names = append(names, k.Name)
+		}
+		return names
+	}
+
+	got := reconcile()
+	if names := members(got); !slices.Equal(names, []string{"galahad"}) {
+		t.Errorf("knights = %v, want only the listed knight", names)
+	}
+	cond := meta.FindStatusCondition(got.Status.Conditions, aiv1alpha1.ConditionRoundTableMembersPresent)
+	if !slices.Equal(got.Status.MissingKnights, []string{"default/percival"}) || cond == nil || cond.Reason != aiv1alpha1.ReasonMembersMissing {
+		t.Errorf("missingKnights = %v, MembersPresent %+v, want percival missing", got.Status.MissingKnights, cond)
+	}
+	galahad := &aiv1alpha1.Knight{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "galahad", Namespace: "default"}, galahad); err != nil {
+		t.Fatalf("get knight: %v", err)
+	}
+	if galahad.Spec.Model != "claude-opus-4" || galahad.Spec.Concurrency != 3 {
+		t.Errorf("galahad model = %s, concurrency %d, want the overrides", galahad.Spec.Model, galahad.Spec.Concurrency)
+	}
+	events := drainEvents(recorder)
+	if !slices.ContainsFunc(events, func(e string) bool { return strings.Contains(e, "KnightsMissing") }) ||
+		!slices.ContainsFunc(events, func(e string) bool { return strings.Contains(e, "KnightOverridden") }) {
+		t.Errorf("events = %v, want KnightsMissing and KnightOverridden", events)
+	}
+
+	// A selector adds its knights to the listed ones.
+	got.Spec.KnightSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"fleet": "a"}}
+	got.Spec.Knights = got.Spec.Knights[:1]
+	if err := c.Update(ctx, got); err != nil {
+		t.Fatalf("update roundtable: %v", err)
+	}
+	got = reconcile()
+	if names := members(got); !slices.Equal(names, []string{"galahad", "tristan"}) {
+		t.Errorf("knights = %v, want the listed and selected knights", names)
+	}
+	cond = meta.FindStatusCondition(got.Status.Conditions, aiv1alpha1.ConditionRoundTableMembersPresent)
+	if len(got.Status.MissingKnights) != 0 || cond == nil || cond.Status != metav1.ConditionTrue {
+		t.Errorf("missingKnights = %v, MembersPresent %+v, want all present", got.Status.MissingKnights, cond)
+	}
+	if selected, _ := roundTableSelects(got, []string{"default"}, knight("kay", nil)); selected {
+		t.Error("roundTableSelects(kay) = true, want unlisted and unselected knights excluded")
+	}
+}
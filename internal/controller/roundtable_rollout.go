@@ -0,0 +1,205 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+const (
+	// defaultWaveInterval is modelRollout.waveInterval's default.
+	defaultWaveInterval = 10 * time.Minute
+
+	// rolloutMinTasks is how many tasks the upgraded knights must finish
+	// before their failure rate can pause a rollout.
+	rolloutMinTasks = 5
+)
+
+// reconcileModelRollout advances spec.modelRollout: it pauses the rollout
+// when the upgraded knights fail more than maxFailurePercent of their
+// tasks, and otherwise starts the next wave once the last has run for
+// waveInterval. Progress is recorded in status.modelRollout.
+func (r *RoundTableReconciler) reconcileModelRollout(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight, now time.Time) error {
+	spec := rt.Spec.ModelRollout
+	interval, err := optionalDuration(spec.WaveInterval)
+	if err != nil {
+		return fmt.Errorf("invalid modelRollout waveInterval: %w", err)
+	}
+	if interval == 0 {
+		interval = defaultWaveInterval
+	}
+
+	status := rt.Status.ModelRollout
+	if status == nil || status.Model != spec.Model {
+		status = &aiv1alpha1.RoundTableModelRolloutStatus{Model: spec.Model, Phase: aiv1alpha1.ModelRolloutProgressing}
+		rt.Status.ModelRollout = status
+		r.Recorder.Eventf(rt, corev1.EventTypeNormal, "ModelRolloutStarted", "Rolling knights out to model %s", spec.Model)
+	}
+	if _, ok := rt.Annotations[aiv1alpha1.AnnotationResumeRollout]; ok {
+		r.resumeModelRollout(ctx, rt, knights)
+	}
+	if status.Phase == aiv1alpha1.ModelRolloutComplete {
+		return nil
+	}
+
+	var pending []aiv1alpha1.Knight
+	for _, k := range knights {
+		if k.Spec.Model != spec.Model {
+			pending = append(pending, k)
+		}
+	}
+	status.TotalKnights = int32(len(knights))
+	status.UpdatedKnights = int32(len(knights) - len(pending))
+
+	finished, failed := rolloutTaskCounts(status, knights)
+	status.FailurePercent = 0
+	if finished > 0 {
+		status.FailurePercent = int32(failed * 100 / finished)
+	}
+	if status.Phase == aiv1alpha1.ModelRolloutProgressing && finished >= rolloutMinTasks &&
+		status.FailurePercent > spec.MaxFailurePercent {
+		status.Phase = aiv1alpha1.ModelRolloutPaused
+		status.Message = fmt.Sprintf("Upgraded knights failed %d%% of %d tasks, over the %d%% limit",
+			status.FailurePercent, finished, spec.MaxFailurePercent)
+		r.Recorder.Event(rt, corev1.EventTypeWarning, "ModelRolloutPaused", status.Message)
+	}
+	if status.Phase == aiv1alpha1.ModelRolloutPaused {
+		return nil
+	}
+	if status.LastWaveAt != nil && now.Before(status.LastWaveAt.Add(interval)) {
+		return nil
+	}
+	if len(pending) == 0 {
+		status.Phase = aiv1alpha1.ModelRolloutComplete
+		status.Message = fmt.Sprintf("All %d knights run %s", len(knights), spec.Model)
+		r.Recorder.Event(rt, corev1.EventTypeNormal, "ModelRolloutComplete", status.Message)
+		return nil
+	}
+
+	wave := nextRolloutWave(spec, len(knights), pending)
+	for i := range wave {
+		knight := &wave[i]
+		patch := client.MergeFrom(knight.DeepCopy())
+		if knight.Annotations == nil {
+			knight.Annotations = map[string]string{}
+		}
+		knight.Annotations[aiv1alpha1.AnnotationPreviousModel] = knight.Spec.Model
+		knight.Spec.Model = spec.Model
+		if err := r.Patch(ctx, knight, patch); err != nil {
+			return fmt.Errorf("failed to update knight %s: %w", knight.Name, err)
+		}
+		status.Knights = append(status.Knights, aiv1alpha1.RoundTableRolloutKnight{
+			Name:           knight.Name,
+			Namespace:      knight.Namespace,
+			TasksCompleted: knight.Status.TasksCompleted,
+			TasksFailed:    knight.Status.TasksFailed,
+		})
+	}
+	status.Wave++
+	status.UpdatedKnights += int32(len(wave))
+	waveAt := metav1.NewTime(now)
+	status.LastWaveAt = &waveAt
+	status.Message = fmt.Sprintf("Wave %d moved %d knights to %s", status.Wave, len(wave), spec.Model)
+	r.Recorder.Event(rt, corev1.EventTypeNormal, "ModelRolloutWave", status.Message)
+	return nil
+}
+
+// resumeModelRollout resumes a paused rollout from the upgraded knights'
+// current task counts and removes the resume annotation.
+func (r *RoundTableReconciler) resumeModelRollout(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight) {
+	status := rt.Status.ModelRollout
+	if status.Phase == aiv1alpha1.ModelRolloutPaused {
+		for i, b := range status.Knights {
+			for _, k := range knights {
+				if k.Name == b.Name && k.Namespace == b.Namespace {
+					status.Knights[i].TasksCompleted = k.Status.TasksCompleted
+					status.Knights[i].TasksFailed = k.Status.TasksFailed
+				}
+			}
+		}
+		status.Phase = aiv1alpha1.ModelRolloutProgressing
+		status.Message = ""
+		r.Recorder.Eventf(rt, corev1.EventTypeNormal, "ModelRolloutResumed", "Resumed rolling knights out to model %s", status.Model)
+	}
+
+	// Patch a copy: the response would overwrite the status computed so far.
+	cleared := rt.DeepCopy()
+	delete(cleared.Annotations, aiv1alpha1.AnnotationResumeRollout)
+	if err := r.Patch(ctx, cleared, client.MergeFrom(rt)); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to clear resume-rollout annotation")
+		return
+	}
+	rt.Annotations = cleared.Annotations
+	rt.ResourceVersion = cleared.ResourceVersion
+}
+
+// rolloutTaskCounts returns the tasks the upgraded knights finished, and
+// failed, since their baseline.
+func rolloutTaskCounts(status *aiv1alpha1.RoundTableModelRolloutStatus, knights []aiv1alpha1.Knight) (int64, int64) {
+	var finished, failed int64
+	for _, b := range status.Knights {
+		for _, k := range knights {
+			if k.Name != b.Name || k.Namespace != b.Namespace {
+				continue
+			}
+			completed := max(k.Status.TasksCompleted-b.TasksCompleted, 0)
+			f := max(k.Status.TasksFailed-b.TasksFailed, 0)
+			finished += completed + f
+			failed += f
+		}
+	}
+	return finished, failed
+}
+
+// nextRolloutWave picks the knights the next wave upgrades from those not
+// yet on the model: the next domain in domainOrder, or wavePercent of the
+// table's knights (at least one), in name order.
+func nextRolloutWave(spec *aiv1alpha1.RoundTableModelRollout, total int, pending []aiv1alpha1.Knight) []aiv1alpha1.Knight {
+	rank := func(k aiv1alpha1.Knight) int {
+		if i := slices.Index(spec.DomainOrder, k.Spec.Domain); i >= 0 {
+			return i
+		}
+		return len(spec.DomainOrder)
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		if ri, rj := rank(pending[i]), rank(pending[j]); ri != rj {
+			return ri < rj
+		}
+		return pending[i].Name < pending[j].Name
+	})
+
+	if len(spec.DomainOrder) > 0 {
+		n := 1
+		for n < len(pending) && rank(pending[n]) == rank(pending[0]) {
+			n++
+		}
+		return pending[:n]
+	}
+	size := max((total*int(spec.WavePercent)+99)/100, 1)
+	return pending[:min(size, len(pending))]
+}
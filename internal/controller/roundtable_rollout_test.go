@@ -0,0 +1,137 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestModelRollout(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{ModelRollout: &aiv1alpha1.RoundTableModelRollout{
+			Model:             "claude-sonnet-5",
+			WavePercent:       50,
+			MaxFailurePercent: 20,
+		}},
+	}
+	knight := func(name string) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       aiv1alpha1.KnightSpec{Domain: "security", Model: "claude-sonnet-4"},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(rt, knight("percival"), knight("galahad"), knight("tristan"), knight("gawain")).
+		WithStatusSubresource(&aiv1alpha1.Knight{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{Client: c, Recorder: recorder}
+	list := func() []aiv1alpha1.Knight {
+		t.Helper()
+		knights := &aiv1alpha1.KnightList{}
+		if err := c.List(ctx, knights); err != nil {
+			t.Fatalf("list knights: %v", err)
+		}
+		return knights.Items
+	}
+	rollout := func(now time.Time) []string {
+		t.Helper()
+		if err := r.reconcileModelRollout(ctx, rt, list(), now); err != nil {
+			t.Fatalf("reconcileModelRollout() error = %v", err)
+		}
+		var upgraded []string
+		for _, k := range list() {
+			if k.Spec.Model == "claude-sonnet-5" {
+				upgraded = append(upgraded, k.Name)
+			}
+		}
+		return upgraded
+	}
+
+	if got := rollout(start); strings.Join(got, ",") != "galahad,gawain" {
+		t.Fatalf("upgraded = %v, want the first half by name", got)
+	}
+	if events := drainEvents(recorder); len(events) != 2 || !strings.Contains(events[1], "Wave 1 moved 2 knights") {
+		t.Errorf("events = %v, want ModelRolloutStarted and the first wave", events)
+	}
+	galahad := &aiv1alpha1.Knight{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "galahad", Namespace: "default"}, galahad); err != nil {
+		t.Fatalf("get knight: %v", err)
+	}
+	if galahad.Annotations[aiv1alpha1.AnnotationPreviousModel] != "claude-sonnet-4" {
+		t.Errorf("annotations = %v, want the previous model", galahad.Annotations)
+	}
+	if got := rollout(start.Add(5 * time.Minute)); len(got) != 2 {
+		t.Errorf("upgraded = %v, want the wave left to run for waveInterval", got)
+	}
+
+	// The upgraded knights fail 2 of 5 tasks.
+	galahad.Status.TasksCompleted, galahad.Status.TasksFailed = 3, 2
+	if err := c.Status().Update(ctx, galahad); err != nil {
+		t.Fatalf("update knight status: %v", err)
+	}
+	if got := rollout(start.Add(11 * time.Minute)); len(got) != 2 || rt.Status.ModelRollout.Phase != aiv1alpha1.ModelRolloutPaused {
+		t.Fatalf("upgraded = %v, phase %s, want the rollout paused", got, rt.Status.ModelRollout.Phase)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "failed 40% of 5 tasks") {
+		t.Errorf("events = %v, want ModelRolloutPaused", events)
+	}
+
+	annotated := rt.DeepCopy()
+	annotated.Annotations = map[string]string{aiv1alpha1.AnnotationResumeRollout: "true"}
+	if err := c.Patch(ctx, annotated, client.MergeFrom(rt)); err != nil {
+		t.Fatalf("annotate roundtable: %v", err)
+	}
+	rt.Annotations, rt.ResourceVersion = annotated.Annotations, annotated.ResourceVersion
+	if got := rollout(start.Add(12 * time.Minute)); len(got) != 4 || rt.Status.ModelRollout.FailurePercent != 0 {
+		t.Errorf("upgraded = %v, failurePercent %d, want the second wave from a fresh count", got, rt.Status.ModelRollout.FailurePercent)
+	}
+	if _, ok := rt.Annotations[aiv1alpha1.AnnotationResumeRollout]; ok {
+		t.Error("resume annotation still set, want it removed")
+	}
+	rollout(start.Add(30 * time.Minute))
+	if status := rt.Status.ModelRollout; status.Phase != aiv1alpha1.ModelRolloutComplete || status.UpdatedKnights != 4 || status.Wave != 2 {
+		t.Errorf("modelRollout = %+v, want complete after two waves", status)
+	}
+	if events := drainEvents(recorder); len(events) != 3 || !strings.Contains(events[2], "ModelRolloutComplete") {
+		t.Errorf("events = %v, want resumed, the second wave and complete", events)
+	}
+}
+
+func TestNextRolloutWaveByDomain(t *testing.T) {
+	knight := func(name, domain string) aiv1alpha1.Knight {
+		return aiv1alpha1.Knight{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: aiv1alpha1.KnightSpec{Domain: domain}}
+	}
+	spec := &aiv1alpha1.RoundTableModelRollout{DomainOrder: []string{"research", "security"}}
+	pending := []aiv1alpha1.Knight{
+		knight("kay", "ops"), knight("galahad", "security"), knight("percival", "research"), knight("bors", "research"),
+	}
+	var got []string
+	for len(pending) > 0 {
+		wave := nextRolloutWave(spec, 4, pending)
+		var names []string
+		for _, k := range wave {
+			names = append(names, k.Name)
+		}
+		got = append(got, strings.Join(names, "+"))
+		pending = pending[len(wave):]
+	}
+	if want := "bors+percival galahad kay"; strings.Join(got, " ") != want {
+		t.Errorf("waves = %v, want %s", got, want)
+	}
+}
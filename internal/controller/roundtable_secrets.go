@@ -0,0 +1,133 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// distributeSecrets copies the table's secrets into the namespaces of its
+// knights outside the table's own, refreshing the copies when a source
+// changes, and with secretsMode EnvFrom records the secrets and a hash of
+// their data on each knight for the Knight controller to inject. Knights
+// no longer given secrets have the annotations removed.
+func (r *RoundTableReconciler) distributeSecrets(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight) error {
+	var names []string
+	var sources []*corev1.Secret
+	for _, ref := range rt.Spec.Secrets {
+		source := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: rt.Namespace}, source); err != nil {
+			return fmt.Errorf("failed to get secret %s: %w", ref.Name, err)
+		}
+		names = append(names, ref.Name)
+		sources = append(sources, source)
+	}
+
+	copied := map[string]bool{rt.Namespace: true}
+	for _, k := range knights {
+		if copied[k.Namespace] {
+			continue
+		}
+		copied[k.Namespace] = true
+		for _, source := range sources {
+			if err := r.copyFleetSecret(ctx, rt, source, k.Namespace); err != nil {
+				return err
+			}
+		}
+	}
+
+	var list, hash string
+	if rt.Spec.SecretsMode != aiv1alpha1.SecretsModeCopy && len(sources) > 0 {
+		list, hash = strings.Join(names, ","), secretsHash(sources)
+	}
+	for i := range knights {
+		knight := &knights[i]
+		if knight.Annotations[aiv1alpha1.AnnotationFleetSecrets] == list &&
+			knight.Annotations[aiv1alpha1.AnnotationFleetSecretsHash] == hash {
+			continue
+		}
+		patch := client.MergeFrom(knight.DeepCopy())
+		if list == "" {
+			delete(knight.Annotations, aiv1alpha1.AnnotationFleetSecrets)
+			delete(knight.Annotations, aiv1alpha1.AnnotationFleetSecretsHash)
+		} else {
+			if knight.Annotations == nil {
+				knight.Annotations = map[string]string{}
+			}
+			knight.Annotations[aiv1alpha1.AnnotationFleetSecrets] = list
+			knight.Annotations[aiv1alpha1.AnnotationFleetSecretsHash] = hash
+		}
+		if err := r.Patch(ctx, knight, patch); err != nil {
+			return fmt.Errorf("failed to update knight %s: %w", knight.Name, err)
+		}
+	}
+	return nil
+}
+
+// copyFleetSecret copies a table secret into namespace, refreshing an
+// earlier copy. A secret of the same name the table didn't copy is left
+// alone.
+func (r *RoundTableReconciler) copyFleetSecret(ctx context.Context, rt *aiv1alpha1.RoundTable, source *corev1.Secret, namespace string) error {
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: source.Name, Namespace: namespace}, secret)
+	if err == nil && secret.Labels[aiv1alpha1.LabelRoundTable] != rt.Name {
+		return fmt.Errorf("secret %s/%s exists and was not copied from RoundTable %s", namespace, source.Name, rt.Name)
+	}
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	secret.Name, secret.Namespace = source.Name, namespace
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Labels = map[string]string{aiv1alpha1.LabelRoundTable: rt.Name}
+		secret.Type = source.Type
+		secret.Data = source.Data
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy secret %s into %s: %w", source.Name, namespace, err)
+	}
+	return nil
+}
+
+// secretsHash returns a short hash of the secrets' names and data.
+func secretsHash(secrets []*corev1.Secret) string {
+	h := sha256.New()
+	for _, s := range secrets {
+		keys := make([]string, 0, len(s.Data))
+		for k := range s.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		fmt.Fprintf(h, "%s\n", s.Name)
+		for _, k := range keys {
+			fmt.Fprintf(h, "%s=%x\n", k, s.Data[k])
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
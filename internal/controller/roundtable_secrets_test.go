@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestDistributeSecrets(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{
+			Namespaces: []string{"team-red", "team-blue"},
+			Secrets:    []corev1.LocalObjectReference{{Name: "anthropic-api-key"}},
+		},
+	}
+	source := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "anthropic-api-key", Namespace: "default"},
+		Data:       map[string][]byte{"ANTHROPIC_API_KEY": []byte("sk-1")},
+	}
+	knight := func(name, ns string) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns}}
+	}
+	optedIn := func(name string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Annotations: map[string]string{aiv1alpha1.AnnotationRoundTables: "default/fleet"},
+		}}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(rt, source, optedIn("team-red"), optedIn("team-blue"),
+			knight("galahad", "default"), knight("gawain", "team-red")).Build()
+	r := &RoundTableReconciler{Client: c}
+	distribute := func() []aiv1alpha1.Knight {
+		t.Helper()
+		knights, err := tableKnights(ctx, c, rt)
+		if err != nil {
+			t.Fatalf("tableKnights() error = %v", err)
+		}
+		if err := r.distributeSecrets(ctx, rt, knights); err != nil {
+			t.Fatalf("distributeSecrets() error = %v", err)
+		}
+		return knights
+	}
+
+	knights := distribute()
+	copied := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: "anthropic-api-key", Namespace: "team-red"}, copied); err != nil {
+		t.Fatalf("get copied secret: %v", err)
+	}
+	if string(copied.Data["ANTHROPIC_API_KEY"]) != "sk-1" || copied.Labels[aiv1alpha1.LabelRoundTable] != "fleet" {
+		t.Errorf("copy = %+v, want the source's data labelled with the table", copied)
+	}
+	hash := knights[0].Annotations[aiv1alpha1.AnnotationFleetSecretsHash]
+	for _, k := range knights {
+		if k.Annotations[aiv1alpha1.AnnotationFleetSecrets] != "anthropic-api-key" || k.Annotations[aiv1alpha1.AnnotationFleetSecretsHash] != hash {
+			t.Errorf("%s annotations = %v, want the table's secrets", k.Name, k.Annotations)
+		}
+	}
+
+	// Rotating the source refreshes the copy and the knights' hash.
+	source.Data["ANTHROPIC_API_KEY"] = []byte("sk-2")
+	if err := c.Update(ctx, source); err != nil {
+		t.Fatalf("update secret: %v", err)
+	}
+	knights = distribute()
+	if err := c.Get(ctx, types.NamespacedName{Name: "anthropic-api-key", Namespace: "team-red"}, copied); err != nil {
+		t.Fatalf("get copied secret: %v", err)
+	}
+	if string(copied.Data["ANTHROPIC_API_KEY"]) != "sk-2" {
+		t.Errorf("copy data = %s, want the rotated key", copied.Data["ANTHROPIC_API_KEY"])
+	}
+	if got := knights[0].Annotations[aiv1alpha1.AnnotationFleetSecretsHash]; got == hash {
+		t.Errorf("hash = %s after rotation, want it changed", got)
+	}
+
+	// Copy mode keeps the copies but drops the injection.
+	rt.Spec.SecretsMode = aiv1alpha1.SecretsModeCopy
+	for _, k := range distribute() {
+		if _, ok := k.Annotations[aiv1alpha1.AnnotationFleetSecrets]; ok {
+			t.Errorf("%s annotations = %v, want none in Copy mode", k.Name, k.Annotations)
+		}
+	}
+
+	// A secret the table didn't copy is not overwritten.
+	if err := c.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "anthropic-api-key", Namespace: "team-blue"}}); err != nil {
+		t.Fatalf("create secret: %v", err)
+	}
+	if err := c.Create(ctx, knight("tristan", "team-blue")); err != nil {
+		t.Fatalf("create knight: %v", err)
+	}
+	knights, _ = tableKnights(ctx, c, rt)
+	if err := r.distributeSecrets(ctx, rt, knights); err == nil {
+		t.Error("distributeSecrets() over a foreign secret succeeded, want an error")
+	}
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	corev1 "k8s.io/api/core/v1"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// fleetStreamConfigs returns the desired configuration of the RoundTable's
+// tasks and results streams, its domain streams under the PerDomain
+// topology, and its dead-letter streams if enabled.
+func fleetStreamConfigs(rt *aiv1alpha1.RoundTable) ([]natspkg.StreamConfig, error) {
+	// Map retention policy string to enum
+	retention := natspkg.RetentionWorkQueue
+	switch rt.Spec.NATS.StreamRetention {
+	case "Limits":
+		retention = natspkg.RetentionLimits
+	case "Interest":
+		retention = natspkg.RetentionInterest
+	}
+
+	base := natspkg.StreamConfig{Retention: retention, Storage: natspkg.StorageFile}
+	if settings := rt.Spec.NATS.Stream; settings != nil {
+		if settings.Storage != "" {
+			base.Storage = natspkg.StorageType(settings.Storage)
+		}
+		if settings.Discard != "" {
+			base.Discard = natspkg.DiscardPolicy(settings.Discard)
+		}
+		base.Replicas = int(settings.Replicas)
+		base.MaxBytes = settings.MaxBytes
+		base.MaxMsgSize = settings.MaxMsgSize
+		var err error
+		if base.MaxAge, err = optionalDuration(settings.MaxAge); err != nil {
+			return nil, fmt.Errorf("invalid stream maxAge: %w", err)
+		}
+		if base.Duplicates, err = optionalDuration(settings.DuplicateWindow); err != nil {
+			return nil, fmt.Errorf("invalid stream duplicateWindow: %w", err)
+		}
+	}
+
+	tasks, results := base, base
+	tasks.Name = rt.Spec.NATS.TasksStream
+	tasks.Subjects = []string{natspkg.StreamSubject(rt.Spec.NATS.SubjectPrefix, "tasks")}
+	results.Name = rt.Spec.NATS.ResultsStream
+	results.Subjects = []string{natspkg.StreamSubject(rt.Spec.NATS.SubjectPrefix, "results")}
+	configs := []natspkg.StreamConfig{tasks, results}
+	if rt.Spec.NATS.Topology == aiv1alpha1.NATSTopologyPerDomain {
+		// Stream subjects can't overlap, so the shared stream keeps only
+		// the herald's unaddressed tasks. It comes first so it's narrowed
+		// before the domain streams are created.
+		configs[0].Subjects = []string{natspkg.AnyTaskSubject(rt.Spec.NATS.SubjectPrefix)}
+		overrides := make(map[string]aiv1alpha1.RoundTableDomainStream, len(rt.Spec.NATS.DomainStreams))
+		for _, d := range rt.Spec.NATS.DomainStreams {
+			overrides[d.Domain] = d
+		}
+		for _, domain := range tableDomains(rt) {
+			cfg := base
+			cfg.Name = domainTasksStream(rt, domain)
+			cfg.Subjects = []string{domainTaskSubjects(rt.Spec.NATS.SubjectPrefix, domain)}
+			if o, ok := overrides[domain]; ok {
+				if o.MaxAge != "" {
+					var err error
+					if cfg.MaxAge, err = time.ParseDuration(o.MaxAge); err != nil {
+						return nil, fmt.Errorf("invalid maxAge of domain stream %s: %w", domain, err)
+					}
+				}
+				if o.MaxBytes != 0 {
+					cfg.MaxBytes = o.MaxBytes
+				}
+			}
+			configs = append(configs, cfg)
+		}
+	}
+	if rt.Spec.NATS.DeadLetter != nil {
+		deadLetter, err := deadLetterStreamConfigs(rt)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, deadLetter...)
+	}
+	return configs, nil
+}
+
+// tableDomains returns the domains that get their own stream under the
+// PerDomain topology: those of the table's knights, as last rolled up in
+// status.domains, and those listed in nats.domainStreams, sorted.
+func tableDomains(rt *aiv1alpha1.RoundTable) []string {
+	var domains []string
+	for _, d := range rt.Status.Domains {
+		domains = append(domains, d.Domain)
+	}
+	for _, d := range rt.Spec.NATS.DomainStreams {
+		domains = append(domains, d.Domain)
+	}
+	slices.Sort(domains)
+	return slices.Compact(domains)
+}
+
+// domainTasksStream returns the stream holding a domain's tasks: its own
+// under the PerDomain topology, otherwise the shared tasks stream.
+func domainTasksStream(rt *aiv1alpha1.RoundTable, domain string) string {
+	if rt.Spec.NATS.Topology == aiv1alpha1.NATSTopologyPerDomain {
+		return natspkg.DomainStreamName(rt.Spec.NATS.TasksStream, domain)
+	}
+	return rt.Spec.NATS.TasksStream
+}
+
+// optionalDuration parses a Go duration, "" being zero.
+func optionalDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// ensureStreams creates the RoundTable's JetStream streams, or updates
+// them to match the spec, and records them in status.streams.
+func (r *RoundTableReconciler) ensureStreams(ctx context.Context, rt *aiv1alpha1.RoundTable) error {
+	configs, err := fleetStreamConfigs(rt)
+	if err != nil {
+		return err
+	}
+	// Streams live on the fleet's own NATS server
+	client, err := r.natsClient(ctx, rt)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	statuses := make([]aiv1alpha1.RoundTableStreamStatus, 0, len(configs))
+	for _, cfg := range configs {
+		status, err := r.ensureStream(rt, client, cfg)
+		if err != nil {
+			return err
+		}
+		statuses = append(statuses, status)
+	}
+	rt.Status.Streams = statuses
+	return nil
+}
+
+// ensureStream creates a missing stream or updates one whose settings
+// drifted from cfg. Settings a stream can't change in place are left as
+// they are and reported as drift.
+func (r *RoundTableReconciler) ensureStream(rt *aiv1alpha1.RoundTable, client natspkg.Client, cfg natspkg.StreamConfig) (aiv1alpha1.RoundTableStreamStatus, error) {
+	status := aiv1alpha1.RoundTableStreamStatus{Name: cfg.Name}
+	info, err := client.StreamInfo(cfg.Name)
+	if errors.Is(err, nats.ErrStreamNotFound) {
+		if err := client.CreateStream(cfg); err != nil {
+			return status, fmt.Errorf("stream %s: %w", cfg.Name, err)
+		}
+		return status, nil
+	}
+	if err != nil {
+		return status, fmt.Errorf("stream %s: %w", cfg.Name, err)
+	}
+	status.Messages = int64(info.State.Msgs)
+	status.Bytes = int64(info.State.Bytes)
+
+	var updatable []string
+	for _, field := range natspkg.StreamDrift(&info.Config, cfg) {
+		if natspkg.ImmutableStreamFields[field] {
+			status.Drift = append(status.Drift, field)
+		} else {
+			updatable = append(updatable, field)
+		}
+	}
+	if len(updatable) == 0 {
+		return status, nil
+	}
+	// Keep the settings that can't change so the update is accepted.
+	cfg.Retention = retentionPolicy(info.Config.Retention)
+	if info.Config.Storage == nats.MemoryStorage {
+		cfg.Storage = natspkg.StorageMemory
+	} else {
+		cfg.Storage = natspkg.StorageFile
+	}
+	if err := client.UpdateStream(cfg); err != nil {
+		return status, fmt.Errorf("stream %s: %w", cfg.Name, err)
+	}
+	r.Recorder.Eventf(rt, corev1.EventTypeNormal, "StreamUpdated",
+		"Updated stream %s: %s", cfg.Name, strings.Join(updatable, ", "))
+	return status, nil
+}
+
+// retentionPolicy maps a JetStream retention policy back to ours.
+func retentionPolicy(p nats.RetentionPolicy) natspkg.RetentionPolicy {
+	switch p {
+	case nats.LimitsPolicy:
+		return natspkg.RetentionLimits
+	case nats.InterestPolicy:
+		return natspkg.RetentionInterest
+	default:
+		return natspkg.RetentionWorkQueue
+	}
+}
+
+// streamDriftMessage describes the streams that differ from the spec in
+// settings that need the stream recreated, or "" if none do.
+func streamDriftMessage(rt *aiv1alpha1.RoundTable) string {
+	var parts []string
+	for _, s := range rt.Status.Streams {
+		if len(s.Drift) > 0 {
+			parts = append(parts, fmt.Sprintf("%s (%s)", s.Name, strings.Join(s.Drift, ", ")))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "Streams differ from the spec in settings that need them recreated: " + strings.Join(parts, "; ")
+}
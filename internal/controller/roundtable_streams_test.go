@@ -0,0 +1,119 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+func TestEnsureStreams(t *testing.T) {
+	nc := newFakeNATSClient()
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{Recorder: recorder, NATS: natspkg.NewProviderWithClient(nc, logr.Discard())}
+	rt := &aiv1alpha1.RoundTable{Spec: aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{
+		SubjectPrefix: "fleet-a",
+		TasksStream:   "fleet_a_tasks",
+		ResultsStream: "fleet_a_results",
+		Stream:        &aiv1alpha1.RoundTableStreamSettings{Replicas: 1, MaxAge: "24h"},
+	}}}
+	ctx := context.Background()
+
+	if err := r.ensureStreams(ctx, rt); err != nil {
+		t.Fatalf("ensureStreams() error = %v", err)
+	}
+	tasks := nc.streams["fleet_a_tasks"]
+	if tasks.MaxAge != 24*time.Hour || tasks.Retention != natspkg.RetentionWorkQueue || tasks.Subjects[0] != "fleet-a.tasks.>" {
+		t.Errorf("tasks stream = %+v, want created from the spec", tasks)
+	}
+	if len(rt.Status.Streams) != 2 || streamDriftMessage(rt) != "" {
+		t.Errorf("streams = %+v, want two without drift", rt.Status.Streams)
+	}
+
+	// A mutable change is applied in place; storage needs a recreate.
+	rt.Spec.NATS.Stream.MaxAge = "48h"
+	rt.Spec.NATS.Stream.Storage = "Memory"
+	if err := r.ensureStreams(ctx, rt); err != nil {
+		t.Fatalf("ensureStreams() error = %v", err)
+	}
+	tasks = nc.streams["fleet_a_tasks"]
+	if tasks.MaxAge != 48*time.Hour || tasks.Storage != natspkg.StorageFile {
+		t.Errorf("tasks stream = %+v, want maxAge updated and storage kept", tasks)
+	}
+	if msg := streamDriftMessage(rt); !strings.Contains(msg, "fleet_a_tasks (storage)") {
+		t.Errorf("drift message = %q, want storage drift on the tasks stream", msg)
+	}
+	if events := drainEvents(recorder); len(events) != 2 || !strings.Contains(events[0], "StreamUpdated") {
+		t.Errorf("events = %v, want StreamUpdated for both streams", events)
+	}
+
+	rt.Spec.NATS.Stream.DuplicateWindow = "soon"
+	if err := r.ensureStreams(ctx, rt); err == nil {
+		t.Error("ensureStreams() with an invalid duplicateWindow succeeded, want an error")
+	}
+}
+
+func TestFleetStreamConfigsPerDomain(t *testing.T) {
+	rt := &aiv1alpha1.RoundTable{
+		Spec: aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{
+			SubjectPrefix: "fleet-a",
+			TasksStream:   "fleet_a_tasks",
+			ResultsStream: "fleet_a_results",
+			Topology:      aiv1alpha1.NATSTopologyPerDomain,
+			Stream:        &aiv1alpha1.RoundTableStreamSettings{MaxAge: "72h"},
+			DomainStreams: []aiv1alpha1.RoundTableDomainStream{
+				{Domain: "security", MaxAge: "720h"},
+				{Domain: "chatter", MaxAge: "24h", MaxBytes: 1 << 20},
+			},
+			DeadLetter: &aiv1alpha1.RoundTableDeadLetter{},
+		}},
+		Status: aiv1alpha1.RoundTableStatus{Domains: []aiv1alpha1.RoundTableDomainStatus{
+			{Domain: "research"}, {Domain: "security"},
+		}},
+	}
+
+	configs, err := fleetStreamConfigs(rt)
+	if err != nil {
+		t.Fatalf("fleetStreamConfigs() error = %v", err)
+	}
+	byName := map[string]natspkg.StreamConfig{}
+	for _, cfg := range configs {
+		byName[cfg.Name] = cfg
+	}
+	if tasks := byName["fleet_a_tasks"]; len(tasks.Subjects) != 1 || tasks.Subjects[0] != "fleet-a.tasks.any" {
+		t.Errorf("tasks stream subjects = %v, want only unaddressed tasks", tasks.Subjects)
+	}
+	for name, want := range map[string]time.Duration{
+		"fleet_a_tasks_chatter":  24 * time.Hour,
+		"fleet_a_tasks_research": 72 * time.Hour,
+		"fleet_a_tasks_security": 720 * time.Hour,
+	} {
+		cfg, ok := byName[name]
+		if !ok || cfg.MaxAge != want {
+			t.Errorf("stream %s = %+v, want maxAge %s", name, cfg, want)
+		}
+	}
+	if got := byName["fleet_a_tasks_security"].Subjects; len(got) != 1 || got[0] != "fleet-a.tasks.security.>" {
+		t.Errorf("security stream subjects = %v, want the domain's tasks", got)
+	}
+	if got := byName["fleet_a_tasks_chatter"].MaxBytes; got != 1<<20 {
+		t.Errorf("chatter stream maxBytes = %d, want the override", got)
+	}
+	if got := byName["fleet_a_tasks_dlq_advisories"].Subjects; len(got) != 4 {
+		t.Errorf("advisory subjects = %v, want the tasks stream's and three domain streams'", got)
+	}
+	if got := domainTasksStream(rt, "research"); got != "fleet_a_tasks_research" {
+		t.Errorf("domainTasksStream() = %s, want the domain's stream", got)
+	}
+
+	rt.Spec.NATS.DomainStreams[0].MaxAge = "a month"
+	if _, err := fleetStreamConfigs(rt); err == nil {
+		t.Error("fleetStreamConfigs() with an invalid domain maxAge succeeded, want an error")
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// suspendKnights suspends the suspended table's running knights, marking
+// them with the table-suspended annotation. Knights already suspended, by
+// hand or for the budget, are not marked, so resuming the table leaves
+// them suspended.
+func (r *RoundTableReconciler) suspendKnights(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight) error {
+	changed := 0
+	for i := range knights {
+		knight := &knights[i]
+		if knight.Spec.Suspended {
+			continue
+		}
+		patch := client.MergeFrom(knight.DeepCopy())
+		if knight.Annotations == nil {
+			knight.Annotations = map[string]string{}
+		}
+		knight.Annotations[aiv1alpha1.AnnotationTableSuspended] = "true"
+		knight.Spec.Suspended = true
+		if err := r.Patch(ctx, knight, patch); err != nil {
+			return fmt.Errorf("failed to suspend knight %s: %w", knight.Name, err)
+		}
+		changed++
+	}
+	if changed > 0 {
+		r.Recorder.Eventf(rt, corev1.EventTypeNormal, "KnightsSuspended",
+			"RoundTable is suspended, suspended %d knights", changed)
+	}
+	return nil
+}
+
+// resumeKnights resumes the knights the table suspended. A table that is
+// over its budget hands them to the budget instead: they stay suspended
+// with the budget-suspended annotation, to resume with the rest once the
+// cost is back under.
+func (r *RoundTableReconciler) resumeKnights(ctx context.Context, rt *aiv1alpha1.RoundTable, knights []aiv1alpha1.Knight, overBudget bool) error {
+	changed := 0
+	for i := range knights {
+		knight := &knights[i]
+		if _, marked := knight.Annotations[aiv1alpha1.AnnotationTableSuspended]; !marked {
+			continue
+		}
+		patch := client.MergeFrom(knight.DeepCopy())
+		delete(knight.Annotations, aiv1alpha1.AnnotationTableSuspended)
+		if overBudget {
+			knight.Annotations[aiv1alpha1.AnnotationBudgetSuspended] = "true"
+		} else {
+			knight.Spec.Suspended = false
+			changed++
+		}
+		if err := r.Patch(ctx, knight, patch); err != nil {
+			return fmt.Errorf("failed to resume knight %s: %w", knight.Name, err)
+		}
+	}
+	if changed > 0 {
+		r.Recorder.Eventf(rt, corev1.EventTypeNormal, "KnightsResumed",
+			"RoundTable resumed, resumed %d knights", changed)
+	}
+	return nil
+}
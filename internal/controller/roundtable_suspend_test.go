@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestRoundTableSuspensionCascade(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	ctx := context.Background()
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet", Namespace: "default"},
+		Spec:       aiv1alpha1.RoundTableSpec{Suspended: true},
+	}
+	knight := func(name string, suspended bool) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       aiv1alpha1.KnightSpec{Suspended: suspended},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(rt, knight("galahad", false), knight("percival", false), knight("tristan", true)).
+		WithStatusSubresource(&aiv1alpha1.RoundTable{}, &aiv1alpha1.Knight{}).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &RoundTableReconciler{Client: c, Recorder: recorder}
+	reconcile := func() {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "fleet", Namespace: "default"}}); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+	}
+	knightState := func(name string) (bool, bool) {
+		t.Helper()
+		k := &aiv1alpha1.Knight{}
+		if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, k); err != nil {
+			t.Fatalf("get knight %s: %v", name, err)
+		}
+		_, marked := k.Annotations[aiv1alpha1.AnnotationTableSuspended]
+		return k.Spec.Suspended, marked
+	}
+
+	reconcile()
+	for _, name := range []string{"galahad", "percival"} {
+		if suspended, marked := knightState(name); !suspended || !marked {
+			t.Errorf("%s suspended = %t, marked %t, want suspended with the table", name, suspended, marked)
+		}
+	}
+	if suspended, marked := knightState("tristan"); !suspended || marked {
+		t.Errorf("tristan suspended = %t, marked %t, want left suspended by hand", suspended, marked)
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "suspended 2 knights") {
+		t.Errorf("events = %v, want KnightsSuspended for 2 knights", events)
+	}
+
+	reconcile()
+	if events := drainEvents(recorder); len(events) != 0 {
+		t.Errorf("events = %v, want none while the knights stay suspended", events)
+	}
+
+	if err := c.Get(ctx, types.NamespacedName{Name: "fleet", Namespace: "default"}, rt); err != nil {
+		t.Fatalf("get roundtable: %v", err)
+	}
+	rt.Spec.Suspended = false
+	if err := c.Update(ctx, rt); err != nil {
+		t.Fatalf("update roundtable: %v", err)
+	}
+	reconcile()
+	for _, name := range []string{"galahad", "percival"} {
+		if suspended, marked := knightState(name); suspended || marked {
+			t.Errorf("%s suspended = %t, marked %t, want resumed", name, suspended, marked)
+		}
+	}
+	if suspended, _ := knightState("tristan"); !suspended {
+		t.Error("tristan resumed, want knights suspended by hand left alone")
+	}
+	if events := drainEvents(recorder); len(events) != 1 || !strings.Contains(events[0], "resumed 2 knights") {
+		t.Errorf("events = %v, want KnightsResumed for 2 knights", events)
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// tablesForKnight maps a Knight to the RoundTables that discover it, in
+// any namespace. Updates are mapped for both the old and new knight, so a
+// relabelled knight also refreshes the table it left.
+func (r *RoundTableReconciler) tablesForKnight(ctx context.Context, obj client.Object) []reconcile.Request {
+	knight, ok := obj.(*aiv1alpha1.Knight)
+	if !ok {
+		return nil
+	}
+	tables := &aiv1alpha1.RoundTableList{}
+	if err := r.List(ctx, tables); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list roundtables for knight", "knight", knight.Name)
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range tables.Items {
+		rt := &tables.Items[i]
+		namespaces, err := tableNamespaces(ctx, r.Client, rt)
+		if err != nil {
+			continue
+		}
+		if selected, err := roundTableSelects(rt, namespaces, knight); err != nil || !selected {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: rt.Namespace, Name: rt.Name},
+		})
+	}
+	return requests
+}
+
+// tableForMission maps a Mission to the RoundTable its roundTableRef names.
+func tableForMission(_ context.Context, obj client.Object) []reconcile.Request {
+	mission, ok := obj.(*aiv1alpha1.Mission)
+	if !ok || mission.Spec.RoundTableRef == "" {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Namespace: mission.Namespace, Name: mission.Spec.RoundTableRef},
+	}}
+}
+
+// missionSlotChanged passes Mission creates and deletes, and updates that
+// change its phase or RoundTable — what the active missions count reads.
+func missionSlotChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldMission, ok := e.ObjectOld.(*aiv1alpha1.Mission)
+			if !ok {
+				return false
+			}
+			newMission, ok := e.ObjectNew.(*aiv1alpha1.Mission)
+			if !ok {
+				return false
+			}
+			return oldMission.Status.Phase != newMission.Status.Phase ||
+				oldMission.Spec.RoundTableRef != newMission.Spec.RoundTableRef
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestTablesForKnight(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	table := func(name, ns string, spec aiv1alpha1.RoundTableSpec) *aiv1alpha1.RoundTable {
+		return &aiv1alpha1.RoundTable{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns}, Spec: spec}
+	}
+	r := &RoundTableReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ops"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name: "team-red", Annotations: map[string]string{aiv1alpha1.AnnotationRoundTables: "ops/fleet-a"},
+		}},
+		table("fleet-a", "ops", aiv1alpha1.RoundTableSpec{
+			KnightSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"fleet": "a"}},
+			Namespaces:     []string{"team-red"},
+		}),
+		table("fleet-b", "team-red", aiv1alpha1.RoundTableSpec{
+			KnightSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"fleet": "b"}},
+		}),
+		table("everyone", "team-red", aiv1alpha1.RoundTableSpec{}),
+	).Build()}
+
+	knight := &aiv1alpha1.Knight{ObjectMeta: metav1.ObjectMeta{
+		Name: "gawain", Namespace: "team-red", Labels: map[string]string{"fleet": "a"},
+	}}
+	var got []string
+	for _, req := range r.tablesForKnight(context.Background(), knight) {
+		got = append(got, req.Namespace+"/"+req.Name)
+	}
+	slices.Sort(got)
+	if want := []string{"ops/fleet-a", "team-red/everyone"}; !slices.Equal(got, want) {
+		t.Errorf("tablesForKnight() = %v, want %v", got, want)
+	}
+}
+
+func TestTableForMission(t *testing.T) {
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "quest", Namespace: "default"},
+		Spec:       aiv1alpha1.MissionSpec{RoundTableRef: "fleet"},
+	}
+	requests := tableForMission(context.Background(), mission)
+	if len(requests) != 1 || requests[0].Name != "fleet" || requests[0].Namespace != "default" {
+		t.Errorf("tableForMission() = %v, want default/fleet", requests)
+	}
+	mission.Spec.RoundTableRef = ""
+	if requests := tableForMission(context.Background(), mission); len(requests) != 0 {
+		t.Errorf("tableForMission() = %v, want none without a roundTableRef", requests)
+	}
+}
+
+func TestMissionSlotChanged(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(*aiv1alpha1.Mission)
+		want   bool
+	}{
+		{name: "no change", update: func(*aiv1alpha1.Mission) {}},
+		{name: "phase", update: func(m *aiv1alpha1.Mission) { m.Status.Phase = aiv1alpha1.MissionPhaseSucceeded }, want: true},
+		{name: "roundTableRef", update: func(m *aiv1alpha1.Mission) { m.Spec.RoundTableRef = "other" }, want: true},
+		{name: "result", update: func(m *aiv1alpha1.Mission) { m.Status.Result = "done" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldMission := &aiv1alpha1.Mission{
+				Spec:   aiv1alpha1.MissionSpec{RoundTableRef: "fleet"},
+				Status: aiv1alpha1.MissionStatus{Phase: aiv1alpha1.MissionPhaseActive},
+			}
+			newMission := oldMission.DeepCopy()
+			tt.update(newMission)
+			if got := missionSlotChanged().Update(event.UpdateEvent{ObjectOld: oldMission, ObjectNew: newMission}); got != tt.want {
+				t.Errorf("Update() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 
 	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
 	"github.com/dapperdivers/roundtable/internal/util"
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
 )
 
 // knightToolPATH returns the container PATH for a knight, set as a pod-spec env
@@ -57,6 +58,7 @@ type PodBuilder struct {
 	mounts     []corev1.VolumeMount
 	sidecars   []corev1.Container
 	env        []corev1.EnvVar
+	envFrom    []corev1.EnvFromSource
 	defaultImg string
 	security   PodSecurity
 	reader     client.Reader
@@ -176,20 +178,22 @@ func (b *PodBuilder) WithNixStore() *PodBuilder {
 	return b
 }
 
-// WithVault adds the vault PVC with optional writable subpaths.
-func (b *PodBuilder) WithVault() *PodBuilder {
-	if b.knight.Spec.Vault == nil {
+// WithVault adds the vault PVC with optional writable subpaths. Knights
+// without a vault inherit their RoundTable's.
+func (b *PodBuilder) WithVault(ctx context.Context) *PodBuilder {
+	vault := InheritedVault(ctx, b.reader, b.knight)
+	if vault == nil {
 		return b
 	}
 
-	claimName := b.knight.Spec.Vault.ClaimName
+	claimName := vault.ClaimName
 	if claimName == "" {
 		claimName = "obsidian-vault"
 	}
 
 	// PVC must be ReadOnly=false when writablePaths exist
-	pvcReadOnly := b.knight.Spec.Vault.ReadOnly
-	if len(b.knight.Spec.Vault.WritablePaths) > 0 {
+	pvcReadOnly := vault.ReadOnly
+	if len(vault.WritablePaths) > 0 {
 		pvcReadOnly = false
 	}
 
@@ -207,11 +211,11 @@ func (b *PodBuilder) WithVault() *PodBuilder {
 	b.mounts = append(b.mounts, corev1.VolumeMount{
 		Name:      "vault",
 		MountPath: "/vault",
-		ReadOnly:  b.knight.Spec.Vault.ReadOnly,
+		ReadOnly:  vault.ReadOnly,
 	})
 
 	// Writable subpaths override the read-only base
-	for _, wp := range b.knight.Spec.Vault.WritablePaths {
+	for _, wp := range vault.WritablePaths {
 		b.mounts = append(b.mounts, corev1.VolumeMount{
 			Name:      "vault",
 			MountPath: fmt.Sprintf("/vault/%s", strings.TrimSuffix(wp, "/")),
@@ -267,6 +271,167 @@ func (b *PodBuilder) WithSharedWorkspace(ctx context.Context) *PodBuilder {
 	return b
 }
 
+// Paths the knight's NATS credential and certificate Secrets are mounted at.
+const (
+	natsCredsPath      = "/etc/nats/creds"
+	natsCAPath         = "/etc/nats/ca"
+	natsClientCertPath = "/etc/nats/client"
+)
+
+// WithNATSAuth passes the knight's NATS credentials and TLS settings to the
+// runtime as NATS_* env vars (secrets by secretKeyRef) and mounted files.
+// Auth and TLS unset on the knight are inherited from its RoundTable.
+func (b *PodBuilder) WithNATSAuth(ctx context.Context) *PodBuilder {
+	auth, tls := b.knight.Spec.NATS.Auth, b.knight.Spec.NATS.TLS
+	if auth == nil || tls == nil {
+		if rt := b.roundTable(ctx); rt != nil {
+			if auth == nil {
+				auth = rt.Spec.NATS.Auth
+			}
+			if tls == nil {
+				tls = rt.Spec.NATS.TLS
+			}
+		}
+	}
+
+	secretEnv := func(name string, ref *corev1.SecretKeySelector) {
+		b.env = append(b.env, corev1.EnvVar{Name: name, ValueFrom: &corev1.EnvVarSource{SecretKeyRef: ref}})
+	}
+	if auth != nil {
+		switch {
+		case auth.CredsSecretRef != nil:
+			b.mountSecretKey("nats-creds", auth.CredsSecretRef, natsCredsPath, "user.creds")
+			b.env = append(b.env, corev1.EnvVar{Name: natspkg.EnvCredsFile, Value: natsCredsPath + "/user.creds"})
+		case auth.NKeySeedSecretRef != nil:
+			secretEnv(natspkg.EnvNKeySeed, auth.NKeySeedSecretRef)
+		case auth.TokenSecretRef != nil:
+			secretEnv(natspkg.EnvToken, auth.TokenSecretRef)
+		case auth.User != nil:
+			b.env = append(b.env, corev1.EnvVar{Name: natspkg.EnvUser, Value: auth.User.Username})
+			secretEnv(natspkg.EnvPassword, &auth.User.PasswordSecretRef)
+		}
+	}
+
+	if tls != nil {
+		if tls.CASecretRef != nil {
+			b.mountSecretKey("nats-ca", tls.CASecretRef, natsCAPath, "ca.crt")
+			b.env = append(b.env, corev1.EnvVar{Name: natspkg.EnvCAFile, Value: natsCAPath + "/ca.crt"})
+		}
+		if tls.ClientCertSecretName != "" {
+			b.volumes = append(b.volumes, corev1.Volume{
+				Name:         "nats-client-cert",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: tls.ClientCertSecretName}},
+			})
+			b.mounts = append(b.mounts, corev1.VolumeMount{Name: "nats-client-cert", MountPath: natsClientCertPath, ReadOnly: true})
+			b.env = append(b.env,
+				corev1.EnvVar{Name: natspkg.EnvCertFile, Value: natsClientCertPath + "/" + corev1.TLSCertKey},
+				corev1.EnvVar{Name: natspkg.EnvKeyFile, Value: natsClientCertPath + "/" + corev1.TLSPrivateKeyKey})
+		}
+		if tls.InsecureSkipVerify {
+			b.env = append(b.env, corev1.EnvVar{Name: natspkg.EnvTLSInsecure, Value: "true"})
+		}
+	}
+	return b
+}
+
+// WithKVBuckets passes the names of the RoundTable's shared KV buckets to
+// the runtime: NATS_KV_{NAME} per bucket and NATS_KV_BUCKETS as
+// name=bucket pairs.
+func (b *PodBuilder) WithKVBuckets(ctx context.Context) *PodBuilder {
+	rt := b.roundTable(ctx)
+	if rt == nil || len(rt.Spec.NATS.KVBuckets) == 0 {
+		return b
+	}
+
+	pairs := make([]string, 0, len(rt.Spec.NATS.KVBuckets))
+	for _, kv := range rt.Spec.NATS.KVBuckets {
+		bucket := natspkg.KVBucketName(rt.Spec.NATS.SubjectPrefix, kv.Name)
+		pairs = append(pairs, kv.Name+"="+bucket)
+		b.env = append(b.env, corev1.EnvVar{
+			Name:  "NATS_KV_" + strings.ToUpper(strings.ReplaceAll(kv.Name, "-", "_")),
+			Value: bucket,
+		})
+	}
+	b.env = append(b.env, corev1.EnvVar{Name: "NATS_KV_BUCKETS", Value: strings.Join(pairs, ",")})
+	return b
+}
+
+// WithFleetSecrets injects the RoundTable secrets listed in the knight's
+// ai.roundtable.io/fleet-secrets annotation as envFrom. The RoundTable
+// controller maintains the annotation, so knights in other namespaces get
+// their table's secrets too.
+func (b *PodBuilder) WithFleetSecrets() *PodBuilder {
+	names := b.knight.Annotations[aiv1alpha1.AnnotationFleetSecrets]
+	if names == "" {
+		return b
+	}
+	for _, name := range strings.Split(names, ",") {
+		b.envFrom = append(b.envFrom, corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: name}},
+		})
+	}
+	return b
+}
+
+// roundTable returns the RoundTable named by the knight's
+// ai.roundtable.io/table label, or nil without a reader, label or table.
+func (b *PodBuilder) roundTable(ctx context.Context) *aiv1alpha1.RoundTable {
+	return knightTable(ctx, b.reader, b.knight)
+}
+
+// knightTable returns the RoundTable named by k's ai.roundtable.io/table
+// label, or nil without a reader, label or table.
+func knightTable(ctx context.Context, reader client.Reader, k *aiv1alpha1.Knight) *aiv1alpha1.RoundTable {
+	if reader == nil {
+		return nil
+	}
+	tableName, ok := k.Labels["ai.roundtable.io/table"]
+	if !ok {
+		return nil
+	}
+	rt := &aiv1alpha1.RoundTable{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: tableName, Namespace: k.Namespace}, rt); err != nil {
+		return nil
+	}
+	return rt
+}
+
+// InheritedVault returns the knight's vault config or, when it sets none,
+// its RoundTable's, so fleet-wide vault changes reach every knight without
+// editing them.
+func InheritedVault(ctx context.Context, reader client.Reader, k *aiv1alpha1.Knight) *aiv1alpha1.KnightVault {
+	if k.Spec.Vault != nil {
+		return k.Spec.Vault
+	}
+	if rt := knightTable(ctx, reader, k); rt != nil {
+		return rt.Spec.Vault
+	}
+	return nil
+}
+
+// TasksStream returns the stream the knight consumes tasks from: its
+// domain's stream when its RoundTable uses the PerDomain topology and the
+// knight kept the table's tasks stream, otherwise spec.nats.stream.
+func TasksStream(ctx context.Context, reader client.Reader, k *aiv1alpha1.Knight) string {
+	rt := knightTable(ctx, reader, k)
+	if rt == nil || rt.Spec.NATS.Topology != aiv1alpha1.NATSTopologyPerDomain || k.Spec.NATS.Stream != rt.Spec.NATS.TasksStream {
+		return k.Spec.NATS.Stream
+	}
+	return natspkg.DomainStreamName(rt.Spec.NATS.TasksStream, k.Spec.Domain)
+}
+
+// mountSecretKey mounts one key of a Secret as file under dir.
+func (b *PodBuilder) mountSecretKey(volume string, ref *corev1.SecretKeySelector, dir, file string) {
+	b.volumes = append(b.volumes, corev1.Volume{
+		Name: volume,
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+			SecretName: ref.Name,
+			Items:      []corev1.KeyToPath{{Key: ref.Key, Path: file}},
+		}},
+	})
+	b.mounts = append(b.mounts, corev1.VolumeMount{Name: volume, MountPath: dir, ReadOnly: true})
+}
+
 // WithArsenal adds emptyDir volumes for git-sync and skills.
 func (b *PodBuilder) WithArsenal() *PodBuilder {
 	b.volumes = append(b.volumes,
@@ -474,7 +639,7 @@ func (b *PodBuilder) Build(ctx context.Context) corev1.PodSpec {
 		{Name: "KNIGHT_NAME", Value: util.Capitalize(b.knight.Name)},
 		{Name: "KNIGHT_MODEL", Value: b.knight.Spec.Model},
 		{Name: "NATS_URL", Value: b.knight.Spec.NATS.URL},
-		{Name: "NATS_TASKS_STREAM", Value: b.knight.Spec.NATS.Stream},
+		{Name: "NATS_TASKS_STREAM", Value: TasksStream(ctx, b.reader, b.knight)},
 		{Name: "NATS_RESULTS_STREAM", Value: b.knight.Spec.NATS.ResultsStream},
 		{Name: "NATS_RESULTS_PREFIX", Value: DeriveResultsPrefix(b.knight.Spec.NATS.Subjects)},
 		{Name: "SUBSCRIBE_TOPICS", Value: strings.Join(b.knight.Spec.NATS.Subjects, ",")},
@@ -498,13 +663,19 @@ func (b *PodBuilder) Build(ctx context.Context) corev1.PodSpec {
 	env = append(env, b.knight.Spec.Env...)
 	env = append(env, b.env...)
 
+	// Table secrets come first, so the knight's own envFrom overrides them
+	envFrom := b.knight.Spec.EnvFrom
+	if len(b.envFrom) > 0 {
+		envFrom = append(append([]corev1.EnvFromSource{}, b.envFrom...), b.knight.Spec.EnvFrom...)
+	}
+
 	// Main knight container
 	probePort := 3000
 	knightContainer := corev1.Container{
 		Name:    "app",
 		Image:   image,
 		Env:     env,
-		EnvFrom: b.knight.Spec.EnvFrom,
+		EnvFrom: envFrom,
 		Resources: corev1.ResourceRequirements{
 			Requests: corev1.ResourceList{
 				corev1.ResourceMemory: resource.MustParse("256Mi"),
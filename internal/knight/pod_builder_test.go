@@ -24,6 +24,8 @@ import (
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
 )
@@ -140,7 +142,7 @@ var _ = Describe("PodBuilder", func() {
 
 	Describe("WithVault", func() {
 		It("does nothing when vault not configured", func() {
-			builder.WithVault()
+			builder.WithVault(context.Background())
 			Expect(builder.volumes).To(BeEmpty())
 			Expect(builder.mounts).To(BeEmpty())
 		})
@@ -150,7 +152,7 @@ var _ = Describe("PodBuilder", func() {
 				ClaimName: "my-vault",
 				ReadOnly:  true,
 			}
-			builder.WithVault()
+			builder.WithVault(context.Background())
 
 			Expect(builder.volumes).To(HaveLen(1))
 			Expect(builder.volumes[0].Name).To(Equal("vault"))
@@ -168,7 +170,7 @@ var _ = Describe("PodBuilder", func() {
 				ReadOnly:      true,
 				WritablePaths: []string{"logs/", "temp"},
 			}
-			builder.WithVault()
+			builder.WithVault(context.Background())
 
 			// PVC must be non-readonly when writable paths exist
 			Expect(builder.volumes[0].PersistentVolumeClaim.ReadOnly).To(BeFalse())
@@ -194,10 +196,29 @@ var _ = Describe("PodBuilder", func() {
 			knight.Spec.Vault = &aiv1alpha1.KnightVault{
 				ReadOnly: true,
 			}
-			builder.WithVault()
+			builder.WithVault(context.Background())
 
 			Expect(builder.volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("obsidian-vault"))
 		})
+
+		It("inherits the RoundTable's vault unless the knight sets its own", func() {
+			scheme := runtime.NewScheme()
+			Expect(aiv1alpha1.AddToScheme(scheme)).To(Succeed())
+			rt := &aiv1alpha1.RoundTable{
+				ObjectMeta: metav1.ObjectMeta{Name: "fleet-a", Namespace: "default"},
+				Spec:       aiv1alpha1.RoundTableSpec{Vault: &aiv1alpha1.KnightVault{ClaimName: "fleet-vault", ReadOnly: true}},
+			}
+			knight.Labels = map[string]string{"ai.roundtable.io/table": "fleet-a"}
+			builder.WithReader(fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt).Build()).
+				WithVault(context.Background())
+			Expect(builder.volumes).To(HaveLen(1))
+			Expect(builder.volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("fleet-vault"))
+
+			knight.Spec.Vault = &aiv1alpha1.KnightVault{ClaimName: "my-vault"}
+			builder.volumes = nil
+			builder.WithVault(context.Background())
+			Expect(builder.volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("my-vault"))
+		})
 	})
 
 	Describe("WithArsenal", func() {
@@ -220,6 +241,115 @@ var _ = Describe("PodBuilder", func() {
 		})
 	})
 
+	Describe("WithNATSAuth", func() {
+		It("does nothing without auth or TLS", func() {
+			builder.WithNATSAuth(context.Background())
+			Expect(builder.env).To(BeEmpty())
+			Expect(builder.volumes).To(BeEmpty())
+		})
+
+		It("passes the password by secretKeyRef and mounts the CA", func() {
+			knight.Spec.NATS.Auth = &aiv1alpha1.NATSAuth{User: &aiv1alpha1.NATSUserAuth{
+				Username:          "galahad",
+				PasswordSecretRef: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "nats-users"}, Key: "galahad"},
+			}}
+			knight.Spec.NATS.TLS = &aiv1alpha1.NATSTLS{
+				CASecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "nats-ca"}, Key: "ca.pem"},
+			}
+			builder.WithNATSAuth(context.Background())
+
+			Expect(builder.env).To(HaveLen(3))
+			Expect(builder.env[0]).To(Equal(corev1.EnvVar{Name: "NATS_USER", Value: "galahad"}))
+			Expect(builder.env[1].Name).To(Equal("NATS_PASSWORD"))
+			Expect(builder.env[1].ValueFrom.SecretKeyRef.Name).To(Equal("nats-users"))
+			Expect(builder.env[2]).To(Equal(corev1.EnvVar{Name: "NATS_CA_FILE", Value: "/etc/nats/ca/ca.crt"}))
+			Expect(builder.volumes).To(HaveLen(1))
+			Expect(builder.volumes[0].Secret.Items).To(Equal([]corev1.KeyToPath{{Key: "ca.pem", Path: "ca.crt"}}))
+			Expect(builder.mounts[0].MountPath).To(Equal("/etc/nats/ca"))
+		})
+
+		It("inherits the RoundTable's creds", func() {
+			scheme := runtime.NewScheme()
+			Expect(aiv1alpha1.AddToScheme(scheme)).To(Succeed())
+			rt := &aiv1alpha1.RoundTable{
+				ObjectMeta: metav1.ObjectMeta{Name: "fleet-a", Namespace: "default"},
+				Spec: aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{
+					Auth: &aiv1alpha1.NATSAuth{
+						CredsSecretRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "fleet-creds"}, Key: "user.creds"},
+					},
+				}},
+			}
+			knight.Labels = map[string]string{"ai.roundtable.io/table": "fleet-a"}
+			builder.WithReader(fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt).Build()).
+				WithNATSAuth(context.Background())
+
+			Expect(builder.env).To(Equal([]corev1.EnvVar{{Name: "NATS_CREDS_FILE", Value: "/etc/nats/creds/user.creds"}}))
+			Expect(builder.volumes).To(HaveLen(1))
+			Expect(builder.volumes[0].Secret.SecretName).To(Equal("fleet-creds"))
+		})
+	})
+
+	Describe("WithKVBuckets", func() {
+		It("exposes the RoundTable's bucket names", func() {
+			scheme := runtime.NewScheme()
+			Expect(aiv1alpha1.AddToScheme(scheme)).To(Succeed())
+			rt := &aiv1alpha1.RoundTable{
+				ObjectMeta: metav1.ObjectMeta{Name: "fleet-a", Namespace: "default"},
+				Spec: aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{
+					SubjectPrefix: "fleet-a",
+					KVBuckets:     []aiv1alpha1.RoundTableKVBucket{{Name: "memory"}, {Name: "scratch-pad"}},
+				}},
+			}
+			knight.Labels = map[string]string{"ai.roundtable.io/table": "fleet-a"}
+			builder.WithReader(fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt).Build()).
+				WithKVBuckets(context.Background())
+
+			Expect(builder.env).To(Equal([]corev1.EnvVar{
+				{Name: "NATS_KV_MEMORY", Value: "fleet-a-memory"},
+				{Name: "NATS_KV_SCRATCH_PAD", Value: "fleet-a-scratch-pad"},
+				{Name: "NATS_KV_BUCKETS", Value: "memory=fleet-a-memory,scratch-pad=fleet-a-scratch-pad"},
+			}))
+		})
+	})
+
+	Describe("TasksStream", func() {
+		It("points knights on the table's stream at their domain's under PerDomain", func() {
+			scheme := runtime.NewScheme()
+			Expect(aiv1alpha1.AddToScheme(scheme)).To(Succeed())
+			rt := &aiv1alpha1.RoundTable{
+				ObjectMeta: metav1.ObjectMeta{Name: "fleet-a", Namespace: "default"},
+				Spec: aiv1alpha1.RoundTableSpec{NATS: aiv1alpha1.RoundTableNATS{
+					TasksStream: "test_tasks",
+					Topology:    aiv1alpha1.NATSTopologyPerDomain,
+				}},
+			}
+			reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt).Build()
+			knight.Labels = map[string]string{"ai.roundtable.io/table": "fleet-a"}
+			spec := builder.WithReader(reader).Build(context.Background())
+
+			Expect(spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "NATS_TASKS_STREAM", Value: "test_tasks_testing"}))
+
+			knight.Spec.NATS.Stream = "private_tasks"
+			Expect(TasksStream(context.Background(), reader, knight)).To(Equal("private_tasks"))
+		})
+	})
+
+	Describe("WithFleetSecrets", func() {
+		It("injects the annotated secrets ahead of the knight's own envFrom", func() {
+			knight.Annotations = map[string]string{aiv1alpha1.AnnotationFleetSecrets: "anthropic-api-key,github-token"}
+			knight.Spec.EnvFrom = []corev1.EnvFromSource{{ConfigMapRef: &corev1.ConfigMapEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "overrides"},
+			}}}
+			spec := builder.WithFleetSecrets().Build(context.Background())
+
+			envFrom := spec.Containers[0].EnvFrom
+			Expect(envFrom).To(HaveLen(3))
+			Expect(envFrom[0].SecretRef.Name).To(Equal("anthropic-api-key"))
+			Expect(envFrom[1].SecretRef.Name).To(Equal("github-token"))
+			Expect(envFrom[2].ConfigMapRef.Name).To(Equal("overrides"))
+		})
+	})
+
 	Describe("WithSkillFilter", func() {
 		It("adds skill-filter sidecar container", func() {
 			builder.WithSkillFilter()
@@ -66,15 +66,17 @@ func (a *KnightAssembler) ReconcileAssembling(ctx context.Context, mission *aiv1
 		if rt != nil {
 			return rt, nil
 		}
-		rtName := mission.Status.RoundTableName
+		// The ephemeral RoundTable lives with the mission's workloads; a
+		// referenced one with the mission.
+		rtName, rtNamespace := mission.Status.RoundTableName, WorkNamespace(mission)
 		if rtName == "" {
-			rtName = mission.Spec.RoundTableRef
+			rtName, rtNamespace = mission.Spec.RoundTableRef, mission.Namespace
 		}
 		if rtName == "" {
 			return nil, fmt.Errorf("cannot create ephemeral knight: no RoundTable (neither status.roundTableName nor spec.roundTableRef is set)")
 		}
 		rt = &aiv1alpha1.RoundTable{}
-		rtKey := types.NamespacedName{Name: rtName, Namespace: mission.Namespace}
+		rtKey := types.NamespacedName{Name: rtName, Namespace: rtNamespace}
 		if err := a.Client.Get(ctx, rtKey, rt); err != nil {
 			return nil, fmt.Errorf("failed to get RoundTable %q: %w", rtName, err)
 		}
@@ -135,7 +137,7 @@ func (a *KnightAssembler) ReconcileAssembling(ctx context.Context, mission *aiv1
 		// Ephemeral knight - create if doesn't exist (or claim from warm pool)
 		knightName := fmt.Sprintf("%s-%s", mission.Name, mk.Name)
 		knight := &aiv1alpha1.Knight{}
-		knightKey := types.NamespacedName{Name: knightName, Namespace: mission.Namespace}
+		knightKey := types.NamespacedName{Name: knightName, Namespace: WorkNamespace(mission)}
 		err := a.Client.Get(ctx, knightKey, knight)
 
 		if err != nil && client.IgnoreNotFound(err) == nil {
@@ -511,18 +513,15 @@ func (a *KnightAssembler) buildEphemeralKnight(
 	spec.ServiceAccountName = fmt.Sprintf("mission-%s", mission.Name)
 
 	// Build Knight CR
+	labels := MissionLabels(mission)
+	labels[aiv1alpha1.LabelEphemeral] = "true"
+	labels[aiv1alpha1.LabelRoundTable] = rt.Name
 	knight := &aiv1alpha1.Knight{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      knightName,
-			Namespace: mission.Namespace,
-			Labels: map[string]string{
-				aiv1alpha1.LabelMission:    mission.Name,
-				aiv1alpha1.LabelEphemeral:  "true",
-				aiv1alpha1.LabelRoundTable: rt.Name,
-			},
-			OwnerReferences: []metav1.OwnerReference{
-				*metav1.NewControllerRef(mission, aiv1alpha1.GroupVersion.WithKind("Mission")),
-			},
+			Name:            knightName,
+			Namespace:       WorkNamespace(mission),
+			Labels:          labels,
+			OwnerReferences: OwnerReferences(mission),
 		},
 		Spec: *spec,
 	}
@@ -541,22 +540,19 @@ func (a *KnightAssembler) buildEphemeralKnight(
 // ensureMissionServiceAccount creates a mission-scoped ServiceAccount if it doesn't exist.
 func (a *KnightAssembler) EnsureMissionServiceAccount(ctx context.Context, mission *aiv1alpha1.Mission, saName string) error {
 	sa := &corev1.ServiceAccount{}
-	saKey := types.NamespacedName{Name: saName, Namespace: mission.Namespace}
+	saKey := types.NamespacedName{Name: saName, Namespace: WorkNamespace(mission)}
 	err := a.Client.Get(ctx, saKey, sa)
 
 	if err != nil && client.IgnoreNotFound(err) == nil {
 		// Create ServiceAccount
+		labels := MissionLabels(mission)
+		labels[aiv1alpha1.LabelEphemeral] = "true"
 		sa = &corev1.ServiceAccount{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      saName,
-				Namespace: mission.Namespace,
-				Labels: map[string]string{
-					aiv1alpha1.LabelMission:   mission.Name,
-					aiv1alpha1.LabelEphemeral: "true",
-				},
-				OwnerReferences: []metav1.OwnerReference{
-					*metav1.NewControllerRef(mission, aiv1alpha1.GroupVersion.WithKind("Mission")),
-				},
+				Name:            saName,
+				Namespace:       WorkNamespace(mission),
+				Labels:          labels,
+				OwnerReferences: OwnerReferences(mission),
 			},
 		}
 
@@ -575,7 +571,7 @@ func (a *KnightAssembler) EnsureMissionServiceAccount(ctx context.Context, missi
 func (a *KnightAssembler) EnsureMissionNetworkPolicy(ctx context.Context, mission *aiv1alpha1.Mission) error {
 	policyName := fmt.Sprintf("mission-%s-isolation", mission.Name)
 	policy := &networkingv1.NetworkPolicy{}
-	policyKey := types.NamespacedName{Name: policyName, Namespace: mission.Namespace}
+	policyKey := types.NamespacedName{Name: policyName, Namespace: WorkNamespace(mission)}
 	err := a.Client.Get(ctx, policyKey, policy)
 
 	if err != nil && client.IgnoreNotFound(err) == nil {
@@ -595,17 +591,14 @@ func (a *KnightAssembler) EnsureMissionNetworkPolicy(ctx context.Context, missio
 
 // buildMissionNetworkPolicy constructs a NetworkPolicy that restricts ephemeral knight egress.
 func (a *KnightAssembler) buildMissionNetworkPolicy(mission *aiv1alpha1.Mission, policyName string) *networkingv1.NetworkPolicy {
+	labels := MissionLabels(mission)
+	labels[aiv1alpha1.LabelEphemeral] = "true"
 	return &networkingv1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      policyName,
-			Namespace: mission.Namespace,
-			Labels: map[string]string{
-				aiv1alpha1.LabelMission:   mission.Name,
-				aiv1alpha1.LabelEphemeral: "true",
-			},
-			OwnerReferences: []metav1.OwnerReference{
-				*metav1.NewControllerRef(mission, aiv1alpha1.GroupVersion.WithKind("Mission")),
-			},
+			Name:            policyName,
+			Namespace:       WorkNamespace(mission),
+			Labels:          labels,
+			OwnerReferences: OwnerReferences(mission),
 		},
 		Spec: networkingv1.NetworkPolicySpec{
 			// Apply to all ephemeral knights in this mission
@@ -693,6 +686,8 @@ func (a *KnightAssembler) BuildEphemeralRoundTable(
 	// Get parent RoundTable for defaults (if specified)
 	var parentDefaults *aiv1alpha1.RoundTableDefaults
 	var parentPolicies *aiv1alpha1.RoundTablePolicies
+	var parentSecrets []corev1.LocalObjectReference
+	var parentTemplates map[string]aiv1alpha1.KnightSpec
 	natsURL := "nats://nats.database.svc.cluster.local:4222" // Default
 
 	if mission.Spec.RoundTableRef != "" {
@@ -709,6 +704,11 @@ func (a *KnightAssembler) BuildEphemeralRoundTable(
 			if parentRT.Spec.NATS.URL != "" {
 				natsURL = parentRT.Spec.NATS.URL
 			}
+			// An isolated mission runs on its ephemeral RoundTable even
+			// with a roundTableRef, so its knights still need the parent's
+			// secrets and templates.
+			parentSecrets = parentRT.Spec.Secrets
+			parentTemplates = parentRT.Spec.KnightTemplates
 		}
 	}
 
@@ -726,21 +726,20 @@ func (a *KnightAssembler) BuildEphemeralRoundTable(
 	}
 
 	// Build RoundTable CR
+	labels := MissionLabels(mission)
+	labels[aiv1alpha1.LabelEphemeral] = "true"
 	rt := &aiv1alpha1.RoundTable{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: mission.Namespace,
-			Labels: map[string]string{
-				aiv1alpha1.LabelMission:   mission.Name,
-				aiv1alpha1.LabelEphemeral: "true",
-			},
-			OwnerReferences: []metav1.OwnerReference{
-				*metav1.NewControllerRef(mission, aiv1alpha1.GroupVersion.WithKind("Mission")),
-			},
+			Name:            name,
+			Namespace:       WorkNamespace(mission),
+			Labels:          labels,
+			OwnerReferences: OwnerReferences(mission),
 		},
 		Spec: aiv1alpha1.RoundTableSpec{
-			Ephemeral:  true,
-			MissionRef: mission.Name,
+			Ephemeral:       true,
+			MissionRef:      mission.Name,
+			Secrets:         parentSecrets,
+			KnightTemplates: parentTemplates,
 			NATS: aiv1alpha1.RoundTableNATS{
 				URL:             natsURL,
 				SubjectPrefix:   natsPrefix,
@@ -770,9 +769,14 @@ func (a *KnightAssembler) BuildEphemeralRoundTable(
 		rt.Spec.Policies = &aiv1alpha1.RoundTablePolicies{}
 	}
 
-	// Override cost budget with mission budget
-	if mission.Spec.CostBudgetUSD != "" && mission.Spec.CostBudgetUSD != "0" {
-		rt.Spec.Policies.CostBudgetUSD = mission.Spec.CostBudgetUSD
+	// Override cost budget with mission budget, or the parent's default
+	// mission budget
+	budget := mission.Spec.CostBudgetUSD
+	if budget == "" && parentDefaults != nil {
+		budget = parentDefaults.MissionCostBudgetUSD
+	}
+	if budget != "" && budget != "0" {
+		rt.Spec.Policies.CostBudgetUSD = budget
 	}
 
 	// Set max knights to mission knight count
@@ -0,0 +1,60 @@
+package mission
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+// Isolated reports whether the mission runs its workloads in a
+// mission-scoped namespace.
+func Isolated(mission *aiv1alpha1.Mission) bool {
+	return mission.Spec.Isolation == aiv1alpha1.MissionIsolationNamespace
+}
+
+// IsolationNamespaceName returns the name of an isolated mission's
+// namespace: "mission-<name>-<uid8>", so a recreated mission never reuses a
+// namespace that is still terminating.
+func IsolationNamespaceName(mission *aiv1alpha1.Mission) string {
+	uid := string(mission.UID)
+	if len(uid) > 8 {
+		uid = uid[:8]
+	}
+	return fmt.Sprintf("mission-%s-%s", mission.Name, uid)
+}
+
+// WorkNamespace returns the namespace holding the mission's ephemeral
+// knights, chain copies and ephemeral RoundTable: its isolation namespace
+// once created, otherwise the mission's own.
+func WorkNamespace(mission *aiv1alpha1.Mission) string {
+	if mission.Status.IsolationNamespace != "" {
+		return mission.Status.IsolationNamespace
+	}
+	return mission.Namespace
+}
+
+// MissionLabels returns the labels marking an object as the mission's.
+// Objects in an isolation namespace also record the mission's namespace,
+// since it can't be read from their own.
+func MissionLabels(mission *aiv1alpha1.Mission) map[string]string {
+	labels := map[string]string{aiv1alpha1.LabelMission: mission.Name}
+	if WorkNamespace(mission) != mission.Namespace {
+		labels[aiv1alpha1.LabelMissionNamespace] = mission.Namespace
+	}
+	return labels
+}
+
+// OwnerReferences returns the owner references for an object in the
+// mission's work namespace. Owner references can't cross namespaces, so
+// objects in an isolation namespace have none; deleting the namespace
+// removes them.
+func OwnerReferences(mission *aiv1alpha1.Mission) []metav1.OwnerReference {
+	if WorkNamespace(mission) != mission.Namespace {
+		return nil
+	}
+	return []metav1.OwnerReference{
+		*metav1.NewControllerRef(mission, aiv1alpha1.GroupVersion.WithKind("Mission")),
+	}
+}
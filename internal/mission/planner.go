@@ -44,6 +44,19 @@ func natsPrefix(mission *aiv1alpha1.Mission) string {
 	return fmt.Sprintf("mission-%s", mission.Name)
 }
 
+// archiveAudit copies a planning task or result of an audited mission into
+// its audit stream. A failure is logged: the task has already been
+// dispatched, or its result received.
+func archiveAudit(ctx context.Context, natsClient natspkg.Client, mission *aiv1alpha1.Mission, kind, taskID string, v interface{}) {
+	if mission.Spec.Audit == nil {
+		return
+	}
+	subject := natspkg.AuditSubject(mission.Namespace, mission.Name, kind, taskID)
+	if err := natsClient.PublishJSON(subject, v); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to archive planning message", "subject", subject)
+	}
+}
+
 // ReconcilePlanning handles the Planning phase for meta-missions.
 func (p *Planner) ReconcilePlanning(ctx context.Context, mission *aiv1alpha1.Mission) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
@@ -88,6 +101,10 @@ func (p *Planner) ReconcilePlanning(ctx context.Context, mission *aiv1alpha1.Mis
 	// If PlanApplied condition is True, skip plan application and transition to Assembling
 	planAppliedCondition := meta.FindStatusCondition(mission.Status.Conditions, "PlanApplied")
 	if planAppliedCondition != nil && planAppliedCondition.Status == metav1.ConditionTrue {
+		if PlanApprovalPending(mission) {
+			log.V(1).Info("Plan applied, waiting for approval")
+			return ctrl.Result{}, nil
+		}
 		log.Info("Plan already applied, transitioning to Assembling phase")
 		mission.Status.Phase = aiv1alpha1.MissionPhaseAssembling
 		mission.Status.ObservedGeneration = mission.Generation
@@ -262,8 +279,11 @@ func (p *Planner) ReconcilePlanning(ctx context.Context, mission *aiv1alpha1.Mis
 		ObservedGeneration: mission.Generation,
 	})
 
-	// Transition to Assembling phase with the PlanApplied condition in one update
-	mission.Status.Phase = aiv1alpha1.MissionPhaseAssembling
+	// Transition to Assembling phase with the PlanApplied condition in one
+	// update, unless the plan waits for review
+	if !PlanApprovalPending(mission) {
+		mission.Status.Phase = aiv1alpha1.MissionPhaseAssembling
+	}
 	mission.Status.ObservedGeneration = mission.Generation
 
 	if err := p.Client.Status().Update(ctx, mission); err != nil {
@@ -274,6 +294,13 @@ func (p *Planner) ReconcilePlanning(ctx context.Context, mission *aiv1alpha1.Mis
 	return ctrl.Result{}, nil
 }
 
+// PlanApprovalPending reports whether the mission's generated plan must be
+// approved before the mission leaves Planning.
+func PlanApprovalPending(mission *aiv1alpha1.Mission) bool {
+	return mission.Spec.Planner != nil && mission.Spec.Planner.RequireApproval &&
+		!meta.IsStatusConditionTrue(mission.Status.Conditions, aiv1alpha1.ConditionPlanApproved)
+}
+
 // ensurePlannerKnight creates or retrieves the planner knight.
 func (p *Planner) ensurePlannerKnight(ctx context.Context, mission *aiv1alpha1.Mission) (*aiv1alpha1.Knight, error) {
 	log := logf.FromContext(ctx)
@@ -300,7 +327,7 @@ func (p *Planner) ensurePlannerKnight(ctx context.Context, mission *aiv1alpha1.M
 	knight := &aiv1alpha1.Knight{}
 	err := p.Client.Get(ctx, types.NamespacedName{
 		Name:      plannerKnightName,
-		Namespace: mission.Namespace,
+		Namespace: WorkNamespace(mission),
 	}, knight)
 	if err == nil {
 		log.Info("Planner knight already exists", "knight", knight.Name)
@@ -347,18 +374,15 @@ func (p *Planner) ensurePlannerKnight(ctx context.Context, mission *aiv1alpha1.M
 	}
 
 	// Create knight CR
+	labels := MissionLabels(mission)
+	labels[aiv1alpha1.LabelEphemeral] = "true"
+	labels["ai.roundtable.io/role"] = "planner"
 	knight = &aiv1alpha1.Knight{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      plannerKnightName,
-			Namespace: mission.Namespace,
-			Labels: map[string]string{
-				aiv1alpha1.LabelMission:   mission.Name,
-				aiv1alpha1.LabelEphemeral: "true",
-				"ai.roundtable.io/role":   "planner",
-			},
-			OwnerReferences: []metav1.OwnerReference{
-				*metav1.NewControllerRef(mission, aiv1alpha1.GroupVersion.WithKind("Mission")),
-			},
+			Name:            plannerKnightName,
+			Namespace:       WorkNamespace(mission),
+			Labels:          labels,
+			OwnerReferences: OwnerReferences(mission),
 		},
 		Spec: *spec,
 	}
@@ -392,8 +416,9 @@ func (p *Planner) dispatchPlanningTask(ctx context.Context, mission *aiv1alpha1.
 
 	// Construct task payload
 	payload := natspkg.TaskPayload{
-		TaskID: taskID,
-		Task:   prompt,
+		TaskID:   taskID,
+		Task:     prompt,
+		Priority: mission.Spec.Priority,
 	}
 
 	// Publish to planner knight's task subject.
@@ -406,9 +431,10 @@ func (p *Planner) dispatchPlanningTask(ctx context.Context, mission *aiv1alpha1.
 	}
 	subject := natspkg.TaskSubject(prefix, plannerKnight.Spec.Domain, plannerKnight.Name)
 
-	if err := natsClient.PublishJSON(subject, payload); err != nil {
+	if err := natspkg.PublishTask(natsClient, subject, payload); err != nil {
 		return "", fmt.Errorf("failed to publish planning task: %w", err)
 	}
+	archiveAudit(ctx, natsClient, mission, natspkg.AuditKindTask, taskID, payload)
 
 	log.Info("Published planning task",
 		"taskID", taskID,
@@ -651,6 +677,7 @@ func (p *Planner) pollPlanningResult(ctx context.Context, mission *aiv1alpha1.Mi
 	if err := json.Unmarshal(msg.Data, &taskResult); err != nil {
 		return nil, fmt.Errorf("failed to parse planning result: %w", err)
 	}
+	archiveAudit(ctx, natsClient, mission, natspkg.AuditKindResult, taskID, taskResult)
 
 	log.Info("Retrieved planning result from stream",
 		"taskID", taskID,
@@ -738,6 +765,9 @@ func (p *Planner) validatePlan(ctx context.Context, mission *aiv1alpha1.Mission,
 		knightNames[k.Name] = true
 
 		if !k.Ephemeral {
+			if Isolated(mission) {
+				return fmt.Errorf("knight %q must be ephemeral: an isolated mission cannot recruit existing knights", k.Name)
+			}
 			knight := &aiv1alpha1.Knight{}
 			err := p.Client.Get(ctx, types.NamespacedName{
 				Name:      k.Name,
@@ -968,18 +998,15 @@ func (p *Planner) applyPlan(ctx context.Context, mission *aiv1alpha1.Mission, pl
 
 		// FIX #3: Use consistent naming convention with mission controller
 		chainName := fmt.Sprintf("mission-%s-%s", mission.Name, pc.Name)
+		labels := MissionLabels(mission)
+		labels[aiv1alpha1.LabelEphemeral] = "true"
+		labels["ai.roundtable.io/generated-by"] = "planner"
 		chain := &aiv1alpha1.Chain{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      chainName,
-				Namespace: mission.Namespace,
-				Labels: map[string]string{
-					aiv1alpha1.LabelMission:         mission.Name,
-					aiv1alpha1.LabelEphemeral:       "true",
-					"ai.roundtable.io/generated-by": "planner",
-				},
-				OwnerReferences: []metav1.OwnerReference{
-					*metav1.NewControllerRef(mission, aiv1alpha1.GroupVersion.WithKind("Mission")),
-				},
+				Name:            chainName,
+				Namespace:       WorkNamespace(mission),
+				Labels:          labels,
+				OwnerReferences: OwnerReferences(mission),
 			},
 			Spec: aiv1alpha1.ChainSpec{
 				Description:   pc.Description,
@@ -987,8 +1014,13 @@ func (p *Planner) applyPlan(ctx context.Context, mission *aiv1alpha1.Mission, pl
 				Input:         pc.Input,
 				MissionRef:    mission.Name,
 				RoundTableRef: mission.Spec.RoundTableRef, // Bug #84: Inherit roundTableRef from parent Mission
+				Priority:      mission.Spec.Priority,
 			},
 		}
+		if Isolated(mission) {
+			// Chains resolve their RoundTable in their own namespace.
+			chain.Spec.RoundTableRef = mission.Status.RoundTableName
+		}
 
 		if pc.Timeout != nil {
 			chain.Spec.Timeout = *pc.Timeout
@@ -1034,18 +1066,15 @@ func (p *Planner) createSkillConfigMaps(ctx context.Context, mission *aiv1alpha1
 	for _, skill := range skills {
 		cmName := fmt.Sprintf("%s-skill-%s", mission.Name, skill.Name)
 
+		labels := MissionLabels(mission)
+		labels[aiv1alpha1.LabelEphemeral] = "true"
+		labels["ai.roundtable.io/skill"] = skill.Name
 		cm := &corev1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      cmName,
-				Namespace: mission.Namespace,
-				Labels: map[string]string{
-					aiv1alpha1.LabelMission:   mission.Name,
-					aiv1alpha1.LabelEphemeral: "true",
-					"ai.roundtable.io/skill":  skill.Name,
-				},
-				OwnerReferences: []metav1.OwnerReference{
-					*metav1.NewControllerRef(mission, aiv1alpha1.GroupVersion.WithKind("Mission")),
-				},
+				Name:            cmName,
+				Namespace:       WorkNamespace(mission),
+				Labels:          labels,
+				OwnerReferences: OwnerReferences(mission),
 			},
 			Data: map[string]string{
 				"skill.sh":    skill.Content,
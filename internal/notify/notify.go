@@ -24,9 +24,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	neturl "net/url"
 	"strings"
 	"time"
 
@@ -49,6 +51,12 @@ const (
 	// resource's completion time.
 	DefaultGiveUpAfter = 15 * time.Minute
 
+	// DiscordContentCap is the longest message Discord accepts.
+	DiscordContentCap = 2000
+
+	// PagerDutySummaryCap is the longest incident summary PagerDuty accepts.
+	PagerDutySummaryCap = 1024
+
 	// IdempotencyHeader mirrors Payload.IdempotencyKey for receivers that
 	// dedupe at the HTTP layer.
 	IdempotencyHeader = "X-Roundtable-Idempotency-Key"
@@ -165,19 +173,88 @@ func (n *Notifier) Deliver(ctx context.Context, url, token string, payload Paylo
 	if err != nil {
 		return fmt.Errorf("marshal payload: %w", err)
 	}
+	header := http.Header{}
+	header.Set(IdempotencyHeader, payload.IdempotencyKey)
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+	return n.post(ctx, url, header, body)
+}
 
+// PostSlack posts text as a Slack incoming-webhook message.
+func (n *Notifier) PostSlack(ctx context.Context, url, text string) error {
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return fmt.Errorf("marshal message: %w", err)
+	}
+	return n.post(ctx, url, nil, body)
+}
+
+// PostDiscord posts text as a Discord webhook message, cut to Discord's
+// DiscordContentCap.
+func (n *Notifier) PostDiscord(ctx context.Context, url, text string) error {
+	if len(text) > DiscordContentCap {
+		text = text[:DiscordContentCap]
+	}
+	body, err := json.Marshal(map[string]string{"content": text})
+	if err != nil {
+		return fmt.Errorf("marshal message: %w", err)
+	}
+	return n.post(ctx, url, nil, body)
+}
+
+// PagerDutyEvent is the subset of a PagerDuty Events API v2 trigger event
+// the operator sends.
+type PagerDutyEvent struct {
+	// DedupKey groups repeat triggers into one incident.
+	DedupKey string
+	Summary  string
+	Source   string
+	Severity string
+}
+
+// PostPagerDuty sends a trigger event to a PagerDuty Events API v2 endpoint.
+// The routing key is a credential and never included in returned errors.
+func (n *Notifier) PostPagerDuty(ctx context.Context, url, routingKey string, event PagerDutyEvent) error {
+	summary := event.Summary
+	if len(summary) > PagerDutySummaryCap {
+		summary = summary[:PagerDutySummaryCap]
+	}
+	body, err := json.Marshal(map[string]any{
+		"routing_key":  routingKey,
+		"event_action": "trigger",
+		"dedup_key":    event.DedupKey,
+		"payload": map[string]string{
+			"summary":  summary,
+			"source":   event.Source,
+			"severity": event.Severity,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+	return n.post(ctx, url, nil, body)
+}
+
+// post sends a JSON body to url. The URL and header values are never
+// included in returned errors: chat webhook URLs are credentials.
+func (n *Notifier) post(ctx context.Context, url string, header http.Header, body []byte) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
 		return fmt.Errorf("build request: %w", err)
 	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set(IdempotencyHeader, payload.IdempotencyKey)
-	if token != "" {
-		req.Header.Set("Authorization", "Bearer "+token)
+	for name, values := range header {
+		req.Header[name] = values
 	}
+	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := n.Client.Do(req)
 	if err != nil {
+		// The client error quotes the URL; keep only the cause.
+		var urlErr *neturl.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
 		return fmt.Errorf("post notification: %w", err)
 	}
 	defer func() {
@@ -128,6 +128,73 @@ func TestDeliverNon2xxIsError(t *testing.T) {
 	}
 }
 
+func TestPostChatMessages(t *testing.T) {
+	var got map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = nil
+		_ = json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+
+	n := NewNotifier([]string{srv.URL})
+	if err := n.PostSlack(context.Background(), srv.URL, "chain failed"); err != nil {
+		t.Fatalf("PostSlack: %v", err)
+	}
+	if got["text"] != "chain failed" {
+		t.Errorf("Slack body = %v", got)
+	}
+
+	if err := n.PostDiscord(context.Background(), srv.URL, strings.Repeat("x", 3000)); err != nil {
+		t.Fatalf("PostDiscord: %v", err)
+	}
+	if len(got["content"]) != DiscordContentCap {
+		t.Errorf("Discord content is %d bytes, want %d", len(got["content"]), DiscordContentCap)
+	}
+}
+
+func TestPostPagerDuty(t *testing.T) {
+	var got struct {
+		RoutingKey  string            `json:"routing_key"`
+		EventAction string            `json:"event_action"`
+		DedupKey    string            `json:"dedup_key"`
+		Payload     map[string]string `json:"payload"`
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	n := NewNotifier([]string{srv.URL})
+	err := n.PostPagerDuty(context.Background(), srv.URL, "rk", PagerDutyEvent{
+		DedupKey: "uid-1",
+		Summary:  strings.Repeat("x", 2000),
+		Source:   "default/recon",
+		Severity: "error",
+	})
+	if err != nil {
+		t.Fatalf("PostPagerDuty: %v", err)
+	}
+	if got.RoutingKey != "rk" || got.EventAction != "trigger" || got.DedupKey != "uid-1" {
+		t.Errorf("event = %+v", got)
+	}
+	if len(got.Payload["summary"]) != PagerDutySummaryCap || got.Payload["severity"] != "error" {
+		t.Errorf("payload = %v", got.Payload)
+	}
+}
+
+func TestPostErrorOmitsURL(t *testing.T) {
+	n := NewNotifier(nil)
+	n.Client = &http.Client{Timeout: time.Second}
+	err := n.PostSlack(context.Background(), "http://127.0.0.1:1/services/T000/B000/sekrit", "hi")
+	if err == nil {
+		t.Fatal("expected error for an unreachable endpoint")
+	}
+	if strings.Contains(err.Error(), "sekrit") {
+		t.Errorf("error message leaks the webhook URL: %v", err)
+	}
+}
+
 func TestTruncate(t *testing.T) {
 	short, cut := Truncate("hello")
 	if short != "hello" || cut {
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/controller"
+)
+
+var chainlog = logf.Log.WithName("chain-webhook")
+
+// SetupChainWebhookWithManager registers the Chain validating webhook.
+func SetupChainWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &aiv1alpha1.Chain{}).
+		WithValidator(&ChainCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-ai-roundtable-io-v1alpha1-chain,mutating=false,failurePolicy=fail,sideEffects=None,groups=ai.roundtable.io,resources=chains,verbs=create;update,versions=v1alpha1,name=vchain-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ChainCustomValidator rejects Chains whose spec would fail validation in
+// the reconciler: cycles or unknown dependsOn references, template parse
+// errors, bad schedules, and the other checks in controller.ValidateChainSpec.
+type ChainCustomValidator struct{}
+
+var _ admission.Validator[*aiv1alpha1.Chain] = &ChainCustomValidator{}
+
+// ValidateCreate validates a new Chain.
+func (v *ChainCustomValidator) ValidateCreate(_ context.Context, chain *aiv1alpha1.Chain) (admission.Warnings, error) {
+	chainlog.V(1).Info("Validating Chain create", "name", chain.Name)
+	return nil, validateChain(chain)
+}
+
+// ValidateUpdate validates a Chain update. Updates that leave the spec
+// alone (finalizers, labels) and updates to a Chain being deleted are
+// allowed, so a Chain created before the webhook was installed can still
+// be cleaned up.
+func (v *ChainCustomValidator) ValidateUpdate(_ context.Context, oldChain, newChain *aiv1alpha1.Chain) (admission.Warnings, error) {
+	if newChain.DeletionTimestamp != nil || equality.Semantic.DeepEqual(oldChain.Spec, newChain.Spec) {
+		return nil, nil
+	}
+	chainlog.V(1).Info("Validating Chain update", "name", newChain.Name)
+	return nil, validateChain(newChain)
+}
+
+// ValidateDelete allows every delete.
+func (v *ChainCustomValidator) ValidateDelete(_ context.Context, _ *aiv1alpha1.Chain) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateChain(chain *aiv1alpha1.Chain) error {
+	if err := controller.ValidateChainSpec(chain); err != nil {
+		return apierrors.NewInvalid(aiv1alpha1.GroupVersion.WithKind("Chain").GroupKind(), chain.Name,
+			field.ErrorList{field.Invalid(field.NewPath("spec"), field.OmitValueType{}, err.Error())})
+	}
+	return nil
+}
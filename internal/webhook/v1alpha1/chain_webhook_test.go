@@ -0,0 +1,75 @@
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestChainCustomValidator(t *testing.T) {
+	valid := func() *aiv1alpha1.Chain {
+		return &aiv1alpha1.Chain{
+			ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default"},
+			Spec: aiv1alpha1.ChainSpec{
+				RoundTableRef: "fleet-a",
+				Schedule:      "0 6 * * *",
+				Steps: []aiv1alpha1.ChainStep{
+					{Name: "scan", KnightRef: "galahad", Task: "scan"},
+					{Name: "report", KnightRef: "gawain", Task: "report {{ .Steps.scan.Output }}", DependsOn: []string{"scan"}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(*aiv1alpha1.Chain)
+		wantErr string
+	}{
+		{name: "valid", mutate: func(*aiv1alpha1.Chain) {}},
+		{name: "cycle", mutate: func(c *aiv1alpha1.Chain) { c.Spec.Steps[0].DependsOn = []string{"report"} }, wantErr: "cycle"},
+		{name: "unknown dependency", mutate: func(c *aiv1alpha1.Chain) { c.Spec.Steps[1].DependsOn = []string{"scna"} }, wantErr: "unknown step"},
+		{name: "template parse error", mutate: func(c *aiv1alpha1.Chain) { c.Spec.Steps[1].Task = "report {{ .Steps.scan.Output" }, wantErr: "invalid template"},
+		{name: "bad schedule", mutate: func(c *aiv1alpha1.Chain) { c.Spec.Schedule = "every morning" }, wantErr: "invalid schedule"},
+	}
+	v := &ChainCustomValidator{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := valid()
+			tt.mutate(chain)
+			_, err := v.ValidateCreate(context.Background(), chain)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateCreate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateCreate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChainCustomValidatorUpdate(t *testing.T) {
+	v := &ChainCustomValidator{}
+	broken := &aiv1alpha1.Chain{Spec: aiv1alpha1.ChainSpec{Steps: []aiv1alpha1.ChainStep{
+		{Name: "scan", KnightRef: "galahad", DependsOn: []string{"scan"}},
+	}}}
+
+	// A Chain that predates the webhook can still get finalizer updates.
+	updated := broken.DeepCopy()
+	updated.Finalizers = nil
+	if _, err := v.ValidateUpdate(context.Background(), broken, updated); err != nil {
+		t.Errorf("ValidateUpdate() without a spec change error = %v", err)
+	}
+
+	updated.Spec.Description = "changed"
+	if _, err := v.ValidateUpdate(context.Background(), broken, updated); err == nil {
+		t.Error("ValidateUpdate() accepted an invalid spec change")
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/controller"
+)
+
+var policylog = logf.Log.WithName("fleet-policy-webhook")
+
+// SetupFleetPolicyWebhookWithManager registers the fleet policy webhooks
+// for Chains and Missions.
+func SetupFleetPolicyWebhookWithManager(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr, &aiv1alpha1.Chain{}).
+		WithDefaulter(&ChainFleetPolicy{Client: mgr.GetClient()}).
+		Complete(); err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr, &aiv1alpha1.Mission{}).
+		WithDefaulter(&MissionFleetPolicy{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-ai-roundtable-io-v1alpha1-chain,mutating=true,failurePolicy=fail,sideEffects=None,groups=ai.roundtable.io,resources=chains,verbs=create;update,versions=v1alpha1,name=mchain-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ChainFleetPolicy enforces a RoundTable's policies on Chains: it rejects
+// Chains using knights outside the table or its allowedDomains, or created
+// while the table is over budget, and annotates the rest with the limits
+// they will wait on. An update is checked again when it changes the steps
+// or the RoundTable, so a compliant Chain can't be edited out of policy.
+type ChainFleetPolicy struct {
+	Client client.Reader
+}
+
+var _ admission.Defaulter[*aiv1alpha1.Chain] = &ChainFleetPolicy{}
+
+// Default checks a new or edited Chain against its RoundTable's policies.
+func (p *ChainFleetPolicy) Default(ctx context.Context, chain *aiv1alpha1.Chain) error {
+	old := &aiv1alpha1.Chain{}
+	if updated, err := oldObject(ctx, old); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	} else if updated && (chain.DeletionTimestamp != nil || !chainFleetUseChanged(old, chain)) {
+		return nil
+	}
+	policylog.V(1).Info("Checking Chain against fleet policies", "name", chain.Name)
+	warnings, err := controller.CheckChainPolicies(ctx, p.Client, chain)
+	if err != nil {
+		return apierrors.NewForbidden(aiv1alpha1.GroupVersion.WithResource("chains").GroupResource(), chain.Name, err)
+	}
+	annotatePolicyWarnings(chain, warnings)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/mutate-ai-roundtable-io-v1alpha1-mission,mutating=true,failurePolicy=fail,sideEffects=None,groups=ai.roundtable.io,resources=missions,verbs=create;update,versions=v1alpha1,name=mmission-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// MissionFleetPolicy enforces a RoundTable's policies on Missions, as
+// ChainFleetPolicy does for Chains, and also warns of a table at
+// maxMissions. An update is checked again when it changes the knights or
+// the RoundTable.
+type MissionFleetPolicy struct {
+	Client client.Reader
+}
+
+var _ admission.Defaulter[*aiv1alpha1.Mission] = &MissionFleetPolicy{}
+
+// Default checks a new or edited Mission against its RoundTable's policies.
+func (p *MissionFleetPolicy) Default(ctx context.Context, mission *aiv1alpha1.Mission) error {
+	old := &aiv1alpha1.Mission{}
+	if updated, err := oldObject(ctx, old); err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	} else if updated && (mission.DeletionTimestamp != nil || !missionFleetUseChanged(old, mission)) {
+		return nil
+	}
+	policylog.V(1).Info("Checking Mission against fleet policies", "name", mission.Name)
+	warnings, err := controller.CheckMissionPolicies(ctx, p.Client, mission)
+	if err != nil {
+		return apierrors.NewForbidden(aiv1alpha1.GroupVersion.WithResource("missions").GroupResource(), mission.Name, err)
+	}
+	annotatePolicyWarnings(mission, warnings)
+	return nil
+}
+
+// oldObject decodes into old the object an update replaces, reporting
+// whether the admission request is an update.
+func oldObject(ctx context.Context, old runtime.Object) (bool, error) {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil || req.Operation != admissionv1.Update {
+		return false, nil
+	}
+	return true, json.Unmarshal(req.OldObject.Raw, old)
+}
+
+// chainFleetUseChanged reports whether an update changes what a Chain asks
+// of its RoundTable: the table, template, or any step.
+func chainFleetUseChanged(old, chain *aiv1alpha1.Chain) bool {
+	return old.Spec.RoundTableRef != chain.Spec.RoundTableRef ||
+		!equality.Semantic.DeepEqual(old.Spec.TemplateRef, chain.Spec.TemplateRef) ||
+		!equality.Semantic.DeepEqual(old.Spec.Steps, chain.Spec.Steps) ||
+		!equality.Semantic.DeepEqual(old.Spec.OnFailure, chain.Spec.OnFailure) ||
+		!equality.Semantic.DeepEqual(old.Spec.Finally, chain.Spec.Finally)
+}
+
+// missionFleetUseChanged reports whether an update changes what a Mission
+// asks of its RoundTable: the table or its knights.
+func missionFleetUseChanged(old, mission *aiv1alpha1.Mission) bool {
+	return old.Spec.RoundTableRef != mission.Spec.RoundTableRef ||
+		!equality.Semantic.DeepEqual(old.Spec.Knights, mission.Spec.Knights)
+}
+
+// annotatePolicyWarnings records warnings in obj's
+// ai.roundtable.io/policy-warnings annotation.
+func annotatePolicyWarnings(obj client.Object, warnings []string) {
+	if len(warnings) == 0 {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[aiv1alpha1.AnnotationPolicyWarnings] = strings.Join(warnings, "; ")
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,173 @@
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestFleetPolicy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	fleet := map[string]string{"fleet": "a"}
+	knight := func(name, domain string, labels map[string]string) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Spec:       aiv1alpha1.KnightSpec{Domain: domain},
+		}
+	}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet-a", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{
+			KnightSelector: &metav1.LabelSelector{MatchLabels: fleet},
+			NATS:           aiv1alpha1.RoundTableNATS{SubjectPrefix: "fleet-a"},
+			Policies: &aiv1alpha1.RoundTablePolicies{
+				AllowedDomains:     []string{"security", "research"},
+				MaxMissions:        2,
+				MaxConcurrentTasks: 10,
+			},
+		},
+		Status: aiv1alpha1.RoundTableStatus{
+			ActiveMissions: 2,
+			Knights:        []aiv1alpha1.RoundTableKnightSummary{{Name: "galahad", QueueDepth: 4}},
+		},
+	}
+	template := &aiv1alpha1.ChainTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "audit", Namespace: "default"},
+		Spec: aiv1alpha1.ChainTemplateSpec{
+			Steps:   []aiv1alpha1.ChainStep{{Name: "scan", KnightRef: "galahad"}},
+			Finally: []aiv1alpha1.ChainStep{{Name: "bill", KnightRef: "tristan"}},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt, template,
+		knight("galahad", "security", fleet), knight("kay", "security", nil), knight("tristan", "finance", fleet)).Build()
+	chainPolicy := &ChainFleetPolicy{Client: c}
+	chain := func(steps ...aiv1alpha1.ChainStep) *aiv1alpha1.Chain {
+		return &aiv1alpha1.Chain{
+			ObjectMeta: metav1.ObjectMeta{Name: "recon", Namespace: "default"},
+			Spec:       aiv1alpha1.ChainSpec{RoundTableRef: "fleet-a", Steps: steps},
+		}
+	}
+	withFinally := chain(aiv1alpha1.ChainStep{Name: "scan", KnightRef: "galahad"})
+	withFinally.Spec.Finally = []aiv1alpha1.ChainStep{{Name: "cleanup", KnightRef: "kay"}}
+	notifyOn := func(subject string) *aiv1alpha1.Chain {
+		return chain(aiv1alpha1.ChainStep{Name: "scan", KnightRef: "galahad",
+			Notifications: []aiv1alpha1.StepNotification{{NATSSubject: subject}}})
+	}
+	fromTemplate := chain()
+	fromTemplate.Spec.TemplateRef = &aiv1alpha1.ChainTemplateRef{Name: "audit"}
+
+	tests := []struct {
+		name    string
+		chain   *aiv1alpha1.Chain
+		wantErr string
+	}{
+		{name: "member knight", chain: chain(aiv1alpha1.ChainStep{Name: "scan", KnightRef: "galahad"}, aiv1alpha1.ChainStep{Name: "read", Domain: "research"})},
+		{name: "unknown knight", chain: chain(aiv1alpha1.ChainStep{Name: "scan", KnightRef: "percival"})},
+		{name: "other table's knight", chain: chain(aiv1alpha1.ChainStep{Name: "scan", KnightRef: "kay"}), wantErr: "not a member of RoundTable fleet-a"},
+		{name: "knight domain not allowed", chain: chain(aiv1alpha1.ChainStep{Name: "audit", KnightRef: "tristan"}), wantErr: "domain finance"},
+		{name: "step domain not allowed", chain: chain(aiv1alpha1.ChainStep{Name: "audit", Domain: "finance"}), wantErr: "domain finance"},
+		{name: "other table's knight in finally", chain: withFinally, wantErr: "knight kay is not a member"},
+		{name: "template knight domain not allowed", chain: fromTemplate, wantErr: "domain finance"},
+		{name: "notification subject", chain: notifyOn("fleet-a.notifications.scan")},
+		{name: "notification onto a knight's tasks", chain: notifyOn("fleet-a.tasks.security.galahad"), wantErr: "not under RoundTable fleet-a's fleet-a.notifications."},
+		{name: "notification onto another fleet", chain: notifyOn("fleet-b.notifications.scan"), wantErr: "notification subject"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := chainPolicy.Default(context.Background(), tt.chain)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Default() error = %v", err)
+				}
+				if _, ok := tt.chain.Annotations[aiv1alpha1.AnnotationPolicyWarnings]; ok {
+					t.Errorf("annotations = %v, want no warnings under maxConcurrentTasks", tt.chain.Annotations)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Default() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	updating := func(old runtime.Object) context.Context {
+		raw, err := json.Marshal(old)
+		if err != nil {
+			t.Fatalf("failed to marshal old object: %v", err)
+		}
+		return admission.NewContextWithRequest(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Update,
+			OldObject: runtime.RawExtension{Raw: raw},
+		}})
+	}
+	compliant := chain(aiv1alpha1.ChainStep{Name: "scan", KnightRef: "galahad"})
+	edited := compliant.DeepCopy()
+	edited.Spec.Steps[0].KnightRef = "kay"
+	if err := chainPolicy.Default(updating(compliant), edited); err == nil || !strings.Contains(err.Error(), "knight kay is not a member") {
+		t.Errorf("Default() on an edited step error = %v, want rejected", err)
+	}
+	// An update that leaves the steps alone isn't re-checked, so labelling
+	// a Chain admitted before a policy tightened still works.
+	relabelled := edited.DeepCopy()
+	relabelled.Labels = map[string]string{"team": "red"}
+	if err := chainPolicy.Default(updating(edited), relabelled); err != nil {
+		t.Errorf("Default() on an unchanged spec error = %v", err)
+	}
+
+	mission := &aiv1alpha1.Mission{
+		ObjectMeta: metav1.ObjectMeta{Name: "sweep", Namespace: "default"},
+		Spec: aiv1alpha1.MissionSpec{
+			RoundTableRef: "fleet-a",
+			Knights: []aiv1alpha1.MissionKnight{
+				{Name: "galahad"},
+				{Name: "scout", Ephemeral: true, EphemeralSpec: &aiv1alpha1.KnightSpec{Domain: "research"}},
+			},
+		},
+	}
+	if err := (&MissionFleetPolicy{Client: c}).Default(context.Background(), mission); err != nil {
+		t.Fatalf("Default() error = %v", err)
+	}
+	if got := mission.Annotations[aiv1alpha1.AnnotationPolicyWarnings]; !strings.Contains(got, "2 of 2 active missions") {
+		t.Errorf("policy warnings = %q, want maxMissions reached", got)
+	}
+	addKay := mission.DeepCopy()
+	addKay.Spec.Knights = append(addKay.Spec.Knights, aiv1alpha1.MissionKnight{Name: "kay"})
+	if err := (&MissionFleetPolicy{Client: c}).Default(updating(mission), addKay); err == nil || !strings.Contains(err.Error(), "knight kay is not a member") {
+		t.Errorf("Default() on an added knight error = %v, want rejected", err)
+	}
+	relabelledMission := addKay.DeepCopy()
+	relabelledMission.Labels = map[string]string{"team": "red"}
+	if err := (&MissionFleetPolicy{Client: c}).Default(updating(addKay), relabelledMission); err != nil {
+		t.Errorf("Default() on an unchanged mission spec error = %v", err)
+	}
+
+	rt.Status.Knights[0].QueueDepth = 12
+	rt.Status.Phase = aiv1alpha1.RoundTablePhaseOverBudget
+	c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt, knight("galahad", "security", fleet)).Build()
+	chainPolicy = &ChainFleetPolicy{Client: c}
+	if err := chainPolicy.Default(context.Background(), chain(aiv1alpha1.ChainStep{Name: "scan", KnightRef: "galahad"})); err == nil || !strings.Contains(err.Error(), "over its cost budget") {
+		t.Errorf("Default() over budget error = %v, want rejected", err)
+	}
+
+	rt.Status.Phase = aiv1alpha1.RoundTablePhaseReady
+	c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt, knight("galahad", "security", fleet)).Build()
+	busy := chain(aiv1alpha1.ChainStep{Name: "scan", KnightRef: "galahad"})
+	if err := (&ChainFleetPolicy{Client: c}).Default(context.Background(), busy); err != nil {
+		t.Fatalf("Default() error = %v", err)
+	}
+	if got := busy.Annotations[aiv1alpha1.AnnotationPolicyWarnings]; !strings.Contains(got, "12 tasks queued") {
+		t.Errorf("policy warnings = %q, want maxConcurrentTasks reached", got)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+	"github.com/dapperdivers/roundtable/internal/controller"
+)
+
+var knightlog = logf.Log.WithName("knight-webhook")
+
+// SetupKnightWebhookWithManager registers the Knight validating webhook.
+func SetupKnightWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &aiv1alpha1.Knight{}).
+		WithValidator(&KnightCustomValidator{Client: mgr.GetClient()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-ai-roundtable-io-v1alpha1-knight,mutating=false,failurePolicy=fail,sideEffects=None,groups=ai.roundtable.io,resources=knights,verbs=create,versions=v1alpha1,name=vknight-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// KnightCustomValidator rejects new Knights that would take a RoundTable
+// past policies.maxKnights or policies.maxKnightsPerDomain.
+type KnightCustomValidator struct {
+	Client client.Reader
+}
+
+var _ admission.Validator[*aiv1alpha1.Knight] = &KnightCustomValidator{}
+
+// ValidateCreate validates a new Knight against its RoundTables' limits.
+func (v *KnightCustomValidator) ValidateCreate(ctx context.Context, knight *aiv1alpha1.Knight) (admission.Warnings, error) {
+	knightlog.V(1).Info("Validating Knight create", "name", knight.Name)
+	if err := controller.ValidateKnightCapacity(ctx, v.Client, knight); err != nil {
+		return nil, apierrors.NewForbidden(aiv1alpha1.GroupVersion.WithResource("knights").GroupResource(), knight.Name, err)
+	}
+	return nil, nil
+}
+
+// ValidateUpdate allows every update; limits apply to new knights only.
+func (v *KnightCustomValidator) ValidateUpdate(_ context.Context, _, _ *aiv1alpha1.Knight) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete allows every delete.
+func (v *KnightCustomValidator) ValidateDelete(_ context.Context, _ *aiv1alpha1.Knight) (admission.Warnings, error) {
+	return nil, nil
+}
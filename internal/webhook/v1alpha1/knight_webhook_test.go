@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	aiv1alpha1 "github.com/dapperdivers/roundtable/api/v1alpha1"
+)
+
+func TestKnightCustomValidator(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := aiv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	knight := func(name, domain string, labels map[string]string) *aiv1alpha1.Knight {
+		return &aiv1alpha1.Knight{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Spec:       aiv1alpha1.KnightSpec{Domain: domain},
+		}
+	}
+	fleet := map[string]string{"fleet": "a"}
+	rt := &aiv1alpha1.RoundTable{
+		ObjectMeta: metav1.ObjectMeta{Name: "fleet-a", Namespace: "default"},
+		Spec: aiv1alpha1.RoundTableSpec{
+			KnightSelector: &metav1.LabelSelector{MatchLabels: fleet},
+			Policies: &aiv1alpha1.RoundTablePolicies{
+				MaxKnights:          3,
+				MaxKnightsPerDomain: map[string]int32{"research": 1},
+			},
+		},
+	}
+	v := &KnightCustomValidator{Client: fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(rt, knight("galahad", "research", fleet), knight("gawain", "security", fleet)).Build()}
+
+	tests := []struct {
+		name    string
+		knight  *aiv1alpha1.Knight
+		wantErr string
+	}{
+		{name: "within limits", knight: knight("tristan", "security", fleet)},
+		{name: "domain quota", knight: knight("percival", "research", fleet), wantErr: "1 of 1 knights in domain research"},
+		{name: "other table", knight: knight("kay", "research", map[string]string{"fleet": "b"})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := v.ValidateCreate(context.Background(), tt.knight)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateCreate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateCreate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	full := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rt,
+		knight("galahad", "research", fleet), knight("gawain", "security", fleet), knight("tristan", "security", fleet)).Build()
+	v = &KnightCustomValidator{Client: full}
+	if _, err := v.ValidateCreate(context.Background(), knight("bors", "ops", fleet)); err == nil || !strings.Contains(err.Error(), "3 of 3 knights") {
+		t.Errorf("ValidateCreate() on a full table error = %v, want maxKnights", err)
+	}
+}
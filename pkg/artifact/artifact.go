@@ -0,0 +1,107 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package artifact stores full chain step outputs outside the CRD status.
+// Status keeps only a truncated preview plus a reference returned by Put;
+// the reference is an opaque URI that the same Store can resolve with Get.
+package artifact
+
+import (
+	"fmt"
+	"strings"
+
+	natspkg "github.com/dapperdivers/roundtable/pkg/nats"
+)
+
+// DefaultBucket is the NATS object store bucket for chain step artifacts.
+const DefaultBucket = "chain-artifacts"
+
+// natsObjectScheme prefixes references to objects in a NATS object store.
+const natsObjectScheme = "nats-object://"
+
+// Store persists large outputs and resolves the references it hands out.
+type Store interface {
+	// Put stores data under key and returns a reference for Get.
+	Put(key string, data []byte) (string, error)
+
+	// Get returns the data behind a reference previously returned by Put.
+	Get(ref string) ([]byte, error)
+}
+
+// NATSObjectStore is a Store backed by a NATS JetStream object store bucket.
+type NATSObjectStore struct {
+	nats   *natspkg.Provider
+	bucket string
+}
+
+// NewNATSObjectStore returns a Store writing to the given object store
+// bucket through the shared NATS provider.
+func NewNATSObjectStore(provider *natspkg.Provider, bucket string) *NATSObjectStore {
+	if bucket == "" {
+		bucket = DefaultBucket
+	}
+	return &NATSObjectStore{nats: provider, bucket: bucket}
+}
+
+// Put stores data in the object store and returns a nats-object:// reference.
+func (s *NATSObjectStore) Put(key string, data []byte) (string, error) {
+	client, err := s.client()
+	if err != nil {
+		return "", err
+	}
+	if err := client.ObjectPut(s.bucket, key, data); err != nil {
+		return "", err
+	}
+	return NATSObjectRef(s.bucket, key), nil
+}
+
+// Get resolves a nats-object:// reference.
+func (s *NATSObjectStore) Get(ref string) ([]byte, error) {
+	bucket, key, err := ParseNATSObjectRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.client()
+	if err != nil {
+		return nil, err
+	}
+	return client.ObjectGet(bucket, key)
+}
+
+func (s *NATSObjectStore) client() (natspkg.Client, error) {
+	if s.nats == nil {
+		return nil, fmt.Errorf("NATS provider not configured")
+	}
+	return s.nats.Client()
+}
+
+// NATSObjectRef builds the reference for an object in a NATS object store.
+func NATSObjectRef(bucket, key string) string {
+	return natsObjectScheme + bucket + "/" + key
+}
+
+// ParseNATSObjectRef splits a nats-object://<bucket>/<key> reference.
+func ParseNATSObjectRef(ref string) (bucket, key string, err error) {
+	rest, ok := strings.CutPrefix(ref, natsObjectScheme)
+	if !ok {
+		return "", "", fmt.Errorf("unsupported artifact reference %q", ref)
+	}
+	bucket, key, ok = strings.Cut(rest, "/")
+	if !ok || bucket == "" || key == "" {
+		return "", "", fmt.Errorf("malformed artifact reference %q", ref)
+	}
+	return bucket, key, nil
+}
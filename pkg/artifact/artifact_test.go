@@ -0,0 +1,59 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package artifact
+
+import "testing"
+
+func TestNATSObjectRefRoundTrip(t *testing.T) {
+	ref := NATSObjectRef("chain-artifacts", "default/nightly/run-1/summarize")
+	if ref != "nats-object://chain-artifacts/default/nightly/run-1/summarize" {
+		t.Fatalf("NATSObjectRef() = %q", ref)
+	}
+
+	bucket, key, err := ParseNATSObjectRef(ref)
+	if err != nil {
+		t.Fatalf("ParseNATSObjectRef() error = %v", err)
+	}
+	if bucket != "chain-artifacts" || key != "default/nightly/run-1/summarize" {
+		t.Errorf("ParseNATSObjectRef() = (%q, %q)", bucket, key)
+	}
+}
+
+func TestParseNATSObjectRefErrors(t *testing.T) {
+	for _, ref := range []string{
+		"",
+		"s3://bucket/key",
+		"nats-object://",
+		"nats-object://bucket",
+		"nats-object://bucket/",
+		"nats-object:///key",
+	} {
+		if _, _, err := ParseNATSObjectRef(ref); err == nil {
+			t.Errorf("ParseNATSObjectRef(%q) expected error", ref)
+		}
+	}
+}
+
+func TestNATSObjectStoreWithoutProvider(t *testing.T) {
+	s := NewNATSObjectStore(nil, "")
+	if s.bucket != DefaultBucket {
+		t.Errorf("bucket = %q, want %q", s.bucket, DefaultBucket)
+	}
+	if _, err := s.Put("k", []byte("v")); err == nil {
+		t.Error("Put() without provider expected error")
+	}
+}
@@ -106,6 +106,69 @@ var (
 		[]string{"state", "table"}, // state: available, provisioning, claimed
 	)
 
+	// TableKnightsReady tracks the ready knights of each RoundTable.
+	// Labels: namespace (roundtable namespace), table (roundtable name)
+	TableKnightsReady = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "roundtable_table_knights_ready",
+			Help: "Ready knights by table",
+		},
+		[]string{"namespace", "table"},
+	)
+
+	// TableKnights tracks the knights each RoundTable discovers.
+	// Labels: namespace (roundtable namespace), table (roundtable name)
+	TableKnights = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "roundtable_table_knights",
+			Help: "Knights by table",
+		},
+		[]string{"namespace", "table"},
+	)
+
+	// TableActiveMissions tracks the active missions referencing each RoundTable.
+	// Labels: namespace (roundtable namespace), table (roundtable name)
+	TableActiveMissions = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "roundtable_table_active_missions",
+			Help: "Active missions by table",
+		},
+		[]string{"namespace", "table"},
+	)
+
+	// TableCostUSD tracks each RoundTable's cost since its last cost reset.
+	// Labels: namespace (roundtable namespace), table (roundtable name)
+	TableCostUSD = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "roundtable_table_cost_usd",
+			Help: "Cost in USD since the last cost reset by table",
+		},
+		[]string{"namespace", "table"},
+	)
+
+	// TableTasksCompleted tracks the tasks completed by each RoundTable's
+	// knights. It is summed from knight statuses, so it drops when a knight
+	// leaves the table.
+	// Labels: namespace (roundtable namespace), table (roundtable name)
+	TableTasksCompleted = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "roundtable_table_tasks_completed",
+			Help: "Tasks completed by the table's knights",
+		},
+		[]string{"namespace", "table"},
+	)
+
+	// TableStreamBacklog tracks the tasks pending on each RoundTable's knight
+	// consumers, by domain.
+	// Labels: namespace (roundtable namespace), table (roundtable name), domain (knight domain)
+	TableStreamBacklog = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "roundtable_table_stream_backlog",
+			Help: "Pending and unacknowledged tasks on the table's knight consumers by domain",
+		},
+		[]string{"namespace", "table", "domain"},
+	)
+
 	// ReconcileErrorsTotal tracks reconciliation errors by controller.
 	// Labels: controller (knight-controller, chain-controller, mission-controller, roundtable-controller)
 	ReconcileErrorsTotal = prometheus.NewCounterVec(
@@ -128,6 +191,40 @@ func init() {
 		MissionsTotal,
 		CostTotalUSD,
 		WarmPoolSize,
+		TableKnightsReady,
+		TableKnights,
+		TableActiveMissions,
+		TableCostUSD,
+		TableTasksCompleted,
+		TableStreamBacklog,
 		ReconcileErrorsTotal,
 	)
 }
+
+// tableGauges are the per-RoundTable gauges labelled by namespace and table.
+var tableGauges = []*prometheus.GaugeVec{
+	TableKnightsReady,
+	TableKnights,
+	TableActiveMissions,
+	TableCostUSD,
+	TableTasksCompleted,
+	TableStreamBacklog,
+}
+
+// DeleteTable removes a RoundTable's per-table series, so a deleted table
+// stops being exported.
+func DeleteTable(namespace, table string) {
+	labels := prometheus.Labels{"namespace": namespace, "table": table}
+	for _, g := range tableGauges {
+		g.DeletePartialMatch(labels)
+	}
+}
+
+// SetTableBacklogs replaces a RoundTable's backlog series with one per
+// domain in backlogs, dropping domains it no longer has.
+func SetTableBacklogs(namespace, table string, backlogs map[string]int64) {
+	TableStreamBacklog.DeletePartialMatch(prometheus.Labels{"namespace": namespace, "table": table})
+	for domain, backlog := range backlogs {
+		TableStreamBacklog.WithLabelValues(namespace, table, domain).Set(float64(backlog))
+	}
+}
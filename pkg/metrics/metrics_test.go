@@ -38,6 +38,12 @@ func TestMetricsRegistered(t *testing.T) {
 		"CostTotalUSD":         CostTotalUSD,
 		"WarmPoolSize":         WarmPoolSize,
 		"ReconcileErrorsTotal": ReconcileErrorsTotal,
+		"TableKnightsReady":    TableKnightsReady,
+		"TableKnights":         TableKnights,
+		"TableActiveMissions":  TableActiveMissions,
+		"TableCostUSD":         TableCostUSD,
+		"TableTasksCompleted":  TableTasksCompleted,
+		"TableStreamBacklog":   TableStreamBacklog,
 	}
 	for name, c := range collectors {
 		if c == nil {
@@ -183,6 +189,33 @@ func TestReconcileErrorsTotal(t *testing.T) {
 	}
 }
 
+func TestDeleteTable(t *testing.T) {
+	TableKnights.WithLabelValues("ops", "gone-table").Set(3)
+	TableStreamBacklog.WithLabelValues("ops", "gone-table", "security").Set(7)
+	TableStreamBacklog.WithLabelValues("ops", "kept-table", "security").Set(2)
+
+	DeleteTable("ops", "gone-table")
+
+	if n := testutil.CollectAndCount(TableKnights, "roundtable_table_knights"); n != 0 {
+		t.Errorf("TableKnights: expected the deleted table's series gone, got %d", n)
+	}
+	if n := testutil.CollectAndCount(TableStreamBacklog, "roundtable_table_stream_backlog"); n != 1 {
+		t.Errorf("TableStreamBacklog: expected only kept-table's series, got %d", n)
+	}
+}
+
+func TestSetTableBacklogs(t *testing.T) {
+	SetTableBacklogs("ops", "backlog-table", map[string]int64{"security": 4, "research": 1})
+	SetTableBacklogs("ops", "backlog-table", map[string]int64{"security": 2})
+
+	if v := testutil.ToFloat64(TableStreamBacklog.WithLabelValues("ops", "backlog-table", "security")); v != 2 {
+		t.Errorf("TableStreamBacklog security: expected 2, got %v", v)
+	}
+	if TableStreamBacklog.DeleteLabelValues("ops", "backlog-table", "research") {
+		t.Error("TableStreamBacklog research: expected the dropped domain's series gone")
+	}
+}
+
 // ---------------------------------------------------------------------------
 // HistogramVec tests
 // ---------------------------------------------------------------------------
@@ -237,6 +270,12 @@ func TestMetricsLint(t *testing.T) {
 		{"CostTotalUSD", CostTotalUSD},
 		{"WarmPoolSize", WarmPoolSize},
 		{"ReconcileErrorsTotal", ReconcileErrorsTotal},
+		{"TableKnightsReady", TableKnightsReady},
+		{"TableKnights", TableKnights},
+		{"TableActiveMissions", TableActiveMissions},
+		{"TableCostUSD", TableCostUSD},
+		{"TableTasksCompleted", TableTasksCompleted},
+		{"TableStreamBacklog", TableStreamBacklog},
 	}
 	for _, tc := range collectors {
 		problems, err := testutil.CollectAndLint(tc.collector)
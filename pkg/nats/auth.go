@@ -0,0 +1,214 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nkeys"
+)
+
+// Auth holds the credentials a connection authenticates with. Set at most
+// one method: Creds, NKeySeed, Token, or User and Password.
+type Auth struct {
+	// User and Password authenticate with a username and password.
+	User     string
+	Password string
+
+	// Token is a server auth token.
+	Token string
+
+	// NKeySeed is a user NKey seed ("SU...") that signs the server nonce.
+	NKeySeed string
+
+	// Creds is the content of a .creds file: a user JWT and its NKey seed.
+	Creds []byte
+}
+
+// TLSConfig configures TLS for a connection. Certificates are PEM-encoded.
+type TLSConfig struct {
+	// CA verifies the server's certificate. Empty uses the system roots.
+	CA []byte
+
+	// Cert and Key are the client certificate and key for mutual TLS.
+	Cert []byte
+	Key  []byte
+
+	// InsecureSkipVerify skips verifying the server's certificate.
+	InsecureSkipVerify bool
+}
+
+// authOptions returns the nats.Options that authenticate with a.
+func (a Auth) authOptions() ([]nats.Option, error) {
+	switch {
+	case len(a.Creds) > 0:
+		jwt, err := nkeys.ParseDecoratedJWT(a.Creds)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NATS creds: %w", err)
+		}
+		kp, err := nkeys.ParseDecoratedUserNKey(a.Creds)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NATS creds: %w", err)
+		}
+		seed, err := kp.Seed()
+		if err != nil {
+			return nil, fmt.Errorf("invalid NATS creds: %w", err)
+		}
+		return []nats.Option{nats.UserJWTAndSeed(jwt, string(seed))}, nil
+	case a.NKeySeed != "":
+		kp, err := nkeys.FromSeed([]byte(a.NKeySeed))
+		if err != nil {
+			return nil, fmt.Errorf("invalid NATS nkey seed: %w", err)
+		}
+		pub, err := kp.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid NATS nkey seed: %w", err)
+		}
+		return []nats.Option{nats.Nkey(pub, kp.Sign)}, nil
+	case a.Token != "":
+		return []nats.Option{nats.Token(a.Token)}, nil
+	case a.User != "":
+		return []nats.Option{nats.UserInfo(a.User, a.Password)}, nil
+	}
+	return nil, nil
+}
+
+// tlsOption returns the nats.Option that secures a connection with t.
+func (t *TLSConfig) tlsOption() (nats.Option, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: t.InsecureSkipVerify, //nolint:gosec // opt-in for self-signed test servers
+	}
+	if len(t.CA) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(t.CA) {
+			return nil, errors.New("invalid NATS TLS CA: no PEM certificates found")
+		}
+		cfg.RootCAs = pool
+	}
+	if len(t.Cert) > 0 || len(t.Key) > 0 {
+		cert, err := tls.X509KeyPair(t.Cert, t.Key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NATS TLS client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return nats.Secure(cfg), nil
+}
+
+// securityOptions returns the nats.Options for the config's Auth and TLS.
+func (c Config) securityOptions() ([]nats.Option, error) {
+	opts, err := c.Auth.authOptions()
+	if err != nil {
+		return nil, err
+	}
+	if c.TLS != nil {
+		opt, err := c.TLS.tlsOption()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, opt)
+	}
+	return opts, nil
+}
+
+// Secured reports whether the config sets credentials or TLS.
+func (c Config) Secured() bool {
+	a := c.Auth
+	return c.TLS != nil || a.User != "" || a.Token != "" || a.NKeySeed != "" || len(a.Creds) > 0
+}
+
+// fingerprint identifies the config's URL, credentials and TLS settings
+// without exposing them, so a change gets a new connection.
+func (c Config) fingerprint() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00", c.URL, c.Auth.User, c.Auth.Password, c.Auth.Token, c.Auth.NKeySeed, c.Auth.Creds)
+	if c.TLS != nil {
+		fmt.Fprintf(h, "%s\x00%s\x00%s\x00%t", c.TLS.CA, c.TLS.Cert, c.TLS.Key, c.TLS.InsecureSkipVerify)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Environment variables carrying a connection's credentials and TLS
+// settings. The operator reads them with LoadEnv; knight pods get them
+// from their NATS auth and TLS spec.
+const (
+	EnvUser        = "NATS_USER"
+	EnvPassword    = "NATS_PASSWORD"
+	EnvToken       = "NATS_TOKEN"
+	EnvNKeySeed    = "NATS_NKEY_SEED"
+	EnvCredsFile   = "NATS_CREDS_FILE"
+	EnvCAFile      = "NATS_CA_FILE"
+	EnvCertFile    = "NATS_CERT_FILE"
+	EnvKeyFile     = "NATS_KEY_FILE"
+	EnvTLSInsecure = "NATS_TLS_INSECURE"
+)
+
+// LoadEnv sets the config's Auth and TLS from the NATS_* environment
+// variables, reading the files they name. A client certificate file that
+// doesn't exist is skipped, so a mounted Secret may omit it.
+func (c *Config) LoadEnv(getenv func(string) string) error {
+	c.Auth = Auth{
+		User:     getenv(EnvUser),
+		Password: getenv(EnvPassword),
+		Token:    getenv(EnvToken),
+		NKeySeed: getenv(EnvNKeySeed),
+	}
+	if path := getenv(EnvCredsFile); path != "" {
+		creds, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read NATS creds: %w", err)
+		}
+		c.Auth.Creds = creds
+	}
+
+	caFile, certFile, keyFile := getenv(EnvCAFile), getenv(EnvCertFile), getenv(EnvKeyFile)
+	insecure := getenv(EnvTLSInsecure) == "true"
+	if caFile == "" && certFile == "" && !insecure {
+		return nil
+	}
+	c.TLS = &TLSConfig{InsecureSkipVerify: insecure}
+	if caFile != "" {
+		ca, err := os.ReadFile(caFile)
+		if err != nil {
+			return fmt.Errorf("read NATS TLS CA: %w", err)
+		}
+		c.TLS.CA = ca
+	}
+	if certFile != "" {
+		cert, err := os.ReadFile(certFile)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read NATS TLS certificate: %w", err)
+		}
+		key, err := os.ReadFile(keyFile)
+		if err != nil {
+			return fmt.Errorf("read NATS TLS key: %w", err)
+		}
+		c.TLS.Cert, c.TLS.Key = cert, key
+	}
+	return nil
+}
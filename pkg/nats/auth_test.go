@@ -0,0 +1,95 @@
+/*
+Copyright 2026 dapperdivers.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nats
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nats-io/nkeys"
+)
+
+// TestAuthOptions tests building connect options from credentials
+func TestAuthOptions(t *testing.T) {
+	user, err := nkeys.CreateUser()
+	if err != nil {
+		t.Fatalf("create nkey: %v", err)
+	}
+	seed, _ := user.Seed()
+	creds := "-----BEGIN NATS USER JWT-----\neyJhbGciOiJlZDI1NTE5In0.e30.c2ln\n------END NATS USER JWT------\n\n" +
+		"-----BEGIN USER NKEY SEED-----\n" + string(seed) + "\n------END USER NKEY SEED------\n"
+
+	tests := []struct {
+		name    string
+		auth    Auth
+		want    int
+		wantErr bool
+	}{
+		{name: "anonymous", auth: Auth{}, want: 0},
+		{name: "user", auth: Auth{User: "galahad", Password: "grail"}, want: 1},
+		{name: "token", auth: Auth{Token: "s3cret"}, want: 1},
+		{name: "nkey", auth: Auth{NKeySeed: string(seed)}, want: 1},
+		{name: "creds", auth: Auth{Creds: []byte(creds)}, want: 1},
+		{name: "bad nkey", auth: Auth{NKeySeed: "not-a-seed"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, err := tt.auth.authOptions()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("authOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(opts) != tt.want {
+				t.Errorf("authOptions() = %d options, want %d", len(opts), tt.want)
+			}
+		})
+	}
+
+	if _, err := (&TLSConfig{CA: []byte("not pem")}).tlsOption(); err == nil {
+		t.Error("tlsOption() with an invalid CA should fail")
+	}
+}
+
+// TestLoadEnv tests reading credentials and TLS files from the environment
+func TestLoadEnv(t *testing.T) {
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.crt")
+	if err := os.WriteFile(caFile, []byte("ca"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	env := map[string]string{
+		EnvToken:    "s3cret",
+		EnvCAFile:   caFile,
+		EnvCertFile: filepath.Join(dir, "tls.crt"), // not mounted
+	}
+
+	config := DefaultConfig()
+	if err := config.LoadEnv(func(k string) string { return env[k] }); err != nil {
+		t.Fatalf("LoadEnv() error = %v", err)
+	}
+	if config.Auth.Token != "s3cret" || config.TLS == nil || string(config.TLS.CA) != "ca" || config.TLS.Cert != nil {
+		t.Errorf("config = %+v, want the token and CA", config)
+	}
+	if !config.Secured() || config.fingerprint() == DefaultConfig().fingerprint() {
+		t.Error("a config with credentials should be secured with its own fingerprint")
+	}
+
+	plain := DefaultConfig()
+	if err := plain.LoadEnv(func(string) string { return "" }); err != nil || plain.Secured() {
+		t.Errorf("LoadEnv() without variables = %+v, %v, want an anonymous config", plain, err)
+	}
+}
@@ -37,33 +37,68 @@ type Client interface {
 	// IsConnected returns true if the client is connected to NATS.
 	IsConnected() bool
 
+	// RTT returns the round-trip time to the NATS server.
+	RTT() (time.Duration, error)
+
 	// Publish publishes raw bytes to a subject.
 	Publish(subject string, data []byte) error
 
 	// PublishJSON publishes a JSON-encoded value to a subject.
 	PublishJSON(subject string, v interface{}) error
 
+	// PublishMsg publishes a message, including its headers, to JetStream.
+	PublishMsg(msg *nats.Msg) error
+
+	// PublishCore publishes raw bytes over core NATS, bypassing JetStream.
+	// Used for fire-and-forget control messages that no stream captures.
+	PublishCore(subject string, data []byte) error
+
 	// Subscribe creates a synchronous subscription to a subject.
 	Subscribe(subject string, opts ...SubscribeOption) (*nats.Subscription, error)
 
 	// CreateStream creates a JetStream stream with the given configuration.
 	CreateStream(config StreamConfig) error
 
+	// UpdateStream updates an existing JetStream stream's configuration.
+	UpdateStream(config StreamConfig) error
+
 	// DeleteStream deletes a JetStream stream.
 	DeleteStream(name string) error
 
+	// PurgeStream removes a stream's messages below sequence before, or
+	// every message when before is 0.
+	PurgeStream(name string, before uint64) error
+
+	// GetMessage returns the message stored at a stream sequence.
+	GetMessage(stream string, seq uint64) (*nats.RawStreamMsg, error)
+
 	// StreamInfo returns information about a stream.
 	StreamInfo(name string) (*nats.StreamInfo, error)
 
+	// SubjectMessages returns the number of messages a stream holds on
+	// subjects matching filter.
+	SubjectMessages(stream, filter string) (uint64, error)
+
 	// EnsureConsumer creates or updates a JetStream consumer.
 	EnsureConsumer(stream, name string, config ConsumerConfig) error
 
 	// DeleteConsumer deletes a JetStream consumer.
 	DeleteConsumer(stream, consumer string) error
 
+	// ConsumerInfo returns information about a consumer, including its backlog.
+	ConsumerInfo(stream, consumer string) (*nats.ConsumerInfo, error)
+
 	// PollMessage polls for a single message with a timeout.
 	PollMessage(subject string, timeout time.Duration, opts ...SubscribeOption) (*nats.Msg, error)
 
+	// FetchMessage pulls a single message from an existing durable consumer,
+	// returning nil (and no error) if none arrives within the timeout. The
+	// consumer is left in place so its position survives between fetches.
+	FetchMessage(stream, consumer string, timeout time.Duration) (*nats.Msg, error)
+
+	// StreamNameBySubject returns the name of the stream capturing a subject.
+	StreamNameBySubject(subject string) (string, error)
+
 	// KVPut stores a value in a NATS KV bucket (creates bucket if needed).
 	KVPut(bucket, key string, value []byte) error
 
@@ -75,6 +110,19 @@ type Client interface {
 
 	// KVKeys lists all keys in a NATS KV bucket.
 	KVKeys(bucket string) ([]string, error)
+
+	// EnsureKeyValue creates a KV bucket, or updates an existing one's
+	// limits to match the config.
+	EnsureKeyValue(config KeyValueConfig) error
+
+	// DeleteKeyValue deletes a KV bucket and everything in it.
+	DeleteKeyValue(bucket string) error
+
+	// ObjectPut stores an object in a NATS object store bucket (creates bucket if needed).
+	ObjectPut(bucket, name string, data []byte) error
+
+	// ObjectGet retrieves an object from a NATS object store bucket.
+	ObjectGet(bucket, name string) ([]byte, error)
 }
 
 // JetStreamClient implements the Client interface using NATS JetStream.
@@ -113,6 +161,11 @@ func (c *JetStreamClient) Connect() error {
 	if c.config.ReconnectWait > 0 {
 		opts = append(opts, nats.ReconnectWait(c.config.ReconnectWait))
 	}
+	security, err := c.config.securityOptions()
+	if err != nil {
+		return err
+	}
+	opts = append(opts, security...)
 
 	nc, err := nats.Connect(c.config.URL, opts...)
 	if err != nil {
@@ -153,6 +206,19 @@ func (c *JetStreamClient) IsConnected() bool {
 	return c.nc != nil && c.nc.IsConnected()
 }
 
+// RTT returns the round-trip time to the NATS server.
+func (c *JetStreamClient) RTT() (time.Duration, error) {
+	if err := c.Connect(); err != nil {
+		return 0, err
+	}
+
+	c.mu.Lock()
+	nc := c.nc
+	c.mu.Unlock()
+
+	return nc.RTT()
+}
+
 // Publish publishes raw bytes to a subject.
 func (c *JetStreamClient) Publish(subject string, data []byte) error {
 	if err := c.Connect(); err != nil {
@@ -180,6 +246,40 @@ func (c *JetStreamClient) PublishJSON(subject string, v interface{}) error {
 	return c.Publish(subject, data)
 }
 
+// PublishMsg publishes a message, including its headers, to JetStream.
+func (c *JetStreamClient) PublishMsg(msg *nats.Msg) error {
+	if err := c.Connect(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	js := c.js
+	c.mu.Unlock()
+
+	if _, err := js.PublishMsg(msg); err != nil {
+		return fmt.Errorf("NATS publish to %s failed: %w", msg.Subject, err)
+	}
+
+	return nil
+}
+
+// PublishCore publishes raw bytes over core NATS, bypassing JetStream.
+func (c *JetStreamClient) PublishCore(subject string, data []byte) error {
+	if err := c.Connect(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	nc := c.nc
+	c.mu.Unlock()
+
+	if err := nc.Publish(subject, data); err != nil {
+		return fmt.Errorf("NATS core publish to %s failed: %w", subject, err)
+	}
+
+	return nil
+}
+
 // Subscribe creates a synchronous subscription to a subject.
 func (c *JetStreamClient) Subscribe(subject string, opts ...SubscribeOption) (*nats.Subscription, error) {
 	if err := c.Connect(); err != nil {
@@ -210,6 +310,9 @@ func (c *JetStreamClient) Subscribe(subject string, opts ...SubscribeOption) (*n
 	if subOpts.deliverAll {
 		natsOpts = append(natsOpts, nats.DeliverAll())
 	}
+	if !subOpts.startTime.IsZero() {
+		natsOpts = append(natsOpts, nats.StartTime(subOpts.startTime))
+	}
 
 	sub, err := js.SubscribeSync(subject, natsOpts...)
 	if err != nil {
@@ -226,6 +329,9 @@ func (c *JetStreamClient) Subscribe(subject string, opts ...SubscribeOption) (*n
 			if subOpts.deliverAll {
 				natsOptsFallback = append(natsOptsFallback, nats.DeliverAll())
 			}
+			if !subOpts.startTime.IsZero() {
+				natsOptsFallback = append(natsOptsFallback, nats.StartTime(subOpts.startTime))
+			}
 			sub, err = js.SubscribeSync(subject, natsOptsFallback...)
 		}
 		if err != nil {
@@ -252,30 +358,31 @@ func (c *JetStreamClient) CreateStream(config StreamConfig) error {
 		return nil // Stream already exists
 	}
 
-	// Create stream
-	streamConfig := &nats.StreamConfig{
-		Name:      config.Name,
-		Subjects:  config.Subjects,
-		Retention: config.Retention.ToNATS(),
-		Storage:   config.Storage.ToNATS(),
+	_, err = js.AddStream(config.NATSConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create stream %s: %w", config.Name, err)
 	}
 
-	if config.MaxAge > 0 {
-		streamConfig.MaxAge = config.MaxAge
-	}
-	if config.MaxMsgs > 0 {
-		streamConfig.MaxMsgs = config.MaxMsgs
-	}
-	if config.Discard != "" {
-		streamConfig.Discard = config.Discard.ToNATS()
+	c.log.Info("Created JetStream stream", "name", config.Name, "retention", config.Retention)
+	return nil
+}
+
+// UpdateStream updates an existing JetStream stream to the given
+// configuration.
+func (c *JetStreamClient) UpdateStream(config StreamConfig) error {
+	if err := c.Connect(); err != nil {
+		return err
 	}
 
-	_, err = js.AddStream(streamConfig)
-	if err != nil {
-		return fmt.Errorf("failed to create stream %s: %w", config.Name, err)
+	c.mu.Lock()
+	js := c.js
+	c.mu.Unlock()
+
+	if _, err := js.UpdateStream(config.NATSConfig()); err != nil {
+		return fmt.Errorf("failed to update stream %s: %w", config.Name, err)
 	}
 
-	c.log.Info("Created JetStream stream", "name", config.Name, "retention", config.Retention)
+	c.log.Info("Updated JetStream stream", "name", config.Name)
 	return nil
 }
 
@@ -298,6 +405,47 @@ func (c *JetStreamClient) DeleteStream(name string) error {
 	return nil
 }
 
+// PurgeStream removes a JetStream stream's messages below sequence before,
+// or every message when before is 0.
+func (c *JetStreamClient) PurgeStream(name string, before uint64) error {
+	if err := c.Connect(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	js := c.js
+	c.mu.Unlock()
+
+	var opts []nats.JSOpt
+	if before > 0 {
+		opts = append(opts, &nats.StreamPurgeRequest{Sequence: before})
+	}
+	if err := js.PurgeStream(name, opts...); err != nil {
+		return fmt.Errorf("failed to purge stream %s: %w", name, err)
+	}
+
+	c.log.Info("Purged JetStream stream", "name", name, "before", before)
+	return nil
+}
+
+// GetMessage returns the message stored at a stream sequence.
+func (c *JetStreamClient) GetMessage(stream string, seq uint64) (*nats.RawStreamMsg, error) {
+	if err := c.Connect(); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	js := c.js
+	c.mu.Unlock()
+
+	msg, err := js.GetMsg(stream, seq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message %d from stream %s: %w", seq, stream, err)
+	}
+
+	return msg, nil
+}
+
 // StreamInfo returns information about a stream.
 func (c *JetStreamClient) StreamInfo(name string) (*nats.StreamInfo, error) {
 	if err := c.Connect(); err != nil {
@@ -316,6 +464,29 @@ func (c *JetStreamClient) StreamInfo(name string) (*nats.StreamInfo, error) {
 	return info, nil
 }
 
+// SubjectMessages returns the number of messages a stream holds on
+// subjects matching filter.
+func (c *JetStreamClient) SubjectMessages(stream, filter string) (uint64, error) {
+	if err := c.Connect(); err != nil {
+		return 0, err
+	}
+
+	c.mu.Lock()
+	js := c.js
+	c.mu.Unlock()
+
+	info, err := js.StreamInfo(stream, &nats.StreamInfoRequest{SubjectsFilter: filter})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get stream info for %s: %w", stream, err)
+	}
+
+	var total uint64
+	for _, n := range info.State.Subjects {
+		total += n
+	}
+	return total, nil
+}
+
 // EnsureConsumer creates or updates a JetStream consumer.
 func (c *JetStreamClient) EnsureConsumer(stream, name string, config ConsumerConfig) error {
 	if err := c.Connect(); err != nil {
@@ -336,6 +507,12 @@ func (c *JetStreamClient) EnsureConsumer(stream, name string, config ConsumerCon
 	if config.AckPolicy == AckExplicit {
 		consumerConfig.AckPolicy = nats.AckExplicitPolicy
 	}
+	switch config.DeliverPolicy {
+	case DeliverLast:
+		consumerConfig.DeliverPolicy = nats.DeliverLastPolicy
+	case DeliverNew:
+		consumerConfig.DeliverPolicy = nats.DeliverNewPolicy
+	}
 
 	_, err := js.AddConsumer(stream, consumerConfig)
 	if err != nil {
@@ -345,6 +522,24 @@ func (c *JetStreamClient) EnsureConsumer(stream, name string, config ConsumerCon
 	return nil
 }
 
+// ConsumerInfo returns information about a consumer, including its backlog.
+func (c *JetStreamClient) ConsumerInfo(stream, consumer string) (*nats.ConsumerInfo, error) {
+	if err := c.Connect(); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	js := c.js
+	c.mu.Unlock()
+
+	info, err := js.ConsumerInfo(stream, consumer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consumer info for %s on stream %s: %w", consumer, stream, err)
+	}
+
+	return info, nil
+}
+
 // DeleteConsumer deletes a JetStream consumer.
 func (c *JetStreamClient) DeleteConsumer(stream, consumer string) error {
 	if err := c.Connect(); err != nil {
@@ -383,6 +578,53 @@ func (c *JetStreamClient) PollMessage(subject string, timeout time.Duration, opt
 	return msg, nil
 }
 
+// FetchMessage pulls a single message from an existing durable consumer.
+func (c *JetStreamClient) FetchMessage(stream, consumer string, timeout time.Duration) (*nats.Msg, error) {
+	if err := c.Connect(); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	js := c.js
+	c.mu.Unlock()
+
+	// Binding to the consumer means unsubscribing won't delete it.
+	sub, err := js.PullSubscribe("", consumer, nats.Bind(stream, consumer))
+	if err != nil {
+		return nil, fmt.Errorf("NATS bind to consumer %s on stream %s failed: %w", consumer, stream, err)
+	}
+	defer func() { _ = sub.Unsubscribe() }()
+
+	msgs, err := sub.Fetch(1, nats.MaxWait(timeout))
+	if err != nil {
+		if err == nats.ErrTimeout {
+			return nil, nil // No message available, not an error
+		}
+		return nil, fmt.Errorf("NATS fetch: %w", err)
+	}
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+	return msgs[0], nil
+}
+
+// StreamNameBySubject returns the name of the stream capturing a subject.
+func (c *JetStreamClient) StreamNameBySubject(subject string) (string, error) {
+	if err := c.Connect(); err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	js := c.js
+	c.mu.Unlock()
+
+	name, err := js.StreamNameBySubject(subject)
+	if err != nil {
+		return "", fmt.Errorf("no stream captures subject %s: %w", subject, err)
+	}
+	return name, nil
+}
+
 // SubscribeOption configures subscription behavior.
 type SubscribeOption func(*subscribeOptions)
 
@@ -391,6 +633,7 @@ type subscribeOptions struct {
 	bindStream         string
 	ackExplicit        bool
 	deliverAll         bool
+	startTime          time.Time
 	fallbackAutoDetect bool
 }
 
@@ -422,6 +665,13 @@ func WithDeliverAll() SubscribeOption {
 	}
 }
 
+// WithStartTime delivers messages stored at or after t.
+func WithStartTime(t time.Time) SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.startTime = t
+	}
+}
+
 // WithFallbackAutoDetect enables fallback to auto-detect stream if BindStream fails.
 func WithFallbackAutoDetect() SubscribeOption {
 	return func(o *subscribeOptions) {
@@ -452,6 +702,88 @@ func (c *JetStreamClient) getOrCreateBucket(bucket string) (nats.KeyValue, error
 	return kv, nil
 }
 
+// EnsureKeyValue creates a KV bucket, or updates an existing one's limits
+// to match the config. Storage can't change once the bucket exists.
+func (c *JetStreamClient) EnsureKeyValue(config KeyValueConfig) error {
+	if err := c.Connect(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	js := c.js
+	c.mu.Unlock()
+
+	history := config.History
+	if history == 0 {
+		history = 1
+	}
+	_, err := js.KeyValue(config.Bucket)
+	if err == nats.ErrBucketNotFound {
+		if _, err := js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket:       config.Bucket,
+			Description:  config.Description,
+			TTL:          config.TTL,
+			MaxBytes:     unlimited(config.MaxBytes),
+			MaxValueSize: int32(unlimited(int64(config.MaxValueSize))),
+			History:      history,
+			Storage:      config.Storage.ToNATS(),
+			Replicas:     config.Replicas,
+		}); err != nil {
+			return fmt.Errorf("failed to create KV bucket %s: %w", config.Bucket, err)
+		}
+		c.log.Info("Created NATS KV bucket", "bucket", config.Bucket)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to access KV bucket %s: %w", config.Bucket, err)
+	}
+
+	// A bucket's limits live on its backing stream.
+	info, err := js.StreamInfo(KVStreamName(config.Bucket))
+	if err != nil {
+		return fmt.Errorf("failed to get KV bucket %s: %w", config.Bucket, err)
+	}
+	want := info.Config
+	want.Description = config.Description
+	want.MaxAge = config.TTL
+	want.MaxBytes = unlimited(config.MaxBytes)
+	want.MaxMsgSize = int32(unlimited(int64(config.MaxValueSize)))
+	want.MaxMsgsPerSubject = int64(history)
+	if want.Description == info.Config.Description && want.MaxAge == info.Config.MaxAge &&
+		want.MaxBytes == info.Config.MaxBytes && want.MaxMsgSize == info.Config.MaxMsgSize &&
+		want.MaxMsgsPerSubject == info.Config.MaxMsgsPerSubject {
+		return nil
+	}
+	if _, err := js.UpdateStream(&want); err != nil {
+		return fmt.Errorf("failed to update KV bucket %s: %w", config.Bucket, err)
+	}
+	c.log.Info("Updated NATS KV bucket", "bucket", config.Bucket)
+	return nil
+}
+
+// DeleteKeyValue deletes a KV bucket and everything in it. A missing
+// bucket is reported as nats.ErrBucketNotFound.
+func (c *JetStreamClient) DeleteKeyValue(bucket string) error {
+	if err := c.Connect(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	js := c.js
+	c.mu.Unlock()
+
+	err := js.DeleteKeyValue(bucket)
+	if err == nats.ErrStreamNotFound {
+		err = nats.ErrBucketNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete KV bucket %s: %w", bucket, err)
+	}
+
+	c.log.Info("Deleted NATS KV bucket", "bucket", bucket)
+	return nil
+}
+
 // KVPut stores a value in a NATS KV bucket (creates bucket if needed).
 func (c *JetStreamClient) KVPut(bucket, key string, value []byte) error {
 	kv, err := c.getOrCreateBucket(bucket)
@@ -502,3 +834,51 @@ func (c *JetStreamClient) KVKeys(bucket string) ([]string, error) {
 	}
 	return keys, nil
 }
+
+// getOrCreateObjectStore returns an object store bucket, creating it if it doesn't exist.
+func (c *JetStreamClient) getOrCreateObjectStore(bucket string) (nats.ObjectStore, error) {
+	if c.js == nil {
+		return nil, fmt.Errorf("JetStream not connected")
+	}
+	obs, err := c.js.ObjectStore(bucket)
+	if err == nats.ErrStreamNotFound {
+		obs, err = c.js.CreateObjectStore(&nats.ObjectStoreConfig{
+			Bucket:      bucket,
+			Description: fmt.Sprintf("Round Table %s object store", bucket),
+			TTL:         30 * 24 * time.Hour, // 30 day TTL, matching KV buckets
+			Storage:     nats.FileStorage,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create object store %s: %w", bucket, err)
+		}
+		c.log.Info("Created NATS object store", "bucket", bucket)
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to access object store %s: %w", bucket, err)
+	}
+	return obs, nil
+}
+
+// ObjectPut stores an object in a NATS object store bucket (creates bucket if needed).
+func (c *JetStreamClient) ObjectPut(bucket, name string, data []byte) error {
+	obs, err := c.getOrCreateObjectStore(bucket)
+	if err != nil {
+		return err
+	}
+	if _, err := obs.PutBytes(name, data); err != nil {
+		return fmt.Errorf("failed to put object %s in bucket %s: %w", name, bucket, err)
+	}
+	return nil
+}
+
+// ObjectGet retrieves an object from a NATS object store bucket.
+func (c *JetStreamClient) ObjectGet(bucket, name string) ([]byte, error) {
+	obs, err := c.getOrCreateObjectStore(bucket)
+	if err != nil {
+		return nil, err
+	}
+	data, err := obs.GetBytes(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object %s from bucket %s: %w", name, bucket, err)
+	}
+	return data, nil
+}
@@ -18,6 +18,7 @@ package nats
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -324,6 +325,34 @@ func TestDiscardPolicyConversion(t *testing.T) {
 	}
 }
 
+// TestStreamDrift tests comparing a stream against its desired config
+func TestStreamDrift(t *testing.T) {
+	want := StreamConfig{
+		Name:      "fleet_tasks",
+		Subjects:  []string{"fleet.tasks.>"},
+		Retention: RetentionWorkQueue,
+		Storage:   StorageFile,
+		MaxAge:    time.Hour,
+	}
+
+	have := want.NATSConfig()
+	have.Duplicates = 2 * time.Minute
+	if drift := StreamDrift(have, want); len(drift) != 0 {
+		t.Errorf("StreamDrift() = %v, want none for a matching stream", drift)
+	}
+
+	have.Storage = StorageMemory.ToNATS()
+	have.MaxAge = 2 * time.Hour
+	have.MaxBytes = 1024
+	drift := StreamDrift(have, want)
+	if strings.Join(drift, ",") != "maxAge,maxBytes,storage" {
+		t.Errorf("StreamDrift() = %v, want maxAge, maxBytes and storage", drift)
+	}
+	if !ImmutableStreamFields["storage"] || ImmutableStreamFields["maxAge"] {
+		t.Error("ImmutableStreamFields should hold storage but not maxAge")
+	}
+}
+
 // TestTaskPayloadSerialization tests TaskPayload JSON marshaling
 func TestTaskPayloadSerialization(t *testing.T) {
 	tests := []struct {
@@ -338,6 +367,7 @@ func TestTaskPayloadSerialization(t *testing.T) {
 				ChainName: "chain-abc",
 				StepName:  "step-1",
 				Task:      "Analyze security findings",
+				Model:     "claude-sonnet-4-20250514",
 			},
 			wantErr: false,
 		},
@@ -384,6 +414,9 @@ func TestTaskPayloadSerialization(t *testing.T) {
 				if decoded.Task != tt.payload.Task {
 					t.Errorf("Task mismatch: got %s, want %s", decoded.Task, tt.payload.Task)
 				}
+				if decoded.Model != tt.payload.Model {
+					t.Errorf("Model mismatch: got %s, want %s", decoded.Model, tt.payload.Model)
+				}
 			}
 		})
 	}
@@ -465,6 +498,54 @@ func TestTaskResultSerialization(t *testing.T) {
 	}
 }
 
+// TestTaskResultUsage tests cost and token parsing (both formats)
+func TestTaskResultUsage(t *testing.T) {
+	tests := []struct {
+		name             string
+		jsonInput        string
+		wantCost         float64
+		wantInputTokens  int64
+		wantOutputTokens int64
+	}{
+		{
+			name:             "controller format (camelCase)",
+			jsonInput:        `{"taskId":"task-1","output":"ok","costUsd":0.0125,"inputTokens":1200,"outputTokens":300}`,
+			wantCost:         0.0125,
+			wantInputTokens:  1200,
+			wantOutputTokens: 300,
+		},
+		{
+			name:             "pi-knight format (snake_case)",
+			jsonInput:        `{"task_id":"task-2","result":"ok","cost_usd":0.5,"input_tokens":10,"output_tokens":20}`,
+			wantCost:         0.5,
+			wantInputTokens:  10,
+			wantOutputTokens: 20,
+		},
+		{
+			name:      "not reported",
+			jsonInput: `{"taskId":"task-3","output":"ok"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result TaskResult
+			if err := json.Unmarshal([]byte(tt.jsonInput), &result); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if got := result.GetCostUSD(); got != tt.wantCost {
+				t.Errorf("GetCostUSD() = %v, want %v", got, tt.wantCost)
+			}
+			if got := result.GetInputTokens(); got != tt.wantInputTokens {
+				t.Errorf("GetInputTokens() = %d, want %d", got, tt.wantInputTokens)
+			}
+			if got := result.GetOutputTokens(); got != tt.wantOutputTokens {
+				t.Errorf("GetOutputTokens() = %d, want %d", got, tt.wantOutputTokens)
+			}
+		})
+	}
+}
+
 // TestTaskResultDualFormatGetters tests the dual-format getter methods
 func TestTaskResultDualFormatGetters(t *testing.T) {
 	t.Run("GetTaskID prefers controller format", func(t *testing.T) {
@@ -17,6 +17,8 @@ limitations under the License.
 package nats
 
 import (
+	"slices"
+	"sort"
 	"time"
 
 	"github.com/nats-io/nats.go"
@@ -35,6 +37,14 @@ type Config struct {
 
 	// ReconnectWait is the duration to wait between reconnect attempts.
 	ReconnectWait time.Duration
+
+	// Auth holds the credentials the connection authenticates with. The
+	// zero value connects anonymously.
+	Auth Auth
+
+	// TLS configures TLS for the connection. Nil leaves it to the URL
+	// scheme (tls://) and the system roots.
+	TLS *TLSConfig
 }
 
 // DefaultConfig returns a Config with sensible defaults for the Round Table operator.
@@ -69,6 +79,124 @@ type StreamConfig struct {
 
 	// Discard policy when limits are exceeded.
 	Discard DiscardPolicy
+
+	// Replicas is the number of stream replicas in a clustered server (0 = 1).
+	Replicas int
+
+	// MaxBytes is the maximum total size of the stream (0 = unlimited).
+	MaxBytes int64
+
+	// MaxMsgSize is the largest message the stream accepts (0 = unlimited).
+	MaxMsgSize int32
+
+	// Duplicates is the window for de-duplicating published message IDs
+	// (0 = the server default, two minutes).
+	Duplicates time.Duration
+}
+
+// KeyValueConfig defines a JetStream KV bucket.
+type KeyValueConfig struct {
+	// Bucket is the bucket name (e.g., "fleet-a-memory").
+	Bucket string
+
+	// Description describes the bucket.
+	Description string
+
+	// TTL expires keys not updated for this long (0 = never).
+	TTL time.Duration
+
+	// MaxBytes is the maximum total size of the bucket (0 = unlimited).
+	MaxBytes int64
+
+	// MaxValueSize is the largest value the bucket accepts (0 = unlimited).
+	MaxValueSize int32
+
+	// History is the number of revisions kept per key (0 = 1).
+	History uint8
+
+	// Storage type (file or memory).
+	Storage StorageType
+
+	// Replicas is the number of replicas in a clustered server (0 = 1).
+	Replicas int
+}
+
+// KVStreamName returns the name of the stream backing a KV bucket.
+func KVStreamName(bucket string) string {
+	return "KV_" + bucket
+}
+
+// NATSConfig converts the configuration to a nats.StreamConfig.
+func (c StreamConfig) NATSConfig() *nats.StreamConfig {
+	cfg := &nats.StreamConfig{
+		Name:       c.Name,
+		Subjects:   c.Subjects,
+		Retention:  c.Retention.ToNATS(),
+		Storage:    c.Storage.ToNATS(),
+		Discard:    c.Discard.ToNATS(),
+		Replicas:   c.Replicas,
+		MaxAge:     c.MaxAge,
+		MaxMsgs:    unlimited(c.MaxMsgs),
+		MaxBytes:   unlimited(c.MaxBytes),
+		MaxMsgSize: int32(unlimited(int64(c.MaxMsgSize))),
+		Duplicates: c.Duplicates,
+	}
+	if cfg.Replicas == 0 {
+		cfg.Replicas = 1
+	}
+	return cfg
+}
+
+// unlimited maps an unset limit to JetStream's -1.
+func unlimited(n int64) int64 {
+	if n <= 0 {
+		return -1
+	}
+	return n
+}
+
+// ImmutableStreamFields are the StreamDrift fields a stream update can't
+// change; the stream must be recreated to apply them.
+var ImmutableStreamFields = map[string]bool{"retention": true, "storage": true}
+
+// StreamDrift returns the names of the fields where a stream's current
+// configuration differs from want, sorted. A Duplicates of 0 accepts
+// whatever window the server chose.
+func StreamDrift(have *nats.StreamConfig, want StreamConfig) []string {
+	w := want.NATSConfig()
+	var drift []string
+	if !slices.Equal(have.Subjects, w.Subjects) {
+		drift = append(drift, "subjects")
+	}
+	if have.Retention != w.Retention {
+		drift = append(drift, "retention")
+	}
+	if have.Storage != w.Storage {
+		drift = append(drift, "storage")
+	}
+	if have.Discard != w.Discard {
+		drift = append(drift, "discard")
+	}
+	if have.Replicas != w.Replicas {
+		drift = append(drift, "replicas")
+	}
+	if have.MaxAge != w.MaxAge {
+		drift = append(drift, "maxAge")
+	}
+	if have.MaxMsgs != w.MaxMsgs {
+		drift = append(drift, "maxMsgs")
+	}
+	if have.MaxBytes != w.MaxBytes {
+		drift = append(drift, "maxBytes")
+	}
+	if have.MaxMsgSize != w.MaxMsgSize {
+		drift = append(drift, "maxMsgSize")
+	}
+	if w.Duplicates != 0 && have.Duplicates != w.Duplicates {
+		drift = append(drift, "duplicates")
+	}
+	sort.Strings(drift)
+	return drift
 }
 
 // RetentionPolicy defines how messages are retained.
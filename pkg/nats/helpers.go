@@ -18,6 +18,8 @@ package nats
 
 import (
 	"fmt"
+	"strings"
+	"unicode"
 )
 
 // TaskSubject constructs a NATS subject for publishing tasks to a knight.
@@ -26,6 +28,27 @@ func TaskSubject(prefix, domain, knight string) string {
 	return fmt.Sprintf("%s.tasks.%s.%s", prefix, domain, knight)
 }
 
+// AnyTaskSubject constructs the NATS subject for tasks not addressed to a
+// knight, which a RoundTable herald routes.
+// Format: {prefix}.tasks.any
+func AnyTaskSubject(prefix string) string {
+	return prefix + ".tasks.any"
+}
+
+// NotificationSubjectPrefix returns the prefix of the subjects chain step
+// notifications may publish to.
+// Format: {prefix}.notifications.
+func NotificationSubjectPrefix(prefix string) string {
+	return prefix + ".notifications."
+}
+
+// ControlSubject constructs a NATS subject for control messages (e.g. task
+// cancellation) addressed to a knight.
+// Format: {prefix}.control.{domain}.{knight}
+func ControlSubject(prefix, domain, knight string) string {
+	return fmt.Sprintf("%s.control.%s.%s", prefix, domain, knight)
+}
+
 // ResultSubject constructs a NATS subject for task results.
 // Format: {prefix}.results.{taskID}
 func ResultSubject(prefix, taskID string) string {
@@ -38,18 +61,129 @@ func ResultSubjectWildcard(prefix, taskPrefix string) string {
 	return fmt.Sprintf("%s.results.%s.*", prefix, taskPrefix)
 }
 
+// BriefingAckSubject constructs the subject a knight acknowledges a
+// mission briefing on.
+// Format: {prefix}.briefing.ack.{knight}
+func BriefingAckSubject(prefix, knight string) string {
+	return fmt.Sprintf("%s.briefing.ack.%s", prefix, knight)
+}
+
+// ChatSubject constructs the subject of a mission's collaboration channel.
+// Format: {prefix}.chat
+func ChatSubject(prefix string) string {
+	return fmt.Sprintf("%s.chat", prefix)
+}
+
+// DebateSubject constructs the subject a debating mission's arguments are
+// published to, one message per argument.
+// Format: {prefix}.debate
+func DebateSubject(prefix string) string {
+	return fmt.Sprintf("%s.debate", prefix)
+}
+
 // StreamSubject constructs a NATS subject pattern for stream capture.
 // Format: {prefix}.{streamType}.>
 func StreamSubject(prefix, streamType string) string {
 	return fmt.Sprintf("%s.%s.>", prefix, streamType)
 }
 
+// DomainStreamName returns the name of a domain's stream under the
+// PerDomain topology, with characters stream names can't hold replaced.
+// Format: {tasksStream}_{domain}
+func DomainStreamName(tasksStream, domain string) string {
+	return tasksStream + "_" + strings.Map(func(r rune) rune {
+		if r == '.' || r == '*' || r == '>' || r == '/' || r == '\\' || unicode.IsSpace(r) {
+			return '_'
+		}
+		return r
+	}, domain)
+}
+
+// DeadLetterStreamName returns the name of the dead-letter stream for a
+// tasks stream.
+// Format: {tasksStream}_dlq
+func DeadLetterStreamName(tasksStream string) string {
+	return tasksStream + "_dlq"
+}
+
+// AdvisoryStreamName returns the name of the stream capturing a tasks
+// stream's MAX_DELIVERIES advisories.
+// Format: {tasksStream}_dlq_advisories
+func AdvisoryStreamName(tasksStream string) string {
+	return tasksStream + "_dlq_advisories"
+}
+
+// DeadLetterSubject constructs the subject a dead-lettered task is copied
+// to, keeping its original subject below the prefix.
+// Format: {prefix}.dlq.{subject without prefix}
+func DeadLetterSubject(prefix, subject string) string {
+	return fmt.Sprintf("%s.dlq.%s", prefix, strings.TrimPrefix(subject, prefix+"."))
+}
+
+// MaxDeliveriesAdvisorySubject constructs the subject JetStream publishes
+// a stream's MAX_DELIVERIES advisories on.
+// Format: $JS.EVENT.ADVISORY.CONSUMER.MAX_DELIVERIES.{stream}.*
+func MaxDeliveriesAdvisorySubject(stream string) string {
+	return fmt.Sprintf("$JS.EVENT.ADVISORY.CONSUMER.MAX_DELIVERIES.%s.*", stream)
+}
+
+// KVBucketName returns the name of a RoundTable's KV bucket.
+// Format: {prefix}-{name}, with dots in the prefix replaced by dashes
+func KVBucketName(prefix, name string) string {
+	return fmt.Sprintf("%s-%s", strings.ReplaceAll(prefix, ".", "-"), name)
+}
+
+// FederationBucketName returns the KV bucket knights in other clusters put
+// their heartbeats in.
+// Format: {prefix}-federation
+func FederationBucketName(prefix string) string {
+	return KVBucketName(prefix, "federation")
+}
+
+// Kinds of message archived in a mission's audit stream.
+const (
+	AuditKindTask   = "task"
+	AuditKindResult = "result"
+)
+
+// auditToken makes a name safe to use as a single subject token.
+func auditToken(name string) string {
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+// AuditStreamName returns the name of a mission's audit stream.
+// Format: audit_{namespace}_{mission}
+func AuditStreamName(namespace, mission string) string {
+	return fmt.Sprintf("audit_%s_%s", auditToken(namespace), auditToken(mission))
+}
+
+// AuditStreamSubject constructs the subject pattern a mission's audit
+// stream captures.
+// Format: audit.{namespace}.{mission}.>
+func AuditStreamSubject(namespace, mission string) string {
+	return fmt.Sprintf("audit.%s.%s.>", auditToken(namespace), auditToken(mission))
+}
+
+// AuditSubject constructs the subject a mission's task or result is
+// archived under.
+// Format: audit.{namespace}.{mission}.{kind}.{taskID}
+func AuditSubject(namespace, mission, kind, taskID string) string {
+	return fmt.Sprintf("audit.%s.%s.%s.%s", auditToken(namespace), auditToken(mission), kind, taskID)
+}
+
 // ChainConsumerName generates a consumer name for chain result polling.
 // Format: chain-poll-{chainName}-{stepName}-{timestamp}
 func ChainConsumerName(chainName, stepName string) string {
 	return fmt.Sprintf("chain-poll-%s-%s", chainName, stepName)
 }
 
+// ChainTriggerConsumerName generates the durable consumer name for a
+// chain's NATS trigger.
+// Format: chain-trigger-{namespace}-{chainName}
+func ChainTriggerConsumerName(namespace, chainName string) string {
+	return fmt.Sprintf("chain-trigger-%s-%s", namespace, chainName)
+}
+
 // KnightConsumerName generates a consumer name for a knight.
 // Format: knight-{knightName}
 func KnightConsumerName(knightName string) string {
@@ -19,6 +19,9 @@ package nats
 import (
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
 )
 
 // TestTaskSubject tests task subject construction
@@ -63,6 +66,68 @@ func TestTaskSubject(t *testing.T) {
 	}
 }
 
+// TestAnyTaskSubject tests the herald's subject construction
+func TestAnyTaskSubject(t *testing.T) {
+	if got, want := AnyTaskSubject("fleet-a"), "fleet-a.tasks.any"; got != want {
+		t.Errorf("AnyTaskSubject() = %s, want %s", got, want)
+	}
+}
+
+func TestNotificationSubjectPrefix(t *testing.T) {
+	if got, want := NotificationSubjectPrefix("fleet-a"), "fleet-a.notifications."; got != want {
+		t.Errorf("NotificationSubjectPrefix() = %s, want %s", got, want)
+	}
+}
+
+func TestDomainStreamName(t *testing.T) {
+	for domain, want := range map[string]string{
+		"security":   "fleet_a_tasks_security",
+		"dev.ops":    "fleet_a_tasks_dev_ops",
+		"red team/1": "fleet_a_tasks_red_team_1",
+	} {
+		if got := DomainStreamName("fleet_a_tasks", domain); got != want {
+			t.Errorf("DomainStreamName(%q) = %s, want %s", domain, got, want)
+		}
+	}
+}
+
+// TestControlSubject tests control subject construction
+func TestControlSubject(t *testing.T) {
+	got := ControlSubject("fleet-a", "security", "galahad")
+	if want := "fleet-a.control.security.galahad"; got != want {
+		t.Errorf("ControlSubject() = %s, want %s", got, want)
+	}
+}
+
+// TestBriefingAckSubject tests briefing acknowledgment subject construction
+func TestBriefingAckSubject(t *testing.T) {
+	got := BriefingAckSubject("mission-quest", "galahad")
+	if want := "mission-quest.briefing.ack.galahad"; got != want {
+		t.Errorf("BriefingAckSubject() = %s, want %s", got, want)
+	}
+}
+
+// TestChatSubject tests mission chat subject construction
+func TestChatSubject(t *testing.T) {
+	if got, want := ChatSubject("mission-quest"), "mission-quest.chat"; got != want {
+		t.Errorf("ChatSubject() = %s, want %s", got, want)
+	}
+}
+
+// TestDebateSubject tests mission debate subject construction
+func TestDebateSubject(t *testing.T) {
+	if got, want := DebateSubject("mission-quest"), "mission-quest.debate"; got != want {
+		t.Errorf("DebateSubject() = %s, want %s", got, want)
+	}
+}
+
+// TestDeadLetterSubject tests dead-letter subject construction
+func TestDeadLetterSubject(t *testing.T) {
+	if got, want := DeadLetterSubject("fleet-a", "fleet-a.tasks.security.galahad"), "fleet-a.dlq.tasks.security.galahad"; got != want {
+		t.Errorf("DeadLetterSubject() = %s, want %s", got, want)
+	}
+}
+
 // TestResultSubject tests result subject construction
 func TestResultSubject(t *testing.T) {
 	tests := []struct {
@@ -245,6 +310,26 @@ func TestChainConsumerName(t *testing.T) {
 	}
 }
 
+// TestChainTriggerConsumerName tests chain trigger consumer name generation
+func TestChainTriggerConsumerName(t *testing.T) {
+	got := ChainTriggerConsumerName("ai", "triage")
+	if want := "chain-trigger-ai-triage"; got != want {
+		t.Errorf("ChainTriggerConsumerName() = %s, want %s", got, want)
+	}
+}
+
+func TestAuditSubjects(t *testing.T) {
+	if got, want := AuditStreamName("default", "red.team"), "audit_default_red_team"; got != want {
+		t.Errorf("AuditStreamName() = %s, want %s", got, want)
+	}
+	if got, want := AuditStreamSubject("default", "red.team"), "audit.default.red_team.>"; got != want {
+		t.Errorf("AuditStreamSubject() = %s, want %s", got, want)
+	}
+	if got, want := AuditSubject("default", "red.team", AuditKindTask, "t1"), "audit.default.red_team.task.t1"; got != want {
+		t.Errorf("AuditSubject() = %s, want %s", got, want)
+	}
+}
+
 // TestKnightConsumerName tests knight consumer name generation
 func TestKnightConsumerName(t *testing.T) {
 	tests := []struct {
@@ -331,3 +416,34 @@ func TestSubjectFormatConsistency(t *testing.T) {
 		}
 	})
 }
+
+// TestNewTaskMsg tests task message headers
+func TestNewTaskMsg(t *testing.T) {
+	deadline := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	msg, err := NewTaskMsg("fleet-a.tasks.security.galahad", TaskPayload{
+		TaskID:   "task-1",
+		Task:     "Scan",
+		Priority: 10,
+		Deadline: &deadline,
+	})
+	if err != nil {
+		t.Fatalf("NewTaskMsg() error = %v", err)
+	}
+	if got := msg.Header.Get(HeaderTaskPriority); got != "10" {
+		t.Errorf("priority header = %q, want 10", got)
+	}
+	if got := msg.Header.Get(HeaderTaskDeadline); got != "2026-03-01T12:00:00Z" {
+		t.Errorf("deadline header = %q, want 2026-03-01T12:00:00Z", got)
+	}
+	if got := msg.Header.Get(nats.MsgIdHdr); got != "task-1" {
+		t.Errorf("message ID header = %q, want the task ID", got)
+	}
+
+	msg, err = NewTaskMsg("fleet-a.tasks.security.galahad", TaskPayload{TaskID: "task-2", Task: "Scan"})
+	if err != nil {
+		t.Fatalf("NewTaskMsg() error = %v", err)
+	}
+	if len(msg.Header) != 1 {
+		t.Errorf("headers = %v, want only the message ID", msg.Header)
+	}
+}
@@ -16,6 +16,79 @@ limitations under the License.
 
 package nats
 
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATS headers mirroring TaskPayload scheduling metadata, so knights can
+// order or drop tasks without decoding the body.
+const (
+	HeaderTaskPriority = "Roundtable-Priority"
+	HeaderTaskDeadline = "Roundtable-Deadline"
+)
+
+// NATS headers a dead-lettered task carries: the subject it was published
+// to, the consumer that gave up on it, and how often it was delivered.
+const (
+	HeaderDeadLetterSubject    = "Roundtable-Original-Subject"
+	HeaderDeadLetterConsumer   = "Roundtable-Consumer"
+	HeaderDeadLetterDeliveries = "Roundtable-Deliveries"
+)
+
+// NATS headers a task published to {prefix}.tasks.any carries to steer the
+// RoundTable herald: the domain it belongs to and the comma-separated skills
+// its knight needs.
+const (
+	HeaderRouteDomain = "Roundtable-Domain"
+	HeaderRouteSkills = "Roundtable-Skills"
+)
+
+// MaxDeliveriesAdvisory is the JetStream advisory published when a
+// consumer gives up on a message after maxDeliver attempts.
+type MaxDeliveriesAdvisory struct {
+	// Stream is the stream holding the message.
+	Stream string `json:"stream"`
+
+	// Consumer is the consumer that exhausted its deliveries.
+	Consumer string `json:"consumer"`
+
+	// StreamSeq is the message's stream sequence.
+	StreamSeq uint64 `json:"stream_seq"`
+
+	// Deliveries is the number of delivery attempts.
+	Deliveries uint64 `json:"deliveries"`
+}
+
+// KnightHeartbeat is the JSON value a knight in another cluster puts in its
+// RoundTable's federation KV bucket, under {cluster}.{knight}, to stay a
+// member of the table.
+type KnightHeartbeat struct {
+	// Knight is the knight's name.
+	Knight string `json:"knight"`
+
+	// Cluster names the cluster the knight runs in.
+	Cluster string `json:"cluster"`
+
+	// Domain is the knight's domain (optional).
+	Domain string `json:"domain,omitempty"`
+
+	// Model is the knight's model (optional).
+	Model string `json:"model,omitempty"`
+
+	// Skills are the knight's skills (optional).
+	Skills []string `json:"skills,omitempty"`
+
+	// Ready reports whether the knight can take tasks.
+	Ready bool `json:"ready"`
+
+	// Timestamp is when the heartbeat was sent.
+	Timestamp time.Time `json:"timestamp"`
+}
+
 // TaskPayload is the JSON payload published to NATS for a chain step or knight task.
 type TaskPayload struct {
 	// TaskID is the unique task identifier.
@@ -34,6 +107,115 @@ type TaskPayload struct {
 
 	// Task is the task description or instruction to execute.
 	Task string `json:"task"`
+
+	// Model is a hint to run this task on a different model than the
+	// knight's configured one (optional). Knight runtimes that can't switch
+	// models ignore it.
+	Model string `json:"model,omitempty"`
+
+	// Priority orders tasks in a knight's local queue; higher runs first
+	// (optional, default 0).
+	Priority int32 `json:"priority,omitempty"`
+
+	// Deadline is when the task stops being useful (optional). Knights
+	// should skip a task whose deadline has already passed.
+	Deadline *time.Time `json:"deadline,omitempty"`
+
+	// AckSubject asks the knight to publish an acknowledgment to this
+	// subject on receiving the task (optional). Missions use it to confirm
+	// every knight got the briefing.
+	AckSubject string `json:"ackSubject,omitempty"`
+
+	// ChatSubject is the mission collaboration channel the knight may
+	// publish ChatMessages to and subscribe on to hear the other mission
+	// knights (optional).
+	ChatSubject string `json:"chatSubject,omitempty"`
+}
+
+// ChatMessage is the JSON payload knights exchange on a mission's chat
+// subject.
+type ChatMessage struct {
+	// Knight is the name of the knight posting the message.
+	Knight string `json:"knight,omitempty"`
+
+	// Message is the message text.
+	Message string `json:"message"`
+
+	// Timestamp is when the message was posted.
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
+// DebateArgument is the JSON payload of an argument published to a
+// mission's debate subject.
+type DebateArgument struct {
+	// Round is the debate round, starting at 1.
+	Round int32 `json:"round"`
+
+	// Knight is the name of the arguing knight.
+	Knight string `json:"knight"`
+
+	// Position is the side the knight argues.
+	Position string `json:"position"`
+
+	// Argument is the knight's argument for the round.
+	Argument string `json:"argument"`
+}
+
+// NewTaskMsg builds the message publishing a task: the JSON payload plus
+// priority and deadline headers when set. The task ID is sent as the
+// Nats-Msg-Id, so JetStream drops a republish of the same task within the
+// stream's duplicate window.
+func NewTaskMsg(subject string, payload TaskPayload) (*nats.Msg, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	if payload.TaskID != "" {
+		msg.Header.Set(nats.MsgIdHdr, payload.TaskID)
+	}
+	if payload.Priority != 0 {
+		msg.Header.Set(HeaderTaskPriority, strconv.Itoa(int(payload.Priority)))
+	}
+	if payload.Deadline != nil {
+		msg.Header.Set(HeaderTaskDeadline, payload.Deadline.UTC().Format(time.RFC3339))
+	}
+	return msg, nil
+}
+
+// PublishTask publishes a task with the headers NewTaskMsg sets.
+func PublishTask(c Client, subject string, payload TaskPayload) error {
+	msg, err := NewTaskMsg(subject, payload)
+	if err != nil {
+		return err
+	}
+	return c.PublishMsg(msg)
+}
+
+// TaskControlCancel is the TaskControl action asking a knight to abort a task.
+const TaskControlCancel = "cancel"
+
+// TaskControl is the JSON payload published on a knight's control subject.
+// Knights abort the matching in-flight task instead of running it to completion.
+type TaskControl struct {
+	// Action is the control action, e.g. "cancel".
+	Action string `json:"action"`
+
+	// TaskID is the task the action applies to.
+	TaskID string `json:"taskId"`
+
+	// ChainName is the name of the chain the task belongs to (optional).
+	ChainName string `json:"chainName,omitempty"`
+
+	// StepName is the name of the chain step (optional).
+	StepName string `json:"stepName,omitempty"`
+
+	// RunID identifies the chain run the task belongs to (optional).
+	RunID string `json:"runId,omitempty"`
+
+	// Reason is a human-readable explanation (optional).
+	Reason string `json:"reason,omitempty"`
 }
 
 // TaskResult is the JSON payload received from NATS for a completed task.
@@ -56,6 +238,24 @@ type TaskResult struct {
 
 	// Success indicates task success (pi-knight format).
 	Success *bool `json:"success,omitempty"`
+
+	// CostUSD is the task's cost in USD, if reported (controller format).
+	CostUSD float64 `json:"costUsd,omitempty"`
+
+	// CostUSD2 is the task's cost in USD (pi-knight format using snake_case).
+	CostUSD2 float64 `json:"cost_usd,omitempty"`
+
+	// InputTokens is the number of input tokens used (controller format).
+	InputTokens int64 `json:"inputTokens,omitempty"`
+
+	// InputTokens2 is the number of input tokens used (pi-knight format).
+	InputTokens2 int64 `json:"input_tokens,omitempty"`
+
+	// OutputTokens is the number of output tokens used (controller format).
+	OutputTokens int64 `json:"outputTokens,omitempty"`
+
+	// OutputTokens2 is the number of output tokens used (pi-knight format).
+	OutputTokens2 int64 `json:"output_tokens,omitempty"`
 }
 
 // GetTaskID returns the task ID from whichever field was populated.
@@ -76,6 +276,30 @@ func (r *TaskResult) GetOutput() string {
 	return r.Result
 }
 
+// GetCostUSD returns the reported cost from whichever field was populated.
+func (r *TaskResult) GetCostUSD() float64 {
+	if r.CostUSD != 0 {
+		return r.CostUSD
+	}
+	return r.CostUSD2
+}
+
+// GetInputTokens returns the input token count from whichever field was populated.
+func (r *TaskResult) GetInputTokens() int64 {
+	if r.InputTokens != 0 {
+		return r.InputTokens
+	}
+	return r.InputTokens2
+}
+
+// GetOutputTokens returns the output token count from whichever field was populated.
+func (r *TaskResult) GetOutputTokens() int64 {
+	if r.OutputTokens != 0 {
+		return r.OutputTokens
+	}
+	return r.OutputTokens2
+}
+
 // GetError returns the error message, checking both explicit error field and success boolean.
 // This handles compatibility between controller and pi-knight message formats.
 func (r *TaskResult) GetError() string {
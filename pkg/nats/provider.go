@@ -28,6 +28,9 @@ import (
 // This reduces connection overhead and ensures consistent NATS configuration.
 type Provider struct {
 	client Client
+	// fleets holds clients for NATS servers other than the default one,
+	// or reached with their own credentials, keyed by Config.fingerprint.
+	fleets map[string]Client
 	mu     sync.Mutex
 	config Config
 	log    logr.Logger
@@ -64,6 +67,12 @@ func (p *Provider) Client() (Client, error) {
 		return p.client, nil
 	}
 
+	if p.client != nil {
+		if err := p.client.Close(); err != nil {
+			p.log.Error(err, "Failed to close stale shared NATS client")
+		}
+	}
+
 	// Create new client
 	p.log.Info("Creating shared NATS client", "url", p.config.URL)
 	p.client = NewClient(p.config, p.log)
@@ -77,18 +86,92 @@ func (p *Provider) Client() (Client, error) {
 	return p.client, nil
 }
 
-// Close closes the shared NATS connection.
+// Connection is the NATS server a fleet uses and the credentials and TLS
+// settings it is reached with.
+type Connection struct {
+	// URL is the server URL; empty means the provider's own server.
+	URL string
+
+	// Auth holds the fleet's credentials.
+	Auth Auth
+
+	// TLS configures TLS for the fleet's server.
+	TLS *TLSConfig
+}
+
+// ClientFor returns a client for the NATS server at url, e.g. a fleet's
+// RoundTable spec.nats.url. An empty url, or the provider's own URL, returns
+// the shared client; other servers get one shared client per URL, connected
+// lazily like the default one. A provider wrapping an injected client always
+// returns that client.
+func (p *Provider) ClientFor(url string) (Client, error) {
+	return p.ClientForConnection(Connection{URL: url})
+}
+
+// ClientForConnection returns a client for a fleet's connection. Like
+// ClientFor, a connection without credentials or TLS to the provider's own
+// server uses the shared client; every other connection gets one client per
+// server and settings, so rotated credentials reconnect.
+func (p *Provider) ClientForConnection(conn Connection) (Client, error) {
+	config := p.config
+	if conn.URL != "" {
+		config.URL = conn.URL
+	}
+	config.Auth = conn.Auth
+	config.TLS = conn.TLS
+	if p.config.URL == "" || (config.URL == p.config.URL && !config.Secured()) {
+		return p.Client()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := config.fingerprint()
+	if c := p.fleets[key]; c != nil {
+		if c.IsConnected() {
+			return c, nil
+		}
+		// Close the stale client so its connection and goroutines go too.
+		if err := c.Close(); err != nil {
+			p.log.Error(err, "Failed to close stale NATS client for fleet server", "url", config.URL)
+		}
+		delete(p.fleets, key)
+	}
+
+	p.log.Info("Creating NATS client for fleet server", "url", config.URL, "secured", config.Secured())
+	c := NewClient(config, p.log)
+	if err := c.Connect(); err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS at %s: %w", config.URL, err)
+	}
+	if p.fleets == nil {
+		p.fleets = make(map[string]Client)
+	}
+	p.fleets[key] = c
+	return c, nil
+}
+
+// Close closes the shared NATS connection and any fleet connections.
 // Should be called during controller shutdown.
 func (p *Provider) Close() error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
+	var err error
+	for key, c := range p.fleets {
+		if closeErr := c.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+		delete(p.fleets, key)
+	}
+
 	if p.client == nil {
-		return nil
+		return err
 	}
 
 	p.log.Info("Closing shared NATS connection")
-	err := p.client.Close()
+	if closeErr := p.client.Close(); closeErr != nil {
+		err = closeErr
+	}
 	p.client = nil
 	return err
 }
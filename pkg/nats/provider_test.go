@@ -167,3 +167,43 @@ func TestProvider_MultipleClose(t *testing.T) {
 		t.Error("Provider should not be connected after multiple Close() calls")
 	}
 }
+
+func TestProvider_ClientFor(t *testing.T) {
+	provider := NewProvider(Config{URL: "nats://default:4222"}, logr.Discard())
+
+	// Unreachable fleet servers fail without touching the shared client.
+	if _, err := provider.ClientFor("nats://127.0.0.1:1"); err == nil {
+		t.Error("ClientFor() for an unreachable server should fail")
+	}
+	if len(provider.fleets) != 0 {
+		t.Errorf("fleet clients = %d, want none cached after a failed connect", len(provider.fleets))
+	}
+}
+
+// staleClient is a cached client that has lost its connection.
+type staleClient struct {
+	Client
+	closed bool
+}
+
+func (c *staleClient) IsConnected() bool { return false }
+func (c *staleClient) Close() error      { c.closed = true; return nil }
+
+func TestProvider_ClientForClosesStaleClient(t *testing.T) {
+	provider := NewProvider(Config{URL: "nats://default:4222"}, logr.Discard())
+	conn := Connection{URL: "nats://127.0.0.1:1"}
+	config := provider.config
+	config.URL = conn.URL
+	stale := &staleClient{}
+	provider.fleets = map[string]Client{config.fingerprint(): stale}
+
+	if _, err := provider.ClientForConnection(conn); err == nil {
+		t.Error("ClientForConnection() for an unreachable server should fail")
+	}
+	if !stale.closed {
+		t.Error("stale fleet client was replaced without being closed")
+	}
+	if len(provider.fleets) != 0 {
+		t.Errorf("fleet clients = %d, want the stale client dropped", len(provider.fleets))
+	}
+}